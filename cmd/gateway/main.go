@@ -8,8 +8,11 @@ import (
 	"crypto/rand"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	base58 "github.com/jbenet/go-base58"
 	"github.com/minio/cli"
@@ -21,8 +24,11 @@ import (
 	"storj.io/storj/internal/fpath"
 	"storj.io/storj/pkg/cfgstruct"
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/miniogw"
 	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/uplink"
 )
@@ -34,6 +40,7 @@ type GatewayFlags struct {
 
 	Server miniogw.ServerConfig
 	Minio  miniogw.MinioConfig
+	Links  miniogw.LinkConfig
 
 	uplink.Config
 }
@@ -56,9 +63,17 @@ var (
 		Short: "Run the S3 gateway",
 		RunE:  cmdRun,
 	}
+	presignCmd = &cobra.Command{
+		Use:   "presign",
+		Short: "Print a presigned link to download sj://bucket/path from the links server",
+		RunE:  cmdPresign,
+	}
+
+	setupCfg   GatewayFlags
+	runCfg     GatewayFlags
+	presignCfg GatewayFlags
 
-	setupCfg GatewayFlags
-	runCfg   GatewayFlags
+	presignExpires *time.Duration
 
 	confDir     string
 	identityDir string
@@ -74,7 +89,10 @@ func init() {
 
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(setupCmd)
+	rootCmd.AddCommand(presignCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	cfgstruct.Bind(presignCmd.Flags(), &presignCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	presignExpires = presignCmd.Flags().Duration("expires", 24*time.Hour, "how long the link is valid for")
 	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
 
@@ -164,9 +182,43 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 			"Perhaps your configuration is invalid?\n%s", err)
 	}
 
+	if runCfg.Links.Address != "" {
+		go func() {
+			err := runCfg.serveLinks(ctx, identity)
+			zap.S().Errorf("Failed to serve presigned links: %v", err)
+		}()
+	}
+
 	return runCfg.Run(ctx, identity)
 }
 
+func cmdPresign(cmd *cobra.Command, args []string) (err error) {
+	if len(args) == 0 {
+		return fmt.Errorf("No object specified, use format sj://bucket/path")
+	}
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+	if src.IsLocal() || src.Path() == "" {
+		return fmt.Errorf("No object specified, use format sj://bucket/path")
+	}
+
+	linksURL := presignCfg.Links.URL
+	if linksURL == "" {
+		if presignCfg.Links.Address == "" {
+			return fmt.Errorf("No links server configured, set links.address")
+		}
+		linksURL = "http://" + presignCfg.Links.Address
+	}
+
+	signer := miniogw.NewLinkSigner([]byte(presignCfg.Minio.SecretKey))
+	fmt.Println(strings.TrimSuffix(linksURL, "/") + signer.Sign(src.Bucket(), src.Path(), time.Now().Add(*presignExpires)))
+
+	return nil
+}
+
 func generateKey() (key string, err error) {
 	var buf [20]byte
 	_, err = rand.Read(buf[:])
@@ -206,29 +258,53 @@ func (flags GatewayFlags) Run(ctx context.Context, identity *identity.FullIdenti
 }
 
 func (flags GatewayFlags) action(ctx context.Context, cliCtx *cli.Context, identity *identity.FullIdentity) (err error) {
-	gw, err := flags.NewGateway(ctx, identity)
+	metainfo, streams, err := flags.GetMetainfo(ctx, identity)
 	if err != nil {
 		return err
 	}
 
-	minio.StartGateway(cliCtx, miniogw.Logging(gw, zap.L()))
+	// minio doesn't route the bucket lifecycle requests to the gateway
+	miniogw.RegisterLifecycleHandler(zap.L(), metainfo)
+
+	minio.StartGateway(cliCtx, miniogw.Logging(flags.NewGateway(metainfo, streams), zap.L()))
 	return errs.New("unexpected minio exit")
 }
 
 // NewGateway creates a new minio Gateway
-func (flags GatewayFlags) NewGateway(ctx context.Context, identity *identity.FullIdentity) (gw minio.Gateway, err error) {
-	metainfo, streams, err := flags.GetMetainfo(ctx, identity)
-	if err != nil {
-		return nil, err
-	}
-
+func (flags GatewayFlags) NewGateway(metainfo storj.Metainfo, streams streams.Store) minio.Gateway {
 	return miniogw.NewStorjGateway(
 		metainfo,
 		streams,
 		storj.Cipher(flags.Enc.PathType),
 		flags.GetEncryptionScheme(),
 		flags.GetRedundancyScheme(),
-	), nil
+	)
+}
+
+// serveLinks serves the downloads of presigned links until ctx is canceled
+func (flags GatewayFlags) serveLinks(ctx context.Context, identity *identity.FullIdentity) error {
+	listener, err := net.Listen("tcp", flags.Links.Address)
+	if err != nil {
+		return err
+	}
+
+	handler := miniogw.NewLinkHandler(zap.L(), miniogw.NewLinkSigner([]byte(flags.Minio.SecretKey)),
+		func(ctx context.Context, caveat macaroon.Caveat) (*kvmetainfo.DB, streams.Store, error) {
+			restricted, err := flags.Config.Restrict(caveat)
+			if err != nil {
+				return nil, nil, err
+			}
+			return restricted.GetMetainfo(ctx, identity)
+		})
+
+	server := &http.Server{Handler: handler}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	fmt.Printf("Presigned links: %s\n", listener.Addr())
+	return server.Serve(listener)
 }
 
 func main() {
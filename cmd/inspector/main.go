@@ -17,6 +17,7 @@ import (
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	prompt "github.com/segmentio/go-prompt"
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
@@ -325,9 +326,7 @@ func GetStats(cmd *cobra.Command, args []string) (err error) {
 		return ErrRequest.Wrap(err)
 	}
 
-	fmt.Printf("Stats for ID %s:\n", nodeID)
-	fmt.Printf("AuditSuccessRatio: %f, AuditCount: %d, UptimeRatio: %f, UptimeCount: %d,\n",
-		res.AuditRatio, res.AuditCount, res.UptimeRatio, res.UptimeCount)
+	printStats(nodeID, res)
 	return nil
 }
 
@@ -361,13 +360,24 @@ func GetCSVStats(cmd *cobra.Command, args []string) (err error) {
 			return ErrRequest.Wrap(err)
 		}
 
-		fmt.Printf("Stats for ID %s:\n", nodeID)
-		fmt.Printf("AuditSuccessRatio: %f, AuditCount: %d, UptimeRatio: %f, UptimeCount: %d,\n",
-			res.AuditRatio, res.AuditCount, res.UptimeRatio, res.UptimeCount)
+		printStats(nodeID, res)
 	}
 	return nil
 }
 
+// printStats prints the stats of a node
+func printStats(nodeID storj.NodeID, res *pb.GetStatsResponse) {
+	fmt.Printf("Stats for ID %s:\n", nodeID)
+	fmt.Printf("AuditSuccessRatio: %f, AuditCount: %d, UptimeRatio: %f, UptimeCount: %d,\n",
+		res.AuditRatio, res.AuditCount, res.UptimeRatio, res.UptimeCount)
+	fmt.Printf("AuditReputation: %f (alpha %f, beta %f), UptimeReputation: %f (alpha %f, beta %f)\n",
+		res.AuditReputation, res.AuditReputationAlpha, res.AuditReputationBeta,
+		res.UptimeReputation, res.UptimeReputationAlpha, res.UptimeReputationBeta)
+	if res.Disqualified != nil {
+		fmt.Printf("Disqualified: %s\n", ptypes.TimestampString(res.Disqualified))
+	}
+}
+
 // CreateStats creates a node with stats in overlay
 func CreateStats(cmd *cobra.Command, args []string) (err error) {
 	i, err := NewInspector(*Addr, *IdentityPath)
@@ -0,0 +1,115 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/linksharing"
+	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/uplink"
+)
+
+// LinkSharingFlags configuration flags
+type LinkSharingFlags struct {
+	Identity identity.Config
+	Server   linksharing.Config
+
+	uplink.Config
+}
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "linksharing",
+		Short: "Link sharing server for objects shared with a serialized access",
+	}
+	runCmd = &cobra.Command{
+		Use:   "run",
+		Short: "Run the link sharing server",
+		RunE:  cmdRun,
+	}
+	setupCmd = &cobra.Command{
+		Use:         "setup",
+		Short:       "Create config files",
+		RunE:        cmdSetup,
+		Annotations: map[string]string{"type": "setup"},
+	}
+
+	runCfg   LinkSharingFlags
+	setupCfg LinkSharingFlags
+
+	confDir     string
+	identityDir string
+	isDev       bool
+)
+
+func init() {
+	defaultConfDir := fpath.ApplicationDir("storj", "linksharing")
+	defaultIdentityDir := fpath.ApplicationDir("storj", "identity", "linksharing")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for linksharing configuration")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &identityDir, "identity-dir", defaultIdentityDir, "main directory for linksharing identity credentials")
+	cfgstruct.DevFlag(rootCmd, &isDev, false, "use development and test configuration settings")
+	rootCmd.AddCommand(runCmd)
+	rootCmd.AddCommand(setupCmd)
+	cfgstruct.Bind(runCmd.Flags(), &runCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+}
+
+func cmdRun(cmd *cobra.Command, args []string) (err error) {
+	identity, err := runCfg.Identity.Load()
+	if err != nil {
+		zap.S().Fatal(err)
+	}
+
+	server, err := linksharing.NewServer(zap.L(), runCfg.Server,
+		func(ctx context.Context, access string) (*kvmetainfo.DB, streams.Store, error) {
+			config := runCfg.Config
+			config.Client.Access = access
+			return config.GetMetainfo(ctx, identity)
+		})
+	if err != nil {
+		return err
+	}
+
+	ctx := process.Ctx(cmd)
+	if err := process.InitMetricsWithCertPath(ctx, nil, runCfg.Identity.CertPath); err != nil {
+		zap.S().Error("Failed to initialize telemetry batcher: ", err)
+	}
+
+	return server.Run(ctx)
+}
+
+func cmdSetup(cmd *cobra.Command, args []string) (err error) {
+	setupDir, err := filepath.Abs(confDir)
+	if err != nil {
+		return err
+	}
+
+	valid, _ := fpath.IsValidSetupDir(setupDir)
+	if !valid {
+		return fmt.Errorf("linksharing configuration already exists (%v)", setupDir)
+	}
+
+	err = os.MkdirAll(setupDir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return process.SaveConfigWithAllDefaults(cmd.Flags(), filepath.Join(setupDir, "config.yaml"), nil)
+}
+
+func main() {
+	process.Exec(rootCmd)
+}
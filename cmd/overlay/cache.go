@@ -31,5 +31,5 @@ func (c cacheConfig) open(ctx context.Context) (cache *overlay.Cache, dbClose fu
 		}
 	}
 
-	return overlay.NewCache(zap.L(), database.OverlayCache(), overlay.NodeSelectionConfig{}), dbClose, nil
+	return overlay.NewCache(zap.L(), database.OverlayCache(), overlay.NodeSelectionConfig{}, nil), dbClose, nil
 }
@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/version"
 	"storj.io/storj/pkg/cfgstruct"
 	"storj.io/storj/pkg/process"
@@ -67,6 +68,13 @@ var (
 		Args:  cobra.MinimumNArgs(2),
 		RunE:  cmdNodeUsage,
 	}
+	projectLimitsCmd = &cobra.Command{
+		Use:   "project-limits [project-id]",
+		Short: "Show or change the usage limits of a project",
+		Long:  "Show the storage and bandwidth usage limits of a project, or change them with --storage and --bandwidth. A limit of 0 means the default limit of the satellite applies.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdProjectLimits,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
@@ -82,6 +90,11 @@ var (
 		Database string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
 		Output   string `help:"destination of report output" default:""`
 	}
+	projectLimitsCfg struct {
+		Database  string      `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+		Storage   memory.Size `help:"the storage limit of the project, 0 for the default limit" default:"0"`
+		Bandwidth memory.Size `help:"the monthly bandwidth limit of the project, 0 for the default limit" default:"0"`
+	}
 	confDir     string
 	identityDir string
 	isDev       bool
@@ -99,11 +112,13 @@ func init() {
 	rootCmd.AddCommand(qdiagCmd)
 	rootCmd.AddCommand(reportsCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
+	rootCmd.AddCommand(projectLimitsCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.Bind(diagCmd.Flags(), &diagCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.Bind(qdiagCmd.Flags(), &qdiagCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	cfgstruct.Bind(nodeUsageCmd.Flags(), &nodeUsageCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	cfgstruct.Bind(projectLimitsCmd.Flags(), &projectLimitsCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -226,11 +241,11 @@ func cmdQDiag(cmd *cobra.Command, args []string) (err error) {
 	// initialize the table header (fields)
 	const padding = 3
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, padding, ' ', tabwriter.AlignRight|tabwriter.Debug)
-	fmt.Fprintln(w, "Path\tLost Pieces\t")
+	fmt.Fprintln(w, "Path\tLost Pieces\tHealthy Pieces\tRepair Threshold\t")
 
 	// populate the row fields
 	for _, v := range list {
-		fmt.Fprint(w, v.GetPath(), "\t", v.GetLostPieces(), "\t")
+		fmt.Fprint(w, v.GetPath(), "\t", v.GetLostPieces(), "\t", v.GetNumHealthyPieces(), "\t", v.GetRepairThreshold(), "\t")
 	}
 
 	// display the data
@@ -0,0 +1,59 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// cmdProjectLimits shows the usage limits of a project, after changing the
+// limits given with the flags
+func cmdProjectLimits(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	projectID, err := uuid.Parse(args[0])
+	if err != nil {
+		return errs.New("invalid project id %q: %+v", args[0], err)
+	}
+
+	db, err := satellitedb.New(zap.L().Named("db"), projectLimitsCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	limits, err := db.Accounting().GetProjectLimits(ctx, *projectID)
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("storage") || flags.Changed("bandwidth") {
+		if flags.Changed("storage") {
+			limits.Storage = projectLimitsCfg.Storage
+		}
+		if flags.Changed("bandwidth") {
+			limits.Bandwidth = projectLimitsCfg.Bandwidth
+		}
+
+		err = db.Accounting().UpdateProjectLimits(ctx, *projectID, limits)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Limits of project %s (0 means the default limit):\n", projectID)
+	fmt.Printf("Storage: %s, Bandwidth: %s\n", limits.Storage, limits.Bandwidth)
+	return nil
+}
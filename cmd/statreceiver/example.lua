@@ -10,6 +10,10 @@ source = udpin("localhost:9000")
 --  * print() goes to stdout
 --  * db("sqlite3", path) goes to sqlite
 --  * db("postgres", connstring) goes to postgres
+--  * prometheus(address, expiration) serves the latest values on
+--    http://address/metrics for prometheus to scrape, the metrics not updated
+--    within expiration (like "10m") are dropped
+--  * influx(writeurl) goes to influxdb with the line protocol over http
 graphite_out = graphite("localhost:5555")
 db_out = mcopy(
   db("sqlite3", "db.db"),
@@ -27,6 +31,11 @@ metric_handlers = mcopy(
         "|hw\\.disk\\..*Avail" ..
         "|hw\\.network\\.stats\\..*\\.(tx|rx)_bytes\\.(deriv|val)",
       db_out)),
+  -- serve storagenode data to prometheus and send it to influxdb
+  appfilter("storagenode-prod",
+    mcopy(
+      prometheus("localhost:9099", "10m"),
+      influx("http://localhost:8086/write?db=statreceiver"))),
   -- just print uplink stuff
   appfilter("uplink-prod",
     print()))
@@ -0,0 +1,145 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// influxMaxBuffer is the size of the buffered lines, which triggers the write
+// before the next flush
+const influxMaxBuffer = 1 << 20
+
+// InfluxDest is a MetricDest that writes data to InfluxDB with the line
+// protocol over HTTP
+type InfluxDest struct {
+	writeURL string
+	client   *http.Client
+
+	mu      sync.Mutex
+	buf     *bytes.Buffer
+	stopped bool
+}
+
+// NewInfluxDest creates an InfluxDest with the write endpoint writeURL, like
+// "http://localhost:8086/write?db=storj". Because this function is called in a
+// Lua pipeline domain-specific language, the DSL wants an influx destination
+// to be flushing every few seconds, so this constructor will start that
+// process. Use Close to stop it.
+func NewInfluxDest(writeURL string) *InfluxDest {
+	rv := &InfluxDest{
+		writeURL: writeURL,
+		client:   &http.Client{Timeout: 30 * time.Second},
+		buf:      new(bytes.Buffer),
+	}
+	go rv.flush()
+	return rv
+}
+
+// Metric implements MetricDest
+func (d *InfluxDest) Metric(application, instance string, key []byte, val float64, ts time.Time) error {
+	// the line protocol supports only finite values
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return nil
+	}
+
+	// empty tag values aren't allowed, such tags are left out
+	var tags string
+	if application != "" {
+		tags += ",application=" + influxTag(application)
+	}
+	if instance != "" {
+		tags += ",instance=" + influxTag(instance)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, err := fmt.Fprintf(d.buf, "%s%s value=%s %d\n",
+		influxMeasurement(string(key)), tags,
+		strconv.FormatFloat(val, 'g', -1, 64), ts.UnixNano())
+	if err != nil {
+		return err
+	}
+
+	if d.buf.Len() >= influxMaxBuffer {
+		buf := d.buf
+		d.buf = new(bytes.Buffer)
+		go func() {
+			if err := d.write(buf); err != nil {
+				log.Printf("failed writing to influx: %v", err)
+			}
+		}()
+	}
+	return nil
+}
+
+// Close stops the flushing goroutine
+func (d *InfluxDest) Close() error {
+	d.mu.Lock()
+	d.stopped = true
+	d.mu.Unlock()
+	return nil
+}
+
+func (d *InfluxDest) flush() {
+	for {
+		time.Sleep(5 * time.Second)
+		d.mu.Lock()
+		if d.stopped {
+			d.mu.Unlock()
+			return
+		}
+		buf := d.buf
+		d.buf = new(bytes.Buffer)
+		d.mu.Unlock()
+
+		if buf.Len() == 0 {
+			continue
+		}
+		if err := d.write(buf); err != nil {
+			log.Printf("failed flushing: %v", err)
+		}
+	}
+}
+
+// write sends the lines to the write endpoint
+func (d *InfluxDest) write(lines io.Reader) (err error) {
+	resp, err := d.client.Post(d.writeURL, "text/plain; charset=utf-8", lines)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		if closeErr := resp.Body.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("influx write failed with %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// influxMeasurement escapes the measurement for the line protocol
+func influxMeasurement(val string) string {
+	return strings.NewReplacer(`,`, `\,`, ` `, `\ `, "\n", `\n`).Replace(val)
+}
+
+// influxTag escapes the tag value for the line protocol
+func influxTag(val string) string {
+	return strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `, "\n", `\n`).Replace(val)
+}
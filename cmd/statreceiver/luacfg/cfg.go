@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"reflect"
 	"sync"
 
 	lua "github.com/Shopify/go-lua"
@@ -33,9 +34,46 @@ func (scope *Scope) RegisterType(name string, example interface{}) error {
 }
 
 // RegisterVal adds the Go value 'value', including Go functions, to the Lua
-// scope.
+// scope. The Go functions returning an error as their last result raise it as
+// a Lua error instead of returning it to Lua.
 func (scope *Scope) RegisterVal(name string, value interface{}) error {
-	return scope.register(name, value, luar.PushValue)
+	return scope.register(name, raiseErrors(value), luar.PushValue)
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// raiseErrors wraps the function fn returning an error as its last result
+// into a function without it, which panics with the error. The panics are
+// turned into Lua errors by the Lua runtime.
+func raiseErrors(fn interface{}) interface{} {
+	fnValue := reflect.ValueOf(fn)
+	fnType := fnValue.Type()
+	if fnType.Kind() != reflect.Func || fnType.NumOut() == 0 || fnType.Out(fnType.NumOut()-1) != errorType {
+		return fn
+	}
+
+	in := make([]reflect.Type, fnType.NumIn())
+	for i := range in {
+		in[i] = fnType.In(i)
+	}
+	out := make([]reflect.Type, fnType.NumOut()-1)
+	for i := range out {
+		out[i] = fnType.Out(i)
+	}
+
+	wrapperType := reflect.FuncOf(in, out, fnType.IsVariadic())
+	return reflect.MakeFunc(wrapperType, func(args []reflect.Value) []reflect.Value {
+		var results []reflect.Value
+		if fnType.IsVariadic() {
+			results = fnValue.CallSlice(args)
+		} else {
+			results = fnValue.Call(args)
+		}
+		if err := results[len(results)-1]; !err.IsNil() {
+			panic(err.Interface().(error))
+		}
+		return results[:len(results)-1]
+	}).Interface()
 }
 
 func (scope *Scope) register(name string, val interface{}, pusher func(l *lua.State, val interface{}) error) error {
@@ -81,6 +81,8 @@ func Main(cmd *cobra.Command, args []string) error {
 		scope.RegisterVal("sanitize", NewSanitizer),
 		scope.RegisterVal("graphite", NewGraphiteDest),
 		scope.RegisterVal("db", NewDBDest),
+		scope.RegisterVal("prometheus", NewPrometheusDest),
+		scope.RegisterVal("influx", NewInfluxDest),
 		scope.RegisterVal("pbufprep", NewPacketBufPrep),
 		scope.RegisterVal("mbufprep", NewMetricBufPrep),
 	)
@@ -0,0 +1,208 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	prometheusContentType  = "text/plain; version=0.0.4; charset=utf-8"
+	openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// PrometheusDest is a MetricDest that keeps the latest value of every metric
+// per application and instance, and serves them to Prometheus scrapes on
+// the /metrics HTTP endpoint. Metrics that aren't updated for the expiration
+// time are considered stale and dropped.
+type PrometheusDest struct {
+	expiration time.Duration
+	server     *http.Server
+
+	mu      sync.Mutex
+	metrics map[prometheusSeries]prometheusSample
+	stopped bool
+}
+
+// prometheusSeries identifies a metric of an application instance
+type prometheusSeries struct {
+	name        string
+	application string
+	instance    string
+}
+
+// prometheusSample is the latest value of a series
+type prometheusSample struct {
+	val     float64
+	updated time.Time
+}
+
+// NewPrometheusDest creates a PrometheusDest serving on TCP address address.
+// expiration is a positive duration string, like "10m". Because this function
+// is called in a Lua pipeline domain-specific language, it starts the HTTP
+// server and the expiration of the stale metrics. Use Close to stop them.
+func NewPrometheusDest(address, expiration string) (*PrometheusDest, error) {
+	expirationDuration, err := time.ParseDuration(expiration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expiration %q: %v", expiration, err)
+	}
+	if expirationDuration <= 0 {
+		return nil, fmt.Errorf("invalid expiration %q: must be positive", expiration)
+	}
+
+	rv := &PrometheusDest{
+		expiration: expirationDuration,
+		metrics:    map[prometheusSeries]prometheusSample{},
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", rv)
+	rv.server = &http.Server{Addr: address, Handler: mux}
+
+	go func() {
+		err := rv.server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Printf("failed serving prometheus metrics: %v", err)
+		}
+	}()
+	go rv.expire()
+
+	return rv, nil
+}
+
+// Metric implements MetricDest
+func (d *PrometheusDest) Metric(application, instance string, key []byte, val float64, ts time.Time) error {
+	series := prometheusSeries{
+		name:        prometheusName(key),
+		application: application,
+		instance:    instance,
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.metrics[series] = prometheusSample{val: val, updated: time.Now()}
+	return nil
+}
+
+// ServeHTTP serves the metrics in the Prometheus text format, or in the
+// OpenMetrics format if the scraper accepts it
+func (d *PrometheusDest) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	openMetrics := strings.Contains(req.Header.Get("Accept"), "application/openmetrics-text")
+	if openMetrics {
+		w.Header().Set("Content-Type", openMetricsContentType)
+	} else {
+		w.Header().Set("Content-Type", prometheusContentType)
+	}
+
+	err := d.write(w, openMetrics, time.Now())
+	if err != nil {
+		log.Printf("failed writing prometheus metrics: %v", err)
+	}
+}
+
+// Close stops the HTTP server and the expiration goroutine
+func (d *PrometheusDest) Close() error {
+	d.mu.Lock()
+	d.stopped = true
+	d.mu.Unlock()
+	return d.server.Close()
+}
+
+// write writes the metrics, which aren't stale at now, to w
+func (d *PrometheusDest) write(w io.Writer, openMetrics bool, now time.Time) error {
+	type line struct {
+		series prometheusSeries
+		val    float64
+	}
+
+	d.mu.Lock()
+	lines := make([]line, 0, len(d.metrics))
+	for series, sample := range d.metrics {
+		if now.Sub(sample.updated) > d.expiration {
+			continue
+		}
+		lines = append(lines, line{series: series, val: sample.val})
+	}
+	d.mu.Unlock()
+
+	sort.Slice(lines, func(i, k int) bool {
+		a, b := lines[i].series, lines[k].series
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		if a.application != b.application {
+			return a.application < b.application
+		}
+		return a.instance < b.instance
+	})
+
+	metricType := "untyped"
+	if openMetrics {
+		metricType = "unknown"
+	}
+
+	buf := bufio.NewWriter(w)
+	for i, line := range lines {
+		if i == 0 || lines[i-1].series.name != line.series.name {
+			_, _ = fmt.Fprintf(buf, "# TYPE %s %s\n", line.series.name, metricType)
+		}
+		_, _ = fmt.Fprintf(buf, "%s{application=\"%s\",instance=\"%s\"} %s\n",
+			line.series.name,
+			prometheusLabelValue(line.series.application),
+			prometheusLabelValue(line.series.instance),
+			strconv.FormatFloat(line.val, 'g', -1, 64))
+	}
+	if openMetrics {
+		_, _ = fmt.Fprint(buf, "# EOF\n")
+	}
+	return buf.Flush()
+}
+
+func (d *PrometheusDest) expire() {
+	for {
+		time.Sleep(d.expiration)
+		d.mu.Lock()
+		if d.stopped {
+			d.mu.Unlock()
+			return
+		}
+		now := time.Now()
+		for series, sample := range d.metrics {
+			if now.Sub(sample.updated) > d.expiration {
+				delete(d.metrics, series)
+			}
+		}
+		d.mu.Unlock()
+	}
+}
+
+// prometheusName converts the metric key to a valid Prometheus metric name,
+// replacing the disallowed characters with underscores
+func prometheusName(key []byte) string {
+	name := append([]byte(nil), key...)
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == ':':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			name[i] = '_'
+		}
+	}
+	return string(name)
+}
+
+// prometheusLabelValue escapes the label value for the Prometheus text format
+func prometheusLabelValue(val string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(val)
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/process"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "storagenode-updater",
+		Short: "Storage node updater",
+	}
+	runCmd = &cobra.Command{
+		Use:   "run",
+		Short: "Run the storage node and keep it updated",
+		RunE:  cmdRun,
+	}
+
+	runCfg Config
+
+	confDir     string
+	identityDir string
+	isDev       bool
+)
+
+func init() {
+	defaultConfDir := fpath.ApplicationDir("storj", "storagenode-updater")
+	defaultIdentityDir := fpath.ApplicationDir("storj", "identity", "storagenode")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for storagenode-updater configuration")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &identityDir, "identity-dir", defaultIdentityDir, "main directory for storagenode identity credentials")
+	cfgstruct.DevFlag(rootCmd, &isDev, false, "use development and test configuration settings")
+	rootCmd.AddCommand(runCmd)
+	cfgstruct.Bind(runCmd.Flags(), &runCfg, isDev, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+}
+
+func cmdRun(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+	log := zap.L()
+
+	if runCfg.BinaryLocation == "" {
+		runCfg.BinaryLocation = defaultBinaryLocation()
+	}
+	if runCfg.NodeConfigDir == "" {
+		runCfg.NodeConfigDir = fpath.ApplicationDir("storj", "storagenode")
+	}
+
+	nodeID, err := identity.NodeIDFromCertPath(runCfg.CertPath)
+	if err != nil {
+		return err
+	}
+
+	nodeArgs := []string{"--config-dir", runCfg.NodeConfigDir, "--identity-dir", identityDir}
+	return NewUpdater(log, runCfg, nodeID, nodeArgs).Run(ctx)
+}
+
+// defaultBinaryLocation returns the location of the storage node executable
+// next to the updater executable
+func defaultBinaryLocation() string {
+	name := "storagenode"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return name
+	}
+	return filepath.Join(filepath.Dir(exe), name)
+}
+
+func main() {
+	process.Exec(rootCmd)
+}
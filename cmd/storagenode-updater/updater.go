@@ -0,0 +1,387 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/processgroup"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the updater
+	Error = errs.Class("storagenode-updater error")
+)
+
+// restartDelay is how long to wait before restarting the exited storage node
+const restartDelay = 10 * time.Second
+
+// Config contains the configuration of the updater
+type Config struct {
+	BinaryLocation string        `help:"the storage node executable location, next to the updater if empty" default:""`
+	NodeConfigDir  string        `help:"the storage node configuration directory, the default one if empty" default:""`
+	CertPath       string        `help:"path to the storage node identity certificate" default:"$IDENTITYDIR/identity.cert"`
+	HealthTimeout  time.Duration `help:"how long the updated storage node has to keep running to be considered healthy" default:"1m0s"`
+	StopTimeout    time.Duration `help:"how long to wait for the storage node to stop gracefully before killing it" default:"1m0s"`
+
+	Version version.Config
+}
+
+// Updater runs the storage node and updates it to the versions rolled out by
+// the version server. The updated storage node is rolled back if it fails its
+// health check.
+type Updater struct {
+	log    *zap.Logger
+	config Config
+	nodeID storj.NodeID
+	// args are the common arguments of the storage node commands
+	args []string
+
+	// node is the running storage node process, exited receives its result
+	node   *exec.Cmd
+	exited chan error
+
+	// failed is the version that was rolled back, it isn't installed again
+	// until the version server suggests another version
+	failed version.SemVer
+}
+
+// NewUpdater creates an Updater of the storage node with nodeID
+func NewUpdater(log *zap.Logger, config Config, nodeID storj.NodeID, args []string) *Updater {
+	return &Updater{
+		log:    log,
+		config: config,
+		nodeID: nodeID,
+		args:   args,
+	}
+}
+
+// Run runs the storage node and checks for the updates until ctx is canceled
+func (u *Updater) Run(ctx context.Context) (err error) {
+	if err := u.checkPublicKey(); err != nil {
+		return err
+	}
+	if err := u.start(); err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, u.stop()) }()
+
+	ticker := time.NewTicker(u.config.Version.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.update(ctx); err != nil {
+			u.log.Error("update failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-u.exited:
+			u.node = nil
+			u.log.Error("storage node exited, restarting", zap.Error(err))
+			if !sync2.Sleep(ctx, restartDelay) {
+				return nil
+			}
+			if err := u.start(); err != nil {
+				return err
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// update updates the storage node, if the version server suggests a newer
+// version for it
+func (u *Updater) update(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := u.checkPublicKey(); err != nil {
+		return err
+	}
+
+	allowed, err := version.QueryAllowedVersions(ctx, u.config.Version)
+	if err != nil {
+		return Error.New("version check failed: %v", err)
+	}
+	process := allowed.Processes.Storagenode
+
+	current, err := u.binaryVersion(ctx, u.config.BinaryLocation)
+	if err != nil {
+		return err
+	}
+
+	if !version.ShouldUpdate(process, current, u.nodeID) {
+		u.log.Debug("no update", zap.String("version", current.String()))
+		return nil
+	}
+
+	suggested := process.Suggested
+	if suggested.Version == u.failed {
+		u.log.Debug("skipping the rolled back version", zap.String("version", suggested.Version.String()))
+		return nil
+	}
+	u.failed = version.SemVer{}
+
+	u.log.Info("updating storage node",
+		zap.String("from", current.String()), zap.String("to", suggested.Version.String()))
+
+	hash := suggested.DownloadSHA256(runtime.GOOS, runtime.GOARCH)
+	if hash == "" {
+		return Error.New("version server has no SHA-256 hash of the %s_%s download", runtime.GOOS, runtime.GOARCH)
+	}
+
+	newBinary := u.config.BinaryLocation + ".new"
+	defer func() { _ = os.Remove(newBinary) }()
+
+	err = download(ctx, suggested.DownloadURL(runtime.GOOS, runtime.GOARCH), hash, newBinary)
+	if err != nil {
+		return err
+	}
+
+	downloaded, err := u.binaryVersion(ctx, newBinary)
+	if err != nil {
+		return err
+	}
+	if downloaded != suggested.Version {
+		return Error.New("downloaded storage node has version %s, expected %s",
+			downloaded.String(), suggested.Version.String())
+	}
+
+	return u.replace(ctx, newBinary, suggested.Version)
+}
+
+// checkPublicKey checks that the responses of the version server are verified,
+// the storage node isn't run or updated otherwise
+func (u *Updater) checkPublicKey() error {
+	if u.config.Version.PublicKey == "" {
+		return Error.New("the public key of the version server is required")
+	}
+	return nil
+}
+
+// replace restarts the storage node with newBinary of version newVersion,
+// the previous binary is restored if the new one fails the health check
+func (u *Updater) replace(ctx context.Context, newBinary string, newVersion version.SemVer) (err error) {
+	oldBinary := u.config.BinaryLocation + ".old"
+
+	if err := u.stop(); err != nil {
+		u.log.Error("failed to stop storage node", zap.Error(err))
+	}
+
+	if err := replaceFile(u.config.BinaryLocation, oldBinary); err != nil {
+		return errs.Combine(Error.Wrap(err), u.start())
+	}
+	if err := replaceFile(newBinary, u.config.BinaryLocation); err != nil {
+		return errs.Combine(Error.Wrap(err), replaceFile(oldBinary, u.config.BinaryLocation), u.start())
+	}
+
+	err = u.start()
+	if err == nil {
+		err = u.checkHealth(ctx)
+	}
+	if err == nil {
+		u.log.Info("storage node updated")
+		return nil
+	}
+
+	u.log.Error("updated storage node failed, rolling back", zap.Error(err))
+	u.failed = newVersion
+	return errs.Combine(
+		Error.New("updated storage node failed: %v", err),
+		u.stop(),
+		replaceFile(oldBinary, u.config.BinaryLocation),
+		u.start(),
+	)
+}
+
+// checkHealth checks if the started storage node keeps running for the
+// health timeout
+func (u *Updater) checkHealth(ctx context.Context) error {
+	timer := time.NewTimer(u.config.HealthTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-u.exited:
+		u.node = nil
+		return Error.New("storage node exited: %v", err)
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// start starts the storage node
+func (u *Updater) start() error {
+	cmd := exec.Command(u.config.BinaryLocation, append([]string{"run"}, u.args...)...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	processgroup.Setup(cmd)
+
+	if err := cmd.Start(); err != nil {
+		return Error.New("failed to start storage node: %v", err)
+	}
+
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	u.node, u.exited = cmd, exited
+	return nil
+}
+
+// stop stops the storage node gracefully, it is killed if it doesn't stop in
+// the stop timeout
+func (u *Updater) stop() error {
+	if u.node == nil {
+		return nil
+	}
+	defer func() { u.node = nil }()
+
+	// interrupting isn't supported on every platform
+	if err := u.node.Process.Signal(os.Interrupt); err != nil {
+		processgroup.Kill(u.node)
+	}
+
+	timer := time.NewTimer(u.config.StopTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-u.exited:
+		return nil
+	case <-timer.C:
+		u.log.Warn("storage node didn't stop gracefully, killing it")
+		processgroup.Kill(u.node)
+		<-u.exited
+		return nil
+	}
+}
+
+// binaryVersion returns the version reported by the storage node binary
+func (u *Updater) binaryVersion(ctx context.Context, binary string) (_ version.SemVer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, binary, append([]string{"version"}, u.args...)...)
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return version.SemVer{}, Error.New("failed to get the version of %s: %v", binary, err)
+	}
+
+	info, err := version.New(bytes.TrimSpace(stdout.Bytes()))
+	if err != nil {
+		return version.SemVer{}, Error.New("invalid version of %s: %v", binary, err)
+	}
+	return info.Version, nil
+}
+
+// download downloads the executable from url to dest, after verifying that
+// the hex encoded SHA-256 hash of the download is expectedHash. The
+// executable is extracted if url is a zip archive.
+func download(ctx context.Context, url, expectedHash, dest string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return Error.New("downloading %s failed: %s", url, resp.Status)
+	}
+
+	downloaded := dest + ".download"
+	defer func() { _ = os.Remove(downloaded) }()
+
+	file, err := os.OpenFile(downloaded, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(file, hash), resp.Body)
+	if err = errs.Combine(err, file.Close()); err != nil {
+		return Error.Wrap(err)
+	}
+
+	if actualHash := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(actualHash, expectedHash) {
+		return Error.New("downloaded %s has SHA-256 hash %s, expected %s", url, actualHash, expectedHash)
+	}
+
+	if !strings.HasSuffix(url, ".zip") {
+		if err := os.Chmod(downloaded, 0755); err != nil {
+			return Error.Wrap(err)
+		}
+		return Error.Wrap(replaceFile(downloaded, dest))
+	}
+
+	return Error.Wrap(unzipExecutable(downloaded, dest))
+}
+
+// unzipExecutable extracts the only file of the zip archive to dest
+func unzipExecutable(archive, dest string) (err error) {
+	zipReader, err := zip.OpenReader(archive)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, zipReader.Close()) }()
+
+	if len(zipReader.File) != 1 {
+		return errs.New("expected a single file in the archive, found %d", len(zipReader.File))
+	}
+
+	file, err := zipReader.File[0].Open()
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	return writeExecutable(dest, file)
+}
+
+// writeExecutable writes the executable from r to dest
+func writeExecutable(dest string, r io.Reader) (err error) {
+	file, err := os.OpenFile(dest, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(file, r)
+	return errs.Combine(err, file.Close())
+}
+
+// replaceFile moves src to dest, replacing dest if it exists
+func replaceFile(src, dest string) error {
+	// renaming doesn't replace the existing files on windows
+	if runtime.GOOS == "windows" {
+		if err := os.Remove(dest); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(src, dest)
+}
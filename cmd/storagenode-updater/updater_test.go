@@ -0,0 +1,260 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+)
+
+// nodeScript returns a shell script standing in for the storage node binary
+// with the version v. The broken storage node exits right after starting.
+func nodeScript(v string, broken bool) string {
+	sv, err := version.NewSemVer(v)
+	if err != nil {
+		panic(err)
+	}
+	run := "exec sleep 600"
+	if broken {
+		run = "exit 1"
+	}
+	return fmt.Sprintf("#!/bin/sh\nif [ \"$1\" = \"version\" ]; then\n  echo '{\"version\":{\"major\":%d,\"minor\":%d,\"patch\":%d}}'\n  exit 0\nfi\n%s\n",
+		sv.Major, sv.Minor, sv.Patch, run)
+}
+
+// versionServer is a version control server stand-in serving the storage node
+// binaries, its responses are signed by key
+type versionServer struct {
+	*httptest.Server
+	key crypto.PrivateKey
+
+	mu       sync.Mutex
+	process  version.Process
+	binaries map[string]string
+	// downloads counts the served binaries
+	downloads int
+}
+
+func newVersionServer(key crypto.PrivateKey) *versionServer {
+	server := &versionServer{key: key, binaries: map[string]string{}}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serve))
+	return server
+}
+
+func (server *versionServer) serve(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if r.URL.Path == "/" {
+		allowed := version.AllowedVersions{
+			Timestamp: time.Now(),
+			Expires:   time.Now().Add(time.Hour),
+		}
+		allowed.Processes.Storagenode = server.process
+
+		data, err := json.Marshal(allowed)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		signature, err := pkcrypto.HashAndSign(server.key, data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(version.SignatureHeader, base64.StdEncoding.EncodeToString(signature))
+		_, _ = w.Write(data)
+		return
+	}
+	binary, ok := server.binaries[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	server.downloads++
+	_, _ = w.Write([]byte(binary))
+}
+
+// suggest suggests the version v of the storage node rolled out to cursor
+// percentage of the nodes, the binary of the version is served
+func (server *versionServer) suggest(v string, cursor int, broken bool) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	sv, err := version.NewSemVer(v)
+	if err != nil {
+		panic(err)
+	}
+	server.process = version.Process{
+		Minimum: version.Version{Version: version.SemVer{Major: 0, Minor: 1, Patch: 0}},
+		Suggested: version.Version{
+			Version: *sv,
+			URL:     server.URL + "/{version}/{os}/storagenode",
+		},
+		Rollout: version.Rollout{Seed: "seed", Cursor: cursor},
+	}
+	server.setBinary(v, nodeScript(v, broken))
+}
+
+// setBinary serves binary as the version v of the storage node and sets its
+// hash in the suggested version
+func (server *versionServer) setBinary(v, binary string) {
+	server.binaries["/"+v+"/"+runtime.GOOS+"/storagenode"] = binary
+	hash := sha256.Sum256([]byte(binary))
+	server.process.Suggested.SHA256 = map[string]string{
+		runtime.GOOS + "_" + runtime.GOARCH: hex.EncodeToString(hash[:]),
+	}
+}
+
+func TestUpdater(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the storage node stand-ins are shell scripts")
+	}
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	key, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	publicKey, err := pkcrypto.PublicKeyToPKIX(pkcrypto.PublicKeyFromPrivate(key))
+	require.NoError(t, err)
+
+	server := newVersionServer(key)
+	defer server.Close()
+
+	binary := ctx.File("storagenode")
+	require.NoError(t, ioutil.WriteFile(binary, []byte(nodeScript("v0.1.0", false)), 0755))
+
+	config := Config{
+		BinaryLocation: binary,
+		HealthTimeout:  500 * time.Millisecond,
+		StopTimeout:    5 * time.Second,
+		Version: version.Config{
+			ServerAddress:  server.URL + "/",
+			RequestTimeout: 15 * time.Second,
+			CheckInterval:  time.Minute,
+		},
+	}
+
+	{ // the updater doesn't run without the public key
+		updater := NewUpdater(zaptest.NewLogger(t), config, storj.NodeID{}, nil)
+		require.Error(t, updater.Run(ctx))
+		assert.Nil(t, updater.node)
+	}
+
+	config.Version.PublicKey = base64.StdEncoding.EncodeToString(publicKey)
+	updater := NewUpdater(zaptest.NewLogger(t), config, storj.NodeID{}, nil)
+
+	require.NoError(t, updater.start())
+	defer ctx.Check(updater.stop)
+
+	assertVersion := func(expected string) {
+		t.Helper()
+		current, err := updater.binaryVersion(ctx, binary)
+		require.NoError(t, err)
+		assert.Equal(t, expected, current.String())
+		assert.NotNil(t, updater.node, "storage node isn't running")
+	}
+
+	// the node isn't selected by the rollout yet
+	server.suggest("v0.2.0", 0, false)
+	require.NoError(t, updater.update(ctx))
+	assertVersion("v0.1.0")
+
+	server.suggest("v0.2.0", 100, false)
+	require.NoError(t, updater.update(ctx))
+	assertVersion("v0.2.0")
+
+	// the broken version is rolled back
+	server.suggest("v0.3.0", 100, true)
+	require.Error(t, updater.update(ctx))
+	assertVersion("v0.2.0")
+
+	// the rolled back version isn't downloaded again
+	server.mu.Lock()
+	downloads := server.downloads
+	server.mu.Unlock()
+	require.NoError(t, updater.update(ctx))
+	assertVersion("v0.2.0")
+	server.mu.Lock()
+	assert.Equal(t, downloads, server.downloads)
+	server.mu.Unlock()
+
+	// the binary with another version than suggested isn't installed
+	server.suggest("v0.4.0", 100, false)
+	server.mu.Lock()
+	server.setBinary("v0.4.0", nodeScript("v0.3.5", false))
+	server.mu.Unlock()
+	require.Error(t, updater.update(ctx))
+	assertVersion("v0.2.0")
+
+	// the binary with another hash than suggested isn't run or installed
+	marker := ctx.File("tampered")
+	server.suggest("v0.5.0", 100, false)
+	server.mu.Lock()
+	server.binaries["/v0.5.0/"+runtime.GOOS+"/storagenode"] = "#!/bin/sh\ntouch " + marker + "\n"
+	server.mu.Unlock()
+	require.Error(t, updater.update(ctx))
+	assertVersion("v0.2.0")
+	_, err = os.Stat(marker)
+	assert.True(t, os.IsNotExist(err), "tampered binary was run")
+
+	// the version without the hash of the platform isn't installed
+	server.suggest("v0.6.0", 100, false)
+	server.mu.Lock()
+	server.process.Suggested.SHA256 = nil
+	server.mu.Unlock()
+	require.Error(t, updater.update(ctx))
+	assertVersion("v0.2.0")
+
+	// the rolled back version is tried again once another one was suggested
+	server.suggest("v0.3.0", 100, false)
+	require.NoError(t, updater.update(ctx))
+	assertVersion("v0.3.0")
+}
+
+func TestReplaceFile(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	src, dest := ctx.File("src"), ctx.File("dest")
+	require.NoError(t, ioutil.WriteFile(src, []byte("new"), 0644))
+	require.NoError(t, ioutil.WriteFile(dest, []byte("old"), 0644))
+
+	require.NoError(t, replaceFile(src, dest))
+	data, err := ioutil.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+	_, err = os.Stat(src)
+	assert.True(t, os.IsNotExist(err))
+
+	// dest doesn't need to exist
+	require.NoError(t, ioutil.WriteFile(src, []byte("newer"), 0644))
+	require.NoError(t, os.Remove(dest))
+	require.NoError(t, replaceFile(src, dest))
+	data, err = ioutil.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, "newer", string(data))
+}
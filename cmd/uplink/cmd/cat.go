@@ -4,20 +4,30 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/internal/fpath"
 	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/stream"
+)
+
+var (
+	catVersionFlag *uint32
 )
 
 func init() {
-	addCmd(&cobra.Command{
+	catCmd := addCmd(&cobra.Command{
 		Use:   "cat",
 		Short: "Copies a Storj object to standard out",
 		RunE:  catMain,
 	}, RootCmd)
+	catVersionFlag = catCmd.Flags().Uint32("version", 0, "version of the object to copy instead of the current one")
 }
 
 // catMain is the function executed when catCmd is called
@@ -37,6 +47,10 @@ func catMain(cmd *cobra.Command, args []string) (err error) {
 		return fmt.Errorf("No bucket specified, use format sj://bucket/")
 	}
 
+	if cmd.Flags().Changed("version") {
+		return catVersion(ctx, src, *catVersionFlag)
+	}
+
 	dst, err := fpath.New("-")
 	if err != nil {
 		return err
@@ -44,3 +58,22 @@ func catMain(cmd *cobra.Command, args []string) (err error) {
 
 	return download(ctx, src, dst, false)
 }
+
+// catVersion copies a specific version of a Storj object to standard out
+func catVersion(ctx context.Context, src fpath.FPath, version uint32) (err error) {
+	metainfo, streams, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	readOnlyStream, err := metainfo.GetObjectVersionStream(ctx, src.Bucket(), src.Path(), version)
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	download := stream.NewDownload(ctx, readOnlyStream, streams)
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	_, err = io.Copy(os.Stdout, download)
+	return err
+}
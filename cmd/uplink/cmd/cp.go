@@ -26,6 +26,7 @@ import (
 var (
 	progress *bool
 	expires  *string
+	resume   *bool
 )
 
 func init() {
@@ -36,6 +37,7 @@ func init() {
 	}, RootCmd)
 	progress = cpCmd.Flags().Bool("progress", true, "if true, show progress")
 	expires = cpCmd.Flags().String("expires", "", "optional expiration date of an object. Please use format (yyyy-mm-ddThh:mm:ssZhh:mm)")
+	resume = cpCmd.Flags().Bool("resume", false, "if true, resume the pending upload left by an interrupted upload with --resume, verifying the part already uploaded")
 }
 
 // upload transfers src from local machine to s3 compatible object dst
@@ -107,8 +109,11 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 		reader = bar.NewProxyReader(reader)
 	}
 
-	err = uploadStream(ctx, streams, obj, reader)
+	err = uploadStream(ctx, streams, obj, reader, *resume)
 	if err != nil {
+		if *resume {
+			return fmt.Errorf("upload failed, run the same command again to resume it: %v", err)
+		}
 		return err
 	}
 
@@ -121,13 +126,20 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 	return nil
 }
 
-func uploadStream(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader) error {
-	mutableStream, err := mutableObject.CreateStream(ctx)
+// uploadStream uploads reader to the object, continuing its pending upload
+// when resume is set
+func uploadStream(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader, resume bool) error {
+	newStream, newUpload := mutableObject.CreateStream, stream.NewUpload
+	if resume {
+		newStream, newUpload = mutableObject.ContinueStream, stream.NewResumedUpload
+	}
+
+	mutableStream, err := newStream(ctx)
 	if err != nil {
 		return err
 	}
 
-	upload := stream.NewUpload(ctx, mutableStream, streams)
+	upload := newUpload(ctx, mutableStream, streams)
 
 	_, err = io.Copy(upload, reader)
 
@@ -199,6 +211,7 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 }
 
 // copy copies s3 compatible object src to s3 compatible object dst
+// without transferring the object data
 func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err error) {
 	if src.IsLocal() {
 		return fmt.Errorf("source must be Storj URL: %s", src)
@@ -208,50 +221,24 @@ func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err error) {
 		return fmt.Errorf("destination must be Storj URL: %s", dst)
 	}
 
-	metainfo, streams, err := cfg.Metainfo(ctx)
+	metainfo, _, err := cfg.Metainfo(ctx)
 	if err != nil {
 		return err
 	}
 
-	readOnlyStream, err := metainfo.GetObjectStream(ctx, src.Bucket(), src.Path())
-	if err != nil {
-		return convertError(err, src)
-	}
-
-	download := stream.NewDownload(ctx, readOnlyStream, streams)
-	defer func() { err = errs.Combine(err, download.Close()) }()
-
-	var bar *progressbar.ProgressBar
-	var reader io.Reader
-	if *progress {
-		bar = progressbar.New(int(readOnlyStream.Info().Size)).SetUnits(progressbar.U_BYTES)
-		bar.Start()
-		reader = bar.NewProxyReader(download)
-	} else {
-		reader = download
-	}
-
 	// if destination object name not specified, default to source object name
 	if strings.HasSuffix(dst.Path(), "/") {
 		dst = dst.Join(src.Base())
 	}
 
-	createInfo := storj.CreateObject{
-		RedundancyScheme: cfg.GetRedundancyScheme(),
-		EncryptionScheme: cfg.GetEncryptionScheme(),
-	}
-	obj, err := metainfo.CreateObject(ctx, dst.Bucket(), dst.Path(), &createInfo)
+	_, err = metainfo.GetBucket(ctx, dst.Bucket())
 	if err != nil {
 		return convertError(err, dst)
 	}
 
-	err = uploadStream(ctx, streams, obj, reader)
+	_, err = metainfo.CopyObject(ctx, src.Bucket(), src.Path(), dst.Bucket(), dst.Path(), nil)
 	if err != nil {
-		return err
-	}
-
-	if bar != nil {
-		bar.Finish()
+		return convertError(err, src)
 	}
 
 	fmt.Printf("%s copied to %s\n", src.String(), dst.String())
@@ -0,0 +1,57 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/process"
+)
+
+func init() {
+	addCmd(&cobra.Command{
+		Use:   "import",
+		Short: "Save an access printed by the share command to the uplink config file",
+		RunE:  importMain,
+	}, RootCmd)
+}
+
+// importMain saves the access given as argument to the config file, where it
+// replaces the api key, satellite address and encryption key
+func importMain(cmd *cobra.Command, args []string) (err error) {
+	if len(args) == 0 {
+		return fmt.Errorf("No access specified")
+	}
+
+	access, err := libuplink.ParseAccess(args[0])
+	if err != nil {
+		return err
+	}
+
+	setupDir, err := filepath.Abs(confDir)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(setupDir, 0700)
+	if err != nil {
+		return err
+	}
+
+	err = process.SaveConfigWithAllDefaults(cmd.Flags(), filepath.Join(setupDir, "config.yaml"), map[string]interface{}{
+		"access": args[0],
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Access to %s imported\n", access.SatelliteAddr)
+
+	return nil
+}
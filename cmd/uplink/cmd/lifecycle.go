@@ -0,0 +1,190 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	lifecycleIDFlag         *string
+	lifecycleExpireDaysFlag *int
+	lifecycleAbortDaysFlag  *int
+)
+
+func init() {
+	lifecycleCmd := addCmd(&cobra.Command{
+		Use:   "lifecycle",
+		Short: "Manage the lifecycle rules of a bucket",
+	}, RootCmd)
+
+	addLifecycleCmd := addCmd(&cobra.Command{
+		Use:   "add",
+		Short: "Add a rule that deletes old objects or pending uploads under sj://bucket/prefix",
+		RunE:  addLifecycleRule,
+	}, lifecycleCmd)
+	lifecycleIDFlag = addLifecycleCmd.Flags().String("id", "", "the id of the rule, defaults to the prefix; an existing rule with the same id is replaced")
+	lifecycleExpireDaysFlag = addLifecycleCmd.Flags().Int("expire-days", 0, "delete objects older than this many days")
+	lifecycleAbortDaysFlag = addLifecycleCmd.Flags().Int("abort-days", 0, "delete pending uploads older than this many days")
+
+	addCmd(&cobra.Command{
+		Use:   "ls",
+		Short: "List the lifecycle rules of a bucket",
+		RunE:  listLifecycleRules,
+	}, lifecycleCmd)
+
+	addCmd(&cobra.Command{
+		Use:   "rm",
+		Short: "Remove a lifecycle rule of a bucket by its id",
+		RunE:  removeLifecycleRule,
+	}, lifecycleCmd)
+}
+
+// addLifecycleRule adds or replaces a lifecycle rule of a bucket
+func addLifecycleRule(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	dst, err := lifecycleBucketArg(args)
+	if err != nil {
+		return err
+	}
+
+	if *lifecycleExpireDaysFlag <= 0 && *lifecycleAbortDaysFlag <= 0 {
+		return fmt.Errorf("Specify --expire-days or --abort-days")
+	}
+
+	rule := storj.LifecycleRule{
+		ID:                        *lifecycleIDFlag,
+		Prefix:                    dst.Path(),
+		ExpirationDays:            *lifecycleExpireDaysFlag,
+		AbortIncompleteUploadDays: *lifecycleAbortDaysFlag,
+	}
+	if rule.ID == "" {
+		rule.ID = dst.Path()
+	}
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	rules, err := metainfo.GetBucketLifecycle(ctx, dst.Bucket())
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	replaced := false
+	for i := range rules {
+		if rules[i].ID == rule.ID {
+			rules[i] = rule
+			replaced = true
+		}
+	}
+	if !replaced {
+		rules = append(rules, rule)
+	}
+
+	err = metainfo.SetBucketLifecycle(ctx, dst.Bucket(), rules)
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	fmt.Printf("Lifecycle rule %q set on bucket %s\n", rule.ID, dst.Bucket())
+
+	return nil
+}
+
+// listLifecycleRules prints the lifecycle rules of a bucket
+func listLifecycleRules(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	dst, err := lifecycleBucketArg(args)
+	if err != nil {
+		return err
+	}
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	rules, err := metainfo.GetBucketLifecycle(ctx, dst.Bucket())
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	for _, rule := range rules {
+		fmt.Printf("%q prefix %q expire-days %d abort-days %d\n", rule.ID, rule.Prefix, rule.ExpirationDays, rule.AbortIncompleteUploadDays)
+	}
+
+	return nil
+}
+
+// removeLifecycleRule removes a lifecycle rule of a bucket
+func removeLifecycleRule(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	dst, err := lifecycleBucketArg(args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) < 2 {
+		return fmt.Errorf("No lifecycle rule id specified")
+	}
+	id := args[1]
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	rules, err := metainfo.GetBucketLifecycle(ctx, dst.Bucket())
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	kept := rules[:0]
+	for _, rule := range rules {
+		if rule.ID != id {
+			kept = append(kept, rule)
+		}
+	}
+	if len(kept) == len(rules) {
+		return fmt.Errorf("Lifecycle rule not found: %q", id)
+	}
+
+	err = metainfo.SetBucketLifecycle(ctx, dst.Bucket(), kept)
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	fmt.Printf("Lifecycle rule %q removed from bucket %s\n", id, dst.Bucket())
+
+	return nil
+}
+
+// lifecycleBucketArg parses the sj://bucket/prefix argument of the lifecycle commands
+func lifecycleBucketArg(args []string) (fpath.FPath, error) {
+	if len(args) == 0 {
+		return fpath.FPath{}, fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	dst, err := fpath.New(args[0])
+	if err != nil {
+		return fpath.FPath{}, err
+	}
+
+	if dst.IsLocal() {
+		return fpath.FPath{}, fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	return dst, nil
+}
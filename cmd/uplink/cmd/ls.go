@@ -17,6 +17,7 @@ import (
 
 var (
 	recursiveFlag *bool
+	versionsFlag  *bool
 )
 
 func init() {
@@ -26,6 +27,7 @@ func init() {
 		RunE:  list,
 	}, RootCmd)
 	recursiveFlag = lsCmd.Flags().Bool("recursive", false, "if true, list recursively")
+	versionsFlag = lsCmd.Flags().Bool("versions", false, "if true, list all versions of the objects")
 }
 
 func list(cmd *cobra.Command, args []string) error {
@@ -92,12 +94,20 @@ func listFiles(ctx context.Context, metainfo storj.Metainfo, prefix fpath.FPath,
 	startAfter := ""
 
 	for {
-		list, err := metainfo.ListObjects(ctx, prefix.Bucket(), storj.ListOptions{
+		options := storj.ListOptions{
 			Direction: storj.After,
 			Cursor:    startAfter,
 			Prefix:    prefix.Path(),
 			Recursive: *recursiveFlag,
-		})
+		}
+
+		var list storj.ObjectList
+		var err error
+		if *versionsFlag {
+			list, err = metainfo.ListObjectVersions(ctx, prefix.Bucket(), options)
+		} else {
+			list, err = metainfo.ListObjects(ctx, prefix.Bucket(), options)
+		}
 		if err != nil {
 			return err
 		}
@@ -109,6 +119,12 @@ func listFiles(ctx context.Context, metainfo storj.Metainfo, prefix fpath.FPath,
 			}
 			if object.IsPrefix {
 				fmt.Println("PRE", path)
+			} else if *versionsFlag {
+				kind := "OBJ"
+				if object.IsDeleteMarker {
+					kind = "DEL"
+				}
+				fmt.Printf("%v %v %12v %10v %v\n", kind, formatTime(object.Modified), object.Size, object.Version, path)
 			} else {
 				fmt.Printf("%v %v %12v %v\n", "OBJ", formatTime(object.Modified), object.Size, path)
 			}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/process"
+)
+
+func init() {
+	addCmd(&cobra.Command{
+		Use:   "mv",
+		Short: "Moves a Storj object to another location",
+		RunE:  moveObject,
+	}, RootCmd)
+}
+
+// moveObject moves a Storj object without transferring its data
+func moveObject(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	if len(args) == 0 {
+		return fmt.Errorf("No object specified to move")
+	}
+	if len(args) == 1 {
+		return fmt.Errorf("No destination specified")
+	}
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+
+	dst, err := fpath.New(args[1])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() || dst.IsLocal() {
+		return fmt.Errorf("Both the source and the destination must be Storj URLs")
+	}
+
+	// if destination object name not specified, default to source object name
+	if strings.HasSuffix(dst.Path(), "/") || dst.Path() == "" {
+		dst = dst.Join(src.Base())
+	}
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = metainfo.GetBucket(ctx, dst.Bucket())
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	_, err = metainfo.MoveObject(ctx, src.Bucket(), src.Path(), dst.Bucket(), dst.Path())
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	fmt.Printf("%s moved to %s\n", src, dst)
+
+	return nil
+}
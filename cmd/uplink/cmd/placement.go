@@ -0,0 +1,136 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/process"
+)
+
+func init() {
+	placementCmd := addCmd(&cobra.Command{
+		Use:   "placement",
+		Short: "Manage the countries the pieces of a bucket are stored in",
+	}, RootCmd)
+
+	addCmd(&cobra.Command{
+		Use:   "set",
+		Short: "Restrict the pieces uploaded to sj://bucket to storage nodes in the given countries, e.g. DE FR",
+		RunE:  setPlacement,
+	}, placementCmd)
+
+	addCmd(&cobra.Command{
+		Use:   "ls",
+		Short: "List the countries the pieces of a bucket are restricted to",
+		RunE:  listPlacement,
+	}, placementCmd)
+
+	addCmd(&cobra.Command{
+		Use:   "rm",
+		Short: "Remove the country restriction of a bucket",
+		RunE:  removePlacement,
+	}, placementCmd)
+}
+
+// setPlacement restricts the pieces of a bucket to the countries given as arguments
+func setPlacement(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	dst, err := placementBucketArg(args)
+	if err != nil {
+		return err
+	}
+
+	countries := args[1:]
+	if len(countries) == 0 {
+		return fmt.Errorf("No countries specified")
+	}
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = metainfo.SetBucketPlacement(ctx, dst.Bucket(), countries)
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	fmt.Printf("Bucket %s restricted to %s\n", dst.Bucket(), strings.Join(countries, " "))
+
+	return nil
+}
+
+// listPlacement prints the countries the pieces of a bucket are restricted to
+func listPlacement(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	dst, err := placementBucketArg(args)
+	if err != nil {
+		return err
+	}
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	countries, err := metainfo.GetBucketPlacement(ctx, dst.Bucket())
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	for _, country := range countries {
+		fmt.Println(country)
+	}
+
+	return nil
+}
+
+// removePlacement removes the country restriction of a bucket
+func removePlacement(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	dst, err := placementBucketArg(args)
+	if err != nil {
+		return err
+	}
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = metainfo.SetBucketPlacement(ctx, dst.Bucket(), nil)
+	if err != nil {
+		return convertError(err, dst)
+	}
+
+	fmt.Printf("Bucket %s is no longer restricted to countries\n", dst.Bucket())
+
+	return nil
+}
+
+// placementBucketArg parses the sj://bucket argument of the placement commands
+func placementBucketArg(args []string) (fpath.FPath, error) {
+	if len(args) == 0 {
+		return fpath.FPath{}, fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	dst, err := fpath.New(args[0])
+	if err != nil {
+		return fpath.FPath{}, err
+	}
+
+	if dst.IsLocal() || dst.Path() != "" {
+		return fpath.FPath{}, fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	return dst, nil
+}
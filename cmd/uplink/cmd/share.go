@@ -0,0 +1,130 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	shareReadonlyFlag  *bool
+	shareWriteonlyFlag *bool
+	shareNotBeforeFlag *string
+	shareNotAfterFlag  *string
+)
+
+func init() {
+	shareCmd := addCmd(&cobra.Command{
+		Use:   "share",
+		Short: "Print an access restricted to the given sj://bucket/prefix paths, to be used with the import command",
+		RunE:  shareMain,
+	}, RootCmd)
+	shareReadonlyFlag = shareCmd.Flags().Bool("readonly", false, "if true, the access can't be used to upload or delete objects")
+	shareWriteonlyFlag = shareCmd.Flags().Bool("writeonly", false, "if true, the access can't be used to download or list objects")
+	shareNotBeforeFlag = shareCmd.Flags().String("not-before", "", "optional date the access is valid from. Please use format (yyyy-mm-ddThh:mm:ssZhh:mm)")
+	shareNotAfterFlag = shareCmd.Flags().String("not-after", "", "optional date the access is valid until. Please use format (yyyy-mm-ddThh:mm:ssZhh:mm)")
+}
+
+// shareMain prints the serialized access restricted to the paths given as arguments
+func shareMain(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	if *shareReadonlyFlag && *shareWriteonlyFlag {
+		return fmt.Errorf("--readonly and --writeonly can't be used together")
+	}
+
+	caveat, err := macaroon.NewCaveat()
+	if err != nil {
+		return err
+	}
+	caveat.DisallowWrites = *shareReadonlyFlag
+	caveat.DisallowDeletes = *shareReadonlyFlag
+	caveat.DisallowReads = *shareWriteonlyFlag
+	caveat.DisallowLists = *shareWriteonlyFlag
+
+	caveat.NotBefore, err = parseShareTime(*shareNotBeforeFlag)
+	if err != nil {
+		return err
+	}
+	caveat.NotAfter, err = parseShareTime(*shareNotAfterFlag)
+	if err != nil {
+		return err
+	}
+
+	var prefixes []libuplink.SharePrefix
+	if len(args) > 0 {
+		metainfo, _, err := cfg.Metainfo(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, arg := range args {
+			path, err := fpath.New(arg)
+			if err != nil {
+				return err
+			}
+			if path.IsLocal() {
+				return fmt.Errorf("Path must be a Storj URL: %s", path)
+			}
+
+			bucket, err := metainfo.GetBucket(ctx, path.Bucket())
+			if err != nil {
+				return convertError(err, path)
+			}
+
+			prefixes = append(prefixes, libuplink.SharePrefix{
+				Bucket:     path.Bucket(),
+				Prefix:     path.Path(),
+				PathCipher: bucket.PathCipher.ToCipherSuite(),
+			})
+		}
+	}
+
+	access, err := cfg.Access()
+	if err != nil {
+		return err
+	}
+	if access.EncryptionAccess.Key == (storj.Key{}) && len(access.EncryptionAccess.Shared) == 0 {
+		return fmt.Errorf("No encryption key configured")
+	}
+
+	shared, err := access.Share(caveat, prefixes...)
+	if err != nil {
+		return err
+	}
+
+	serialized, err := shared.Serialize()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(serialized)
+
+	return nil
+}
+
+// parseShareTime parses the value of a time flag of the share command
+func parseShareTime(value string) (*timestamp.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return ptypes.TimestampProto(t)
+}
@@ -0,0 +1,478 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+const (
+	// syncModifiedKey is the object metadata key of the modification time of
+	// the uploaded local file
+	syncModifiedKey = "sync-modified"
+	// syncHashKey is the object metadata key of the hex encoded SHA-256 hash
+	// of the object content
+	syncHashKey = "sync-sha256"
+)
+
+var (
+	syncDryRun      *bool
+	syncDelete      *bool
+	syncChecksum    *bool
+	syncParallelism *int
+	syncInclude     *[]string
+	syncExclude     *[]string
+)
+
+func init() {
+	syncCmd := addCmd(&cobra.Command{
+		Use:   "sync",
+		Short: "Synchronizes a local directory to a Storj prefix or a Storj prefix to a local directory",
+		RunE:  syncMain,
+	}, RootCmd)
+	syncDryRun = syncCmd.Flags().Bool("dry-run", false, "if true, only print the changes without making them")
+	syncDelete = syncCmd.Flags().Bool("delete", false, "if true, delete the destination files missing from the source")
+	syncChecksum = syncCmd.Flags().Bool("checksum", false, "if true, compare the SHA-256 hashes of the contents instead of the modification times")
+	syncParallelism = syncCmd.Flags().Int("parallelism", 4, "number of files transferred in parallel")
+	syncInclude = syncCmd.Flags().StringSlice("include", nil, "only sync the files matching the glob, can be repeated")
+	syncExclude = syncCmd.Flags().StringSlice("exclude", nil, "skip the files matching the glob, can be repeated")
+}
+
+// syncFile is a file of a synced directory or prefix
+type syncFile struct {
+	// path is the slash separated path relative to the synced directory or prefix
+	path     string
+	size     int64
+	modified time.Time
+	// hash is the hex encoded SHA-256 hash of the content, empty if unknown
+	hash string
+}
+
+// syncOp is a change of the destination file at path
+type syncOp struct {
+	path   string
+	delete bool
+	// file is the source file copied to the destination
+	file syncFile
+}
+
+// syncFilter selects the synced files by the include and exclude globs. The
+// globs match the relative path or the base name of the files.
+type syncFilter struct {
+	include []string
+	exclude []string
+}
+
+// validate checks that the globs are valid
+func (filter syncFilter) validate() error {
+	for _, glob := range append(append([]string(nil), filter.include...), filter.exclude...) {
+		if _, err := path.Match(glob, ""); err != nil {
+			return fmt.Errorf("invalid glob %q: %v", glob, err)
+		}
+	}
+	return nil
+}
+
+// matches checks if the file at the relative path p is synced
+func (filter syncFilter) matches(p string) bool {
+	if len(filter.include) > 0 && !matchesAny(filter.include, p) {
+		return false
+	}
+	return !matchesAny(filter.exclude, p)
+}
+
+func matchesAny(globs []string, p string) bool {
+	for _, glob := range globs {
+		if ok, _ := path.Match(glob, p); ok {
+			return true
+		}
+		if ok, _ := path.Match(glob, path.Base(p)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// planSync returns the changes making the destination files dst the same as
+// the source files src, ordered by path. The files are compared by size and
+// modification time, or by the hashes of the contents if checksum is set. The
+// destination files missing from the source are deleted if deleteExtraneous
+// is set.
+func planSync(src, dst map[string]syncFile, checksum, deleteExtraneous bool) []syncOp {
+	var ops []syncOp
+	for p, file := range src {
+		existing, ok := dst[p]
+		if !ok || syncChanged(file, existing, checksum) {
+			ops = append(ops, syncOp{path: p, file: file})
+		}
+	}
+	if deleteExtraneous {
+		for p := range dst {
+			if _, ok := src[p]; !ok {
+				ops = append(ops, syncOp{path: p, delete: true})
+			}
+		}
+	}
+	sort.Slice(ops, func(i, k int) bool { return ops[i].path < ops[k].path })
+	return ops
+}
+
+// syncChanged checks if the source file differs from the destination file
+func syncChanged(src, dst syncFile, checksum bool) bool {
+	if src.size != dst.size {
+		return true
+	}
+	if checksum {
+		return src.hash == "" || src.hash != dst.hash
+	}
+	// the file systems may store the times with less precision
+	return src.modified.Unix() != dst.modified.Unix()
+}
+
+// listLocalSync returns the regular files in the directory root matching the
+// filter, the hashes of their contents are computed if checksum is set
+func listLocalSync(root string, filter syncFilter, checksum bool) (map[string]syncFile, error) {
+	files := make(map[string]syncFile)
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !filter.matches(rel) {
+			return nil
+		}
+
+		file := syncFile{path: rel, size: info.Size(), modified: info.ModTime()}
+		if checksum {
+			file.hash, err = hashLocalFile(p)
+			if err != nil {
+				return err
+			}
+		}
+		files[rel] = file
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return files, nil
+	}
+	return files, err
+}
+
+// hashLocalFile returns the hex encoded SHA-256 hash of the file content
+func hashLocalFile(p string) (_ string, err error) {
+	file, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// listRemoteSync returns the objects under the prefix in the bucket matching
+// the filter. The modification times and the hashes are taken from the object
+// metadata if stored.
+func listRemoteSync(ctx context.Context, metainfo storj.Metainfo, bucket, prefix string, filter syncFilter) (map[string]syncFile, error) {
+	files := make(map[string]syncFile)
+	startAfter := ""
+
+	for {
+		list, err := metainfo.ListObjects(ctx, bucket, storj.ListOptions{
+			Direction: storj.After,
+			Cursor:    startAfter,
+			Prefix:    prefix,
+			Recursive: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range list.Items {
+			if object.IsPrefix || !filter.matches(object.Path) {
+				continue
+			}
+
+			file := syncFile{
+				path:     object.Path,
+				size:     object.Size,
+				modified: object.Modified,
+				hash:     object.Metadata[syncHashKey],
+			}
+			if modified, err := time.Parse(time.RFC3339Nano, object.Metadata[syncModifiedKey]); err == nil {
+				file.modified = modified
+			}
+			files[object.Path] = file
+		}
+
+		if !list.More {
+			break
+		}
+		startAfter = list.Items[len(list.Items)-1].Path
+	}
+
+	return files, nil
+}
+
+// localSyncPath returns the local path of the file at the slash separated
+// relative path p in the directory root, the paths escaping root are rejected
+func localSyncPath(root, p string) (string, error) {
+	root = filepath.Clean(root)
+	localPath := filepath.Join(root, filepath.FromSlash(p))
+
+	rel, err := filepath.Rel(root, localPath)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("path %q is outside of %s", p, root)
+	}
+	return localPath, nil
+}
+
+// syncUpload uploads the local file to the object, storing its modification
+// time and hash in the object metadata
+func syncUpload(ctx context.Context, metainfo storj.Metainfo, streams streams.Store, src string, bucket, dst string, file syncFile) (err error) {
+	reader, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	metadata := map[string]string{
+		syncModifiedKey: file.modified.UTC().Format(time.RFC3339Nano),
+	}
+	if file.hash != "" {
+		metadata[syncHashKey] = file.hash
+	}
+
+	createInfo := storj.CreateObject{
+		Metadata:         metadata,
+		RedundancyScheme: cfg.GetRedundancyScheme(),
+		EncryptionScheme: cfg.GetEncryptionScheme(),
+	}
+	obj, err := metainfo.CreateObject(ctx, bucket, dst, &createInfo)
+	if err != nil {
+		return err
+	}
+
+	return uploadStream(ctx, streams, obj, reader, false)
+}
+
+// syncDownload downloads the object to the local file, setting its
+// modification time to the one of the object
+func syncDownload(ctx context.Context, metainfo storj.Metainfo, streams streams.Store, bucket, src string, dst string, file syncFile) (err error) {
+	readOnlyStream, err := metainfo.GetObjectStream(ctx, bucket, src)
+	if err != nil {
+		return err
+	}
+
+	download := stream.NewDownload(ctx, readOnlyStream, streams)
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+
+	// download to a temporary file, so an interrupted download doesn't
+	// leave a partial file behind
+	tmp, err := ioutil.TempFile(filepath.Dir(dst), "."+filepath.Base(dst)+".")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	_, err = io.Copy(tmp, download)
+	if err = errs.Combine(err, tmp.Close()); err != nil {
+		return err
+	}
+
+	if err := os.Chtimes(tmp.Name(), file.modified, file.modified); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dst)
+}
+
+// syncMain is the function executed when syncCmd is called
+func syncMain(cmd *cobra.Command, args []string) (err error) {
+	if len(args) < 2 {
+		return fmt.Errorf("Source and destination must be specified")
+	}
+	if *syncParallelism < 1 {
+		return fmt.Errorf("Parallelism must be at least 1")
+	}
+
+	ctx := process.Ctx(cmd)
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+	dst, err := fpath.New(args[1])
+	if err != nil {
+		return err
+	}
+
+	if src.IsLocal() == dst.IsLocal() {
+		return errors.New("One of the source or the destination must be a local directory and the other a Storj URL")
+	}
+
+	local, remote := src, dst
+	if dst.IsLocal() {
+		local, remote = dst, src
+	}
+
+	if info, err := os.Stat(local.Path()); err == nil && !info.IsDir() {
+		return fmt.Errorf("local path must be a directory: %s", local)
+	} else if err != nil && (src.IsLocal() || !os.IsNotExist(err)) {
+		return err
+	}
+
+	filter := syncFilter{include: *syncInclude, exclude: *syncExclude}
+	if err := filter.validate(); err != nil {
+		return err
+	}
+
+	metainfo, streams, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	bucket := remote.Bucket()
+	prefix := remote.Path()
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	_, err = metainfo.GetBucket(ctx, bucket)
+	if err != nil {
+		return convertError(err, remote)
+	}
+
+	localFiles, err := listLocalSync(local.Path(), filter, *syncChecksum)
+	if err != nil {
+		return err
+	}
+	remoteFiles, err := listRemoteSync(ctx, metainfo, bucket, prefix, filter)
+	if err != nil {
+		return convertError(err, remote)
+	}
+
+	upload := src.IsLocal()
+	var ops []syncOp
+	if upload {
+		ops = planSync(localFiles, remoteFiles, *syncChecksum, *syncDelete)
+	} else {
+		ops = planSync(remoteFiles, localFiles, *syncChecksum, *syncDelete)
+	}
+
+	if len(ops) == 0 {
+		fmt.Println("Nothing to sync")
+		return nil
+	}
+
+	// apply performs the change of the destination file
+	apply := func(op syncOp) (message string, err error) {
+		remotePath := fmt.Sprintf("sj://%s/%s", bucket, prefix+op.path)
+		localPath, err := localSyncPath(local.Path(), op.path)
+		if err != nil {
+			return fmt.Sprintf("Syncing %s", remotePath), err
+		}
+
+		switch {
+		case op.delete && upload:
+			if !*syncDryRun {
+				err = metainfo.DeleteObject(ctx, bucket, prefix+op.path)
+			}
+			return fmt.Sprintf("Deleted %s", remotePath), err
+		case op.delete:
+			if !*syncDryRun {
+				err = os.Remove(localPath)
+			}
+			return fmt.Sprintf("Deleted %s", localPath), err
+		case upload:
+			if !*syncDryRun {
+				err = syncUpload(ctx, metainfo, streams, localPath, bucket, prefix+op.path, op.file)
+			}
+			return fmt.Sprintf("Uploaded %s to %s", localPath, remotePath), err
+		default:
+			if !*syncDryRun {
+				err = syncDownload(ctx, metainfo, streams, bucket, prefix+op.path, localPath, op.file)
+			}
+			return fmt.Sprintf("Downloaded %s to %s", remotePath, localPath), err
+		}
+	}
+
+	var mu sync.Mutex
+	var failed int
+	report := func(message string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case err != nil:
+			failed++
+			fmt.Printf("Failed: %s: %v\n", message, err)
+		case *syncDryRun:
+			fmt.Printf("(dry run) %s\n", message)
+		default:
+			fmt.Println(message)
+		}
+	}
+
+	queue := make(chan syncOp)
+	var wg sync.WaitGroup
+	for i := 0; i < *syncParallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for op := range queue {
+				report(apply(op))
+			}
+		}()
+	}
+	for _, op := range ops {
+		queue <- op
+	}
+	close(queue)
+	wg.Wait()
+
+	if failed > 0 {
+		return fmt.Errorf("failed to sync %d of %d files", failed, len(ops))
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/identity"
+)
+
+func TestPlanSync(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Minute)
+
+	src := map[string]syncFile{
+		"same":         {path: "same", size: 10, modified: now, hash: "a"},
+		"resized":      {path: "resized", size: 20, modified: now, hash: "b"},
+		"touched":      {path: "touched", size: 10, modified: later, hash: "c"},
+		"rehashed":     {path: "rehashed", size: 10, modified: now, hash: "d"},
+		"dir/new":      {path: "dir/new", size: 10, modified: now, hash: "e"},
+		"nanoseconds":  {path: "nanoseconds", size: 10, modified: now.Truncate(time.Second), hash: "f"},
+		"unknown-hash": {path: "unknown-hash", size: 10, modified: now},
+	}
+	dst := map[string]syncFile{
+		"same":         {path: "same", size: 10, modified: now, hash: "a"},
+		"resized":      {path: "resized", size: 10, modified: now, hash: "b"},
+		"touched":      {path: "touched", size: 10, modified: now, hash: "c"},
+		"rehashed":     {path: "rehashed", size: 10, modified: now, hash: "x"},
+		"nanoseconds":  {path: "nanoseconds", size: 10, modified: now.Truncate(time.Second).Add(time.Millisecond), hash: "f"},
+		"unknown-hash": {path: "unknown-hash", size: 10, modified: now},
+		"extraneous":   {path: "extraneous", size: 10, modified: now},
+	}
+
+	paths := func(ops []syncOp) (copied, deleted []string) {
+		for _, op := range ops {
+			if op.delete {
+				deleted = append(deleted, op.path)
+			} else {
+				copied = append(copied, op.path)
+			}
+		}
+		return copied, deleted
+	}
+
+	copied, deleted := paths(planSync(src, dst, false, false))
+	assert.Equal(t, []string{"dir/new", "resized", "touched"}, copied)
+	assert.Empty(t, deleted)
+
+	copied, deleted = paths(planSync(src, dst, true, true))
+	assert.Equal(t, []string{"dir/new", "rehashed", "resized", "unknown-hash"}, copied)
+	assert.Equal(t, []string{"extraneous"}, deleted)
+}
+
+func TestSyncFilter(t *testing.T) {
+	filter := syncFilter{
+		include: []string{"*.jpg", "docs/*"},
+		exclude: []string{"tmp/*", "private.*"},
+	}
+	assert.NoError(t, filter.validate())
+
+	for p, matches := range map[string]bool{
+		"a.jpg":           true,
+		"photos/b.jpg":    true,
+		"docs/readme.txt": true,
+		"notes.txt":       false,
+		"tmp/c.jpg":       false,
+		"docs/private.md": false,
+	} {
+		assert.Equal(t, matches, filter.matches(p), p)
+	}
+
+	assert.True(t, syncFilter{}.matches("any/file"))
+	assert.Error(t, syncFilter{exclude: []string{"["}}.validate())
+}
+
+func TestLocalSyncPath(t *testing.T) {
+	root := filepath.Join("data", "root")
+
+	for p, expected := range map[string]string{
+		"a.txt":         filepath.Join(root, "a.txt"),
+		"dir/b.txt":     filepath.Join(root, "dir", "b.txt"),
+		"dir/../c.txt":  filepath.Join(root, "c.txt"),
+		"/abs/d.txt":    filepath.Join(root, "abs", "d.txt"),
+		"..e/f.txt":     filepath.Join(root, "..e", "f.txt"),
+		"":              "",
+		".":             "",
+		"..":            "",
+		"../escape":     "",
+		"dir/../../x":   "",
+		"dir/../../r/x": "",
+	} {
+		localPath, err := localSyncPath(root+string(filepath.Separator), p)
+		if expected == "" {
+			assert.Error(t, err, p)
+			continue
+		}
+		if assert.NoError(t, err, p) {
+			assert.Equal(t, expected, localPath, p)
+		}
+	}
+}
+
+func TestSync(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite, uplink := planet.Satellites[0], planet.Uplinks[0]
+
+		identityConfig := identity.Config{
+			CertPath: ctx.File("identity", "identity.cert"),
+			KeyPath:  ctx.File("identity", "identity.key"),
+		}
+		require.NoError(t, identityConfig.Save(uplink.Identity))
+
+		defer func(saved UplinkFlags) { cfg = saved }(cfg)
+		cfg.Identity = identityConfig
+		cfg.Config = uplink.GetConfig(satellite)
+		defer func() { *syncDelete = false }()
+
+		sync := func(src, dst string) error {
+			return syncMain(&cobra.Command{}, []string{src, dst})
+		}
+		writeFile := func(p, content string) {
+			require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+			require.NoError(t, ioutil.WriteFile(p, []byte(content), 0644))
+		}
+		assertFile := func(p, content string) {
+			data, err := ioutil.ReadFile(p)
+			if assert.NoError(t, err, p) {
+				assert.Equal(t, content, string(data), p)
+			}
+		}
+
+		// the bucket is created by the unrelated object
+		require.NoError(t, uplink.Upload(ctx, satellite, "bucket", "unrelated", []byte("unrelated")))
+
+		source := ctx.Dir("source")
+		writeFile(filepath.Join(source, "a.txt"), "a")
+		writeFile(filepath.Join(source, "dir", "b.txt"), "b")
+
+		{ // upload
+			require.NoError(t, sync(source, "sj://bucket/backup"))
+
+			data, err := uplink.Download(ctx, satellite, "bucket", "backup/a.txt")
+			require.NoError(t, err)
+			assert.Equal(t, "a", string(data))
+			data, err = uplink.Download(ctx, satellite, "bucket", "backup/dir/b.txt")
+			require.NoError(t, err)
+			assert.Equal(t, "b", string(data))
+		}
+
+		restored := ctx.Dir("restored")
+		{ // download
+			require.NoError(t, sync("sj://bucket/backup", restored))
+
+			assertFile(filepath.Join(restored, "a.txt"), "a")
+			assertFile(filepath.Join(restored, "dir", "b.txt"), "b")
+		}
+
+		{ // delete the files missing from the source
+			*syncDelete = true
+
+			require.NoError(t, os.Remove(filepath.Join(source, "a.txt")))
+			require.NoError(t, sync(source, "sj://bucket/backup"))
+
+			_, err := uplink.Download(ctx, satellite, "bucket", "backup/a.txt")
+			assert.Error(t, err)
+			_, err = uplink.Download(ctx, satellite, "bucket", "backup/dir/b.txt")
+			assert.NoError(t, err)
+
+			writeFile(filepath.Join(restored, "extra.txt"), "extra")
+			require.NoError(t, sync("sj://bucket/backup", restored))
+
+			_, err = os.Stat(filepath.Join(restored, "a.txt"))
+			assert.True(t, os.IsNotExist(err))
+			_, err = os.Stat(filepath.Join(restored, "extra.txt"))
+			assert.True(t, os.IsNotExist(err))
+			assertFile(filepath.Join(restored, "dir", "b.txt"), "b")
+
+			*syncDelete = false
+		}
+
+		{ // the objects outside of the local directory aren't downloaded
+			require.NoError(t, uplink.Upload(ctx, satellite, "bucket", "backup/../escaped.txt", []byte("escaped")))
+
+			assert.Error(t, sync("sj://bucket/backup", restored))
+
+			_, err := os.Stat(filepath.Join(filepath.Dir(restored), "escaped.txt"))
+			assert.True(t, os.IsNotExist(err))
+			assertFile(filepath.Join(restored, "dir", "b.txt"), "b")
+		}
+	})
+}
@@ -6,6 +6,7 @@ package testplanet
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -43,15 +44,20 @@ import (
 	"storj.io/storj/pkg/peertls/extensions"
 	"storj.io/storj/pkg/peertls/tlsopts"
 	"storj.io/storj/pkg/piecestore/psserver"
+	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/satellitedb"
 	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/storagenodedb"
@@ -97,8 +103,9 @@ type Planet struct {
 	StorageNodes   []*storagenode.Peer
 	Uplinks        []*Uplink
 
-	identities    *Identities
-	whitelistPath string // TODO: in-memory
+	identities       *Identities
+	whitelistPath    string // TODO: in-memory
+	versionPublicKey string // base64 encoded public key of VersionControl
 
 	run    errgroup.Group
 	cancel func()
@@ -427,6 +434,20 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 					AuditCount:            0,
 					NewNodeAuditThreshold: 0,
 					NewNodePercentage:     0,
+					// reputations are tracked, but nodes are never disqualified
+					AuditReputationAlpha0:  1,
+					AuditReputationBeta0:   0,
+					AuditReputationLambda:  0.95,
+					AuditReputationWeight:  1,
+					AuditReputationDQ:      0,
+					UptimeReputationAlpha0: 1,
+					UptimeReputationBeta0:  0,
+					UptimeReputationLambda: 0.99,
+					UptimeReputationWeight: 1,
+					UptimeReputationDQ:     0,
+					// all the nodes of the planet share an ip and an operator
+					DistinctIP:       false,
+					DistinctOperator: false,
 				},
 			},
 			Discovery: discovery.Config{
@@ -442,6 +463,9 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				Overlay:              true,
 				BwExpiration:         45,
 			},
+			MetainfoLoop: metainfo.LoopConfig{
+				CoalesceDuration: 1 * time.Second,
+			},
 			BwAgreement: bwagreement.Config{},
 			Checker: checker.Config{
 				Interval: 30 * time.Second,
@@ -449,12 +473,14 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 			Repairer: repairer.Config{
 				MaxRepair:    10,
 				Interval:     time.Hour,
+				Lease:        time.Hour,
 				MaxBufferMem: 4 * memory.MiB,
 			},
 			Audit: audit.Config{
 				MaxRetriesStatDB:  0,
 				Interval:          30 * time.Second,
 				MinBytesPerSecond: 1 * memory.KB,
+				Candidates:        100,
 			},
 			Tally: tally.Config{
 				Interval: 30 * time.Second,
@@ -462,6 +488,18 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 			Rollup: rollup.Config{
 				Interval:      2 * time.Minute,
 				MaxAlphaUsage: 25 * memory.GB,
+				// usage changes made by the tests are enforced immediately
+				UsageCacheExpiration: 0,
+			},
+			Lifecycle: lifecycle.Config{
+				Interval:  30 * time.Second,
+				BatchSize: 100,
+			},
+			GarbageCollection: gc.Config{
+				Interval:          time.Hour,
+				Enabled:           true,
+				InitialPieces:     10,
+				FalsePositiveRate: 0.1,
 			},
 			Mail: mailservice.Config{
 				SMTPServerAddress: "smtp.mail.example.com:587",
@@ -584,6 +622,9 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatelliteIDs []strin
 					Timeout:  time.Hour,
 				},
 			},
+			Collector: collector.Config{
+				Interval: time.Hour,
+			},
 			Version: planet.NewVersionConfig(),
 		}
 		if planet.config.Reconfigure.StorageNode != nil {
@@ -694,8 +735,28 @@ func (planet *Planet) newVersionControlServer() (peer *versioncontrol.Peer, err
 		return nil, err
 	}
 
+	key, err := pkcrypto.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := pkcrypto.PrivateKeyToPEM(key)
+	if err != nil {
+		return nil, err
+	}
+	keyPath := filepath.Join(dbDir, "signing.key")
+	if err := ioutil.WriteFile(keyPath, keyPEM, 0600); err != nil {
+		return nil, err
+	}
+	publicKey, err := pkcrypto.PublicKeyToPKIX(pkcrypto.PublicKeyFromPrivate(key))
+	if err != nil {
+		return nil, err
+	}
+	planet.versionPublicKey = base64.StdEncoding.EncodeToString(publicKey)
+
 	config := &versioncontrol.Config{
-		Address: "127.0.0.1:0",
+		Address:    "127.0.0.1:0",
+		SigningKey: keyPath,
+		Expiration: time.Hour,
 		Versions: versioncontrol.ServiceVersions{
 			Bootstrap:   "v0.0.1",
 			Satellite:   "v0.0.1",
@@ -734,6 +795,7 @@ func (planet *Planet) NewVersionConfig() version.Config {
 		ServerAddress:  fmt.Sprintf("http://%s/", planet.VersionControl.Addr()),
 		RequestTimeout: time.Second * 15,
 		CheckInterval:  time.Minute * 5,
+		PublicKey:      planet.versionPublicKey,
 	}
 }
 
@@ -17,6 +17,7 @@ import (
 	"storj.io/storj/pkg/auth/signing"
 	"storj.io/storj/pkg/cfgstruct"
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/peertls/tlsopts"
 	"storj.io/storj/pkg/storage/streams"
@@ -91,19 +92,30 @@ func (planet *Planet) newUplink(name string, storageNodeCount int) (*Uplink, err
 			return nil, err
 		}
 
+		secret, err := macaroon.NewSecret()
+		if err != nil {
+			return nil, err
+		}
+
 		_, err = consoleDB.APIKeys().Create(
 			context.Background(),
 			*key,
 			console.APIKeyInfo{
 				Name:      "root",
 				ProjectID: project.ID,
+				Secret:    secret,
 			},
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		apiKeys[satellite.ID()] = key.String()
+		apiKey, err := macaroon.NewAPIKey(key[:], secret).Serialize()
+		if err != nil {
+			return nil, err
+		}
+
+		apiKeys[satellite.ID()] = apiKey
 	}
 
 	uplink.APIKey = apiKeys
@@ -0,0 +1,57 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package version
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Rollout selects the nodes updating to the suggested version. Every node is
+// assigned to a percentile by its ID and the Seed, the nodes below the Cursor
+// percentage are selected. Raising the Cursor selects more nodes, while the
+// already selected ones stay selected.
+type Rollout struct {
+	// Seed should be changed for every rollout, so different nodes are the
+	// first ones to update
+	Seed   string `json:"seed"`
+	Cursor int    `json:"cursor"`
+}
+
+// Contains checks if the node is selected by the rollout
+func (rollout *Rollout) Contains(nodeID storj.NodeID) bool {
+	switch {
+	case rollout.Cursor <= 0:
+		return false
+	case rollout.Cursor >= 100:
+		return true
+	}
+	return rolloutPercentile(rollout.Seed, nodeID) < rollout.Cursor
+}
+
+// rolloutPercentile returns the percentile, from 0 to 99, of the node
+func rolloutPercentile(seed string, nodeID storj.NodeID) int {
+	mac := hmac.New(sha256.New, []byte(seed))
+	_, _ = mac.Write(nodeID.Bytes())
+	sum := mac.Sum(nil)
+	return int(binary.BigEndian.Uint64(sum[:8]) % 100)
+}
+
+// ShouldUpdate checks if the node running the current version should update
+// to the suggested version of the process. The nodes running a version older
+// than the minimum should always update, the others only when selected by the
+// rollout.
+func ShouldUpdate(process Process, current SemVer, nodeID storj.NodeID) bool {
+	suggested := process.Suggested.Version
+	if suggested.IsZero() || current.Compare(suggested) >= 0 {
+		return false
+	}
+	if current.Compare(process.Minimum.Version) < 0 {
+		return true
+	}
+	return process.Rollout.Contains(nodeID)
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package version_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestRolloutContains(t *testing.T) {
+	nodes := make([]storj.NodeID, 1000)
+	for i := range nodes {
+		_, _ = rand.Read(nodes[i][:])
+	}
+
+	selected := map[storj.NodeID]bool{}
+	for _, cursor := range []int{0, 10, 50, 90, 100} {
+		rollout := version.Rollout{Seed: "seed", Cursor: cursor}
+
+		count := 0
+		for _, node := range nodes {
+			if rollout.Contains(node) {
+				count++
+				selected[node] = true
+			} else {
+				// raising the cursor keeps the nodes selected
+				assert.False(t, selected[node], "node deselected at cursor %d", cursor)
+			}
+		}
+		assert.InDelta(t, cursor*len(nodes)/100, count, float64(len(nodes))/20, "cursor %d", cursor)
+	}
+}
+
+func TestShouldUpdate(t *testing.T) {
+	var nodeID storj.NodeID
+	_, _ = rand.Read(nodeID[:])
+
+	process := version.Process{
+		Minimum:   version.Version{Version: version.SemVer{Major: 0, Minor: 2, Patch: 0}},
+		Suggested: version.Version{Version: version.SemVer{Major: 0, Minor: 3, Patch: 1}},
+	}
+
+	for _, tt := range []struct {
+		current version.SemVer
+		cursor  int
+		update  bool
+	}{
+		{current: version.SemVer{Major: 0, Minor: 1, Patch: 9}, cursor: 0, update: true},
+		{current: version.SemVer{Major: 0, Minor: 2, Patch: 0}, cursor: 0, update: false},
+		{current: version.SemVer{Major: 0, Minor: 2, Patch: 0}, cursor: 100, update: true},
+		{current: version.SemVer{Major: 0, Minor: 3, Patch: 1}, cursor: 100, update: false},
+		{current: version.SemVer{Major: 1, Minor: 0, Patch: 0}, cursor: 100, update: false},
+	} {
+		process.Rollout.Cursor = tt.cursor
+		assert.Equal(t, tt.update, version.ShouldUpdate(process, tt.current, nodeID),
+			"current %s, cursor %d", tt.current.String(), tt.cursor)
+	}
+}
+
+func TestDownloadURL(t *testing.T) {
+	v := version.Version{
+		Version: version.SemVer{Major: 0, Minor: 14, Patch: 3},
+		URL:     "https://example.com/{version}/storagenode_{os}_{arch}.zip",
+	}
+	assert.Equal(t, "https://example.com/v0.14.3/storagenode_linux_arm.zip", v.DownloadURL("linux", "arm"))
+}
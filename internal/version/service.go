@@ -5,16 +5,37 @@ package version
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"sync"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrVerification is the error class for the version server responses that
+// can't be verified
+var ErrVerification = errs.Class("version verification error")
+
+// SignatureHeader is the HTTP header of the base64 encoded signature of the
+// version server response
+const SignatureHeader = "Storj-Signature"
+
+const (
+	// maxResponseSize is the maximum size of the version server response
+	maxResponseSize = 1 << 20
+	// maxClockSkew is how far in the future the timestamp of a response may be
+	maxClockSkew = 5 * time.Minute
 )
 
 // Config contains the necessary Information to check the Software Version
@@ -22,6 +43,10 @@ type Config struct {
 	ServerAddress  string        `help:"server address to check its version against" default:"https://version.alpha.storj.io"`
 	RequestTimeout time.Duration `help:"Request timeout for version checks" default:"0h1m0s"`
 	CheckInterval  time.Duration `help:"Interval to check the version" default:"0h15m0s"`
+	PublicKey      string        `help:"base64 encoded public key of the version server verifying its responses" default:""`
+	// SkipVerification accepts the responses without verifying them, it's
+	// meant only for testing
+	SkipVerification bool `internal:"true" help:"accept the version server responses without verifying their signature and expiration" default:"false"`
 }
 
 // Service contains the information and variables to ensure the Software is up to date
@@ -35,17 +60,22 @@ type Service struct {
 	checked sync2.Fence
 	mu      sync.Mutex
 	allowed bool
+	process *Process
 }
 
-// NewService creates a Version Check Client with default configuration
-func NewService(config Config, info Info, service string) (client *Service) {
+// NewService creates a Version Check Client with default configuration. The
+// release builds require the public key to verify the version server.
+func NewService(config Config, info Info, service string) (client *Service, err error) {
+	if info.Release && config.PublicKey == "" && !config.SkipVerification {
+		return nil, ErrVerification.New("the public key of the version server is required")
+	}
 	return &Service{
 		config:  config,
 		info:    info,
 		service: service,
 		Loop:    sync2.NewCycle(config.CheckInterval),
 		allowed: true,
-	}
+	}, nil
 }
 
 // CheckVersion checks to make sure the version is still okay, returning an error if not
@@ -59,7 +89,11 @@ func (srv *Service) CheckVersion(ctx context.Context) error {
 // CheckProcessVersion is not meant to be used for peers but is meant to be
 // used for other utilities
 func CheckProcessVersion(ctx context.Context, config Config, info Info, service string) error {
-	return NewService(config, info, service).CheckVersion(ctx)
+	srv, err := NewService(config, info, service)
+	if err != nil {
+		return err
+	}
+	return srv.CheckVersion(ctx)
 }
 
 // Run logs the current version information
@@ -84,6 +118,27 @@ func (srv *Service) IsAllowed() bool {
 	return srv.allowed
 }
 
+// Process returns the version information of the Service from the last
+// successful version check, ok is false if there is none
+func (srv *Service) Process() (process Process, ok bool) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if srv.process == nil {
+		return Process{}, false
+	}
+	return *srv.process, true
+}
+
+// ShouldUpdate returns whether the node should update to the suggested version,
+// see ShouldUpdate
+func (srv *Service) ShouldUpdate(nodeID storj.NodeID) bool {
+	process, ok := srv.Process()
+	if !ok {
+		return false
+	}
+	return ShouldUpdate(process, srv.info.Version, nodeID)
+}
+
 // CheckVersion checks if the client is running latest/allowed code
 func (srv *Service) checkVersion(ctx context.Context) (allowed bool) {
 	defer mon.Task()(&ctx)(nil)
@@ -99,13 +154,35 @@ func (srv *Service) checkVersion(ctx context.Context) (allowed bool) {
 		return true
 	}
 
-	accepted, err := srv.queryVersionFromControlServer(ctx)
+	accepted, err := QueryAllowedVersions(ctx, srv.config)
+	if ErrVerification.Has(err) {
+		// a response that can't be trusted doesn't allow anything
+		zap.S().Errorf("Failed to verify the version server response: %v", err)
+		return false
+	}
 	if err != nil {
 		// Log about the error, but dont crash the service and allow further operation
-		zap.S().Errorf("Failed to do periodic version check: ", err)
+		zap.S().Errorf("Failed to do periodic version check: %v", err)
 		return true
 	}
 
+	if process := getFieldProcess(&accepted.Processes, srv.service); process != nil && !process.Minimum.Version.IsZero() {
+		srv.mu.Lock()
+		srv.process = process
+		srv.mu.Unlock()
+
+		zap.S().Debugf("minimum version from Control Server: %s", process.Minimum.Version.String())
+		if srv.info.Version.Compare(process.Suggested.Version) < 0 {
+			zap.S().Infof("new version %s is available", process.Suggested.Version.String())
+		}
+		if srv.info.Version.Compare(process.Minimum.Version) >= 0 {
+			zap.S().Infof("running on version %s", srv.info.Version.String())
+			return true
+		}
+		zap.S().Errorf("running on outdated version %s, minimum is %s", srv.info.Version.String(), process.Minimum.Version.String())
+		return false
+	}
+
 	list := getFieldString(&accepted, srv.service)
 	zap.S().Debugf("allowed versions from Control Server: %v", list)
 
@@ -121,17 +198,23 @@ func (srv *Service) checkVersion(ctx context.Context) (allowed bool) {
 	return false
 }
 
-// QueryVersionFromControlServer handles the HTTP request to gather the allowed and latest version information
-func (srv *Service) queryVersionFromControlServer(ctx context.Context) (ver AllowedVersions, err error) {
+// QueryAllowedVersions handles the HTTP request to gather the allowed and latest version information
+// from the version server in config. The signature of the response is verified with the public key
+// of config, and the response is rejected if it has expired, unless the verification is skipped.
+func QueryAllowedVersions(ctx context.Context, config Config) (ver AllowedVersions, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if config.PublicKey == "" && !config.SkipVerification {
+		return AllowedVersions{}, ErrVerification.New("the public key of the version server is required")
+	}
+
 	// Tune Client to have a custom Timeout (reduces hanging software)
 	client := http.Client{
-		Timeout: srv.config.RequestTimeout,
+		Timeout: config.RequestTimeout,
 	}
 
 	// New Request that used the passed in context
-	req, err := http.NewRequest("GET", srv.config.ServerAddress, nil)
+	req, err := http.NewRequest("GET", config.ServerAddress, nil)
 	if err != nil {
 		return AllowedVersions{}, err
 	}
@@ -142,10 +225,79 @@ func (srv *Service) queryVersionFromControlServer(ctx context.Context) (ver Allo
 		return AllowedVersions{}, err
 	}
 
-	defer func() { _ = resp.Body.Close() }()
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return AllowedVersions{}, errs.New("unexpected status from version server: %s", resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return AllowedVersions{}, err
+	}
+
+	if !config.SkipVerification {
+		err = verifyResponse(config.PublicKey, data, resp.Header.Get(SignatureHeader))
+		if err != nil {
+			return AllowedVersions{}, err
+		}
+	}
+
+	err = json.Unmarshal(data, &ver)
+	if err != nil {
+		return AllowedVersions{}, err
+	}
+
+	if !config.SkipVerification {
+		err = checkExpiration(ver, time.Now())
+		if err != nil {
+			return AllowedVersions{}, err
+		}
+	}
 
-	err = json.NewDecoder(resp.Body).Decode(&ver)
-	return ver, err
+	return ver, nil
+}
+
+// checkExpiration checks that the response was created before now and hasn't expired
+func checkExpiration(ver AllowedVersions, now time.Time) error {
+	if ver.Timestamp.IsZero() || ver.Expires.IsZero() {
+		return ErrVerification.New("version server response has no timestamp or expiration")
+	}
+	if ver.Timestamp.After(now.Add(maxClockSkew)) {
+		return ErrVerification.New("version server response is from the future: %s", ver.Timestamp)
+	}
+	if !now.Before(ver.Expires) {
+		return ErrVerification.New("version server response expired at %s", ver.Expires)
+	}
+	return nil
+}
+
+// verifyResponse verifies the base64 encoded signature of the response data
+// with the base64 encoded public key
+func verifyResponse(publicKey string, data []byte, signature string) error {
+	if signature == "" {
+		return ErrVerification.New("version server response isn't signed")
+	}
+
+	keyData, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return ErrVerification.New("invalid public key: %v", err)
+	}
+	key, err := pkcrypto.PublicKeyFromPKIX(keyData)
+	if err != nil {
+		return ErrVerification.New("invalid public key: %v", err)
+	}
+
+	signatureData, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrVerification.New("invalid signature: %v", err)
+	}
+
+	err = pkcrypto.HashAndVerifySignature(key, data, signatureData)
+	if err != nil {
+		return ErrVerification.New("invalid version server response signature: %v", err)
+	}
+	return nil
 }
 
 // DebugHandler returns a json representation of the current version information for the binary
@@ -165,10 +317,26 @@ func (srv *Service) DebugHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getFieldProcess(processes *Processes, field string) *Process {
+	r := reflect.ValueOf(processes)
+	f := reflect.Indirect(r).FieldByName(field)
+	if !f.IsValid() {
+		return nil
+	}
+	process, ok := f.Interface().(Process)
+	if ok {
+		return &process
+	}
+	return nil
+}
+
 func getFieldString(array *AllowedVersions, field string) []SemVer {
 	r := reflect.ValueOf(array)
-	f := reflect.Indirect(r).FieldByName(field).Interface()
-	result, ok := f.([]SemVer)
+	f := reflect.Indirect(r).FieldByName(field)
+	if !f.IsValid() {
+		return nil
+	}
+	result, ok := f.Interface().([]SemVer)
 	if ok {
 		return result
 	}
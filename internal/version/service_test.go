@@ -0,0 +1,104 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package version_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/pkcrypto"
+)
+
+func TestQueryAllowedVersionsExpiration(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	key, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	publicKey, err := pkcrypto.PublicKeyToPKIX(pkcrypto.PublicKeyFromPrivate(key))
+	require.NoError(t, err)
+
+	var response version.AllowedVersions
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := json.Marshal(response)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		signature, err := pkcrypto.HashAndSign(key, data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(version.SignatureHeader, base64.StdEncoding.EncodeToString(signature))
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	config := version.Config{
+		ServerAddress:  server.URL + "/",
+		RequestTimeout: 15 * time.Second,
+		PublicKey:      base64.StdEncoding.EncodeToString(publicKey),
+	}
+
+	now := time.Now()
+	for _, tt := range []struct {
+		name      string
+		timestamp time.Time
+		expires   time.Time
+		valid     bool
+	}{
+		{"valid", now.Add(-time.Hour), now.Add(time.Hour), true},
+		{"missing timestamp", time.Time{}, now.Add(time.Hour), false},
+		{"missing expiration", now.Add(-time.Hour), time.Time{}, false},
+		{"expired", now.Add(-2 * time.Hour), now.Add(-time.Hour), false},
+		{"from the future", now.Add(time.Hour), now.Add(2 * time.Hour), false},
+	} {
+		response = version.AllowedVersions{Timestamp: tt.timestamp, Expires: tt.expires}
+
+		_, err := version.QueryAllowedVersions(ctx, config)
+		if tt.valid {
+			assert.NoError(t, err, tt.name)
+		} else {
+			assert.True(t, version.ErrVerification.Has(err), tt.name)
+		}
+	}
+
+	info := version.Info{Release: true}
+
+	// a response that can't be verified doesn't allow running
+	response = version.AllowedVersions{Timestamp: now.Add(-2 * time.Hour), Expires: now.Add(-time.Hour)}
+	service, err := version.NewService(config, info, "Storagenode")
+	require.NoError(t, err)
+	assert.Error(t, service.CheckVersion(ctx))
+	assert.False(t, service.IsAllowed())
+
+	// an unreachable version server doesn't stop running
+	unreachable := config
+	unreachable.ServerAddress = "http://127.0.0.1:1/"
+	service, err = version.NewService(unreachable, info, "Storagenode")
+	require.NoError(t, err)
+	assert.NoError(t, service.CheckVersion(ctx))
+}
+
+func TestNewServiceRequiresPublicKey(t *testing.T) {
+	_, err := version.NewService(version.Config{}, version.Info{Release: true}, "Storagenode")
+	assert.True(t, version.ErrVerification.Has(err))
+
+	_, err = version.NewService(version.Config{SkipVerification: true}, version.Info{Release: true}, "Storagenode")
+	assert.NoError(t, err)
+
+	// the development builds don't check their version
+	_, err = version.NewService(version.Config{}, version.Info{}, "Storagenode")
+	assert.NoError(t, err)
+}
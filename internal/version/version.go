@@ -44,13 +44,66 @@ type SemVer struct {
 	Patch int64 `json:"patch"`
 }
 
-// AllowedVersions provides a list of SemVer per Service
+// AllowedVersions provides a list of SemVer per Service and the version
+// information of the Processes
 type AllowedVersions struct {
 	Bootstrap   []SemVer
 	Satellite   []SemVer
 	Storagenode []SemVer
 	Uplink      []SemVer
 	Gateway     []SemVer
+
+	Processes Processes
+
+	// Timestamp is when the response was created and it isn't accepted
+	// after Expires, so that the old signed responses can't be replayed
+	Timestamp time.Time `json:"timestamp"`
+	Expires   time.Time `json:"expires"`
+}
+
+// Processes describes the versions of every Service
+type Processes struct {
+	Bootstrap   Process `json:"bootstrap"`
+	Satellite   Process `json:"satellite"`
+	Storagenode Process `json:"storagenode"`
+	Uplink      Process `json:"uplink"`
+	Gateway     Process `json:"gateway"`
+}
+
+// Process is the version information of a Service. The versions older than
+// Minimum aren't allowed to operate, the Suggested version is rolled out to
+// the nodes selected by the Rollout.
+type Process struct {
+	Minimum   Version `json:"minimum"`
+	Suggested Version `json:"suggested"`
+	Rollout   Rollout `json:"rollout"`
+}
+
+// Version is a version of a Service with its download URL
+type Version struct {
+	Version SemVer `json:"version"`
+	// URL is the template of the download URL, where {version}, {os} and
+	// {arch} are replaced
+	URL string `json:"url"`
+	// SHA256 contains the hex encoded SHA-256 hashes of the downloads keyed
+	// by the operating system and the architecture, like "linux_amd64"
+	SHA256 map[string]string `json:"sha256,omitempty"`
+}
+
+// DownloadURL returns the download URL of the version for the operating system
+// and the architecture, like runtime.GOOS and runtime.GOARCH
+func (v *Version) DownloadURL(goos, goarch string) string {
+	return strings.NewReplacer(
+		"{version}", v.Version.String(),
+		"{os}", goos,
+		"{arch}", goarch,
+	).Replace(v.URL)
+}
+
+// DownloadSHA256 returns the hex encoded SHA-256 hash of the download for the
+// operating system and the architecture, it's empty if there is none
+func (v *Version) DownloadSHA256(goos, goarch string) string {
+	return v.SHA256[goos+"_"+goarch]
 }
 
 // SemVerRegex is the regular expression used to parse a semantic version.
@@ -95,6 +148,35 @@ func (sem *SemVer) String() (version string) {
 	return fmt.Sprintf("v%d.%d.%d", sem.Major, sem.Minor, sem.Patch)
 }
 
+// IsZero checks if the version isn't set
+func (sem *SemVer) IsZero() bool {
+	return *sem == SemVer{}
+}
+
+// Compare compares the version to other, the result is negative if the
+// version is older, 0 if they are the same and positive if it is newer
+func (sem *SemVer) Compare(other SemVer) int {
+	switch {
+	case sem.Major != other.Major:
+		return compareInt64(sem.Major, other.Major)
+	case sem.Minor != other.Minor:
+		return compareInt64(sem.Minor, other.Minor)
+	default:
+		return compareInt64(sem.Patch, other.Patch)
+	}
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
 // New creates Version_Info from a json byte array
 func New(data []byte) (v Info, err error) {
 	err = json.Unmarshal(data, &v)
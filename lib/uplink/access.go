@@ -0,0 +1,158 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink
+
+import (
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// accessVersion is the version byte of serialized accesses
+const accessVersion = 0
+
+// Access bundles everything needed to access a project, or only the paths
+// shared from it: the address of the satellite, an API key and the
+// encryption keys.
+type Access struct {
+	SatelliteAddr    string
+	APIKey           APIKey
+	EncryptionAccess EncryptionAccess
+}
+
+// SharePrefix is a path prefix in a bucket to share
+type SharePrefix struct {
+	Bucket string
+	// Prefix is the unencrypted path prefix, empty to share the whole bucket
+	Prefix storj.Path
+	// PathCipher is the path cipher of the bucket, as in BucketConfig.PathCipher
+	PathCipher storj.CipherSuite
+}
+
+// Share returns a copy of the access restricted by caveat to the given
+// prefixes. The API key is restricted to the encrypted prefixes and only
+// the keys derived for the prefixes are kept, so the returned access can't
+// decrypt any other path. Without prefixes, only the API key is restricted.
+// Sharing doesn't contact the satellite.
+func (a Access) Share(caveat macaroon.Caveat, prefixes ...SharePrefix) (Access, error) {
+	shared := Access{
+		SatelliteAddr:    a.SatelliteAddr,
+		EncryptionAccess: a.EncryptionAccess,
+	}
+
+	if len(prefixes) > 0 {
+		keys, err := a.EncryptionAccess.Store()
+		if err != nil {
+			return Access{}, err
+		}
+
+		shared.EncryptionAccess = EncryptionAccess{}
+		for _, prefix := range prefixes {
+			path := prefix.Bucket
+			if trimmed := strings.Trim(prefix.Prefix, "/"); trimmed != "" {
+				path = storj.JoinPaths(prefix.Bucket, trimmed)
+			}
+
+			base, err := keys.Share(path, prefix.PathCipher.ToCipher())
+			if err != nil {
+				return Access{}, Error.Wrap(err)
+			}
+			shared.EncryptionAccess.Shared = append(shared.EncryptionAccess.Shared, base)
+
+			caveat.AllowedPaths = append(caveat.AllowedPaths, &macaroon.CaveatPath{
+				Bucket:              []byte(prefix.Bucket),
+				EncryptedPathPrefix: []byte(storj.JoinPaths(storj.SplitPath(base.Encrypted)[1:]...)),
+			})
+		}
+	}
+
+	apiKey, err := a.APIKey.Restrict(caveat)
+	if err != nil {
+		return Access{}, err
+	}
+	shared.APIKey = apiKey
+
+	return shared, nil
+}
+
+// Serialize serializes the access to a string
+func (a Access) Serialize() (string, error) {
+	encAccess := &pb.EncryptionAccess{}
+	if a.EncryptionAccess.Key != (storj.Key{}) {
+		encAccess.DefaultKey = a.EncryptionAccess.Key[:]
+	}
+	for _, base := range a.EncryptionAccess.Shared {
+		key, bucketKey := base.Key, base.BucketKey
+		encAccess.SharedPrefixes = append(encAccess.SharedPrefixes, &pb.EncryptionAccess_SharedPrefix{
+			UnencryptedPath: base.Unencrypted,
+			EncryptedPath:   base.Encrypted,
+			Key:             key[:],
+			BucketKey:       bucketKey[:],
+		})
+	}
+
+	data, err := proto.Marshal(&pb.Access{
+		SatelliteAddr:    a.SatelliteAddr,
+		ApiKey:           a.APIKey.Serialize(),
+		EncryptionAccess: encAccess,
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	return base58.CheckEncode(data, accessVersion), nil
+}
+
+// ParseAccess parses a serialized access
+func ParseAccess(val string) (Access, error) {
+	data, version, err := base58.CheckDecode(val)
+	if err != nil {
+		return Access{}, Error.Wrap(err)
+	}
+	if version != accessVersion {
+		return Access{}, Error.New("unknown access version %d", version)
+	}
+
+	var access pb.Access
+	if err := proto.Unmarshal(data, &access); err != nil {
+		return Access{}, Error.Wrap(err)
+	}
+
+	apiKey, err := ParseAPIKey(access.ApiKey)
+	if err != nil {
+		return Access{}, err
+	}
+
+	encAccess := EncryptionAccess{}
+	copy(encAccess.Key[:], access.GetEncryptionAccess().GetDefaultKey())
+	for _, prefix := range access.GetEncryptionAccess().GetSharedPrefixes() {
+		if len(prefix.Key) != storj.KeySize || len(prefix.BucketKey) != storj.KeySize {
+			return Access{}, Error.New("invalid key of shared prefix %q", prefix.UnencryptedPath)
+		}
+
+		base := encryption.Base{
+			Unencrypted: prefix.UnencryptedPath,
+			Encrypted:   prefix.EncryptedPath,
+		}
+		copy(base.Key[:], prefix.Key)
+		copy(base.BucketKey[:], prefix.BucketKey)
+		encAccess.Shared = append(encAccess.Shared, base)
+	}
+
+	if _, err := encAccess.Store(); err != nil {
+		return Access{}, err
+	}
+
+	return Access{
+		SatelliteAddr:    access.SatelliteAddr,
+		APIKey:           apiKey,
+		EncryptionAccess: encAccess,
+	}, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+func TestAccessShare(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		identity := planet.Uplinks[0].Identity
+
+		config := planet.Uplinks[0].GetConfig(satellite)
+		config.Enc.Key = "access test key"
+
+		db, streams, err := config.GetMetainfo(ctx, identity)
+		require.NoError(t, err)
+
+		_, err = db.CreateBucket(ctx, "bucket", &storj.Bucket{PathCipher: storj.AESGCM})
+		require.NoError(t, err)
+
+		photo := []byte("photo of a cat")
+		require.NoError(t, upload(ctx, db, streams, "bucket", "photos/cat.jpg", photo))
+		require.NoError(t, upload(ctx, db, streams, "bucket", "docs/secret.txt", []byte("secret")))
+
+		access, err := config.Access()
+		require.NoError(t, err)
+
+		caveat, err := macaroon.NewCaveat()
+		require.NoError(t, err)
+		caveat.DisallowWrites = true
+		caveat.DisallowDeletes = true
+
+		shared, err := access.Share(caveat, uplink.SharePrefix{
+			Bucket:     "bucket",
+			Prefix:     "photos/",
+			PathCipher: storj.EncAESGCM,
+		})
+		require.NoError(t, err)
+
+		serialized, err := shared.Serialize()
+		require.NoError(t, err)
+
+		parsed, err := uplink.ParseAccess(serialized)
+		require.NoError(t, err)
+		assert.Equal(t, satellite.Addr(), parsed.SatelliteAddr)
+		assert.Equal(t, storj.Key{}, parsed.EncryptionAccess.Key)
+		require.Len(t, parsed.EncryptionAccess.Shared, 1)
+		assert.Equal(t, "bucket/photos", parsed.EncryptionAccess.Shared[0].Unencrypted)
+
+		sharedConfig := planet.Uplinks[0].GetConfig(satellite)
+		sharedConfig.Client.APIKey = ""
+		sharedConfig.Client.SatelliteAddr = ""
+		sharedConfig.Client.Access = serialized
+
+		sharedDB, sharedStreams, err := sharedConfig.GetMetainfo(ctx, identity)
+		require.NoError(t, err)
+
+		// the shared prefix can be listed and read
+		list, err := sharedDB.ListObjects(ctx, "bucket", storj.ListOptions{Prefix: "photos/", Direction: storj.After})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "cat.jpg", list.Items[0].Path)
+
+		downloaded, err := download(ctx, sharedDB, sharedStreams, "bucket", "photos/cat.jpg")
+		require.NoError(t, err)
+		assert.Equal(t, photo, downloaded)
+
+		// other paths can't be accessed
+		_, err = sharedDB.GetObject(ctx, "bucket", "docs/secret.txt")
+		assert.Error(t, err)
+
+		_, err = sharedDB.ListObjects(ctx, "bucket", storj.ListOptions{Direction: storj.After})
+		assert.Error(t, err)
+
+		// the caveat restricts the api key
+		err = upload(ctx, sharedDB, sharedStreams, "bucket", "photos/dog.jpg", []byte("photo of a dog"))
+		assert.Error(t, err)
+
+		err = sharedDB.DeleteObject(ctx, "bucket", "photos/cat.jpg")
+		assert.Error(t, err)
+	})
+}
+
+func TestParseAccessInvalid(t *testing.T) {
+	_, err := uplink.ParseAccess("")
+	assert.Error(t, err)
+
+	_, err = uplink.ParseAccess("invalid access")
+	assert.Error(t, err)
+}
+
+func upload(ctx context.Context, db storj.Metainfo, streams streams.Store, bucket string, path storj.Path, data []byte) error {
+	obj, err := db.CreateObject(ctx, bucket, path, &storj.CreateObject{
+		RedundancyScheme: storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			ShareSize:      memory.KiB.Int32(),
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+		},
+		EncryptionScheme: storj.EncryptionScheme{
+			Cipher:    storj.AESGCM,
+			BlockSize: memory.KiB.Int32(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	mutableStream, err := obj.CreateStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	upload := stream.NewUpload(ctx, mutableStream, streams)
+	_, err = io.Copy(upload, bytes.NewReader(data))
+	return errs.Combine(err, upload.Close())
+}
+
+func download(ctx context.Context, db storj.Metainfo, streams streams.Store, bucket string, path storj.Path) (_ []byte, err error) {
+	readOnlyStream, err := db.GetObjectStream(ctx, bucket, path)
+	if err != nil {
+		return nil, err
+	}
+
+	download := stream.NewDownload(ctx, readOnlyStream, streams)
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	return ioutil.ReadAll(download)
+}
@@ -3,9 +3,15 @@
 
 package uplink
 
+import (
+	"storj.io/storj/pkg/macaroon"
+)
+
 // APIKey represents an access credential to certain resources
 type APIKey struct {
 	key string
+	// mac is nil for raw keys, which can't be restricted
+	mac *macaroon.APIKey
 }
 
 // Serialize serializes the API Key to a string
@@ -13,7 +19,33 @@ func (a APIKey) Serialize() string {
 	return a.key
 }
 
+// Restrict returns a copy of the API Key restricted by caveat. Restricting
+// doesn't contact the satellite, which checks the caveats of the key on every
+// request.
+func (a APIKey) Restrict(caveat macaroon.Caveat) (APIKey, error) {
+	if a.mac == nil {
+		return APIKey{}, Error.New("api key can't be restricted")
+	}
+
+	mac, err := a.mac.Restrict(caveat)
+	if err != nil {
+		return APIKey{}, Error.Wrap(err)
+	}
+
+	key, err := mac.Serialize()
+	if err != nil {
+		return APIKey{}, Error.Wrap(err)
+	}
+
+	return APIKey{key: key, mac: mac}, nil
+}
+
 // ParseAPIKey parses an API Key
 func ParseAPIKey(val string) (APIKey, error) {
-	return APIKey{key: val}, nil
+	mac, err := macaroon.ParseAPIKey(val)
+	if err != nil {
+		// raw keys created before api keys could be restricted are passed as they are
+		return APIKey{key: val}, nil
+	}
+	return APIKey{key: val, mac: mac}, nil
 }
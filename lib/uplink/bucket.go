@@ -35,18 +35,37 @@ func (b *Bucket) OpenObject(ctx context.Context, path storj.Path) (o *Object, er
 		return nil, err
 	}
 
+	return b.newObject(info, false), nil
+}
+
+// OpenObjectVersion returns an Object handle for a specific version of an
+// object, if authorized.
+func (b *Bucket) OpenObjectVersion(ctx context.Context, path storj.Path, version uint32) (o *Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := b.metainfo.GetObjectVersion(ctx, b.Name, path, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.newObject(info, true), nil
+}
+
+func (b *Bucket) newObject(info storj.Object, versioned bool) *Object {
 	return &Object{
 		Meta: ObjectMeta{
-			Bucket:      info.Bucket.Name,
-			Path:        info.Path,
-			IsPrefix:    info.IsPrefix,
-			ContentType: info.ContentType,
-			Metadata:    info.Metadata,
-			Created:     info.Created,
-			Modified:    info.Modified,
-			Expires:     info.Expires,
-			Size:        info.Size,
-			Checksum:    info.Checksum,
+			Bucket:         info.Bucket.Name,
+			Path:           info.Path,
+			IsPrefix:       info.IsPrefix,
+			Version:        info.Version,
+			IsDeleteMarker: info.IsDeleteMarker,
+			ContentType:    info.ContentType,
+			Metadata:       info.Metadata,
+			Created:        info.Created,
+			Modified:       info.Modified,
+			Expires:        info.Expires,
+			Size:           info.Size,
+			Checksum:       info.Checksum,
 			Volatile: struct {
 				EncryptionParameters storj.EncryptionParameters
 				RedundancyScheme     storj.RedundancyScheme
@@ -55,9 +74,10 @@ func (b *Bucket) OpenObject(ctx context.Context, path storj.Path) (o *Object, er
 				RedundancyScheme:     info.RedundancyScheme,
 			},
 		},
-		metainfo: b.metainfo,
-		streams:  b.streams,
-	}, nil
+		metainfo:  b.metainfo,
+		streams:   b.streams,
+		versioned: versioned,
+	}
 }
 
 // UploadOptions controls options about uploading a new Object, if authorized.
@@ -92,6 +112,22 @@ type UploadOptions struct {
 // UploadObject uploads a new object, if authorized.
 func (b *Bucket) UploadObject(ctx context.Context, path storj.Path, data io.Reader, opts *UploadOptions) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	return b.upload(ctx, path, data, opts, false)
+}
+
+// ResumeObject uploads a new object like UploadObject, but continues the
+// pending upload left by an earlier call to ResumeObject for the same path.
+// The beginning of data that was already committed is verified against the
+// pending upload instead of being uploaded again. If the upload fails, it is
+// left pending to be resumed later.
+func (b *Bucket) ResumeObject(ctx context.Context, path storj.Path, data io.Reader, opts *UploadOptions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.upload(ctx, path, data, opts, true)
+}
+
+// upload uploads data to path, resuming the pending upload if resume is set
+func (b *Bucket) upload(ctx context.Context, path storj.Path, data io.Reader, opts *UploadOptions, resume bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	if opts == nil {
 		opts = &UploadOptions{}
@@ -110,12 +146,17 @@ func (b *Bucket) UploadObject(ctx context.Context, path storj.Path, data io.Read
 		return err
 	}
 
-	mutableStream, err := obj.CreateStream(ctx)
+	newStream, newUpload := obj.CreateStream, stream.NewUpload
+	if resume {
+		newStream, newUpload = obj.ContinueStream, stream.NewResumedUpload
+	}
+
+	mutableStream, err := newStream(ctx)
 	if err != nil {
 		return err
 	}
 
-	upload := stream.NewUpload(ctx, mutableStream, b.streams)
+	upload := newUpload(ctx, mutableStream, b.streams)
 
 	_, err = io.Copy(upload, data)
 
@@ -128,6 +169,105 @@ func (b *Bucket) DeleteObject(ctx context.Context, path storj.Path) (err error)
 	return b.metainfo.DeleteObject(ctx, b.Bucket.Name, path)
 }
 
+// CopyOptions controls options about copying an Object, if authorized.
+type CopyOptions struct {
+	// ContentType, if set, replaces the MIME content-type of the copy.
+	ContentType string
+	// Metadata, if not nil, replaces the metadata of the copy.
+	Metadata map[string]string
+}
+
+// CopyObject copies an object to destPath in the bucket destBucket, if
+// authorized. The copy references the same data as the original, so no
+// data is transferred.
+func (b *Bucket) CopyObject(ctx context.Context, path storj.Path, destBucket string, destPath storj.Path, opts *CopyOptions) (o *Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts == nil {
+		opts = &CopyOptions{}
+	}
+
+	info, err := b.metainfo.CopyObject(ctx, b.Name, path, destBucket, destPath, &storj.CopyObject{
+		ContentType: opts.ContentType,
+		Metadata:    opts.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.newObject(info, false), nil
+}
+
+// MoveObject moves an object to destPath in the bucket destBucket, if
+// authorized. No data is transferred.
+func (b *Bucket) MoveObject(ctx context.Context, path storj.Path, destBucket string, destPath storj.Path) (o *Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := b.metainfo.MoveObject(ctx, b.Name, path, destBucket, destPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.newObject(info, false), nil
+}
+
+// DeleteObjectVersion permanently removes a specific version of an object,
+// if authorized. When the current version is removed, the latest prior
+// version becomes current.
+func (b *Bucket) DeleteObjectVersion(ctx context.Context, path storj.Path, version uint32) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.DeleteObjectVersion(ctx, b.Bucket.Name, path, version)
+}
+
+// SetVersioning enables or suspends keeping the prior versions of objects
+// in the bucket, if authorized. While versioning is enabled, uploading or
+// deleting an object keeps its previous content as a prior version, and
+// deleting places a delete marker.
+func (b *Bucket) SetVersioning(ctx context.Context, enabled bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := b.metainfo.SetBucketVersioning(ctx, b.Bucket.Name, enabled)
+	if err != nil {
+		return err
+	}
+
+	b.Bucket.Versioning = info.Versioning
+	return nil
+}
+
+// LifecycleRule describes when the objects in a bucket are deleted
+// automatically.
+type LifecycleRule = storj.LifecycleRule
+
+// SetLifecycle replaces the lifecycle rules of the bucket, if authorized.
+// The satellite periodically deletes the objects and pending uploads that
+// are older than the rules allow. Setting no rules removes them.
+func (b *Bucket) SetLifecycle(ctx context.Context, rules []LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.SetBucketLifecycle(ctx, b.Bucket.Name, rules)
+}
+
+// Lifecycle returns the lifecycle rules of the bucket, if authorized.
+func (b *Bucket) Lifecycle(ctx context.Context) (rules []LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.GetBucketLifecycle(ctx, b.Bucket.Name)
+}
+
+// SetPlacement restricts the pieces of the objects uploaded to the bucket
+// afterwards to storage nodes in the given countries, if authorized.
+// Countries are ISO 3166-1 alpha-2 codes. Setting no countries removes
+// the restriction.
+func (b *Bucket) SetPlacement(ctx context.Context, countries []string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.SetBucketPlacement(ctx, b.Bucket.Name, countries)
+}
+
+// Placement returns the countries the pieces of the bucket are restricted to, if authorized.
+func (b *Bucket) Placement(ctx context.Context) (countries []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return b.metainfo.GetBucketPlacement(ctx, b.Bucket.Name)
+}
+
 // ListOptions controls options for the ListObjects() call.
 type ListOptions = storj.ListOptions
 
@@ -141,6 +281,16 @@ func (b *Bucket) ListObjects(ctx context.Context, cfg *ListOptions) (list storj.
 	return b.metainfo.ListObjects(ctx, b.Bucket.Name, *cfg)
 }
 
+// ListObjectVersions lists all versions of the objects a user is authorized
+// to see. The versions of an object are listed from the latest to the oldest.
+func (b *Bucket) ListObjectVersions(ctx context.Context, cfg *ListOptions) (list storj.ObjectList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if cfg == nil {
+		cfg = &storj.ListOptions{Direction: storj.After}
+	}
+	return b.metainfo.ListObjectVersions(ctx, b.Bucket.Name, *cfg)
+}
+
 // Close closes the Bucket session.
 func (b *Bucket) Close() error {
 	return nil
@@ -4,6 +4,7 @@
 package uplink
 
 import (
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -20,4 +21,25 @@ type EncryptionAccess struct {
 	// path from the top of the storage Bucket to this point. This is
 	// necessary to have in order to derive further encryption keys.
 	EncryptedPathPrefix storj.Path
+	// Shared are the path prefixes shared with this access together with
+	// the keys derived for them. The paths under them can be accessed even
+	// without Key.
+	Shared []encryption.Base
+}
+
+// Store returns the keys of the access as an encryption store
+func (access *EncryptionAccess) Store() (*encryption.Store, error) {
+	var defaultKey *storj.Key
+	if access.Key != (storj.Key{}) {
+		defaultKey = new(storj.Key)
+		*defaultKey = access.Key
+	}
+
+	keys := encryption.NewStore(defaultKey)
+	for _, base := range access.Shared {
+		if err := keys.Add(base); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+	return keys, nil
 }
@@ -27,6 +27,13 @@ type ObjectMeta struct {
 	// be called a "folder" or "directory" in a typical filesystem.
 	IsPrefix bool
 
+	// Version identifies the version of the Object within a Bucket that
+	// has versioning enabled.
+	Version uint32
+	// IsDeleteMarker is true if this version of the Object records that
+	// the Object was deleted.
+	IsDeleteMarker bool
+
 	// ContentType, if set, gives a MIME content-type for the Object, as
 	// set when the object was created.
 	ContentType string
@@ -70,14 +77,21 @@ type Object struct {
 	// Meta holds the metainfo associated with the Object.
 	Meta ObjectMeta
 
-	metainfo *kvmetainfo.DB
-	streams  streams.Store
+	metainfo  *kvmetainfo.DB
+	streams   streams.Store
+	versioned bool
 }
 
 // DownloadRange returns an Object's data. A length of -1 will mean
 // (Object.Size - offset).
 func (o *Object) DownloadRange(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
-	readOnlyStream, err := o.metainfo.GetObjectStream(ctx, o.Meta.Bucket, o.Meta.Path)
+	var readOnlyStream storj.ReadOnlyStream
+	var err error
+	if o.versioned {
+		readOnlyStream, err = o.metainfo.GetObjectVersionStream(ctx, o.Meta.Bucket, o.Meta.Path, o.Meta.Version)
+	} else {
+		readOnlyStream, err = o.metainfo.GetObjectStream(ctx, o.Meta.Bucket, o.Meta.Path)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +106,13 @@ func (p *Project) DeleteBucket(ctx context.Context, bucket string) (err error) {
 	return p.project.DeleteBucket(ctx, bucket)
 }
 
+// RevokeAPIKey revokes an API Key restricted from the API Key of the
+// project, together with all keys restricted from it.
+func (p *Project) RevokeAPIKey(ctx context.Context, key APIKey) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return p.metainfo.RevokeAPIKey(ctx, key.Serialize())
+}
+
 // BucketListOptions controls options to the ListBuckets() call.
 type BucketListOptions = storj.BucketListOptions
 
@@ -145,7 +152,7 @@ func (p *Project) OpenBucket(ctx context.Context, bucket string, access *Encrypt
 		return nil, err
 	}
 
-	if access == nil || access.Key == (storj.Key{}) {
+	if access == nil || (access.Key == (storj.Key{}) && len(access.Shared) == 0) {
 		return nil, Error.New("No encryption key chosen")
 	}
 	pathCipher := cfg.PathCipher.ToCipher()
@@ -179,10 +186,12 @@ func (p *Project) OpenBucket(ctx context.Context, bucket string, access *Encrypt
 	}
 	segments := segments.NewSegmentStore(p.metainfo, ec, rs, p.maxInlineSize.Int(), maxEncryptedSegmentSize)
 
-	key := new(storj.Key)
-	copy(key[:], access.Key[:])
+	keys, err := access.Store()
+	if err != nil {
+		return nil, err
+	}
 
-	streams, err := streams.NewStreamStore(segments, cfg.Volatile.SegmentSize.Int64(), key, int(encryptionScheme.BlockSize), encryptionScheme.Cipher)
+	streams, err := streams.NewStreamStore(segments, cfg.Volatile.SegmentSize.Int64(), keys, int(encryptionScheme.BlockSize), encryptionScheme.Cipher)
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +201,7 @@ func (p *Project) OpenBucket(ctx context.Context, bucket string, access *Encrypt
 	return &Bucket{
 		Bucket:     bucketInfo,
 		Config:     *cfg,
-		metainfo:   kvmetainfo.New(p.metainfo, buckets, streams, segments, key),
+		metainfo:   kvmetainfo.New(p.metainfo, buckets, streams, segments, keys),
 		streams:    streams,
 		pathCipher: pathCipher,
 	}, nil
@@ -0,0 +1,219 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"context"
+	"encoding/hex"
+	"html/template"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+// OpenFunc opens the metainfo and the streams store of a serialized access.
+// The metainfo is closed once the request is served.
+type OpenFunc func(ctx context.Context, access string) (*kvmetainfo.DB, streams.Store, error)
+
+// listLimit is the number of objects listed per request to the satellite
+const listLimit = 1000
+
+// Handler serves the objects and prefixes shared with links of the form
+// /<serialized access>/<bucket>/<path>. Paths ending with a slash are
+// rendered as directory listings.
+type Handler struct {
+	log         *zap.Logger
+	open        OpenFunc
+	cacheMaxAge time.Duration
+}
+
+// NewHandler creates a handler opening the accesses of the links with open.
+// Browsers and proxies may cache the objects for cacheMaxAge.
+func NewHandler(log *zap.Logger, open OpenFunc, cacheMaxAge time.Duration) *Handler {
+	return &Handler{
+		log:         log,
+		open:        open,
+		cacheMaxAge: cacheMaxAge,
+	}
+}
+
+// ServeHTTP serves the object or the directory listing of a link
+func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		http.Error(w, "invalid link, use /<access>/<bucket>/<path>", http.StatusBadRequest)
+		return
+	}
+
+	access, bucket := parts[0], parts[1]
+	var objectPath storj.Path
+	if len(parts) == 3 {
+		objectPath = parts[2]
+	} else {
+		// links to buckets are listed like any other prefix
+		http.Redirect(w, r, r.URL.Path+"/", http.StatusMovedPermanently)
+		return
+	}
+
+	db, streamStore, err := handler.open(ctx, access)
+	if err != nil {
+		http.Error(w, "invalid access", http.StatusBadRequest)
+		return
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	if objectPath == "" || strings.HasSuffix(objectPath, "/") {
+		err = handler.serveListing(ctx, w, db, bucket, objectPath)
+	} else {
+		err = handler.serveObject(ctx, w, r, db, streamStore, bucket, objectPath)
+	}
+	if err != nil {
+		handler.serveError(w, err)
+	}
+}
+
+// serveObject streams the object with its content type, supporting ranges
+// and conditional requests
+func (handler *Handler) serveObject(ctx context.Context, w http.ResponseWriter, r *http.Request, db storj.Metainfo, streamStore streams.Store, bucket string, objectPath storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, err := db.GetObject(ctx, bucket, objectPath)
+	if err != nil {
+		return err
+	}
+
+	rr, _, err := streamStore.Get(ctx, storj.JoinPaths(bucket, objectPath), object.Bucket.PathCipher)
+	if err != nil {
+		return err
+	}
+
+	contentType := object.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(objectPath))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(handler.cacheMaxAge.Seconds())))
+	if len(object.Checksum) > 0 {
+		w.Header().Set("Etag", `"`+hex.EncodeToString(object.Checksum)+`"`)
+	}
+
+	ranger.ServeContent(ctx, w, r, objectPath, object.Modified, rr)
+	return nil
+}
+
+// listingItem is an object or a prefix in a directory listing
+type listingItem struct {
+	Name     string
+	Href     string
+	IsPrefix bool
+	Size     int64
+	Modified time.Time
+}
+
+var listingTemplate = template.Must(template.New("listing").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<table>
+{{if .Parent}}<tr><td><a href="../">../</a></td><td></td><td></td></tr>
+{{end}}{{range .Items}}<tr><td><a href="{{.Href}}">{{.Name}}</a></td>{{if .IsPrefix}}<td></td><td></td>{{else}}<td>{{.Size}}</td><td>{{.Modified.Format "2006-01-02 15:04:05"}}</td>{{end}}</tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// serveListing renders the objects and prefixes directly under prefix
+func (handler *Handler) serveListing(ctx context.Context, w http.ResponseWriter, db storj.Metainfo, bucket string, prefix storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var items []listingItem
+	options := storj.ListOptions{Prefix: prefix, Direction: storj.After, Limit: listLimit}
+	for {
+		list, err := db.ListObjects(ctx, bucket, options)
+		if err != nil {
+			return err
+		}
+
+		for _, object := range list.Items {
+			name := object.Path
+			if object.IsPrefix && !strings.HasSuffix(name, "/") {
+				name += "/"
+			}
+			href := (&url.URL{Path: strings.TrimSuffix(name, "/")}).String()
+			if object.IsPrefix {
+				href += "/"
+			}
+
+			items = append(items, listingItem{
+				Name:     name,
+				Href:     href,
+				IsPrefix: object.IsPrefix,
+				Size:     object.Size,
+				Modified: object.Modified,
+			})
+		}
+
+		if !list.More || len(list.Items) == 0 {
+			break
+		}
+		options.Cursor = list.Items[len(list.Items)-1].Path
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	return listingTemplate.Execute(w, struct {
+		Title  string
+		Parent bool
+		Items  []listingItem
+	}{
+		Title:  storj.JoinPaths(bucket, prefix),
+		Parent: prefix != "",
+		Items:  items,
+	})
+}
+
+// serveError responds with the status code matching err
+func (handler *Handler) serveError(w http.ResponseWriter, err error) {
+	switch {
+	case storj.ErrBucketNotFound.Has(err) || storj.ErrObjectNotFound.Has(err):
+		http.Error(w, "not found", http.StatusNotFound)
+	case status.Code(errs.Unwrap(err)) == codes.PermissionDenied || status.Code(errs.Unwrap(err)) == codes.Unauthenticated:
+		http.Error(w, "access denied", http.StatusForbidden)
+	default:
+		handler.log.Error("failed to serve link", zap.Error(err))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}
+}
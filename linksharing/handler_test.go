@@ -0,0 +1,193 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/linksharing"
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+func TestHandler(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		identity := planet.Uplinks[0].Identity
+		config := planet.Uplinks[0].GetConfig(planet.Satellites[0])
+		config.Enc.Key = "link sharing key"
+
+		db, streamStore, err := config.GetMetainfo(ctx, identity)
+		require.NoError(t, err)
+
+		_, err = db.CreateBucket(ctx, "bucket", &storj.Bucket{PathCipher: storj.AESGCM})
+		require.NoError(t, err)
+
+		photo := []byte("photo of a cat")
+		require.NoError(t, upload(ctx, db, streamStore, "bucket", "photos/cat.jpg", "", photo))
+		require.NoError(t, upload(ctx, db, streamStore, "bucket", "photos/2019/dog.jpg", "", []byte("photo of a dog")))
+		require.NoError(t, upload(ctx, db, streamStore, "bucket", "photos/notes.txt", "text/markdown", []byte("# notes")))
+		require.NoError(t, upload(ctx, db, streamStore, "bucket", "docs/secret.txt", "", []byte("secret")))
+
+		access, err := config.Access()
+		require.NoError(t, err)
+
+		share := func(caveat macaroon.Caveat) string {
+			shared, err := access.Share(caveat, uplink.SharePrefix{
+				Bucket:     "bucket",
+				Prefix:     "photos/",
+				PathCipher: storj.EncAESGCM,
+			})
+			require.NoError(t, err)
+
+			serialized, err := shared.Serialize()
+			require.NoError(t, err)
+			return serialized
+		}
+
+		caveat, err := macaroon.NewCaveat()
+		require.NoError(t, err)
+		caveat.DisallowWrites = true
+		caveat.DisallowDeletes = true
+		serialized := share(caveat)
+
+		expired, err := macaroon.NewCaveat()
+		require.NoError(t, err)
+		expired.NotAfter, err = ptypes.TimestampProto(time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		serializedExpired := share(expired)
+
+		var opened []*kvmetainfo.DB
+		handler := linksharing.NewHandler(zaptest.NewLogger(t),
+			func(ctx context.Context, access string) (*kvmetainfo.DB, streams.Store, error) {
+				config := planet.Uplinks[0].GetConfig(planet.Satellites[0])
+				config.Client.APIKey = ""
+				config.Client.SatelliteAddr = ""
+				config.Client.Access = access
+				db, streamStore, err := config.GetMetainfo(ctx, identity)
+				if err == nil {
+					opened = append(opened, db)
+				}
+				return db, streamStore, err
+			}, time.Hour)
+
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		get := func(link string, header http.Header) (*http.Response, []byte) {
+			req, err := http.NewRequest(http.MethodGet, server.URL+link, nil)
+			require.NoError(t, err)
+			for key, values := range header {
+				req.Header[key] = values
+			}
+
+			client := http.Client{
+				CheckRedirect: func(*http.Request, []*http.Request) error {
+					return http.ErrUseLastResponse
+				},
+			}
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer func() { assert.NoError(t, resp.Body.Close()) }()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			return resp, body
+		}
+
+		resp, body := get("/"+serialized+"/bucket/photos/cat.jpg", nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, photo, body)
+		assert.Equal(t, "image/jpeg", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "public, max-age=3600", resp.Header.Get("Cache-Control"))
+
+		// the connection to the satellite is closed with the request
+		require.Len(t, opened, 1)
+		_, err = opened[0].GetObject(ctx, "bucket", "photos/cat.jpg")
+		assert.Error(t, err)
+
+		resp, body = get("/"+serialized+"/bucket/photos/cat.jpg", http.Header{"Range": {"bytes=9-13"}})
+		assert.Equal(t, http.StatusPartialContent, resp.StatusCode)
+		assert.Equal(t, photo[9:14], body)
+
+		resp, _ = get("/"+serialized+"/bucket/photos/notes.txt", nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/markdown", resp.Header.Get("Content-Type"))
+
+		resp, body = get("/"+serialized+"/bucket/photos/", nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Content-Type"), "text/html")
+		assert.Contains(t, string(body), `href="cat.jpg"`)
+		assert.Contains(t, string(body), `href="notes.txt"`)
+		assert.Contains(t, string(body), `href="2019/"`)
+		assert.Contains(t, string(body), `href="../"`)
+
+		resp, _ = get("/"+serialized+"/bucket", nil)
+		assert.Equal(t, http.StatusMovedPermanently, resp.StatusCode)
+		assert.Equal(t, "/"+serialized+"/bucket/", resp.Header.Get("Location"))
+
+		resp, _ = get("/"+serialized+"/bucket/photos/missing.jpg", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, _ = get("/"+serializedExpired+"/bucket/photos/cat.jpg", nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp, _ = get("/invalid/bucket/photos/cat.jpg", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, _ = get("/"+serialized, nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func upload(ctx context.Context, db storj.Metainfo, streams streams.Store, bucket string, path storj.Path, contentType string, data []byte) error {
+	obj, err := db.CreateObject(ctx, bucket, path, &storj.CreateObject{
+		ContentType: contentType,
+		RedundancyScheme: storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			ShareSize:      memory.KiB.Int32(),
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+		},
+		EncryptionScheme: storj.EncryptionScheme{
+			Cipher:    storj.AESGCM,
+			BlockSize: memory.KiB.Int32(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	mutableStream, err := obj.CreateStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	upload := stream.NewUpload(ctx, mutableStream, streams)
+	_, err = io.Copy(upload, bytes.NewReader(data))
+	return errs.Combine(err, upload.Close())
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var mon = monkit.Package()
+
+// Config is all the configuration parameters for a link sharing server
+type Config struct {
+	Address     string        `user:"true" help:"public address to listen on" default:":8080"`
+	CacheMaxAge time.Duration `user:"true" help:"how long browsers and proxies may cache shared objects" default:"1h"`
+}
+
+// Server serves the links of shared objects over HTTP
+type Server struct {
+	log      *zap.Logger
+	server   http.Server
+	listener net.Listener
+}
+
+// NewServer creates a link sharing server listening on the configured
+// address, opening the accesses of the links with open.
+func NewServer(log *zap.Logger, config Config, open OpenFunc) (*Server, error) {
+	listener, err := net.Listen("tcp", config.Address)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return &Server{
+		log: log,
+		server: http.Server{
+			Handler: NewHandler(log, open, config.CacheMaxAge),
+		},
+		listener: listener,
+	}, nil
+}
+
+// Run runs the server until it's either closed or it errors.
+func (server *Server) Run(ctx context.Context) (err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+
+	group.Go(func() error {
+		<-ctx.Done()
+		return ignoreCancel(server.server.Shutdown(context.Background()))
+	})
+	group.Go(func() error {
+		defer cancel()
+		server.log.Sugar().Infof("Link sharing server started on %s", server.Addr())
+		return ignoreCancel(server.server.Serve(server.listener))
+	})
+	return group.Wait()
+}
+
+// Close closes the server and its listener.
+func (server *Server) Close() error {
+	return server.server.Close()
+}
+
+// Addr returns the public address.
+func (server *Server) Addr() string { return server.listener.Addr().String() }
+
+func ignoreCancel(err error) error {
+	if err == context.Canceled || err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
@@ -69,7 +69,11 @@ type DB interface {
 	// CreateBucketStorageTally creates a record for BucketStorageTally in the accounting DB table
 	CreateBucketStorageTally(ctx context.Context, tally BucketStorageTally) error
 	// ProjectBandwidthTotal returns the sum of GET bandwidth usage for a projectID in the past time frame
-	ProjectBandwidthTotal(ctx context.Context, bucketID []byte, from time.Time) (int64, error)
+	ProjectBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (int64, error)
 	// ProjectStorageTotals returns the current inline and remote storage usage for a projectID
 	ProjectStorageTotals(ctx context.Context, projectID uuid.UUID) (int64, int64, error)
+	// GetProjectLimits returns the usage limits of a project
+	GetProjectLimits(ctx context.Context, projectID uuid.UUID) (ProjectLimits, error)
+	// UpdateProjectLimits sets the usage limits of a project
+	UpdateProjectLimits(ctx context.Context, projectID uuid.UUID, limits ProjectLimits) error
 }
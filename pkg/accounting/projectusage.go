@@ -4,6 +4,12 @@
 package accounting
 
 import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
 	"storj.io/storj/internal/memory"
 )
 
@@ -12,18 +18,178 @@ const (
 	AverageDaysInMonth = 30
 )
 
-// ExceedsAlphaUsage returns true if more than 25GB of storage is currently in use
-// or if 25GB of bandwidth or has been used in the past month (30 days)
-// TODO(jg): remove this code once we no longer need usage limiting for alpha release
-// Ref: https://storjlabs.atlassian.net/browse/V3-1274
-func ExceedsAlphaUsage(bandwidthGetTotal, inlineTotal, remoteTotal int64, maxAlphaUsageGB memory.Size) (bool, string) {
-	if bandwidthGetTotal >= maxAlphaUsageGB.Int64() {
-		return true, "bandwidth"
+// ProjectLimits are the usage limits of a project, zero means the default
+// limit of the satellite applies
+type ProjectLimits struct {
+	Storage   memory.Size
+	Bandwidth memory.Size
+}
+
+// maxCachedUsages is how many usages of projects are cached at most
+const maxCachedUsages = 100000
+
+// ProjectUsage checks the storage and bandwidth usage of projects against their limits.
+//
+// The usage and the limits of a project are kept in memory for the cache expiration,
+// so that checking them doesn't need to query the database on every request.
+type ProjectUsage struct {
+	db           DB
+	defaultLimit memory.Size
+	cache        *usageCache
+}
+
+// NewProjectUsage creates a new project usage checker, defaultLimit is used
+// for the projects that don't have their own limits
+func NewProjectUsage(db DB, defaultLimit memory.Size, expiration time.Duration) *ProjectUsage {
+	return &ProjectUsage{
+		db:           db,
+		defaultLimit: defaultLimit,
+		cache:        newUsageCache(expiration, maxCachedUsages),
+	}
+}
+
+// ExceedsStorageUsage returns whether the project has used up its storage limit, and the limit
+func (usage *ProjectUsage) ExceedsStorageUsage(ctx context.Context, projectID uuid.UUID) (_ bool, limit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key := usageKey{projectID: projectID, resource: storageResource}
+	current, err := usage.get(ctx, key, func(limits ProjectLimits) (memory.Size, int64, error) {
+		inlineTotal, remoteTotal, err := usage.db.ProjectStorageTotals(ctx, projectID)
+		return limits.Storage, inlineTotal + remoteTotal, err
+	})
+	if err != nil {
+		return false, 0, err
+	}
+	return current.used >= current.limit.Int64(), current.limit, nil
+}
+
+// ExceedsBandwidthUsage returns whether the project has used up its bandwidth limit
+// in the past month, and the limit
+func (usage *ProjectUsage) ExceedsBandwidthUsage(ctx context.Context, projectID uuid.UUID) (_ bool, limit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key := usageKey{projectID: projectID, resource: bandwidthResource}
+	current, err := usage.get(ctx, key, func(limits ProjectLimits) (memory.Size, int64, error) {
+		from := time.Now().AddDate(0, 0, -AverageDaysInMonth) // past 30 days
+		bandwidthTotal, err := usage.db.ProjectBandwidthTotal(ctx, projectID, from)
+		return limits.Bandwidth, bandwidthTotal, err
+	})
+	if err != nil {
+		return false, 0, err
+	}
+	return current.used >= current.limit.Int64(), current.limit, nil
+}
+
+// get returns the cached usage, loading the limits of the project and the
+// usage with load when it has expired
+func (usage *ProjectUsage) get(ctx context.Context, key usageKey, load func(ProjectLimits) (memory.Size, int64, error)) (_ cachedUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	if current, ok := usage.cache.get(key, now); ok {
+		return current, nil
+	}
+
+	limits, err := usage.db.GetProjectLimits(ctx, key.projectID)
+	if err != nil {
+		return cachedUsage{}, err
+	}
+	if limits.Storage == 0 {
+		limits.Storage = usage.defaultLimit
+	}
+	if limits.Bandwidth == 0 {
+		limits.Bandwidth = usage.defaultLimit
+	}
+
+	limit, used, err := load(limits)
+	if err != nil {
+		return cachedUsage{}, err
 	}
 
-	if inlineTotal+remoteTotal >= maxAlphaUsageGB.Int64() {
-		return true, "storage"
+	current := cachedUsage{limit: limit, used: used}
+	usage.cache.put(key, current, now)
+	return current, nil
+}
+
+// usageResource is the resource whose usage is limited
+type usageResource int
+
+const (
+	storageResource usageResource = iota
+	bandwidthResource
+)
+
+// usageKey identifies the usage of a resource by a project
+type usageKey struct {
+	projectID uuid.UUID
+	resource  usageResource
+}
+
+// cachedUsage is the usage of a resource by a project and its limit
+type cachedUsage struct {
+	limit   memory.Size
+	used    int64
+	expires time.Time
+}
+
+// usageCache keeps up to limit usages for the expiration
+type usageCache struct {
+	expiration time.Duration
+	limit      int
+
+	mu     sync.Mutex
+	usages map[usageKey]cachedUsage
+}
+
+// newUsageCache creates a cache keeping up to limit usages for expiration
+func newUsageCache(expiration time.Duration, limit int) *usageCache {
+	return &usageCache{
+		expiration: expiration,
+		limit:      limit,
+		usages:     map[usageKey]cachedUsage{},
+	}
+}
+
+// get returns the usage if it's cached and hasn't expired at now
+func (cache *usageCache) get(key usageKey, now time.Time) (cachedUsage, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	current, ok := cache.usages[key]
+	if !ok {
+		return cachedUsage{}, false
+	}
+	if !now.Before(current.expires) {
+		delete(cache.usages, key)
+		return cachedUsage{}, false
+	}
+	return current, true
+}
+
+// put caches the usage loaded at now, making room by dropping the expired
+// usages, or an arbitrary one when none has expired
+func (cache *usageCache) put(key usageKey, current cachedUsage, now time.Time) {
+	if cache.expiration <= 0 || cache.limit <= 0 {
+		return
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if _, ok := cache.usages[key]; !ok && len(cache.usages) >= cache.limit {
+		for key, cached := range cache.usages {
+			if !now.Before(cached.expires) {
+				delete(cache.usages, key)
+			}
+		}
+		if len(cache.usages) >= cache.limit {
+			for key := range cache.usages {
+				delete(cache.usages, key)
+				break
+			}
+		}
 	}
 
-	return false, ""
+	current.expires = now.Add(cache.expiration)
+	cache.usages[key] = current
 }
@@ -19,6 +19,9 @@ import (
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
 func TestProjectUsage(t *testing.T) {
@@ -29,8 +32,8 @@ func TestProjectUsage(t *testing.T) {
 		expectedErrMsg   string
 	}{
 		{name: "doesn't exceed storage or bandwidth project limit", expectedExceeded: false, expectedErrMsg: ""},
-		{name: "exceeds storage project limit", expectedExceeded: true, expectedResource: "storage", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Alpha Usage Limit; segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Alpha Usage Limit"},
-		{name: "exceeds bandwidth project limit", expectedExceeded: true, expectedResource: "bandwidth", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Alpha Usage Limit; segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Alpha Usage Limit"},
+		{name: "exceeds storage project limit", expectedExceeded: true, expectedResource: "storage", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Usage Limit; segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Usage Limit"},
+		{name: "exceeds bandwidth project limit", expectedExceeded: true, expectedResource: "bandwidth", expectedErrMsg: "segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Usage Limit; segment error: metainfo error: rpc error: code = ResourceExhausted desc = Exceeded Usage Limit"},
 	}
 
 	testplanet.Run(t, testplanet.Config{
@@ -40,9 +43,9 @@ func TestProjectUsage(t *testing.T) {
 		orderDB := saDB.Orders()
 		acctDB := saDB.Accounting()
 
-		// Setup: This date represents the past 30 days so that we can check
-		// if the alpha max usage has been exceeded in the past month
-		from := time.Now().AddDate(0, 0, -accounting.AverageDaysInMonth)
+		// Setup: the project usage is checked without caching, so that the
+		// changes of every case are seen
+		projectUsage := accounting.NewProjectUsage(acctDB, 25*memory.GB, 0)
 
 		for _, tt := range cases {
 			t.Run(tt.name, func(t *testing.T) {
@@ -71,16 +74,21 @@ func TestProjectUsage(t *testing.T) {
 					require.NoError(t, err)
 				}
 
-				// Execute test: get storage and bandwidth totals for a project, then check if that exceeds the max usage limit
-				inlineTotal, remoteTotal, err := acctDB.ProjectStorageTotals(ctx, projectID)
-				require.NoError(t, err)
-				bandwidthTotal, err := acctDB.ProjectBandwidthTotal(ctx, bucketID, from)
+				// Execute test: check if the storage or bandwidth usage of the project exceeds the default limit
+				var actualExceeded bool
+				switch tt.expectedResource {
+				case "storage":
+					actualExceeded, _, err = projectUsage.ExceedsStorageUsage(ctx, projectID)
+				case "bandwidth":
+					actualExceeded, _, err = projectUsage.ExceedsBandwidthUsage(ctx, projectID)
+				default:
+					actualExceeded, _, err = projectUsage.ExceedsStorageUsage(ctx, projectID)
+					require.NoError(t, err)
+					require.False(t, actualExceeded)
+					actualExceeded, _, err = projectUsage.ExceedsBandwidthUsage(ctx, projectID)
+				}
 				require.NoError(t, err)
-				maxAlphaUsage := 25 * memory.GB
-				actualExceeded, actualResource := accounting.ExceedsAlphaUsage(bandwidthTotal, inlineTotal, remoteTotal, maxAlphaUsage)
-
 				require.Equal(t, tt.expectedExceeded, actualExceeded)
-				require.Equal(t, tt.expectedResource, actualResource)
 
 				// Execute test: does the uplink get an error message when the usage limits are exceeded
 				expectedData := make([]byte, 50*memory.KiB)
@@ -98,6 +106,63 @@ func TestProjectUsage(t *testing.T) {
 	})
 }
 
+func TestProjectLimits(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		acctDB := db.Accounting()
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "limits"})
+		require.NoError(t, err)
+
+		// new projects use the default limits
+		limits, err := acctDB.GetProjectLimits(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, accounting.ProjectLimits{}, limits)
+
+		err = setUpCreateTallies(ctx, project.ID, acctDB, time.Now())
+		require.NoError(t, err)
+
+		exceeded, limit, err := accounting.NewProjectUsage(acctDB, 50*memory.GB, 0).ExceedsStorageUsage(ctx, project.ID)
+		require.NoError(t, err)
+		require.False(t, exceeded)
+		require.Equal(t, 50*memory.GB, limit)
+
+		// the cached usage doesn't see the change of the limits until it expires
+		cached := accounting.NewProjectUsage(acctDB, 50*memory.GB, time.Hour)
+		exceeded, _, err = cached.ExceedsStorageUsage(ctx, project.ID)
+		require.NoError(t, err)
+		require.False(t, exceeded)
+
+		limits = accounting.ProjectLimits{Storage: 30 * memory.GB, Bandwidth: 100 * memory.GB}
+		require.NoError(t, acctDB.UpdateProjectLimits(ctx, project.ID, limits))
+
+		got, err := acctDB.GetProjectLimits(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, limits, got)
+
+		exceeded, _, err = cached.ExceedsStorageUsage(ctx, project.ID)
+		require.NoError(t, err)
+		require.False(t, exceeded)
+
+		exceeded, limit, err = accounting.NewProjectUsage(acctDB, 50*memory.GB, 0).ExceedsStorageUsage(ctx, project.ID)
+		require.NoError(t, err)
+		require.True(t, exceeded)
+		require.Equal(t, 30*memory.GB, limit)
+
+		exceeded, limit, err = accounting.NewProjectUsage(acctDB, 50*memory.GB, 0).ExceedsBandwidthUsage(ctx, project.ID)
+		require.NoError(t, err)
+		require.False(t, exceeded)
+		require.Equal(t, 100*memory.GB, limit)
+
+		unknown, err := uuid.New()
+		require.NoError(t, err)
+		err = acctDB.UpdateProjectLimits(ctx, *unknown, limits)
+		require.Error(t, err)
+	})
+}
+
 func createBucketID(projectID uuid.UUID, bucket []byte) []byte {
 	entries := make([]string, 0)
 	entries = append(entries, projectID.String())
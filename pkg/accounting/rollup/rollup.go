@@ -18,7 +18,9 @@ import (
 // Config contains configurable values for rollup
 type Config struct {
 	Interval      time.Duration `help:"how frequently rollup should run" devDefault:"120s" default:"6h"`
-	MaxAlphaUsage memory.Size   `help:"the bandwidth and storage usage limit for the alpha release" default:"25GB"`
+	MaxAlphaUsage memory.Size   `help:"the default bandwidth and storage usage limit of projects without their own limits" default:"25GB"`
+
+	UsageCacheExpiration time.Duration `help:"how long the usage of a project is cached before it's checked against its limits again, 0 disables caching" default:"1m"`
 }
 
 // Service is the rollup service for totalling data on storage nodes on daily intervals
@@ -7,16 +7,14 @@ import (
 	"context"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
-	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storj"
-	"storj.io/storj/storage"
+	"storj.io/storj/satellite/metainfo"
 )
 
 // Config contains configurable values for the tally service
@@ -27,7 +25,7 @@ type Config struct {
 // Service is the tally service for data stored on each storage node
 type Service struct {
 	logger       *zap.Logger
-	pointerdb    *pointerdb.Service
+	metainfoLoop *metainfo.Loop
 	overlay      *overlay.Cache
 	limit        int
 	ticker       *time.Ticker
@@ -35,10 +33,10 @@ type Service struct {
 }
 
 // New creates a new tally Service
-func New(logger *zap.Logger, accountingDB accounting.DB, pointerdb *pointerdb.Service, overlay *overlay.Cache, limit int, interval time.Duration) *Service {
+func New(logger *zap.Logger, accountingDB accounting.DB, metainfoLoop *metainfo.Loop, overlay *overlay.Cache, limit int, interval time.Duration) *Service {
 	return &Service{
 		logger:       logger,
-		pointerdb:    pointerdb,
+		metainfoLoop: metainfoLoop,
 		overlay:      overlay,
 		limit:        limit,
 		ticker:       time.NewTicker(interval),
@@ -87,8 +85,8 @@ func (t *Service) Tally(ctx context.Context) error {
 	return errs.Combine(errAtRest, errBucketInfo)
 }
 
-// calculateAtRestData iterates through the pieces on pointerdb and calculates
-// the amount of at-rest data stored in each bucket and on each respective node
+// calculateAtRestData joins the metainfo loop and calculates the amount of
+// at-rest data stored in each bucket and on each respective node
 func (t *Service) calculateAtRestData(ctx context.Context) (latestTally time.Time, nodeData map[storj.NodeID]float64, bucketTallies map[string]*accounting.BucketTally, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -96,95 +94,16 @@ func (t *Service) calculateAtRestData(ctx context.Context) (latestTally time.Tim
 	if err != nil {
 		return latestTally, nodeData, bucketTallies, Error.Wrap(err)
 	}
-	nodeData = make(map[storj.NodeID]float64)
-	bucketTallies = make(map[string]*accounting.BucketTally)
-
-	var currentBucket string
-	var bucketCount int64
-	var totalTallies, currentBucketTally accounting.BucketTally
-
-	err = t.pointerdb.Iterate("", "", true, false,
-		func(it storage.Iterator) error {
-			var item storage.ListItem
-			for it.Next(&item) {
-
-				pointer := &pb.Pointer{}
-				err = proto.Unmarshal(item.Value, pointer)
-				if err != nil {
-					return Error.Wrap(err)
-				}
-
-				pathElements := storj.SplitPath(storj.Path(item.Key))
-				// check to make sure there are at least *4* path elements. the first three
-				// are project, segment, and bucket name, but we want to make sure we're talking
-				// about an actual object, and that there's an object name specified
-
-				// handle conditions with buckets with no files
-				if len(pathElements) == 3 {
-					bucketCount++
-				} else if len(pathElements) >= 4 {
-
-					project, segment, bucketName := pathElements[0], pathElements[1], pathElements[2]
-					bucketID := storj.JoinPaths(project, bucketName)
-
-					// paths are iterated in order, so everything in a bucket is
-					// iterated together. When a project or bucket changes,
-					// the previous bucket is completely finished.
-					if currentBucket != bucketID {
-						if currentBucket != "" {
-							// report the previous bucket and add to the totals
-							currentBucketTally.Report("bucket")
-							totalTallies.Combine(&currentBucketTally)
-
-							// add currentBucketTally to bucketTallies
-							bucketTallies[currentBucket] = &currentBucketTally
-							currentBucketTally = accounting.BucketTally{}
-						}
-						currentBucket = bucketID
-					}
-
-					currentBucketTally.AddSegment(pointer, segment == "l")
-				}
-
-				remote := pointer.GetRemote()
-				if remote == nil {
-					continue
-				}
-				pieces := remote.GetRemotePieces()
-				if pieces == nil {
-					t.logger.Debug("no pieces on remote segment")
-					continue
-				}
-				segmentSize := pointer.GetSegmentSize()
-				redundancy := remote.GetRedundancy()
-				if redundancy == nil {
-					t.logger.Debug("no redundancy scheme present")
-					continue
-				}
-				minReq := redundancy.GetMinReq()
-				if minReq <= 0 {
-					t.logger.Debug("pointer minReq must be an int greater than 0")
-					continue
-				}
-				pieceSize := segmentSize / int64(minReq)
-				for _, piece := range pieces {
-					nodeData[piece.NodeId] += float64(pieceSize)
-				}
-			}
-			return nil
-		},
-	)
+
+	observer := newObserver(t.logger)
+	err = t.metainfoLoop.Join(ctx, observer)
 	if err != nil {
 		return latestTally, nodeData, bucketTallies, Error.Wrap(err)
 	}
+	observer.finish()
 
-	if currentBucket != "" {
-		// wrap up the last bucket
-		totalTallies.Combine(&currentBucketTally)
-		bucketTallies[currentBucket] = &currentBucketTally
-	}
-	totalTallies.Report("total")
-	mon.IntVal("bucket_count").Observe(bucketCount)
+	nodeData = observer.Node
+	bucketTallies = observer.Bucket
 
 	if len(nodeData) == 0 {
 		return latestTally, nodeData, bucketTallies, nil
@@ -202,6 +121,115 @@ func (t *Service) calculateAtRestData(ctx context.Context) (latestTally time.Tim
 	return latestTally, nodeData, bucketTallies, err
 }
 
+// observer tallies the pointers visited by the metainfo loop
+type observer struct {
+	logger *zap.Logger
+
+	Node   map[storj.NodeID]float64
+	Bucket map[string]*accounting.BucketTally
+
+	currentBucket string
+	current       *accounting.BucketTally
+	total         accounting.BucketTally
+	bucketCount   int64
+}
+
+// newObserver returns a new tally observer
+func newObserver(logger *zap.Logger) *observer {
+	return &observer{
+		logger: logger,
+		Node:   make(map[storj.NodeID]float64),
+		Bucket: make(map[string]*accounting.BucketTally),
+	}
+}
+
+// RemoteSegment tallies the segment for its bucket and its pieces for the storage nodes
+func (observer *observer) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.addBucketData(path, pointer)
+
+	remote := pointer.GetRemote()
+	pieces := remote.GetRemotePieces()
+	if pieces == nil {
+		observer.logger.Debug("no pieces on remote segment")
+		return nil
+	}
+	segmentSize := pointer.GetSegmentSize()
+	redundancy := remote.GetRedundancy()
+	if redundancy == nil {
+		observer.logger.Debug("no redundancy scheme present")
+		return nil
+	}
+	minReq := redundancy.GetMinReq()
+	if minReq <= 0 {
+		observer.logger.Debug("pointer minReq must be an int greater than 0")
+		return nil
+	}
+	pieceSize := segmentSize / int64(minReq)
+	for _, piece := range pieces {
+		observer.Node[piece.NodeId] += float64(pieceSize)
+	}
+	return nil
+}
+
+// RemoteObject is called for the last segment of every remote object, which RemoteSegment already tallied
+func (observer *observer) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	return nil
+}
+
+// InlineSegment tallies the segment for its bucket
+func (observer *observer) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.addBucketData(path, pointer)
+	return nil
+}
+
+// addBucketData adds the pointer to the tally of its bucket
+func (observer *observer) addBucketData(path storj.Path, pointer *pb.Pointer) {
+	pathElements := storj.SplitPath(path)
+	// check to make sure there are at least *4* path elements. the first three
+	// are project, segment, and bucket name, but we want to make sure we're talking
+	// about an actual object, and that there's an object name specified
+
+	// handle conditions with buckets with no files
+	if len(pathElements) == 3 {
+		observer.bucketCount++
+		return
+	}
+	if len(pathElements) < 4 {
+		return
+	}
+
+	project, segment, bucketName := pathElements[0], pathElements[1], pathElements[2]
+	bucketID := storj.JoinPaths(project, bucketName)
+
+	// paths are iterated in order, so everything in a bucket is
+	// iterated together. When a project or bucket changes,
+	// the previous bucket is completely finished.
+	if observer.currentBucket != bucketID {
+		observer.finishBucket()
+		observer.currentBucket = bucketID
+		observer.current = &accounting.BucketTally{}
+	}
+
+	observer.current.AddSegment(pointer, segment == "l")
+}
+
+// finishBucket reports the current bucket and adds it to the totals
+func (observer *observer) finishBucket() {
+	if observer.currentBucket == "" {
+		return
+	}
+	observer.current.Report("bucket")
+	observer.total.Combine(observer.current)
+	observer.Bucket[observer.currentBucket] = observer.current
+}
+
+// finish wraps up the last bucket and reports the totals
+func (observer *observer) finish() {
+	observer.finishBucket()
+	observer.total.Report("total")
+	mon.IntVal("bucket_count").Observe(observer.bucketCount)
+}
+
 // SaveAtRestRaw records raw tallies of at-rest-data and updates the LastTimestamp
 func (t *Service) SaveAtRestRaw(ctx context.Context, latestTally time.Time, created time.Time, nodeData map[storj.NodeID]float64) error {
 	return t.accountingDB.SaveAtRestRaw(ctx, latestTally, created, nodeData)
@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+)
+
+func TestUsageCache(t *testing.T) {
+	newKey := func(resource usageResource) usageKey {
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		return usageKey{projectID: *projectID, resource: resource}
+	}
+
+	cache := newUsageCache(time.Hour, 2)
+	now := time.Now()
+
+	first, second, third := newKey(storageResource), newKey(bandwidthResource), newKey(storageResource)
+
+	cache.put(first, cachedUsage{limit: memory.GB, used: 1}, now)
+	cache.put(second, cachedUsage{limit: memory.GB, used: 2}, now.Add(30*time.Minute))
+
+	current, ok := cache.get(first, now.Add(time.Minute))
+	require.True(t, ok)
+	assert.Equal(t, int64(1), current.used)
+
+	// the expired usages are dropped when they are read
+	_, ok = cache.get(first, now.Add(time.Hour))
+	assert.False(t, ok)
+	assert.Len(t, cache.usages, 1)
+
+	// the expired usages are dropped to make room
+	cache.put(first, cachedUsage{limit: memory.GB, used: 1}, now)
+	cache.put(third, cachedUsage{limit: memory.GB, used: 3}, now.Add(time.Hour))
+	assert.Len(t, cache.usages, 2)
+	_, ok = cache.get(third, now.Add(time.Hour))
+	assert.True(t, ok)
+	_, ok = cache.get(second, now.Add(time.Hour))
+	assert.True(t, ok)
+
+	// the cache never grows past its limit
+	cache.put(first, cachedUsage{limit: memory.GB, used: 1}, now.Add(time.Hour))
+	assert.Len(t, cache.usages, 2)
+	_, ok = cache.get(first, now.Add(time.Hour))
+	assert.True(t, ok)
+
+	// nothing is cached without an expiration
+	uncached := newUsageCache(0, 2)
+	uncached.put(first, cachedUsage{limit: memory.GB, used: 1}, now)
+	assert.Empty(t, uncached.usages)
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	// ContainError is the containment errs class
+	ContainError = errs.Class("containment error")
+
+	// ErrContainedNotFound is the errs class for when a pending audit isn't found
+	ErrContainedNotFound = errs.Class("pending audit not found")
+
+	// ErrAlreadyExists is the errs class for when a node already has a different pending audit
+	ErrAlreadyExists = errs.Class("node already in containment mode")
+)
+
+// PendingAudit contains the share a contained node has to return to leave containment mode
+type PendingAudit struct {
+	NodeID            storj.NodeID
+	PieceID           storj.PieceID
+	StripeIndex       int64
+	ShareSize         int32
+	ExpectedShareHash []byte
+	ReverifyCount     int32
+	Path              storj.Path
+}
+
+// Same returns whether the pending audits are for the same share
+func (pending *PendingAudit) Same(other *PendingAudit) bool {
+	return pending.NodeID == other.NodeID &&
+		pending.PieceID == other.PieceID &&
+		pending.StripeIndex == other.StripeIndex &&
+		pending.ShareSize == other.ShareSize &&
+		bytes.Equal(pending.ExpectedShareHash, other.ExpectedShareHash)
+}
+
+// Containment holds the pending audits of the nodes in containment mode
+type Containment interface {
+	// Get returns the pending audit of the node, or ErrContainedNotFound
+	Get(ctx context.Context, nodeID storj.NodeID) (*PendingAudit, error)
+	// IncrementPending contains the node with the pending audit, or increments
+	// the reverify count when the node is already contained with the same audit
+	IncrementPending(ctx context.Context, pendingAudit *PendingAudit) error
+	// Delete releases the node from containment mode
+	Delete(ctx context.Context, nodeID storj.NodeID) (bool, error)
+}
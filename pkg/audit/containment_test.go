@@ -0,0 +1,127 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestContainment(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		containment := db.Containment()
+
+		pending := &audit.PendingAudit{
+			NodeID:            storj.NodeID{1},
+			PieceID:           storj.PieceID{2},
+			StripeIndex:       3,
+			ShareSize:         256,
+			ExpectedShareHash: pkcrypto.SHA256Hash([]byte("share")),
+			Path:              "project/l/bucket/object",
+		}
+
+		_, err := containment.Get(ctx, pending.NodeID)
+		require.True(t, audit.ErrContainedNotFound.Has(err))
+
+		require.NoError(t, containment.IncrementPending(ctx, pending))
+
+		got, err := containment.Get(ctx, pending.NodeID)
+		require.NoError(t, err)
+		require.Equal(t, pending, got)
+
+		// the same pending audit increases the reverify count
+		require.NoError(t, containment.IncrementPending(ctx, pending))
+
+		got, err = containment.Get(ctx, pending.NodeID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, got.ReverifyCount)
+
+		// a contained node can't get a different pending audit
+		other := *pending
+		other.StripeIndex++
+		err = containment.IncrementPending(ctx, &other)
+		require.True(t, audit.ErrAlreadyExists.Has(err))
+
+		deleted, err := containment.Delete(ctx, pending.NodeID)
+		require.NoError(t, err)
+		require.True(t, deleted)
+
+		deleted, err = containment.Delete(ctx, pending.NodeID)
+		require.NoError(t, err)
+		require.False(t, deleted)
+
+		_, err = containment.Get(ctx, pending.NodeID)
+		require.True(t, audit.ErrContainedNotFound.Has(err))
+	})
+}
+
+func TestReporterPendingAudits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Audit.Service.Loop.Stop()
+
+		const maxReverifyCount = 2
+		containment := satellite.DB.Containment()
+		reporter := audit.NewReporter(satellite.Overlay.Service, containment, 1, maxReverifyCount)
+
+		nodeID := planet.StorageNodes[0].ID()
+		pending := &audit.PendingAudit{
+			NodeID:            nodeID,
+			PieceID:           teststorj.PieceIDFromString("piece"),
+			StripeIndex:       1,
+			ShareSize:         256,
+			ExpectedShareHash: pkcrypto.SHA256Hash([]byte("share")),
+			Path:              "project/l/bucket/object",
+		}
+
+		before, err := satellite.Overlay.Service.Get(ctx, nodeID)
+		require.NoError(t, err)
+
+		// the node stays contained until it runs out of reverify attempts
+		for i := 0; i <= maxReverifyCount; i++ {
+			_, err = reporter.RecordAudits(ctx, &audit.RecordAuditsInfo{PendingAudits: []*audit.PendingAudit{pending}})
+			require.NoError(t, err)
+
+			pending, err = containment.Get(ctx, nodeID)
+			require.NoError(t, err)
+			require.EqualValues(t, i, pending.ReverifyCount)
+		}
+
+		_, err = reporter.RecordAudits(ctx, &audit.RecordAuditsInfo{PendingAudits: []*audit.PendingAudit{pending}})
+		require.NoError(t, err)
+
+		_, err = containment.Get(ctx, nodeID)
+		require.True(t, audit.ErrContainedNotFound.Has(err))
+
+		after, err := satellite.Overlay.Service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, before.Reputation.AuditCount+1, after.Reputation.AuditCount)
+		require.Equal(t, before.Reputation.AuditSuccessCount, after.Reputation.AuditSuccessCount)
+
+		// a successful audit releases the node from containment
+		pending.ReverifyCount = 0
+		require.NoError(t, containment.IncrementPending(ctx, pending))
+
+		_, err = reporter.RecordAudits(ctx, &audit.RecordAuditsInfo{SuccessNodeIDs: storj.NodeIDList{nodeID}})
+		require.NoError(t, err)
+
+		_, err = containment.Get(ctx, nodeID)
+		require.True(t, audit.ErrContainedNotFound.Has(err))
+	})
+}
@@ -15,8 +15,9 @@ import (
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
-	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/storage"
 )
 
 // Stripe keeps track of a stripe's index and its parent segment
@@ -26,55 +27,55 @@ type Stripe struct {
 	SegmentPath storj.Path
 }
 
-// Cursor keeps track of audit location in pointer db
+// Cursor selects the segments to audit from random samples of the metainfo
 type Cursor struct {
-	pointerdb *pointerdb.Service
-	lastPath  storj.Path
-	mutex     sync.Mutex
+	pointerdb    *pointerdb.Service
+	metainfoLoop *metainfo.Loop
+	candidates   int
+
+	mutex sync.Mutex
+	paths []storj.Path
 }
 
-// NewCursor creates a Cursor which iterates over pointer db
-func NewCursor(pointerdb *pointerdb.Service) *Cursor {
+// NewCursor creates a Cursor which samples up to candidates remote segments
+// on each iteration of the metainfo loop
+func NewCursor(pointerdb *pointerdb.Service, metainfoLoop *metainfo.Loop, candidates int) *Cursor {
 	return &Cursor{
-		pointerdb: pointerdb,
+		pointerdb:    pointerdb,
+		metainfoLoop: metainfoLoop,
+		candidates:   candidates,
 	}
 }
 
 // NextStripe returns a random stripe to be audited
 func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	cursor.mutex.Lock()
 	defer cursor.mutex.Unlock()
 
-	var pointerItems []*pb.ListResponse_Item
-	var path storj.Path
-	var more bool
-
-	pointerItems, more, err = cursor.pointerdb.List("", cursor.lastPath, "", true, 0, meta.None)
-	if err != nil {
-		return nil, err
+	if len(cursor.paths) == 0 {
+		sampler := newSampler(cursor.candidates)
+		err = cursor.metainfoLoop.Join(ctx, sampler)
+		if err != nil {
+			return nil, err
+		}
+		cursor.paths = sampler.paths
 	}
 
-	if len(pointerItems) == 0 {
+	if len(cursor.paths) == 0 {
 		return nil, nil
 	}
 
-	pointerItem, err := getRandomPointer(pointerItems)
-	if err != nil {
-		return nil, err
-	}
-
-	path = pointerItem.Path
+	path := cursor.paths[0]
+	cursor.paths = cursor.paths[1:]
 
-	// keep track of last path listed
-	if !more {
-		cursor.lastPath = ""
-	} else {
-		cursor.lastPath = pointerItems[len(pointerItems)-1].Path
-	}
-
-	// get pointer info
+	// get pointer info, the segment may have been changed since it was sampled
 	pointer, err := cursor.pointerdb.Get(path)
 	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, nil
+		}
 		return nil, err
 	}
 
@@ -85,7 +86,11 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 			return nil, err
 		}
 		if t.Before(time.Now()) {
-			return nil, cursor.pointerdb.Delete(path)
+			_, _, err = cursor.pointerdb.Release(path)
+			if storage.ErrKeyNotFound.Has(err) {
+				return nil, nil
+			}
+			return nil, err
 		}
 	}
 
@@ -109,6 +114,51 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 	}, nil
 }
 
+// sampler picks a uniformly random sample of the remote segments visited by
+// the metainfo loop using reservoir sampling
+type sampler struct {
+	size  int
+	seen  int64
+	paths []storj.Path
+}
+
+// newSampler returns a sampler that keeps at most size paths
+func newSampler(size int) *sampler {
+	return &sampler{size: size}
+}
+
+// RemoteSegment considers the segment for the sample
+func (sampler *sampler) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	if sampler.size <= 0 {
+		return nil
+	}
+
+	sampler.seen++
+	if len(sampler.paths) < sampler.size {
+		sampler.paths = append(sampler.paths, path)
+		return nil
+	}
+
+	random, err := rand.Int(rand.Reader, big.NewInt(sampler.seen))
+	if err != nil {
+		return err
+	}
+	if i := random.Int64(); i < int64(sampler.size) {
+		sampler.paths[i] = path
+	}
+	return nil
+}
+
+// RemoteObject is called for the last segment of every remote object
+func (sampler *sampler) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	return nil
+}
+
+// InlineSegment is called for every inline segment, which can't be audited
+func (sampler *sampler) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	return nil
+}
+
 func getRandomStripe(pointer *pb.Pointer) (index int64, err error) {
 	redundancy, err := eestream.NewRedundancyStrategyFromProto(pointer.GetRemote().GetRedundancy())
 	if err != nil {
@@ -127,12 +177,3 @@ func getRandomStripe(pointer *pb.Pointer) (index int64, err error) {
 
 	return randomStripeIndex.Int64(), nil
 }
-
-func getRandomPointer(pointerItems []*pb.ListResponse_Item) (pointer *pb.ListResponse_Item, err error) {
-	randomNum, err := rand.Int(rand.Reader, big.NewInt(int64(len(pointerItems))))
-	if err != nil {
-		return &pb.ListResponse_Item{}, err
-	}
-
-	return pointerItems[randomNum.Int64()], nil
-}
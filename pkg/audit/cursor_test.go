@@ -159,7 +159,7 @@ func populateTestData(t *testing.T, planet *testplanet.Planet, expiration *times
 		{bm: "success-10", path: "Nada/ビデオ/😶"},
 	}
 	pointerdb := planet.Satellites[0].Metainfo.Service
-	cursor := audit.NewCursor(pointerdb)
+	cursor := audit.NewCursor(pointerdb, planet.Satellites[0].Metainfo.Loop, 10)
 
 	// put 10 pointers in db with expirations
 	t.Run("putToDB", func(t *testing.T) {
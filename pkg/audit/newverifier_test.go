@@ -39,7 +39,7 @@ func TestVerifierHappyPath(t *testing.T) {
 
 		pointerdb := planet.Satellites[0].Metainfo.Service
 		overlay := planet.Satellites[0].Overlay.Service
-		cursor := audit.NewCursor(pointerdb)
+		cursor := audit.NewCursor(pointerdb, planet.Satellites[0].Metainfo.Loop, 10)
 
 		var stripe *audit.Stripe
 		maxRetries := 3
@@ -55,7 +55,7 @@ func TestVerifierHappyPath(t *testing.T) {
 		transport := planet.Satellites[0].Transport
 		orders := planet.Satellites[0].Orders.Service
 		minBytesPerSecond := 128 * memory.B
-		verifier := audit.NewVerifier(zap.L(), transport, overlay, orders, planet.Satellites[0].Identity, minBytesPerSecond)
+		verifier := audit.NewVerifier(zap.L(), transport, overlay, orders, planet.Satellites[0].DB.Containment(), pointerdb, planet.Satellites[0].Identity, minBytesPerSecond, 3)
 		require.NotNil(t, verifier)
 
 		// stop some storage nodes to ensure audit can deal with it
@@ -70,7 +70,7 @@ func TestVerifierHappyPath(t *testing.T) {
 		_, err = planet.Satellites[0].Overlay.Service.UpdateUptime(ctx, planet.StorageNodes[1].ID(), false)
 		require.NoError(t, err)
 
-		_, err = verifier.Verify(ctx, stripe)
+		_, err = verifier.Verify(ctx, stripe, nil)
 		require.NoError(t, err)
 	})
 }
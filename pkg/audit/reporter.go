@@ -6,6 +6,8 @@ package audit
 import (
 	"context"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/storj"
 )
@@ -16,8 +18,10 @@ type reporter interface {
 
 // Reporter records audit reports in overlay and implements the reporter interface
 type Reporter struct {
-	overlay    *overlay.Cache
-	maxRetries int
+	overlay          *overlay.Cache
+	containment      Containment
+	maxRetries       int
+	maxReverifyCount int32
 }
 
 // RecordAuditsInfo is a struct containing arguments/return values for RecordAudits()
@@ -25,11 +29,12 @@ type RecordAuditsInfo struct {
 	SuccessNodeIDs storj.NodeIDList
 	FailNodeIDs    storj.NodeIDList
 	OfflineNodeIDs storj.NodeIDList
+	PendingAudits  []*PendingAudit
 }
 
 // NewReporter instantiates a reporter
-func NewReporter(overlay *overlay.Cache, maxRetries int) *Reporter {
-	return &Reporter{overlay: overlay, maxRetries: maxRetries}
+func NewReporter(overlay *overlay.Cache, containment Containment, maxRetries int, maxReverifyCount int) *Reporter {
+	return &Reporter{overlay: overlay, containment: containment, maxRetries: maxRetries, maxReverifyCount: int32(maxReverifyCount)}
 }
 
 // RecordAudits saves failed audit details to overlay
@@ -37,12 +42,13 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req *RecordAuditsInf
 	successNodeIDs := req.SuccessNodeIDs
 	failNodeIDs := req.FailNodeIDs
 	offlineNodeIDs := req.OfflineNodeIDs
+	pendingAudits := req.PendingAudits
 
 	var errNodeIDs storj.NodeIDList
 
 	retries := 0
 	for retries < reporter.maxRetries {
-		if len(successNodeIDs) == 0 && len(failNodeIDs) == 0 && len(offlineNodeIDs) == 0 {
+		if len(successNodeIDs) == 0 && len(failNodeIDs) == 0 && len(offlineNodeIDs) == 0 && len(pendingAudits) == 0 {
 			return nil, nil
 		}
 
@@ -66,6 +72,14 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req *RecordAuditsInf
 				errNodeIDs = append(errNodeIDs, offlineNodeIDs...)
 			}
 		}
+		if len(pendingAudits) > 0 {
+			pendingAudits, err = reporter.recordPendingAudits(ctx, pendingAudits)
+			if err != nil {
+				for _, pendingAudit := range pendingAudits {
+					errNodeIDs = append(errNodeIDs, pendingAudit.NodeID)
+				}
+			}
+		}
 
 		retries++
 	}
@@ -74,6 +88,7 @@ func (reporter *Reporter) RecordAudits(ctx context.Context, req *RecordAuditsInf
 			SuccessNodeIDs: successNodeIDs,
 			FailNodeIDs:    failNodeIDs,
 			OfflineNodeIDs: offlineNodeIDs,
+			PendingAudits:  pendingAudits,
 		}, Error.New("some nodes failed to be updated in overlay")
 	}
 	return nil, nil
@@ -89,6 +104,13 @@ func (reporter *Reporter) recordAuditFailStatus(ctx context.Context, failedAudit
 			IsUp:         true,
 			AuditSuccess: false,
 		})
+		if err != nil {
+			failedIDs = append(failedIDs, nodeID)
+			continue
+		}
+
+		// the node has been audited, so it isn't contained anymore
+		_, err = reporter.containment.Delete(ctx, nodeID)
 		if err != nil {
 			failedIDs = append(failedIDs, nodeID)
 		}
@@ -126,6 +148,13 @@ func (reporter *Reporter) recordAuditSuccessStatus(ctx context.Context, successN
 			IsUp:         true,
 			AuditSuccess: true,
 		})
+		if err != nil {
+			failedIDs = append(failedIDs, nodeID)
+			continue
+		}
+
+		// the node has been audited, so it isn't contained anymore
+		_, err = reporter.containment.Delete(ctx, nodeID)
 		if err != nil {
 			failedIDs = append(failedIDs, nodeID)
 		}
@@ -135,3 +164,42 @@ func (reporter *Reporter) recordAuditSuccessStatus(ctx context.Context, successN
 	}
 	return nil, nil
 }
+
+// recordPendingAudits keeps the nodes of the pending audits in containment mode,
+// or records an audit failure for them once they ran out of reverify attempts
+func (reporter *Reporter) recordPendingAudits(ctx context.Context, pendingAudits []*PendingAudit) (failed []*PendingAudit, err error) {
+	var errlist errs.Group
+
+	for _, pendingAudit := range pendingAudits {
+		if pendingAudit.ReverifyCount < reporter.maxReverifyCount {
+			err := reporter.containment.IncrementPending(ctx, pendingAudit)
+			if err != nil {
+				failed = append(failed, pendingAudit)
+				errlist.Add(err)
+			}
+			continue
+		}
+
+		// the node didn't return the pending share in any of the reverify attempts
+		_, err := reporter.overlay.UpdateStats(ctx, &overlay.UpdateRequest{
+			NodeID:       pendingAudit.NodeID,
+			IsUp:         true,
+			AuditSuccess: false,
+		})
+		if err != nil {
+			failed = append(failed, pendingAudit)
+			errlist.Add(err)
+			continue
+		}
+
+		_, err = reporter.containment.Delete(ctx, pendingAudit.NodeID)
+		if err != nil {
+			failed = append(failed, pendingAudit)
+			errlist.Add(err)
+		}
+	}
+	if len(failed) > 0 {
+		return failed, Error.New("failed to record some pending audits: %v", errlist.Err())
+	}
+	return nil, nil
+}
@@ -14,7 +14,9 @@ import (
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/orders"
 )
 
@@ -23,6 +25,8 @@ type Config struct {
 	MaxRetriesStatDB  int           `help:"max number of times to attempt updating a statdb batch" default:"3"`
 	Interval          time.Duration `help:"how frequently segments are audited" default:"30s"`
 	MinBytesPerSecond memory.Size   `help:"the minimum acceptable bytes that storage nodes can transfer per second to the satellite" default:"128B"`
+	Candidates        int           `help:"the number of remote segments sampled for auditing on each metainfo loop iteration" default:"100"`
+	MaxReverifyCount  int           `help:"limit of number of reverify attempts for a contained node before it fails the audit" default:"3"`
 }
 
 // Service helps coordinate Cursor and Verifier to run the audit process continuously
@@ -37,15 +41,15 @@ type Service struct {
 }
 
 // NewService instantiates a Service with access to a Cursor and Verifier
-func NewService(log *zap.Logger, config Config, pointerdb *pointerdb.Service,
+func NewService(log *zap.Logger, config Config, pointerdb *pointerdb.Service, metainfoLoop *metainfo.Loop,
 	orders *orders.Service, transport transport.Client, overlay *overlay.Cache,
-	identity *identity.FullIdentity) (service *Service, err error) {
+	containment Containment, identity *identity.FullIdentity) (service *Service, err error) {
 	return &Service{
 		log: log,
 
-		Cursor:   NewCursor(pointerdb),
-		Verifier: NewVerifier(log.Named("audit:verifier"), transport, overlay, orders, identity, config.MinBytesPerSecond),
-		Reporter: NewReporter(overlay, config.MaxRetriesStatDB),
+		Cursor:   NewCursor(pointerdb, metainfoLoop, config.Candidates),
+		Verifier: NewVerifier(log.Named("audit:verifier"), transport, overlay, orders, containment, pointerdb, identity, config.MinBytesPerSecond, config.MaxReverifyCount),
+		Reporter: NewReporter(overlay, containment, config.MaxRetriesStatDB, config.MaxReverifyCount),
 
 		Loop: *sync2.NewCycle(config.Interval),
 	}, nil
@@ -81,7 +85,32 @@ func (service *Service) process(ctx context.Context) error {
 		return nil
 	}
 
-	verifiedNodes, err := service.Verifier.Verify(ctx, stripe)
+	// contained nodes have to return their pending share before they can be audited again
+	reverifiedNodes, err := service.Verifier.Reverify(ctx, stripe)
+	if err != nil {
+		return err
+	}
+
+	_, err = service.Reporter.RecordAudits(ctx, reverifiedNodes)
+	if err != nil {
+		return err
+	}
+
+	skip := make(map[storj.NodeID]bool)
+	for _, nodeID := range reverifiedNodes.SuccessNodeIDs {
+		skip[nodeID] = true
+	}
+	for _, nodeID := range reverifiedNodes.FailNodeIDs {
+		skip[nodeID] = true
+	}
+	for _, nodeID := range reverifiedNodes.OfflineNodeIDs {
+		skip[nodeID] = true
+	}
+	for _, pendingAudit := range reverifiedNodes.PendingAudits {
+		skip[pendingAudit.NodeID] = true
+	}
+
+	verifiedNodes, err := service.Verifier.Verify(ctx, stripe, skip)
 	if err != nil {
 		return err
 	}
@@ -42,7 +42,7 @@ func TestGetShareTimeout(t *testing.T) {
 
 		pointerdb := planet.Satellites[0].Metainfo.Service
 		overlay := planet.Satellites[0].Overlay.Service
-		cursor := audit.NewCursor(pointerdb)
+		cursor := audit.NewCursor(pointerdb, planet.Satellites[0].Metainfo.Loop, 10)
 
 		var stripe *audit.Stripe
 		for {
@@ -67,7 +67,7 @@ func TestGetShareTimeout(t *testing.T) {
 		// downloading from new nodes.
 		minBytesPerSecond := 110 * memory.KB
 		orders := planet.Satellites[0].Orders.Service
-		verifier := audit.NewVerifier(zap.L(), slowClient, overlay, orders, planet.Satellites[0].Identity, minBytesPerSecond)
+		verifier := audit.NewVerifier(zap.L(), slowClient, overlay, orders, planet.Satellites[0].DB.Containment(), pointerdb, planet.Satellites[0].Identity, minBytesPerSecond, 3)
 		require.NotNil(t, verifier)
 
 		// stop some storage nodes to ensure audit can deal with it
@@ -83,7 +83,7 @@ func TestGetShareTimeout(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		_, err = verifier.Verify(ctx, stripe)
+		_, err = verifier.Verify(ctx, stripe, nil)
 		require.NoError(t, err)
 	})
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/vivint/infectious"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
@@ -19,9 +21,12 @@ import (
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
 	"storj.io/storj/uplink/piecestore"
 )
 
@@ -38,8 +43,13 @@ type Share struct {
 
 // Verifier helps verify the correctness of a given stripe
 type Verifier struct {
-	orders  *orders.Service
-	auditor *identity.PeerIdentity
+	log         *zap.Logger
+	orders      *orders.Service
+	auditor     *identity.PeerIdentity
+	containment Containment
+	pointerdb   *pointerdb.Service
+
+	maxReverifyCount int32
 
 	downloader downloader
 }
@@ -64,19 +74,28 @@ func newDefaultDownloader(log *zap.Logger, transport transport.Client, overlay *
 }
 
 // NewVerifier creates a Verifier
-func NewVerifier(log *zap.Logger, transport transport.Client, overlay *overlay.Cache, orders *orders.Service, id *identity.FullIdentity, minBytesPerSecond memory.Size) *Verifier {
-	return &Verifier{downloader: newDefaultDownloader(log, transport, overlay, id, minBytesPerSecond), orders: orders, auditor: id.PeerIdentity()}
+func NewVerifier(log *zap.Logger, transport transport.Client, overlay *overlay.Cache, orders *orders.Service, containment Containment, pointerdb *pointerdb.Service, id *identity.FullIdentity, minBytesPerSecond memory.Size, maxReverifyCount int) *Verifier {
+	return &Verifier{
+		log:              log,
+		downloader:       newDefaultDownloader(log, transport, overlay, id, minBytesPerSecond),
+		orders:           orders,
+		auditor:          id.PeerIdentity(),
+		containment:      containment,
+		pointerdb:        pointerdb,
+		maxReverifyCount: int32(maxReverifyCount),
+	}
 }
 
-// Verify downloads shares then verifies the data correctness at the given stripe
-func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedNodes *RecordAuditsInfo, err error) {
+// Verify downloads shares then verifies the data correctness at the given stripe.
+// Nodes in skip are not audited, nodes that time out are returned as pending audits.
+func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe, skip map[storj.NodeID]bool) (verifiedNodes *RecordAuditsInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	pointer := stripe.Segment
 	shareSize := pointer.GetRemote().GetRedundancy().GetErasureShareSize()
 	bucketID := createBucketID(stripe.SegmentPath)
 
-	orderLimits, err := verifier.orders.CreateAuditOrderLimits(ctx, verifier.auditor, bucketID, pointer)
+	orderLimits, err := verifier.orders.CreateAuditOrderLimits(ctx, verifier.auditor, bucketID, pointer, skip)
 	if err != nil {
 		return nil, err
 	}
@@ -88,18 +107,19 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedN
 
 	var offlineNodes storj.NodeIDList
 	var failedNodes storj.NodeIDList
+	containedNodes := make(map[int]storj.NodeID)
 	sharesToAudit := make(map[int]Share)
 
 	for pieceNum, share := range shares {
-		if shares[pieceNum].Error != nil {
-			if shares[pieceNum].Error == context.DeadlineExceeded ||
-				!transport.Error.Has(shares[pieceNum].Error) {
-				failedNodes = append(failedNodes, nodes[pieceNum])
-			} else {
-				offlineNodes = append(offlineNodes, nodes[pieceNum])
-			}
-		} else {
+		switch {
+		case share.Error == nil:
 			sharesToAudit[pieceNum] = share
+		case transport.Error.Has(share.Error):
+			offlineNodes = append(offlineNodes, nodes[pieceNum])
+		case isTimeout(share.Error):
+			containedNodes[pieceNum] = nodes[pieceNum]
+		default:
+			failedNodes = append(failedNodes, nodes[pieceNum])
 		}
 	}
 
@@ -107,6 +127,8 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedN
 	total := int(pointer.Remote.Redundancy.GetTotal())
 
 	if len(sharesToAudit) < required {
+		// without enough shares the expected shares of the timed out nodes
+		// can't be reconstructed, so they are neither contained nor penalized
 		return &RecordAuditsInfo{
 			SuccessNodeIDs: nil,
 			FailNodeIDs:    failedNodes,
@@ -114,7 +136,7 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedN
 		}, nil
 	}
 
-	pieceNums, err := auditShares(ctx, required, total, sharesToAudit)
+	pieceNums, correctedShares, err := auditShares(ctx, required, total, sharesToAudit)
 	if err != nil {
 		return nil, err
 	}
@@ -123,15 +145,106 @@ func (verifier *Verifier) Verify(ctx context.Context, stripe *Stripe) (verifiedN
 		failedNodes = append(failedNodes, nodes[pieceNum])
 	}
 
-	successNodes := getSuccessNodes(ctx, nodes, failedNodes, offlineNodes)
+	successNodes := getSuccessNodes(ctx, nodes, failedNodes, offlineNodes, containedNodes)
+
+	pendingAudits, err := createPendingAudits(ctx, containedNodes, correctedShares, stripe)
+	if err != nil {
+		return &RecordAuditsInfo{
+			SuccessNodeIDs: successNodes,
+			FailNodeIDs:    failedNodes,
+			OfflineNodeIDs: offlineNodes,
+		}, err
+	}
 
 	return &RecordAuditsInfo{
 		SuccessNodeIDs: successNodes,
 		FailNodeIDs:    failedNodes,
 		OfflineNodeIDs: offlineNodes,
+		PendingAudits:  pendingAudits,
 	}, nil
 }
 
+// Reverify requests the pending shares of the contained nodes holding pieces of the given stripe.
+// A node that returns its pending share passes the audit, a node that returns a different
+// share fails it and a node that times out again stays contained.
+func (verifier *Verifier) Reverify(ctx context.Context, stripe *Stripe) (verifiedNodes *RecordAuditsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	verifiedNodes = &RecordAuditsInfo{}
+
+	for _, piece := range stripe.Segment.GetRemote().GetRemotePieces() {
+		pending, err := verifier.containment.Get(ctx, piece.NodeId)
+		if err != nil {
+			if ErrContainedNotFound.Has(err) {
+				continue
+			}
+			return verifiedNodes, err
+		}
+
+		// the pending share isn't available anymore when the segment has been
+		// deleted or repaired away from the node, so the node is released
+		if !verifier.pendingSegmentExists(ctx, pending) {
+			_, err = verifier.containment.Delete(ctx, pending.NodeID)
+			if err != nil {
+				return verifiedNodes, err
+			}
+			continue
+		}
+
+		limit, err := verifier.orders.CreateAuditOrderLimit(ctx, verifier.auditor, createBucketID(pending.Path), pending.NodeID, pending.PieceID, pending.ShareSize)
+		if err != nil {
+			if orders.ErrNodeOffline.Has(err) {
+				verifiedNodes.OfflineNodeIDs = append(verifiedNodes.OfflineNodeIDs, pending.NodeID)
+				continue
+			}
+			return verifiedNodes, err
+		}
+
+		shares, _, err := verifier.downloader.DownloadShares(ctx, []*pb.AddressedOrderLimit{limit}, pending.StripeIndex, pending.ShareSize)
+		if err != nil {
+			return verifiedNodes, err
+		}
+		share := shares[0]
+
+		switch {
+		case share.Error == nil:
+			downloadedHash := pkcrypto.SHA256Hash(share.Data)
+			if bytes.Equal(downloadedHash, pending.ExpectedShareHash) {
+				verifiedNodes.SuccessNodeIDs = append(verifiedNodes.SuccessNodeIDs, pending.NodeID)
+			} else {
+				verifiedNodes.FailNodeIDs = append(verifiedNodes.FailNodeIDs, pending.NodeID)
+			}
+		case transport.Error.Has(share.Error):
+			verifiedNodes.OfflineNodeIDs = append(verifiedNodes.OfflineNodeIDs, pending.NodeID)
+		case isTimeout(share.Error):
+			verifiedNodes.PendingAudits = append(verifiedNodes.PendingAudits, pending)
+		default:
+			verifiedNodes.FailNodeIDs = append(verifiedNodes.FailNodeIDs, pending.NodeID)
+		}
+	}
+
+	return verifiedNodes, nil
+}
+
+// pendingSegmentExists returns whether the segment of the pending audit still has the piece on the node
+func (verifier *Verifier) pendingSegmentExists(ctx context.Context, pending *PendingAudit) bool {
+	pointer, err := verifier.pointerdb.Get(pending.Path)
+	if err != nil {
+		if !storage.ErrKeyNotFound.Has(err) {
+			verifier.log.Error("error getting pointer of pending audit", zap.String("Path", pending.Path), zap.Error(err))
+		}
+		return false
+	}
+
+	remote := pointer.GetRemote()
+	for _, piece := range remote.GetRemotePieces() {
+		if piece.NodeId == pending.NodeID && remote.RootPieceId.Derive(piece.NodeId) == pending.PieceID {
+			return true
+		}
+	}
+	return false
+}
+
 // Download Shares downloads shares from the nodes where remote pieces are located
 func (d *defaultDownloader) DownloadShares(ctx context.Context, limits []*pb.AddressedOrderLimit, stripeIndex int64, shareSize int32) (shares map[int]Share, nodes map[int]storj.NodeID, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -223,22 +336,23 @@ func (d *defaultDownloader) getShare(ctx context.Context, limit *pb.AddressedOrd
 }
 
 // auditShares takes the downloaded shares and uses infectious's Correct function to check that they
-// haven't been altered. auditShares returns a slice containing the piece numbers of altered shares.
-func auditShares(ctx context.Context, required, total int, originals map[int]Share) (pieceNums []int, err error) {
+// haven't been altered. auditShares returns a slice containing the piece numbers of altered shares
+// and the corrected shares.
+func auditShares(ctx context.Context, required, total int, originals map[int]Share) (pieceNums []int, corrected []infectious.Share, err error) {
 	defer mon.Task()(&ctx)(&err)
 	f, err := infectious.NewFEC(required, total)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	copies, err := makeCopies(ctx, originals)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	err = f.Correct(copies)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	for _, share := range copies {
@@ -246,7 +360,7 @@ func auditShares(ctx context.Context, required, total int, originals map[int]Sha
 			pieceNums = append(pieceNums, share.Number)
 		}
 	}
-	return pieceNums, nil
+	return pieceNums, copies, nil
 }
 
 // makeCopies takes in a map of audit Shares and deep copies their data to a slice of infectious Shares
@@ -261,8 +375,8 @@ func makeCopies(ctx context.Context, originals map[int]Share) (copies []infectio
 	return copies, nil
 }
 
-// getSuccessNodes uses the failed nodes and offline nodes arrays to determine which nodes passed the audit
-func getSuccessNodes(ctx context.Context, nodes map[int]storj.NodeID, failedNodes, offlineNodes storj.NodeIDList) (successNodes storj.NodeIDList) {
+// getSuccessNodes uses the failed, offline and contained nodes to determine which nodes passed the audit
+func getSuccessNodes(ctx context.Context, nodes map[int]storj.NodeID, failedNodes, offlineNodes storj.NodeIDList, containedNodes map[int]storj.NodeID) (successNodes storj.NodeIDList) {
 	fails := make(map[storj.NodeID]bool)
 	for _, fail := range failedNodes {
 		fails[fail] = true
@@ -270,6 +384,9 @@ func getSuccessNodes(ctx context.Context, nodes map[int]storj.NodeID, failedNode
 	for _, offline := range offlineNodes {
 		fails[offline] = true
 	}
+	for _, contained := range containedNodes {
+		fails[contained] = true
+	}
 
 	for _, node := range nodes {
 		if !fails[node] {
@@ -280,6 +397,70 @@ func getSuccessNodes(ctx context.Context, nodes map[int]storj.NodeID, failedNode
 	return successNodes
 }
 
+// createPendingAudits rebuilds the shares the contained nodes should have returned
+// from the corrected shares and creates the pending audits for them
+func createPendingAudits(ctx context.Context, containedNodes map[int]storj.NodeID, correctedShares []infectious.Share, stripe *Stripe) (pending []*PendingAudit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(containedNodes) == 0 {
+		return nil, nil
+	}
+
+	redundancy := stripe.Segment.GetRemote().GetRedundancy()
+	required := int(redundancy.GetMinReq())
+	total := int(redundancy.GetTotal())
+	shareSize := redundancy.GetErasureShareSize()
+
+	fec, err := infectious.NewFEC(required, total)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	stripeData, err := rebuildStripe(ctx, fec, correctedShares, int(shareSize))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	rootPieceID := stripe.Segment.GetRemote().RootPieceId
+	for pieceNum, nodeID := range containedNodes {
+		share := make([]byte, shareSize)
+		err = fec.EncodeSingle(stripeData, share, pieceNum)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		pending = append(pending, &PendingAudit{
+			NodeID:            nodeID,
+			PieceID:           rootPieceID.Derive(nodeID),
+			StripeIndex:       stripe.Index,
+			ShareSize:         shareSize,
+			ExpectedShareHash: pkcrypto.SHA256Hash(share),
+			Path:              stripe.SegmentPath,
+		})
+	}
+
+	return pending, nil
+}
+
+// rebuildStripe decodes the stripe from the corrected shares
+func rebuildStripe(ctx context.Context, fec *infectious.FEC, corrected []infectious.Share, shareSize int) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stripe := make([]byte, fec.Required()*shareSize)
+	err = fec.Rebuild(corrected, func(share infectious.Share) {
+		copy(stripe[share.Number*shareSize:], share.Data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stripe, nil
+}
+
+// isTimeout returns whether the error is caused by the node not responding in time
+func isTimeout(err error) bool {
+	err = errs.Unwrap(err)
+	return err == context.DeadlineExceeded || status.Code(err) == codes.DeadlineExceeded
+}
+
 func createBucketID(path storj.Path) []byte {
 	comps := storj.SplitPath(path)
 	if len(comps) < 3 {
@@ -48,7 +48,7 @@ func TestPassingAudit(t *testing.T) {
 		md := mockDownloader{shares: mockShares}
 		verifier := &audit.Verifier{downloader: &md}
 		pointer := makePointer(tt.nodeAmt)
-		verifiedNodes, err := verifier.Verify(ctx, &audit.Stripe{Index: 6, Segment: pointer, PBA: nil})
+		verifiedNodes, err := verifier.Verify(ctx, &audit.Stripe{Index: 6, Segment: pointer, PBA: nil}, nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -144,7 +144,7 @@ func TestFailingAudit(t *testing.T) {
 			Data:        append([]byte(nil), modifiedShares[i].Data...),
 		}
 	}
-	pieceNums, err := auditShares(ctx, 8, 14, auditPkgShares)
+	pieceNums, _, err := auditShares(ctx, 8, 14, auditPkgShares)
 	if err != nil {
 		panic(err)
 	}
@@ -186,7 +186,7 @@ func TestNotEnoughShares(t *testing.T) {
 			Data:        append([]byte(nil), shares[i].Data...),
 		}
 	}
-	_, err = auditShares(ctx, 20, 40, auditPkgShares)
+	_, _, err = auditShares(ctx, 20, 40, auditPkgShares)
 	assert.Contains(t, err.Error(), "infectious: must specify at least the number of required shares")
 }
 
@@ -0,0 +1,135 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// version is the serialization format of the filter
+const version = 1
+
+// maxHashCount is the largest number of hash functions a filter may use
+const maxHashCount = 32
+
+// Error is the default error class for bloom filter errors
+var Error = errs.Class("bloom filter")
+
+// Filter is a bloom filter of piece IDs. Piece IDs are random, so the hash
+// functions are windows of 8 bytes of the piece ID starting at different
+// offsets.
+type Filter struct {
+	seed      byte
+	hashCount byte
+	table     []byte
+}
+
+// NewOptimal returns a filter sized so that it has a false positive rate of
+// falsePositiveRate once expectedElements are added.
+func NewOptimal(expectedElements int, falsePositiveRate float64) *Filter {
+	if expectedElements < 1 {
+		expectedElements = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.1
+	}
+
+	bitsPerElement := -math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)
+	hashCount := int(math.Ceil(bitsPerElement * math.Ln2))
+	if hashCount > maxHashCount {
+		hashCount = maxHashCount
+	}
+	sizeInBytes := int(math.Ceil(bitsPerElement * float64(expectedElements) / 8))
+
+	return NewExplicit(byte(rand.Intn(len(storj.PieceID{}))), hashCount, sizeInBytes)
+}
+
+// NewExplicit returns a filter with the given seed, number of hash functions
+// and size of the table in bytes.
+func NewExplicit(seed byte, hashCount, sizeInBytes int) *Filter {
+	if hashCount < 1 {
+		hashCount = 1
+	}
+	if sizeInBytes < 1 {
+		sizeInBytes = 1
+	}
+	return &Filter{
+		seed:      seed,
+		hashCount: byte(hashCount),
+		table:     make([]byte, sizeInBytes),
+	}
+}
+
+// Add adds the piece ID to the filter
+func (filter *Filter) Add(pieceID storj.PieceID) {
+	bits := uint64(len(filter.table)) * 8
+	for i := 0; i < int(filter.hashCount); i++ {
+		bit := filter.hash(pieceID, i) % bits
+		filter.table[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Contains returns whether the piece ID may have been added to the filter.
+// A false result is always correct, a true result may be a false positive.
+func (filter *Filter) Contains(pieceID storj.PieceID) bool {
+	bits := uint64(len(filter.table)) * 8
+	for i := 0; i < int(filter.hashCount); i++ {
+		bit := filter.hash(pieceID, i) % bits
+		if filter.table[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// hash returns the i-th hash of the piece ID
+func (filter *Filter) hash(pieceID storj.PieceID, i int) uint64 {
+	var window [8]byte
+	offset := int(filter.seed) + i
+	for k := range window {
+		window[k] = pieceID[(offset+k)%len(pieceID)]
+	}
+	return binary.BigEndian.Uint64(window[:])
+}
+
+// Size returns the size of the serialized filter in bytes
+func (filter *Filter) Size() int {
+	return 3 + len(filter.table)
+}
+
+// Bytes serializes the filter
+func (filter *Filter) Bytes() []byte {
+	data := make([]byte, 0, filter.Size())
+	data = append(data, version, filter.seed, filter.hashCount)
+	return append(data, filter.table...)
+}
+
+// NewFromBytes deserializes a filter serialized with Bytes
+func NewFromBytes(data []byte) (*Filter, error) {
+	if len(data) < 4 {
+		return nil, Error.New("not enough data")
+	}
+	if data[0] != version {
+		return nil, Error.New("unsupported version %d", data[0])
+	}
+
+	filter := &Filter{
+		seed:      data[1],
+		hashCount: data[2],
+		table:     append([]byte{}, data[3:]...),
+	}
+	if int(filter.seed) >= len(storj.PieceID{}) {
+		return nil, Error.New("invalid seed %d", filter.seed)
+	}
+	if filter.hashCount == 0 || filter.hashCount > maxHashCount {
+		return nil, Error.New("invalid hash count %d", filter.hashCount)
+	}
+	return filter, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestFilter(t *testing.T) {
+	const count = 10000
+	const falsePositiveRate = 0.1
+
+	added := make([]storj.PieceID, count)
+	for i := range added {
+		added[i] = storj.NewPieceID()
+	}
+
+	filter := bloomfilter.NewOptimal(count, falsePositiveRate)
+	for _, pieceID := range added {
+		filter.Add(pieceID)
+	}
+
+	decoded, err := bloomfilter.NewFromBytes(filter.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, filter.Size(), len(filter.Bytes()))
+
+	for _, f := range []*bloomfilter.Filter{filter, decoded} {
+		for _, pieceID := range added {
+			require.True(t, f.Contains(pieceID))
+		}
+
+		falsePositives := 0
+		for i := 0; i < count; i++ {
+			if f.Contains(storj.NewPieceID()) {
+				falsePositives++
+			}
+		}
+		assert.InDelta(t, falsePositiveRate, float64(falsePositives)/count, 0.05)
+	}
+}
+
+func TestNewFromBytesInvalid(t *testing.T) {
+	for _, data := range [][]byte{
+		nil,
+		{1, 0, 1},
+		{2, 0, 1, 0},
+		{1, 32, 1, 0},
+		{1, 0, 0, 0},
+		{1, 0, 33, 0},
+	} {
+		_, err := bloomfilter.NewFromBytes(data)
+		assert.Error(t, err, data)
+	}
+}
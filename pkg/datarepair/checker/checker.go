@@ -7,7 +7,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -17,9 +16,8 @@ import (
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
-	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storj"
-	"storj.io/storj/storage"
+	"storj.io/storj/satellite/metainfo"
 )
 
 // Error is a standard error class for this package.
@@ -35,24 +33,24 @@ type Config struct {
 
 // Checker contains the information needed to do checks for missing pieces
 type Checker struct {
-	pointerdb   *pointerdb.Service
-	repairQueue queue.RepairQueue
-	overlay     *overlay.Cache
-	irrdb       irreparable.DB
-	logger      *zap.Logger
-	Loop        sync2.Cycle
+	metainfoLoop *metainfo.Loop
+	repairQueue  queue.RepairQueue
+	overlay      *overlay.Cache
+	irrdb        irreparable.DB
+	logger       *zap.Logger
+	Loop         sync2.Cycle
 }
 
 // NewChecker creates a new instance of checker
-func NewChecker(pointerdb *pointerdb.Service, repairQueue queue.RepairQueue, overlay *overlay.Cache, irrdb irreparable.DB, limit int, logger *zap.Logger, interval time.Duration) *Checker {
+func NewChecker(metainfoLoop *metainfo.Loop, repairQueue queue.RepairQueue, overlay *overlay.Cache, irrdb irreparable.DB, limit int, logger *zap.Logger, interval time.Duration) *Checker {
 	// TODO: reorder arguments
 	checker := &Checker{
-		pointerdb:   pointerdb,
-		repairQueue: repairQueue,
-		overlay:     overlay,
-		irrdb:       irrdb,
-		logger:      logger,
-		Loop:        *sync2.NewCycle(interval),
+		metainfoLoop: metainfoLoop,
+		repairQueue:  repairQueue,
+		overlay:      overlay,
+		irrdb:        irrdb,
+		logger:       logger,
+		Loop:         *sync2.NewCycle(interval),
 	}
 	return checker
 }
@@ -76,110 +74,117 @@ func (checker *Checker) Close() error {
 	return nil
 }
 
-// IdentifyInjuredSegments checks for missing pieces off of the pointerdb and overlay cache
+// IdentifyInjuredSegments checks for missing pieces off of the metainfo loop and overlay cache
 func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var remoteSegmentsChecked int64
-	var remoteSegmentsNeedingRepair int64
-	var remoteSegmentsLost int64
-	var remoteSegmentInfo []string
-
-	err = checker.pointerdb.Iterate("", "", true, false,
-		func(it storage.Iterator) error {
-			var item storage.ListItem
-			for it.Next(&item) {
-				pointer := &pb.Pointer{}
-
-				err = proto.Unmarshal(item.Value, pointer)
-				if err != nil {
-					return Error.New("error unmarshalling pointer %s", err)
-				}
-
-				remote := pointer.GetRemote()
-				if remote == nil {
-					continue
-				}
-
-				pieces := remote.GetRemotePieces()
-				if pieces == nil {
-					checker.logger.Debug("no pieces on remote segment")
-					continue
-				}
-
-				var nodeIDs storj.NodeIDList
-				for _, p := range pieces {
-					nodeIDs = append(nodeIDs, p.NodeId)
-				}
-
-				// Find all offline nodes
-				offlineNodes, err := checker.overlay.OfflineNodes(ctx, nodeIDs)
-				if err != nil {
-					return Error.New("error getting offline nodes %s", err)
-				}
-
-				invalidNodes, err := checker.invalidNodes(ctx, nodeIDs)
-				if err != nil {
-					return Error.New("error getting invalid nodes %s", err)
-				}
-
-				missingPieces := combineOfflineWithInvalid(offlineNodes, invalidNodes)
-
-				remoteSegmentsChecked++
-				numHealthy := len(nodeIDs) - len(missingPieces)
-				if (int32(numHealthy) >= pointer.Remote.Redundancy.MinReq) && (int32(numHealthy) < pointer.Remote.Redundancy.RepairThreshold) {
-					remoteSegmentsNeedingRepair++
-					err = checker.repairQueue.Enqueue(ctx, &pb.InjuredSegment{
-						Path:       string(item.Key),
-						LostPieces: missingPieces,
-					})
-					if err != nil {
-						return Error.New("error adding injured segment to queue %s", err)
-					}
-				} else if int32(numHealthy) < pointer.Remote.Redundancy.MinReq {
-					pathElements := storj.SplitPath(storj.Path(item.Key))
-					// check to make sure there are at least *4* path elements. the first three
-					// are project, segment, and bucket name, but we want to make sure we're talking
-					// about an actual object, and that there's an object name specified
-					if len(pathElements) >= 4 {
-						project, bucketName, segmentpath := pathElements[0], pathElements[2], pathElements[3]
-						lostSegInfo := storj.JoinPaths(project, bucketName, segmentpath)
-						if contains(remoteSegmentInfo, lostSegInfo) == false {
-							remoteSegmentInfo = append(remoteSegmentInfo, lostSegInfo)
-						}
-					}
-
-					// TODO: irreparable segment should be using storj.NodeID or something, since at the point of repair
-					//       it may have been already repaired once.
-
-					remoteSegmentsLost++
-					// make an entry in to the irreparable table
-					segmentInfo := &pb.IrreparableSegment{
-						Path:               item.Key,
-						SegmentDetail:      pointer,
-						LostPieces:         int32(len(missingPieces)),
-						LastRepairAttempt:  time.Now().Unix(),
-						RepairAttemptCount: int64(1),
-					}
-
-					//add the entry if new or update attempt count if already exists
-					err := checker.irrdb.IncrementRepairAttempts(ctx, segmentInfo)
-					if err != nil {
-						return Error.New("error handling irreparable segment to queue %s", err)
-					}
-				}
-			}
-			return nil
-		},
-	)
+	observer := &checkerObserver{checker: checker}
+	err = checker.metainfoLoop.Join(ctx, observer)
 	if err != nil {
 		return err
 	}
-	mon.IntVal("remote_segments_checked").Observe(remoteSegmentsChecked)
-	mon.IntVal("remote_segments_needing_repair").Observe(remoteSegmentsNeedingRepair)
-	mon.IntVal("remote_segments_lost").Observe(remoteSegmentsLost)
-	mon.IntVal("remote_files_lost").Observe(int64(len(remoteSegmentInfo)))
 
+	mon.IntVal("remote_segments_checked").Observe(observer.remoteSegmentsChecked)
+	mon.IntVal("remote_segments_needing_repair").Observe(observer.remoteSegmentsNeedingRepair)
+	mon.IntVal("remote_segments_lost").Observe(observer.remoteSegmentsLost)
+	mon.IntVal("remote_files_lost").Observe(int64(len(observer.remoteSegmentInfo)))
+
+	return nil
+}
+
+// checkerObserver checks the remote segments visited by the metainfo loop
+type checkerObserver struct {
+	checker *Checker
+
+	remoteSegmentsChecked       int64
+	remoteSegmentsNeedingRepair int64
+	remoteSegmentsLost          int64
+	remoteSegmentInfo           []string
+}
+
+// RemoteSegment queues the segment for repair or marks it irreparable when pieces are missing
+func (observer *checkerObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) (err error) {
+	checker := observer.checker
+
+	pieces := pointer.GetRemote().GetRemotePieces()
+	if pieces == nil {
+		checker.logger.Debug("no pieces on remote segment")
+		return nil
+	}
+
+	var nodeIDs storj.NodeIDList
+	for _, p := range pieces {
+		nodeIDs = append(nodeIDs, p.NodeId)
+	}
+
+	// Find all offline nodes
+	offlineNodes, err := checker.overlay.OfflineNodes(ctx, nodeIDs)
+	if err != nil {
+		return Error.New("error getting offline nodes %s", err)
+	}
+
+	invalidNodes, err := checker.invalidNodes(ctx, nodeIDs)
+	if err != nil {
+		return Error.New("error getting invalid nodes %s", err)
+	}
+
+	missingPieces := combineOfflineWithInvalid(offlineNodes, invalidNodes)
+
+	observer.remoteSegmentsChecked++
+	numHealthy := len(nodeIDs) - len(missingPieces)
+	if (int32(numHealthy) >= pointer.Remote.Redundancy.MinReq) && (int32(numHealthy) < pointer.Remote.Redundancy.RepairThreshold) {
+		observer.remoteSegmentsNeedingRepair++
+		err = checker.repairQueue.Enqueue(ctx, &pb.InjuredSegment{
+			Path:             path,
+			LostPieces:       missingPieces,
+			NumHealthyPieces: int32(numHealthy),
+			RepairThreshold:  pointer.Remote.Redundancy.RepairThreshold,
+		})
+		if err != nil {
+			return Error.New("error adding injured segment to queue %s", err)
+		}
+	} else if int32(numHealthy) < pointer.Remote.Redundancy.MinReq {
+		pathElements := storj.SplitPath(path)
+		// check to make sure there are at least *4* path elements. the first three
+		// are project, segment, and bucket name, but we want to make sure we're talking
+		// about an actual object, and that there's an object name specified
+		if len(pathElements) >= 4 {
+			project, bucketName, segmentpath := pathElements[0], pathElements[2], pathElements[3]
+			lostSegInfo := storj.JoinPaths(project, bucketName, segmentpath)
+			if contains(observer.remoteSegmentInfo, lostSegInfo) == false {
+				observer.remoteSegmentInfo = append(observer.remoteSegmentInfo, lostSegInfo)
+			}
+		}
+
+		// TODO: irreparable segment should be using storj.NodeID or something, since at the point of repair
+		//       it may have been already repaired once.
+
+		observer.remoteSegmentsLost++
+		// make an entry in to the irreparable table
+		segmentInfo := &pb.IrreparableSegment{
+			Path:               []byte(path),
+			SegmentDetail:      pointer,
+			LostPieces:         int32(len(missingPieces)),
+			LastRepairAttempt:  time.Now().Unix(),
+			RepairAttemptCount: int64(1),
+		}
+
+		//add the entry if new or update attempt count if already exists
+		err := checker.irrdb.IncrementRepairAttempts(ctx, segmentInfo)
+		if err != nil {
+			return Error.New("error handling irreparable segment to queue %s", err)
+		}
+	}
+	return nil
+}
+
+// RemoteObject is called for the last segment of every remote object
+func (observer *checkerObserver) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	return nil
+}
+
+// InlineSegment is called for every inline segment
+func (observer *checkerObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
 	return nil
 }
 
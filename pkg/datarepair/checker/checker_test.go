@@ -41,11 +41,13 @@ func TestIdentifyInjuredSegments(t *testing.T) {
 
 		//check if the expected segments were added to the queue
 		repairQueue := planet.Satellites[0].DB.RepairQueue()
-		injuredSegment, err := repairQueue.Dequeue(ctx)
+		injuredSegment, err := repairQueue.Dequeue(ctx, time.Hour)
 		assert.NoError(t, err)
 
 		numValidNode := int32(len(planet.StorageNodes))
 		assert.Equal(t, "b", injuredSegment.Path)
+		assert.Equal(t, numValidNode, injuredSegment.NumHealthyPieces)
+		assert.Equal(t, numValidNode+1, injuredSegment.RepairThreshold)
 		assert.Equal(t, len(planet.StorageNodes), len(injuredSegment.LostPieces))
 		for _, lostPiece := range injuredSegment.LostPieces {
 			// makePointer() starts with numValidNode good pieces
@@ -103,7 +105,7 @@ func TestIdentifyIrreparableSegments(t *testing.T) {
 
 		// check if nothing was added to repair queue
 		repairQueue := planet.Satellites[0].DB.RepairQueue()
-		_, err = repairQueue.Dequeue(ctx)
+		_, err = repairQueue.Dequeue(ctx, time.Hour)
 		assert.True(t, storage.ErrEmptyQueue.Has(err))
 
 		//check if the expected segments were added to the irreparable DB
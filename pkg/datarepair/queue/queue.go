@@ -5,9 +5,11 @@ package queue
 
 import (
 	"context"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
-	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/storage"
@@ -15,72 +17,160 @@ import (
 
 // RepairQueue implements queueing for segments that need repairing.
 type RepairQueue interface {
-	// Enqueue adds an injured segment.
+	// Enqueue adds an injured segment or updates the queued segment with the same path.
 	Enqueue(ctx context.Context, qi *pb.InjuredSegment) error
-	// Dequeue removes an injured segment.
-	Dequeue(ctx context.Context) (pb.InjuredSegment, error)
-	// Peekqueue lists limit amount of injured segments.
+	// Dequeue leases the least healthy injured segment that isn't leased yet and
+	// counts the attempt. The segment is dequeued again after the lease expires,
+	// unless it is deleted. The lease doubles with each attempt, see LeaseDuration.
+	Dequeue(ctx context.Context, lease time.Duration) (pb.InjuredSegment, error)
+	// Delete removes a dequeued injured segment, unless it was enqueued again
+	// with other data since it was dequeued.
+	Delete(ctx context.Context, qi *pb.InjuredSegment) error
+	// Peekqueue lists limit amount of injured segments, least healthy first.
 	Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSegment, error)
 }
 
-// Queue implements the RepairQueue interface
+// Health returns the ratio of the healthy pieces of the segment to its repair
+// threshold. Segments without a repair threshold are ordered by the number of
+// healthy pieces after all the others.
+func Health(qi *pb.InjuredSegment) float64 {
+	if qi.RepairThreshold <= 0 {
+		return float64(qi.NumHealthyPieces)
+	}
+	return float64(qi.NumHealthyPieces) / float64(qi.RepairThreshold)
+}
+
+// MaxLeaseDoublings is how many times the lease of a segment doubles with its repair attempts
+const MaxLeaseDoublings = 6
+
+// LeaseDuration returns the lease of a segment dequeued for the attempts-th
+// time, which doubles with each attempt so that failing repairs back off
+func LeaseDuration(lease time.Duration, attempts int32) time.Duration {
+	doublings := attempts - 1
+	if doublings < 0 {
+		doublings = 0
+	}
+	if doublings > MaxLeaseDoublings {
+		doublings = MaxLeaseDoublings
+	}
+	return lease << uint(doublings)
+}
+
+// Queue implements the RepairQueue interface in memory
 type Queue struct {
-	db storage.Queue
+	mu    sync.Mutex
+	items map[string]*item
 }
 
-// NewQueue returns a pointer to a new Queue instance with an initialized connection to Redis
-func NewQueue(client storage.Queue) *Queue {
-	zap.L().Info("Initializing new data repair queue")
-	return &Queue{db: client}
+// item is an injured segment with its lease and its repair attempts
+type item struct {
+	segment     pb.InjuredSegment
+	health      float64
+	leasedUntil time.Time
+	attempts    int32
 }
 
-// Enqueue adds a repair segment to the queue
+// NewQueue returns a new in-memory repair queue
+func NewQueue() *Queue {
+	return &Queue{items: map[string]*item{}}
+}
+
+// Enqueue adds a repair segment to the queue, keeping the lease and the
+// attempts of a segment already in the queue
 func (q *Queue) Enqueue(ctx context.Context, qi *pb.InjuredSegment) error {
-	val, err := proto.Marshal(qi)
-	if err != nil {
-		return Error.New("error marshalling injured seg %s", err)
-	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
 
-	err = q.db.Enqueue(val)
-	if err != nil {
-		return Error.New("error adding injured seg to queue %s", err)
+	segment := *qi
+	segment.Attempts = 0
+
+	if existing, ok := q.items[qi.Path]; ok {
+		existing.segment = segment
+		existing.health = Health(qi)
+		return nil
 	}
+
+	q.items[qi.Path] = &item{segment: segment, health: Health(qi)}
 	return nil
 }
 
-// Dequeue returns the next repair segement and removes it from the queue
-func (q *Queue) Dequeue(ctx context.Context) (pb.InjuredSegment, error) {
-	val, err := q.db.Dequeue()
-	if err != nil {
-		if storage.ErrEmptyQueue.Has(err) {
-			return pb.InjuredSegment{}, err
+// Dequeue leases the least healthy segment that isn't leased
+func (q *Queue) Dequeue(ctx context.Context, lease time.Duration) (pb.InjuredSegment, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+
+	var next *item
+	for _, it := range q.items {
+		if it.leasedUntil.After(now) {
+			continue
+		}
+		if next == nil || less(it, next) {
+			next = it
 		}
-		return pb.InjuredSegment{}, Error.New("error obtaining item from repair queue %s", err)
 	}
-	seg := &pb.InjuredSegment{}
-	err = proto.Unmarshal(val, seg)
-	if err != nil {
-		return pb.InjuredSegment{}, Error.New("error unmarshalling segment %s", err)
+	if next == nil {
+		return pb.InjuredSegment{}, storage.ErrEmptyQueue.New("")
 	}
-	return *seg, nil
+
+	next.attempts++
+	next.leasedUntil = now.Add(LeaseDuration(lease, next.attempts))
+
+	segment := next.segment
+	segment.Attempts = next.attempts
+	return segment, nil
+}
+
+// Delete removes the segment from the queue unless it was enqueued again with other data
+func (q *Queue) Delete(ctx context.Context, qi *pb.InjuredSegment) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	existing, ok := q.items[qi.Path]
+	if !ok {
+		return nil
+	}
+
+	segment := *qi
+	segment.Attempts = 0
+	if proto.Equal(&existing.segment, &segment) {
+		delete(q.items, qi.Path)
+	}
+	return nil
 }
 
 // Peekqueue returns upto 'limit' of the entries from the repair queue
 func (q *Queue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSegment, error) {
-	if limit < 0 || limit > storage.LookupLimit {
+	if limit <= 0 || limit > storage.LookupLimit {
 		limit = storage.LookupLimit
 	}
-	result, err := q.db.Peekqueue(limit)
-	if err != nil {
-		return []pb.InjuredSegment{}, Error.New("error peeking into repair queue %s", err)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	items := make([]*item, 0, len(q.items))
+	for _, it := range q.items {
+		items = append(items, it)
 	}
-	segs := make([]pb.InjuredSegment, 0)
-	for _, v := range result {
-		seg := &pb.InjuredSegment{}
-		if err = proto.Unmarshal(v, seg); err != nil {
-			return nil, err
-		}
-		segs = append(segs, *seg)
+	sort.Slice(items, func(i, k int) bool { return less(items[i], items[k]) })
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	segs := make([]pb.InjuredSegment, 0, len(items))
+	for _, it := range items {
+		segment := it.segment
+		segment.Attempts = it.attempts
+		segs = append(segs, segment)
 	}
 	return segs, nil
 }
+
+// less orders the items by health and then by path
+func less(a, b *item) bool {
+	if a.health != b.health {
+		return a.health < b.health
+	}
+	return a.segment.Path < b.segment.Path
+}
@@ -8,17 +8,17 @@ import (
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
-	"storj.io/storj/storage/redis"
-	"storj.io/storj/storage/redis/redisserver"
-	"storj.io/storj/storage/testqueue"
+	"storj.io/storj/storage"
 )
 
 func TestEnqueueDequeue(t *testing.T) {
@@ -29,14 +29,18 @@ func TestEnqueueDequeue(t *testing.T) {
 		q := db.RepairQueue()
 
 		seg := &pb.InjuredSegment{
-			Path:       "abc",
-			LostPieces: []int32{int32(1), int32(3)},
+			Path:             "abc",
+			LostPieces:       []int32{int32(1), int32(3)},
+			NumHealthyPieces: 4,
+			RepairThreshold:  5,
 		}
 		err := q.Enqueue(ctx, seg)
 		assert.NoError(t, err)
 
-		s, err := q.Dequeue(ctx)
+		s, err := q.Dequeue(ctx, time.Hour)
 		assert.NoError(t, err)
+		assert.Equal(t, int32(1), s.Attempts)
+		s.Attempts = 0
 		assert.True(t, pb.Equal(&s, seg))
 	})
 }
@@ -48,12 +52,108 @@ func TestDequeueEmptyQueue(t *testing.T) {
 
 		q := db.RepairQueue()
 
-		s, err := q.Dequeue(ctx)
+		s, err := q.Dequeue(ctx, time.Hour)
 		assert.Error(t, err)
+		assert.True(t, storage.ErrEmptyQueue.Has(err))
 		assert.Equal(t, pb.InjuredSegment{}, s)
 	})
 }
 
+func TestLeaseAndDelete(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		seg := &pb.InjuredSegment{Path: "abc", NumHealthyPieces: 3, RepairThreshold: 5}
+		require.NoError(t, q.Enqueue(ctx, seg))
+
+		// an expired lease makes the segment available again
+		s, err := q.Dequeue(ctx, -time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, "abc", s.Path)
+
+		s, err = q.Dequeue(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, "abc", s.Path)
+
+		// a leased segment isn't handed out twice
+		_, err = q.Dequeue(ctx, time.Hour)
+		assert.True(t, storage.ErrEmptyQueue.Has(err))
+
+		// enqueueing the same path again keeps the lease
+		seg.NumHealthyPieces = 2
+		require.NoError(t, q.Enqueue(ctx, seg))
+
+		_, err = q.Dequeue(ctx, time.Hour)
+		assert.True(t, storage.ErrEmptyQueue.Has(err))
+
+		list, err := q.Peekqueue(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, int32(2), list[0].NumHealthyPieces)
+
+		require.NoError(t, q.Delete(ctx, seg))
+
+		list, err = q.Peekqueue(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+}
+
+func TestAttemptsAndConditionalDelete(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		seg := &pb.InjuredSegment{Path: "abc", NumHealthyPieces: 3, RepairThreshold: 5}
+		require.NoError(t, q.Enqueue(ctx, seg))
+
+		// every dequeue counts an attempt
+		s, err := q.Dequeue(ctx, -time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), s.Attempts)
+
+		s, err = q.Dequeue(ctx, -time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), s.Attempts)
+
+		// the checker finds the segment again while it is repaired
+		changed := &pb.InjuredSegment{Path: "abc", NumHealthyPieces: 2, RepairThreshold: 5}
+		require.NoError(t, q.Enqueue(ctx, changed))
+
+		// the outdated repair doesn't delete the changed segment
+		require.NoError(t, q.Delete(ctx, &s))
+
+		list, err := q.Peekqueue(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, int32(2), list[0].NumHealthyPieces)
+		assert.Equal(t, int32(2), list[0].Attempts)
+
+		s, err = q.Dequeue(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), s.Attempts)
+
+		require.NoError(t, q.Delete(ctx, &s))
+
+		list, err = q.Peekqueue(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+}
+
+func TestLeaseDuration(t *testing.T) {
+	assert.Equal(t, time.Hour, queue.LeaseDuration(time.Hour, 0))
+	assert.Equal(t, time.Hour, queue.LeaseDuration(time.Hour, 1))
+	assert.Equal(t, 2*time.Hour, queue.LeaseDuration(time.Hour, 2))
+	assert.Equal(t, 8*time.Hour, queue.LeaseDuration(time.Hour, 4))
+	assert.Equal(t, 64*time.Hour, queue.LeaseDuration(time.Hour, 100))
+}
+
 func TestSequential(t *testing.T) {
 	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
 		ctx := testcontext.New(t)
@@ -64,9 +164,12 @@ func TestSequential(t *testing.T) {
 		const N = 100
 		var addSegs []*pb.InjuredSegment
 		for i := 0; i < N; i++ {
+			// later segments are less healthy
 			seg := &pb.InjuredSegment{
-				Path:       strconv.Itoa(i),
-				LostPieces: []int32{int32(i)},
+				Path:             strconv.Itoa(i),
+				LostPieces:       []int32{int32(i)},
+				NumHealthyPieces: int32(N - i),
+				RepairThreshold:  N,
 			}
 			err := q.Enqueue(ctx, seg)
 			assert.NoError(t, err)
@@ -76,15 +179,14 @@ func TestSequential(t *testing.T) {
 		list, err := q.Peekqueue(ctx, 100)
 		assert.NoError(t, err)
 		for i := 0; i < N; i++ {
-			assert.True(t, pb.Equal(addSegs[i], &list[i]))
+			assert.True(t, pb.Equal(addSegs[N-1-i], &list[i]))
 		}
 
-		// TODO: fix out of order issue
 		for i := 0; i < N; i++ {
-			dequeued, err := q.Dequeue(ctx)
+			dequeued, err := q.Dequeue(ctx, time.Hour)
 			assert.NoError(t, err)
-			expected := dequeued.LostPieces[0]
-			assert.True(t, pb.Equal(addSegs[expected], &dequeued))
+			dequeued.Attempts = 0
+			assert.True(t, pb.Equal(addSegs[N-1-i], &dequeued))
 		}
 	})
 }
@@ -120,7 +222,7 @@ func TestParallel(t *testing.T) {
 		for i := 0; i < N; i++ {
 			go func(i int) {
 				defer wg.Done()
-				segment, err := q.Dequeue(ctx)
+				segment, err := q.Dequeue(ctx, time.Hour)
 				if err != nil {
 					errs <- err
 				}
@@ -151,19 +253,33 @@ func TestParallel(t *testing.T) {
 	})
 }
 
-func BenchmarkRedisSequential(b *testing.B) {
-	addr, cleanup, err := redisserver.Start()
-	defer cleanup()
-	assert.NoError(b, err)
-	client, err := redis.NewQueue(addr, "", 1)
-	assert.NoError(b, err)
-	q := queue.NewQueue(client)
-	benchmarkSequential(b, q)
+func TestMemorySequential(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	q := queue.NewQueue()
+
+	healthy := &pb.InjuredSegment{Path: "a", NumHealthyPieces: 9, RepairThreshold: 10}
+	critical := &pb.InjuredSegment{Path: "b", NumHealthyPieces: 5, RepairThreshold: 10}
+	require.NoError(t, q.Enqueue(ctx, healthy))
+	require.NoError(t, q.Enqueue(ctx, critical))
+
+	s, err := q.Dequeue(ctx, time.Hour)
+	require.NoError(t, err)
+	s.Attempts = 0
+	assert.True(t, pb.Equal(critical, &s))
+
+	s, err = q.Dequeue(ctx, time.Hour)
+	require.NoError(t, err)
+	s.Attempts = 0
+	assert.True(t, pb.Equal(healthy, &s))
+
+	_, err = q.Dequeue(ctx, time.Hour)
+	assert.True(t, storage.ErrEmptyQueue.Has(err))
 }
 
-func BenchmarkTeststoreSequential(b *testing.B) {
-	q := queue.NewQueue(testqueue.New())
-	benchmarkSequential(b, q)
+func BenchmarkMemorySequential(b *testing.B) {
+	benchmarkSequential(b, queue.NewQueue())
 }
 
 func benchmarkSequential(b *testing.B, q queue.RepairQueue) {
@@ -176,34 +292,26 @@ func benchmarkSequential(b *testing.B, q queue.RepairQueue) {
 		var addSegs []*pb.InjuredSegment
 		for i := 0; i < N; i++ {
 			seg := &pb.InjuredSegment{
-				Path:       strconv.Itoa(i),
-				LostPieces: []int32{int32(i)},
+				Path:             strconv.Itoa(i),
+				LostPieces:       []int32{int32(i)},
+				NumHealthyPieces: int32(i),
+				RepairThreshold:  N,
 			}
 			err := q.Enqueue(ctx, seg)
 			assert.NoError(b, err)
 			addSegs = append(addSegs, seg)
 		}
 		for i := 0; i < N; i++ {
-			dqSeg, err := q.Dequeue(ctx)
+			dqSeg, err := q.Dequeue(ctx, time.Hour)
 			assert.NoError(b, err)
 			assert.True(b, pb.Equal(addSegs[i], &dqSeg))
+			assert.NoError(b, q.Delete(ctx, &dqSeg))
 		}
 	}
 }
 
-func BenchmarkRedisParallel(b *testing.B) {
-	addr, cleanup, err := redisserver.Start()
-	defer cleanup()
-	assert.NoError(b, err)
-	client, err := redis.NewQueue(addr, "", 1)
-	assert.NoError(b, err)
-	q := queue.NewQueue(client)
-	benchmarkParallel(b, q)
-}
-
-func BenchmarkTeststoreParallel(b *testing.B) {
-	q := queue.NewQueue(testqueue.New())
-	benchmarkParallel(b, q)
+func BenchmarkMemoryParallel(b *testing.B) {
+	benchmarkParallel(b, queue.NewQueue())
 }
 
 func benchmarkParallel(b *testing.B, q queue.RepairQueue) {
@@ -238,10 +346,13 @@ func benchmarkParallel(b *testing.B, q queue.RepairQueue) {
 		for i := 0; i < N; i++ {
 			go func(i int) {
 				defer wg.Done()
-				segment, err := q.Dequeue(ctx)
+				segment, err := q.Dequeue(ctx, time.Hour)
 				if err != nil {
 					errs <- err
 				}
+				if err := q.Delete(ctx, &segment); err != nil {
+					errs <- err
+				}
 				entries <- &segment
 			}(i)
 		}
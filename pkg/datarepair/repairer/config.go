@@ -22,6 +22,7 @@ type Config struct {
 	MaxRepair    int           `help:"maximum segments that can be repaired concurrently" default:"100"`
 	Interval     time.Duration `help:"how frequently checker should audit segments" default:"1h0m0s"`
 	Timeout      time.Duration `help:"time limit for uploading repaired pieces to new storage nodes" default:"1m0s"`
+	Lease        time.Duration `help:"how long a segment is reserved for a repair before it can be dequeued again, doubling with each failed attempt" default:"1h0m0s"`
 	MaxBufferMem memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4M"`
 }
 
@@ -90,7 +90,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 
 // process picks an item from repair queue and spawns a repair worker
 func (service *Service) process(ctx context.Context) error {
-	seg, err := service.queue.Dequeue(ctx)
+	seg, err := service.queue.Dequeue(ctx, service.config.Lease)
 	if err != nil {
 		if storage.ErrEmptyQueue.Has(err) {
 			return nil
@@ -100,8 +100,15 @@ func (service *Service) process(ctx context.Context) error {
 
 	service.limiter.Go(ctx, func() {
 		err := service.repairer.Repair(ctx, seg.GetPath(), seg.GetLostPieces())
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			// the segment is repaired again once its lease expires
+			zap.L().Error("Repair failed", zap.Int32("attempts", seg.GetAttempts()), zap.Error(err))
+			return
+		}
+
+		err = service.queue.Delete(ctx, &seg)
 		if err != nil {
-			zap.L().Error("Repair failed", zap.Error(err))
+			zap.L().Error("Removing repaired segment from queue failed", zap.Error(err))
 		}
 	})
 
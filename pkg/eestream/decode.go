@@ -7,6 +7,7 @@ import (
 	"context"
 	"io"
 	"io/ioutil"
+	"sort"
 	"sync"
 
 	"github.com/zeebo/errs"
@@ -49,10 +50,16 @@ func DecodeReaders(ctx context.Context, rs map[int]io.ReadCloser, es ErasureSche
 	if err := checkMBM(mbm); err != nil {
 		return readcloser.FatalReadCloser(err)
 	}
+	return newDecodedReader(ctx, rs, NewStripeReader(rs, es, mbm), es, expectedSize)
+}
+
+// newDecodedReader returns a Reader of expectedSize bytes decoded by
+// stripeReader. The readers rs are closed together with the Reader.
+func newDecodedReader(ctx context.Context, rs map[int]io.ReadCloser, stripeReader *StripeReader, es ErasureScheme, expectedSize int64) io.ReadCloser {
 	dr := &decodedReader{
 		readers:         rs,
 		scheme:          es,
-		stripeReader:    NewStripeReader(rs, es, mbm),
+		stripeReader:    stripeReader,
 		outbuf:          make([]byte, 0, es.StripeSize()),
 		expectedStripes: expectedSize / int64(es.StripeSize()),
 	}
@@ -83,6 +90,10 @@ func (dr *decodedReader) Read(p []byte) (n int, err error) {
 			return 0, dr.err
 		}
 		dr.currentStripe++
+		if dr.currentStripe >= dr.expectedStripes {
+			// all the needed shares arrived, stop reading the rest
+			dr.stripeReader.stopReaders()
+		}
 	}
 
 	// copy what data we have to the output
@@ -116,6 +127,11 @@ type decodedRanger struct {
 	rrs    map[int]ranger.Ranger
 	inSize int64
 	mbm    int // max buffer memory
+
+	// longTail is set if the pieces are read only as needed
+	longTail *LongTail
+	// order is the order of opening the pieces for the long tail reading
+	order []int
 }
 
 // Decode takes a map of Rangers and an ErasureScheme and returns a combined
@@ -158,6 +174,43 @@ func Decode(rrs map[int]ranger.Ranger, es ErasureScheme, mbm int) (ranger.Ranger
 	}, nil
 }
 
+// DecodeLongTail is like Decode, but the returned Ranger doesn't read all the
+// pieces, see LongTail. The pieces are opened in the order of the piece
+// numbers in order, the pieces missing from order are opened last.
+func DecodeLongTail(rrs map[int]ranger.Ranger, order []int, es ErasureScheme, mbm int, longTail LongTail) (ranger.Ranger, error) {
+	if longTail.Extra < 0 {
+		return nil, Error.New("negative extra piece count")
+	}
+	rr, err := Decode(rrs, es, mbm)
+	if err != nil {
+		return nil, err
+	}
+	dr, ok := rr.(*decodedRanger)
+	if !ok {
+		// there is nothing to read
+		return rr, nil
+	}
+
+	dr.longTail = &longTail
+	ordered := make(map[int]bool, len(rrs))
+	for _, num := range order {
+		if _, ok := rrs[num]; ok && !ordered[num] {
+			ordered[num] = true
+			dr.order = append(dr.order, num)
+		}
+	}
+	var rest []int
+	for num := range rrs {
+		if !ordered[num] {
+			rest = append(rest, num)
+		}
+	}
+	sort.Ints(rest)
+	dr.order = append(dr.order, rest...)
+
+	return dr, nil
+}
+
 func (dr *decodedRanger) Size() int64 {
 	blocks := dr.inSize / int64(dr.es.ErasureShareSize())
 	return blocks * int64(dr.es.StripeSize())
@@ -167,6 +220,9 @@ func (dr *decodedRanger) Range(ctx context.Context, offset, length int64) (io.Re
 	// offset and length might not be block-aligned. figure out which
 	// blocks contain this request
 	firstBlock, blockCount := encryption.CalcEncompassingBlocks(offset, length, dr.es.StripeSize())
+	if dr.longTail != nil {
+		return dr.rangeLongTail(ctx, offset, length, firstBlock, blockCount)
+	}
 	// go ask for ranges for all those block boundaries
 	// do it parallel to save from network latency
 	readers := make(map[int]io.ReadCloser, len(dr.rrs))
@@ -195,6 +251,22 @@ func (dr *decodedRanger) Range(ctx context.Context, offset, length int64) (io.Re
 	}
 	// decode from all those ranges
 	r := DecodeReaders(ctx, readers, dr.es, blockCount*int64(dr.es.StripeSize()), dr.mbm)
+	return dr.limitRange(r, offset, length, firstBlock)
+}
+
+// rangeLongTail returns the decoded range opening the pieces only as needed
+func (dr *decodedRanger) rangeLongTail(ctx context.Context, offset, length, firstBlock, blockCount int64) (io.ReadCloser, error) {
+	shareSize := int64(dr.es.ErasureShareSize())
+	open := func(ctx context.Context, num int, stripe int64) (io.ReadCloser, error) {
+		return dr.rrs[num].Range(ctx, (firstBlock+stripe)*shareSize, (blockCount-stripe)*shareSize)
+	}
+	stripeReader := NewLongTailStripeReader(ctx, dr.order, open, dr.es, dr.mbm, *dr.longTail)
+	r := newDecodedReader(ctx, nil, stripeReader, dr.es, blockCount*int64(dr.es.StripeSize()))
+	return dr.limitRange(r, offset, length, firstBlock)
+}
+
+// limitRange returns the requested range of r, which starts at firstBlock
+func (dr *decodedRanger) limitRange(r io.ReadCloser, offset, length, firstBlock int64) (io.ReadCloser, error) {
 	// offset might start a few bytes in, potentially discard the initial bytes
 	_, err := io.CopyN(ioutil.Discard, r,
 		offset-firstBlock*int64(dr.es.StripeSize()))
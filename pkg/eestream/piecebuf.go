@@ -139,10 +139,13 @@ func (b *PieceBuffer) write(p []byte) (n int, err error) {
 	b.cond.L.Lock()
 	defer b.cond.L.Unlock()
 
-	for b.full {
+	for {
 		if b.err != nil {
 			return n, b.err
 		}
+		if !b.full {
+			break
+		}
 		b.cond.Wait()
 	}
 
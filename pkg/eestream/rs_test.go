@@ -12,6 +12,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -678,3 +679,131 @@ func TestCalcPieceSize(t *testing.T) {
 		}
 	}
 }
+
+// longTailRanger is a piece ranger counting how many times it was opened and
+// how many of its readers were canceled before closing, which can fail or
+// stall the reads
+type longTailRanger struct {
+	ranger.Ranger
+	opened         *int32
+	canceledClosed *int32
+	fail           bool
+	stall          bool
+	canceled       chan struct{}
+}
+
+// longTailReader is a reader of longTailRanger
+type longTailReader struct {
+	io.ReadCloser
+	ctx            context.Context
+	canceledClosed *int32
+}
+
+func (r *longTailReader) Close() error {
+	if r.ctx.Err() != nil {
+		atomic.AddInt32(r.canceledClosed, 1)
+	}
+	return r.ReadCloser.Close()
+}
+
+func (rr *longTailRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	atomic.AddInt32(rr.opened, 1)
+	if rr.fail {
+		return nil, errors.New("piece not available")
+	}
+	if rr.stall {
+		<-ctx.Done()
+		close(rr.canceled)
+		return nil, ctx.Err()
+	}
+	rc, err := rr.Ranger.Range(ctx, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	return &longTailReader{ReadCloser: rc, ctx: ctx, canceledClosed: rr.canceledClosed}, nil
+}
+
+func TestDecodeLongTail(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	data := randData(32 * 1024)
+	fc, err := infectious.NewFEC(4, 8)
+	require.NoError(t, err)
+	rs, err := NewRedundancyStrategy(NewRSScheme(fc, 1024), 0, 0)
+	require.NoError(t, err)
+	readers, err := EncodeReader(ctx, bytes.NewReader(data), rs)
+	require.NoError(t, err)
+	pieces, err := readAll(readers)
+	require.NoError(t, err)
+
+	order := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	for i, tt := range []struct {
+		longTail LongTail
+		failed   []int
+		stalled  []int
+		offset   int64
+		length   int64
+		opened   int32
+		fail     bool
+	}{
+		{LongTail{Extra: 1}, nil, nil, 0, 32 * 1024, 5, false},
+		{LongTail{Extra: 0}, nil, nil, 0, 32 * 1024, 4, false},
+		{LongTail{Extra: 4}, nil, nil, 0, 32 * 1024, 8, false},
+		{LongTail{Extra: 10}, nil, nil, 0, 32 * 1024, 8, false},
+		{LongTail{Extra: 1}, []int{0, 1}, nil, 0, 32 * 1024, 7, false},
+		{LongTail{Extra: 1}, []int{0, 1}, nil, 5000, 10000, 7, false},
+		{LongTail{Extra: 0, SlowTimeout: 50 * time.Millisecond}, nil, []int{0}, 0, 32 * 1024, 5, false},
+		{LongTail{Extra: 1, SlowTimeout: 50 * time.Millisecond}, []int{1}, []int{0}, 1024, 20 * 1024, 7, false},
+		{LongTail{Extra: 1}, []int{0, 2, 4, 6, 7}, nil, 0, 32 * 1024, 8, true},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		var opened, canceledClosed int32
+		stalled := make(map[int]*longTailRanger)
+		rrs := make(map[int]ranger.Ranger, len(pieces))
+		for num, piece := range pieces {
+			rrs[num] = &longTailRanger{Ranger: ranger.ByteRanger(piece), opened: &opened, canceledClosed: &canceledClosed}
+		}
+		for _, num := range tt.failed {
+			rrs[num].(*longTailRanger).fail = true
+		}
+		for _, num := range tt.stalled {
+			rr := rrs[num].(*longTailRanger)
+			rr.stall = true
+			rr.canceled = make(chan struct{})
+			stalled[num] = rr
+		}
+
+		rr, err := DecodeLongTail(rrs, order, rs, 0, tt.longTail)
+		require.NoError(t, err, errTag)
+
+		r, err := rr.Range(ctx, tt.offset, tt.length)
+		require.NoError(t, err, errTag)
+
+		data2, err := ioutil.ReadAll(r)
+		if tt.fail {
+			assert.Error(t, err, errTag)
+		} else if assert.NoError(t, err, errTag) {
+			assert.Equal(t, data[tt.offset:tt.offset+tt.length], data2, errTag)
+		}
+
+		assert.NoError(t, r.Close(), errTag)
+		assert.Equal(t, tt.opened, atomic.LoadInt32(&opened), errTag)
+
+		// the stalled pieces are canceled once the range is closed, while the
+		// other readers are closed without canceling
+		for num, rr := range stalled {
+			select {
+			case <-rr.canceled:
+			case <-time.After(5 * time.Second):
+				t.Errorf("%s: stalled piece %d wasn't canceled", errTag, num)
+			}
+		}
+		assert.Zero(t, atomic.LoadInt32(&canceledClosed), errTag)
+	}
+
+	_, err = DecodeLongTail(map[int]ranger.Ranger{}, nil, rs, 0, LongTail{Extra: -1})
+	assert.Error(t, err)
+}
@@ -4,15 +4,36 @@
 package eestream
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/vivint/infectious"
 )
 
+// LongTail configures reading only as many pieces as needed: the reading
+// starts with the required count of pieces plus Extra, the other pieces are
+// spares opened on demand instead of the failing and slow ones.
+type LongTail struct {
+	// Extra is the number of pieces opened in addition to the required count
+	Extra int
+	// SlowTimeout is how long to wait for new erasure shares before opening a
+	// spare piece. If 0, spares are opened only instead of the failed pieces.
+	SlowTimeout time.Duration
+}
+
+// closeTimeout is how long the closing StripeReader waits for the piece
+// readers to finish gracefully before canceling them
+const closeTimeout = time.Second
+
+// PieceOpener opens the piece num for reading, starting at its stripe-th
+// erasure share
+type PieceOpener func(ctx context.Context, num int, stripe int64) (io.ReadCloser, error)
+
 // StripeReader can read and decodes stripes from a set of readers
 type StripeReader struct {
 	scheme      ErasureScheme
@@ -22,6 +43,17 @@ type StripeReader struct {
 	inbufs      map[int][]byte
 	inmap       map[int][]byte
 	errmap      map[int]error
+
+	// fields of the long tail reading, see NewLongTailStripeReader
+	ctx         context.Context
+	open        PieceOpener
+	spares      []int
+	cancels     map[int]context.CancelFunc
+	running     sync.WaitGroup
+	stopped     bool
+	target      int
+	bufSize     int
+	slowTimeout time.Duration
 }
 
 // NewStripeReader creates a new StripeReader from the given readers, erasure
@@ -62,16 +94,63 @@ func NewStripeReader(rs map[int]io.ReadCloser, es ErasureScheme, mbm int) *Strip
 	return r
 }
 
+// NewLongTailStripeReader creates a new StripeReader which opens the pieces
+// with open on demand, following the order of the pieces. The readers of the
+// pieces are owned by the StripeReader and closed when they aren't needed.
+func NewLongTailStripeReader(ctx context.Context, pieces []int, open PieceOpener, es ErasureScheme, mbm int, longTail LongTail) *StripeReader {
+	target := es.RequiredCount() + longTail.Extra
+	if target > len(pieces) {
+		target = len(pieces)
+	}
+
+	r := &StripeReader{
+		scheme:      es,
+		cond:        sync.NewCond(&sync.Mutex{}),
+		bufs:        make(map[int]*PieceBuffer, target),
+		inbufs:      make(map[int][]byte, target),
+		inmap:       make(map[int][]byte, target),
+		errmap:      make(map[int]error),
+		ctx:         ctx,
+		open:        open,
+		spares:      append([]int(nil), pieces...),
+		cancels:     make(map[int]context.CancelFunc, target),
+		target:      target,
+		slowTimeout: longTail.SlowTimeout,
+	}
+
+	r.bufSize = mbm / target
+	r.bufSize -= r.bufSize % es.ErasureShareSize()
+	if r.bufSize < es.ErasureShareSize() {
+		r.bufSize = es.ErasureShareSize()
+	}
+
+	r.cond.L.Lock()
+	r.openSpares(0)
+	r.cond.L.Unlock()
+
+	return r
+}
+
 // Close closes the StripeReader and all PieceBuffers.
 func (r *StripeReader) Close() error {
-	errs := make(chan error, len(r.bufs))
+	r.stopReaders()
+	r.waitReaders()
+
+	r.cond.L.Lock()
+	bufs := make([]*PieceBuffer, 0, len(r.bufs))
 	for _, buf := range r.bufs {
+		bufs = append(bufs, buf)
+	}
+	r.cond.L.Unlock()
+
+	errs := make(chan error, len(bufs))
+	for _, buf := range bufs {
 		go func(c io.Closer) {
 			errs <- c.Close()
 		}(buf)
 	}
 	var first error
-	for range r.bufs {
+	for range bufs {
 		err := <-errs
 		if err != nil && first == nil {
 			first = Error.Wrap(err)
@@ -91,8 +170,9 @@ func (r *StripeReader) ReadStripe(num int64, p []byte) ([]byte, error) {
 	defer r.cond.L.Unlock()
 
 	for r.pendingReaders() {
+		r.openSpares(num)
 		for r.readAvailableShares(num) == 0 {
-			r.cond.Wait()
+			r.wait(num)
 		}
 		if r.hasEnoughShares() {
 			out, err := r.scheme.Decode(p, r.inmap)
@@ -102,6 +182,7 @@ func (r *StripeReader) ReadStripe(num int64, p []byte) ([]byte, error) {
 				}
 				return nil, err
 			}
+			r.cancelStragglers()
 			return out, nil
 		}
 	}
@@ -130,19 +211,164 @@ func (r *StripeReader) readAvailableShares(num int64) (n int) {
 	return n
 }
 
-// pendingReaders checks if there are any pending readers to get a share from.
+// pendingReaders checks if there are any pending readers to get a share from,
+// including the spare pieces, which aren't opened yet.
 func (r *StripeReader) pendingReaders() bool {
-	goodReaders := r.readerCount - len(r.errmap)
+	goodReaders := r.readerCount - len(r.errmap) + len(r.spares)
 	return goodReaders >= r.scheme.RequiredCount() && goodReaders > len(r.inmap)
 }
 
 // hasEnoughShares check if there are enough erasure shares read to attempt
 // a decode.
 func (r *StripeReader) hasEnoughShares() bool {
+	if r.open != nil && r.target == r.scheme.RequiredCount() {
+		// reading without extra pieces skips the error detection
+		return len(r.inmap) >= r.scheme.RequiredCount()
+	}
 	return len(r.inmap) >= r.scheme.RequiredCount()+1 ||
 		(len(r.inmap) == r.scheme.RequiredCount() && !r.pendingReaders())
 }
 
+// activeReaders returns the number of the opened readers without errors.
+func (r *StripeReader) activeReaders() int {
+	return r.readerCount - len(r.errmap)
+}
+
+// openSpares opens spare pieces until the target count of the readers is
+// reached and there are readers, which may still return the num-th erasure
+// share. It must be called with the lock held.
+func (r *StripeReader) openSpares(num int64) {
+	for len(r.spares) > 0 && (r.activeReaders() < r.target || r.activeReaders() <= len(r.inmap)) {
+		r.openSpare(num)
+	}
+}
+
+// openSpare starts reading the next spare piece from its num-th erasure share.
+// It must be called with the lock held.
+func (r *StripeReader) openSpare(num int64) {
+	piece := r.spares[0]
+	r.spares = r.spares[1:]
+
+	ctx, cancel := context.WithCancel(r.ctx)
+	r.cancels[piece] = cancel
+	r.readerCount++
+
+	buf := NewPieceBuffer(make([]byte, r.bufSize), r.scheme.ErasureShareSize(), r.cond)
+	buf.currentShare = num
+	r.inbufs[piece] = make([]byte, r.scheme.ErasureShareSize())
+	r.bufs[piece] = buf
+
+	// Kick off a goroutine to open the piece and copy it into the PieceBuffer.
+	// The reader is closed by the goroutine, so the download ends gracefully.
+	r.running.Add(1)
+	go func() {
+		defer r.running.Done()
+
+		rc, err := r.open(ctx, piece, num)
+		if err != nil {
+			buf.SetError(err)
+			return
+		}
+		defer func() { _ = rc.Close() }()
+
+		_, err = io.Copy(buf, rc)
+		if err != nil {
+			buf.SetError(err)
+			return
+		}
+		buf.SetError(io.EOF)
+	}()
+}
+
+// wait waits for new data in the piece buffers. If no data arrives in the
+// slow timeout, a spare piece is opened in addition to the slow ones. It must
+// be called with the lock held.
+func (r *StripeReader) wait(num int64) {
+	if r.slowTimeout <= 0 || len(r.spares) == 0 {
+		r.cond.Wait()
+		return
+	}
+
+	expired := false
+	timer := time.AfterFunc(r.slowTimeout, func() {
+		r.cond.L.Lock()
+		expired = true
+		r.cond.L.Unlock()
+		r.cond.Broadcast()
+	})
+	r.cond.Wait()
+	timer.Stop()
+
+	if expired {
+		r.openSpare(num)
+	}
+}
+
+// cancelStragglers stops the readers exceeding the target count, which
+// haven't returned the current erasure share. It must be called with the lock
+// held.
+func (r *StripeReader) cancelStragglers() {
+	excess := r.activeReaders() - r.target
+	for i := range r.cancels {
+		if excess <= 0 {
+			return
+		}
+		if r.inmap[i] != nil || r.errmap[i] != nil {
+			continue
+		}
+		r.bufs[i].setError(io.ErrClosedPipe)
+		r.errmap[i] = Error.New("canceled as straggler")
+		excess--
+	}
+}
+
+// stopReaders stops all the readers opened by the StripeReader after their
+// current read. The contexts of the readers aren't canceled, because the
+// storage nodes don't account the downloads canceled that way.
+func (r *StripeReader) stopReaders() {
+	r.cond.L.Lock()
+	defer r.cond.L.Unlock()
+
+	if r.stopped {
+		return
+	}
+	r.stopped = true
+	for i := range r.cancels {
+		r.bufs[i].setError(io.ErrClosedPipe)
+	}
+	r.cond.Broadcast()
+}
+
+// waitReaders waits for the stopped readers to finish. The readers still
+// running after closeTimeout are canceled.
+func (r *StripeReader) waitReaders() {
+	if r.open == nil {
+		return
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		r.running.Wait()
+		close(finished)
+	}()
+
+	timer := time.NewTimer(closeTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-finished:
+	case <-timer.C:
+	}
+
+	r.cond.L.Lock()
+	for _, cancel := range r.cancels {
+		cancel()
+	}
+	r.cond.L.Unlock()
+
+	<-finished
+}
+
 // shouldWaitForMore checks the returned decode error if it makes sense to wait
 // for more erasure shares to attempt an error correction.
 func (r *StripeReader) shouldWaitForMore(err error) bool {
@@ -0,0 +1,211 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package encryption
+
+import (
+	"storj.io/storj/pkg/storj"
+)
+
+// Base is a path prefix together with its encrypted form and the key derived
+// for it. Holding a base is enough to encrypt and decrypt the paths under
+// the prefix, without being able to access any other path.
+type Base struct {
+	// Unencrypted is the unencrypted path prefix, starting with the bucket name
+	Unencrypted storj.Path
+	// Encrypted is the encrypted path prefix, starting with the unencrypted bucket name
+	Encrypted storj.Path
+	// Key is the key derived for the path prefix
+	Key storj.Key
+	// BucketKey is the content key of the bucket, which is needed to read
+	// the bucket settings when the prefix is below the bucket
+	BucketKey storj.Key
+}
+
+// Store keeps the keys for encrypting paths and content. The first component
+// of a path is the bucket name, which is never encrypted. A path is handled
+// with the most specific base containing it or, when no base contains it,
+// with the default key.
+type Store struct {
+	defaultKey *storj.Key
+	bases      []Base
+}
+
+// NewStore creates a store using defaultKey for the paths not covered by any
+// base. A nil defaultKey limits the store to the paths of the added bases.
+func NewStore(defaultKey *storj.Key) *Store {
+	return &Store{defaultKey: defaultKey}
+}
+
+// Add adds a base to the store
+func (s *Store) Add(base Base) error {
+	unencrypted := storj.SplitPath(base.Unencrypted)
+	encrypted := storj.SplitPath(base.Encrypted)
+
+	if base.Unencrypted == "" || len(unencrypted) != len(encrypted) || unencrypted[0] != encrypted[0] {
+		return Error.New("invalid base %q", base.Unencrypted)
+	}
+
+	s.bases = append(s.bases, base)
+	return nil
+}
+
+// DefaultKey returns the default key of the store, nil if it has none
+func (s *Store) DefaultKey() *storj.Key {
+	return s.defaultKey
+}
+
+// Bases returns the bases added to the store
+func (s *Store) Bases() []Base {
+	return append([]Base(nil), s.bases...)
+}
+
+// EncryptPath encrypts path without encrypting the bucket name
+func (s *Store) EncryptPath(path storj.Path, cipher storj.Cipher) (encrypted storj.Path, err error) {
+	comps := storj.SplitPath(path)
+	if len(comps) <= 1 {
+		return path, nil
+	}
+
+	base, remaining, err := s.lookup(comps, func(base Base) storj.Path { return base.Unencrypted })
+	if err != nil {
+		return "", err
+	}
+	if len(remaining) == 0 {
+		return base.Encrypted, nil
+	}
+
+	encrypted, err = EncryptPath(storj.JoinPaths(remaining...), cipher, &base.Key)
+	if err != nil {
+		return "", err
+	}
+
+	return storj.JoinPaths(base.Encrypted, encrypted), nil
+}
+
+// DecryptPath decrypts path, which was encrypted without encrypting the bucket name
+func (s *Store) DecryptPath(path storj.Path, cipher storj.Cipher) (decrypted storj.Path, err error) {
+	comps := storj.SplitPath(path)
+	if len(comps) <= 1 {
+		return path, nil
+	}
+
+	base, remaining, err := s.lookup(comps, func(base Base) storj.Path { return base.Encrypted })
+	if err != nil {
+		return "", err
+	}
+	if len(remaining) == 0 {
+		return base.Unencrypted, nil
+	}
+
+	decrypted, err = DecryptPath(storj.JoinPaths(remaining...), cipher, &base.Key)
+	if err != nil {
+		return "", err
+	}
+
+	return storj.JoinPaths(base.Unencrypted, decrypted), nil
+}
+
+// DerivePathKey derives the key of the unencrypted path. The keys of the
+// path components below it are derived from this key.
+func (s *Store) DerivePathKey(path storj.Path) (*storj.Key, error) {
+	if path == "" {
+		return nil, Error.New("path is empty")
+	}
+
+	base, remaining, err := s.lookup(storj.SplitPath(path), func(base Base) storj.Path { return base.Unencrypted })
+	if err != nil {
+		return nil, err
+	}
+
+	return DerivePathKey(storj.JoinPaths(remaining...), &base.Key, len(remaining))
+}
+
+// DeriveContentKey derives the key for the encrypted data of the object at the unencrypted path
+func (s *Store) DeriveContentKey(path storj.Path) (*storj.Key, error) {
+	pathKey, err := s.DerivePathKey(path)
+	if err != nil {
+		// the bases of the prefixes in a bucket carry the content key of the bucket
+		for _, base := range s.bases {
+			if storj.SplitPath(base.Unencrypted)[0] == path {
+				key := base.BucketKey
+				return &key, nil
+			}
+		}
+		return nil, err
+	}
+	return DeriveKey(pathKey, "content")
+}
+
+// Share returns the base for the unencrypted prefix, which lets anyone
+// holding it access the paths under prefix and nothing else
+func (s *Store) Share(prefix storj.Path, cipher storj.Cipher) (Base, error) {
+	encrypted, err := s.EncryptPath(prefix, cipher)
+	if err != nil {
+		return Base{}, err
+	}
+
+	key, err := s.DerivePathKey(prefix)
+	if err != nil {
+		return Base{}, err
+	}
+
+	bucketKey, err := s.DeriveContentKey(storj.SplitPath(prefix)[0])
+	if err != nil {
+		return Base{}, err
+	}
+
+	return Base{
+		Unencrypted: prefix,
+		Encrypted:   encrypted,
+		Key:         *key,
+		BucketKey:   *bucketKey,
+	}, nil
+}
+
+// lookup finds the most specific base whose path, as returned by basePath,
+// is a prefix of comps. It returns the base and the remaining components.
+// When no base matches, it derives a base for the bucket from the default key.
+func (s *Store) lookup(comps []string, basePath func(Base) storj.Path) (Base, []string, error) {
+	var found *Base
+	var foundLength int
+	for i := range s.bases {
+		prefix := storj.SplitPath(basePath(s.bases[i]))
+		if len(prefix) <= foundLength || !hasPrefix(comps, prefix) {
+			continue
+		}
+		found, foundLength = &s.bases[i], len(prefix)
+	}
+
+	if found != nil {
+		return *found, comps[foundLength:], nil
+	}
+
+	if s.defaultKey == nil {
+		return Base{}, nil, Error.New("no key for path %q", storj.JoinPaths(comps...))
+	}
+
+	bucketKey, err := DeriveKey(s.defaultKey, "path:"+comps[0])
+	if err != nil {
+		return Base{}, nil, err
+	}
+
+	return Base{
+		Unencrypted: comps[0],
+		Encrypted:   comps[0],
+		Key:         *bucketKey,
+	}, comps[1:], nil
+}
+
+// hasPrefix checks whether the path components start with the prefix components
+func hasPrefix(comps, prefix []string) bool {
+	if len(prefix) > len(comps) {
+		return false
+	}
+	for i := range prefix {
+		if comps[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package encryption
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/storj"
+)
+
+func TestStoreDefaultKey(t *testing.T) {
+	forAllCiphers(func(cipher storj.Cipher) {
+		key := new(storj.Key)
+		copy(key[:], randData(storj.KeySize))
+		store := NewStore(key)
+
+		for i, path := range []storj.Path{
+			"bucket",
+			"bucket/file.txt",
+			"bucket/fold1/file.txt",
+			"bucket/fold1/fold2/file.txt",
+		} {
+			errTag := fmt.Sprintf("%d. %+v", i, path)
+			comps := storj.SplitPath(path)
+
+			encrypted, err := store.EncryptPath(path, cipher)
+			require.NoError(t, err, errTag)
+
+			// the bucket is kept, the rest is encrypted with the key of the bucket
+			bucketKey, err := DerivePathKey(path, key, 1)
+			require.NoError(t, err, errTag)
+			expected, err := EncryptPath(storj.JoinPaths(comps[1:]...), cipher, bucketKey)
+			require.NoError(t, err, errTag)
+			assert.Equal(t, joinPath(comps[0], expected), encrypted, errTag)
+
+			decrypted, err := store.DecryptPath(encrypted, cipher)
+			require.NoError(t, err, errTag)
+			assert.Equal(t, path, decrypted, errTag)
+
+			contentKey, err := store.DeriveContentKey(path)
+			require.NoError(t, err, errTag)
+			expectedKey, err := DeriveContentKey(path, key)
+			require.NoError(t, err, errTag)
+			assert.Equal(t, expectedKey, contentKey, errTag)
+		}
+	})
+}
+
+func TestStoreShare(t *testing.T) {
+	forAllCiphers(func(cipher storj.Cipher) {
+		key := new(storj.Key)
+		copy(key[:], randData(storj.KeySize))
+		full := NewStore(key)
+
+		base, err := full.Share("bucket/fold1", cipher)
+		require.NoError(t, err)
+
+		shared := NewStore(nil)
+		require.NoError(t, shared.Add(base))
+
+		for i, path := range []storj.Path{
+			"bucket/fold1",
+			"bucket/fold1/file.txt",
+			"bucket/fold1/fold2/file.txt",
+		} {
+			errTag := fmt.Sprintf("%d. %+v", i, path)
+
+			encrypted, err := shared.EncryptPath(path, cipher)
+			require.NoError(t, err, errTag)
+			expected, err := full.EncryptPath(path, cipher)
+			require.NoError(t, err, errTag)
+			assert.Equal(t, expected, encrypted, errTag)
+
+			decrypted, err := shared.DecryptPath(encrypted, cipher)
+			require.NoError(t, err, errTag)
+			assert.Equal(t, path, decrypted, errTag)
+
+			contentKey, err := shared.DeriveContentKey(path)
+			require.NoError(t, err, errTag)
+			expectedKey, err := full.DeriveContentKey(path)
+			require.NoError(t, err, errTag)
+			assert.Equal(t, expectedKey, contentKey, errTag)
+		}
+
+		// the settings of the bucket can be read
+		bucketKey, err := shared.DeriveContentKey("bucket")
+		require.NoError(t, err)
+		expectedKey, err := full.DeriveContentKey("bucket")
+		require.NoError(t, err)
+		assert.Equal(t, expectedKey, bucketKey)
+
+		// the paths outside of the prefix can't be accessed
+		for i, path := range []storj.Path{
+			"bucket/file.txt",
+			"bucket/fold2/file.txt",
+			"other/fold1/file.txt",
+		} {
+			errTag := fmt.Sprintf("%d. %+v", i, path)
+
+			_, err := shared.EncryptPath(path, cipher)
+			assert.Error(t, err, errTag)
+
+			_, err = shared.DeriveContentKey(path)
+			assert.Error(t, err, errTag)
+		}
+	})
+}
+
+func TestStoreAddInvalid(t *testing.T) {
+	store := NewStore(nil)
+	assert.Error(t, store.Add(Base{}))
+	assert.Error(t, store.Add(Base{Unencrypted: "bucket/a/b", Encrypted: "bucket/x"}))
+	assert.Error(t, store.Add(Base{Unencrypted: "bucket/a", Encrypted: "other/x"}))
+}
+
+func joinPath(bucket, path storj.Path) storj.Path {
+	if path == "" {
+		return bucket
+	}
+	return storj.JoinPaths(bucket, path)
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon
+
+import (
+	"bytes"
+	"crypto/hmac"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is a general API key error
+	Error = errs.Class("api key error")
+	// ErrFormat means the API key is malformed
+	ErrFormat = errs.Class("api key format error")
+	// ErrInvalid means the API key wasn't derived from the secret it's checked with
+	ErrInvalid = errs.Class("api key invalid error")
+	// ErrUnauthorized means the caveats of the API key don't allow the action
+	ErrUnauthorized = errs.Class("api key unauthorized error")
+	// ErrRevoked means the API key or a key it was restricted from was revoked
+	ErrRevoked = errs.Class("api key revocation error")
+)
+
+// apiKeyVersion is the version byte of serialized API keys
+const apiKeyVersion = 0
+
+// APIKey is an API key backed by a macaroon
+type APIKey struct {
+	mac *Macaroon
+}
+
+// NewAPIKey creates an unrestricted API key for head signed with secret
+func NewAPIKey(head, secret []byte) *APIKey {
+	return &APIKey{mac: NewUnrestricted(head, secret)}
+}
+
+// ParseAPIKey parses a serialized API key
+func ParseAPIKey(key string) (*APIKey, error) {
+	data, version, err := base58.CheckDecode(key)
+	if err != nil {
+		return nil, ErrFormat.Wrap(err)
+	}
+	if version != apiKeyVersion {
+		return nil, ErrFormat.New("unknown version %d", version)
+	}
+
+	mac, err := ParseMacaroon(data)
+	if err != nil {
+		return nil, err
+	}
+	return &APIKey{mac: mac}, nil
+}
+
+// Restrict returns a copy of the API key restricted by caveat
+func (a *APIKey) Restrict(caveat Caveat) (*APIKey, error) {
+	data, err := proto.Marshal(&caveat)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &APIKey{mac: a.mac.AddFirstPartyCaveat(data)}, nil
+}
+
+// Check verifies the API key with Verify and checks that all of its caveats allow the action
+func (a *APIKey) Check(secret []byte, action Action, revoked [][]byte) error {
+	if err := a.Verify(secret, revoked); err != nil {
+		return err
+	}
+	return a.Allows(action)
+}
+
+// Allows checks that all of the caveats of the API key allow the action. It
+// doesn't verify the API key, which has to be checked with Verify first.
+func (a *APIKey) Allows(action Action) error {
+	for _, data := range a.mac.caveats {
+		var caveat Caveat
+		if err := proto.Unmarshal(data, &caveat); err != nil {
+			return ErrFormat.Wrap(err)
+		}
+		if !allows(&caveat, action) {
+			return ErrUnauthorized.New("action disallowed")
+		}
+	}
+
+	return nil
+}
+
+// Verify checks that the API key was derived from secret and that neither it
+// nor any key it was restricted from is among the revoked tails
+func (a *APIKey) Verify(secret []byte, revoked [][]byte) error {
+	tails := a.mac.Tails(secret)
+	if !hmac.Equal(tails[len(tails)-1], a.mac.tail) {
+		return ErrInvalid.New("macaroon unauthorized")
+	}
+
+	for _, tail := range tails {
+		for _, revokedTail := range revoked {
+			if bytes.Equal(tail, revokedTail) {
+				return ErrRevoked.New("macaroon revoked")
+			}
+		}
+	}
+
+	return nil
+}
+
+// RestrictedFrom checks whether the API key is parent or was restricted from it
+func (a *APIKey) RestrictedFrom(parent *APIKey) bool {
+	return a.mac.Extends(parent.mac)
+}
+
+// Head returns the head of the API key, which identifies the root key it was derived from
+func (a *APIKey) Head() []byte { return a.mac.Head() }
+
+// Tail returns the tail of the API key, which identifies it for revocation
+func (a *APIKey) Tail() []byte { return a.mac.Tail() }
+
+// Serialize serializes the API key to a string
+func (a *APIKey) Serialize() (string, error) {
+	data, err := a.mac.Serialize()
+	if err != nil {
+		return "", err
+	}
+	return base58.CheckEncode(data, apiKeyVersion), nil
+}
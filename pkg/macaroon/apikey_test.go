@@ -0,0 +1,122 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/macaroon"
+)
+
+func TestSerializeParse(t *testing.T) {
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+
+	key, err := macaroon.NewAPIKey([]byte("head"), secret).Restrict(macaroon.Caveat{DisallowWrites: true})
+	require.NoError(t, err)
+
+	serialized, err := key.Serialize()
+	require.NoError(t, err)
+
+	parsed, err := macaroon.ParseAPIKey(serialized)
+	require.NoError(t, err)
+	assert.Equal(t, key.Head(), parsed.Head())
+	assert.Equal(t, key.Tail(), parsed.Tail())
+
+	_, err = macaroon.ParseAPIKey(serialized[:len(serialized)-1] + "x")
+	assert.True(t, macaroon.ErrFormat.Has(err))
+}
+
+func TestCheck(t *testing.T) {
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	root := macaroon.NewAPIKey([]byte("head"), secret)
+
+	read := macaroon.Action{Op: macaroon.ActionRead, Bucket: []byte("bucket"), EncryptedPath: []byte("a/b/c"), Time: now}
+	write := macaroon.Action{Op: macaroon.ActionWrite, Bucket: []byte("bucket"), EncryptedPath: []byte("a/b/c"), Time: now}
+
+	require.NoError(t, root.Check(secret, read, nil))
+	require.NoError(t, root.Check(secret, write, nil))
+
+	otherSecret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+	assert.True(t, macaroon.ErrInvalid.Has(root.Check(otherSecret, read, nil)))
+
+	readOnly, err := root.Restrict(macaroon.Caveat{DisallowWrites: true})
+	require.NoError(t, err)
+	require.NoError(t, readOnly.Check(secret, read, nil))
+	assert.True(t, macaroon.ErrUnauthorized.Has(readOnly.Check(secret, write, nil)))
+
+	// the caveats are checked apart from the signature, which Allows ignores
+	require.NoError(t, readOnly.Allows(read))
+	assert.True(t, macaroon.ErrUnauthorized.Has(readOnly.Allows(write)))
+	assert.True(t, macaroon.ErrInvalid.Has(readOnly.Verify(otherSecret, nil)))
+
+	// restricting further can't lift the restrictions of the parent
+	prefixed, err := readOnly.Restrict(macaroon.Caveat{
+		AllowedPaths: []*macaroon.CaveatPath{{Bucket: []byte("bucket"), EncryptedPathPrefix: []byte("a/b")}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, prefixed.Check(secret, read, nil))
+	assert.True(t, macaroon.ErrUnauthorized.Has(prefixed.Check(secret, write, nil)))
+
+	for _, test := range []struct {
+		bucket, path string
+		allowed      bool
+	}{
+		{"bucket", "a/b", true},
+		{"bucket", "a/b/", true},
+		{"bucket", "a/b/d", true},
+		{"bucket", "a/bc", false},
+		{"bucket", "a", false},
+		{"other", "a/b/c", false},
+		{"", "", false},
+	} {
+		action := macaroon.Action{Op: macaroon.ActionList, Bucket: []byte(test.bucket), EncryptedPath: []byte(test.path), Time: now}
+		err := prefixed.Check(secret, action, nil)
+		assert.Equal(t, test.allowed, err == nil, "%s/%s", test.bucket, test.path)
+	}
+
+	// the metadata of the bucket can be read
+	require.NoError(t, prefixed.Check(secret, macaroon.Action{Op: macaroon.ActionRead, Bucket: []byte("bucket"), Time: now}, nil))
+
+	notAfter, err := ptypes.TimestampProto(now.Add(time.Hour))
+	require.NoError(t, err)
+	expiring, err := root.Restrict(macaroon.Caveat{NotAfter: notAfter})
+	require.NoError(t, err)
+	require.NoError(t, expiring.Check(secret, read, nil))
+
+	read.Time = now.Add(2 * time.Hour)
+	assert.True(t, macaroon.ErrUnauthorized.Has(expiring.Check(secret, read, nil)))
+}
+
+func TestRevocation(t *testing.T) {
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+
+	action := macaroon.Action{Op: macaroon.ActionRead, Bucket: []byte("bucket"), Time: time.Now()}
+	root := macaroon.NewAPIKey([]byte("head"), secret)
+
+	child, err := root.Restrict(macaroon.Caveat{DisallowDeletes: true})
+	require.NoError(t, err)
+	grandchild, err := child.Restrict(macaroon.Caveat{DisallowWrites: true})
+	require.NoError(t, err)
+
+	assert.True(t, grandchild.RestrictedFrom(child))
+	assert.True(t, grandchild.RestrictedFrom(root))
+	assert.True(t, child.RestrictedFrom(child))
+	assert.False(t, child.RestrictedFrom(grandchild))
+
+	revoked := [][]byte{child.Tail()}
+	require.NoError(t, root.Check(secret, action, revoked))
+	assert.True(t, macaroon.ErrRevoked.Has(child.Check(secret, action, revoked)))
+	assert.True(t, macaroon.ErrRevoked.Has(grandchild.Check(secret, action, revoked)))
+}
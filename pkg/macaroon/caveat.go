@@ -0,0 +1,121 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon
+
+import (
+	"bytes"
+	"crypto/rand"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// Caveat is a restriction of the actions an API key allows
+type Caveat = pb.Caveat
+
+// CaveatPath is a bucket and an encrypted path prefix a caveat allows
+type CaveatPath = pb.Caveat_Path
+
+// ActionType is the type of an action checked against the caveats of an API key
+type ActionType int
+
+const (
+	// ActionRead is reading an object, a segment or the metadata of a bucket
+	ActionRead ActionType = iota + 1
+	// ActionWrite is writing an object, a segment or a bucket
+	ActionWrite
+	// ActionList is listing buckets or the objects in a bucket
+	ActionList
+	// ActionDelete is deleting an object, a segment or a bucket
+	ActionDelete
+)
+
+// Action is an operation an API key is checked against
+type Action struct {
+	Op ActionType
+	// Bucket is empty for operations on all buckets, e.g. listing them
+	Bucket []byte
+	// EncryptedPath is the path in the bucket, the prefix for listings
+	EncryptedPath []byte
+	Time          time.Time
+}
+
+// NewCaveat returns a caveat with a random nonce, so that keys restricted
+// with otherwise equal caveats can be revoked independently
+func NewCaveat() (Caveat, error) {
+	var caveat Caveat
+	caveat.Nonce = make([]byte, 16)
+	_, err := rand.Read(caveat.Nonce)
+	if err != nil {
+		return caveat, Error.Wrap(err)
+	}
+	return caveat, nil
+}
+
+// allows checks whether caveat allows action
+func allows(caveat *Caveat, action Action) bool {
+	switch action.Op {
+	case ActionRead:
+		if caveat.DisallowReads {
+			return false
+		}
+	case ActionWrite:
+		if caveat.DisallowWrites {
+			return false
+		}
+	case ActionList:
+		if caveat.DisallowLists {
+			return false
+		}
+	case ActionDelete:
+		if caveat.DisallowDeletes {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if caveat.NotBefore != nil {
+		notBefore, err := ptypes.Timestamp(caveat.NotBefore)
+		if err != nil || action.Time.Before(notBefore) {
+			return false
+		}
+	}
+	if caveat.NotAfter != nil {
+		notAfter, err := ptypes.Timestamp(caveat.NotAfter)
+		if err != nil || action.Time.After(notAfter) {
+			return false
+		}
+	}
+
+	if len(caveat.AllowedPaths) == 0 {
+		return true
+	}
+	for _, path := range caveat.AllowedPaths {
+		if len(action.Bucket) == 0 || !bytes.Equal(path.Bucket, action.Bucket) {
+			continue
+		}
+		// the metadata of the bucket is needed to reach any path in it
+		if action.Op == ActionRead && len(action.EncryptedPath) == 0 {
+			return true
+		}
+		if hasPathPrefix(action.EncryptedPath, path.EncryptedPathPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPathPrefix checks whether the components of path start with the components of prefix
+func hasPathPrefix(path, prefix []byte) bool {
+	path = bytes.TrimSuffix(path, []byte("/"))
+	prefix = bytes.TrimSuffix(prefix, []byte("/"))
+	if len(prefix) == 0 {
+		return true
+	}
+	return bytes.Equal(path, prefix) ||
+		(bytes.HasPrefix(path, prefix) && path[len(prefix)] == '/')
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package macaroon implements API keys that can be restricted by caveats
+// without contacting the satellite that issued them.
+package macaroon
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// Macaroon is a head followed by a chain of caveats, authenticated by the
+// tail. The first tail is the HMAC of the head keyed with the root secret and
+// every caveat appended to the chain replaces the tail with the HMAC of the
+// caveat keyed with the previous tail, so anyone holding a macaroon can
+// restrict it further, but only the holder of the secret can verify it.
+type Macaroon struct {
+	head    []byte
+	caveats [][]byte
+	tail    []byte
+}
+
+// NewSecret creates a new random root secret
+func NewSecret() ([]byte, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return secret, nil
+}
+
+// NewUnrestricted creates a macaroon without caveats for head signed with secret
+func NewUnrestricted(head, secret []byte) *Macaroon {
+	return &Macaroon{
+		head: append([]byte(nil), head...),
+		tail: sign(secret, head),
+	}
+}
+
+// AddFirstPartyCaveat returns a copy of the macaroon restricted by caveat
+func (m *Macaroon) AddFirstPartyCaveat(caveat []byte) *Macaroon {
+	caveats := make([][]byte, 0, len(m.caveats)+1)
+	caveats = append(caveats, m.caveats...)
+	caveats = append(caveats, append([]byte(nil), caveat...))
+
+	return &Macaroon{
+		head:    m.head,
+		caveats: caveats,
+		tail:    sign(m.tail, caveat),
+	}
+}
+
+// Validate checks whether the macaroon was derived from the root secret
+func (m *Macaroon) Validate(secret []byte) bool {
+	tails := m.Tails(secret)
+	return hmac.Equal(tails[len(tails)-1], m.tail)
+}
+
+// Tails returns the tail after every step of the chain computed from the
+// root secret, starting with the tail of the unrestricted macaroon
+func (m *Macaroon) Tails(secret []byte) [][]byte {
+	tails := make([][]byte, 0, len(m.caveats)+1)
+	tail := sign(secret, m.head)
+	tails = append(tails, tail)
+	for _, caveat := range m.caveats {
+		tail = sign(tail, caveat)
+		tails = append(tails, tail)
+	}
+	return tails
+}
+
+// Extends checks whether the macaroon is parent or was restricted from it
+func (m *Macaroon) Extends(parent *Macaroon) bool {
+	if !bytes.Equal(m.head, parent.head) || len(m.caveats) < len(parent.caveats) {
+		return false
+	}
+	for i, caveat := range parent.caveats {
+		if !bytes.Equal(caveat, m.caveats[i]) {
+			return false
+		}
+	}
+
+	tail := parent.tail
+	for _, caveat := range m.caveats[len(parent.caveats):] {
+		tail = sign(tail, caveat)
+	}
+	return hmac.Equal(tail, m.tail)
+}
+
+// Head returns the head of the macaroon
+func (m *Macaroon) Head() []byte { return append([]byte(nil), m.head...) }
+
+// Caveats returns the serialized caveats of the macaroon
+func (m *Macaroon) Caveats() [][]byte {
+	caveats := make([][]byte, 0, len(m.caveats))
+	for _, caveat := range m.caveats {
+		caveats = append(caveats, append([]byte(nil), caveat...))
+	}
+	return caveats
+}
+
+// Tail returns the tail of the macaroon
+func (m *Macaroon) Tail() []byte { return append([]byte(nil), m.tail...) }
+
+// Serialize serializes the macaroon
+func (m *Macaroon) Serialize() ([]byte, error) {
+	data, err := proto.Marshal(&pb.Macaroon{
+		Head:    m.head,
+		Caveats: m.caveats,
+		Tail:    m.tail,
+	})
+	return data, Error.Wrap(err)
+}
+
+// ParseMacaroon parses a serialized macaroon
+func ParseMacaroon(data []byte) (*Macaroon, error) {
+	var mac pb.Macaroon
+	if err := proto.Unmarshal(data, &mac); err != nil {
+		return nil, ErrFormat.Wrap(err)
+	}
+	if len(mac.Head) == 0 || len(mac.Tail) == 0 {
+		return nil, ErrFormat.New("missing head or tail")
+	}
+	return &Macaroon{
+		head:    mac.Head,
+		caveats: mac.Caveats,
+		tail:    mac.Tail,
+	}, nil
+}
+
+// sign returns the HMAC-SHA256 of data keyed with key
+func sign(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -112,5 +112,6 @@ func bucketFromMeta(bucketName string, meta buckets.Meta) storj.Bucket {
 		SegmentsSize:     meta.SegmentsSize,
 		RedundancyScheme: meta.RedundancyScheme,
 		EncryptionScheme: meta.EncryptionScheme,
+		Versioning:       meta.Versioning,
 	}
 }
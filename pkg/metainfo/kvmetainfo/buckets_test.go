@@ -18,6 +18,7 @@ import (
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/storage/buckets"
 	ecclient "storj.io/storj/pkg/storage/ec"
@@ -320,13 +321,13 @@ func runTest(t *testing.T, test func(context.Context, *testplanet.Planet, *kvmet
 
 	planet.Start(ctx)
 
-	db, buckets, streams, err := newMetainfoParts(planet)
+	db, buckets, streams, err := newMetainfoParts(planet, 64*memory.MiB.Int64())
 	require.NoError(t, err)
 
 	test(ctx, planet, db, buckets, streams)
 }
 
-func newMetainfoParts(planet *testplanet.Planet) (*kvmetainfo.DB, buckets.Store, streams.Store, error) {
+func newMetainfoParts(planet *testplanet.Planet, segmentSize int64) (*kvmetainfo.DB, buckets.Store, streams.Store, error) {
 	// TODO(kaloyan): We should have a better way for configuring the Satellite's API Key
 	// add project to satisfy constraint
 	project, err := planet.Satellites[0].DB.Console().Projects().Insert(context.Background(), &console.Project{
@@ -369,15 +370,16 @@ func newMetainfoParts(planet *testplanet.Planet) (*kvmetainfo.DB, buckets.Store,
 
 	key := new(storj.Key)
 	copy(key[:], TestEncKey)
+	keys := encryption.NewStore(key)
 
-	streams, err := streams.NewStreamStore(segments, 64*memory.MiB.Int64(), key, 1*memory.KiB.Int(), storj.AESGCM)
+	streams, err := streams.NewStreamStore(segments, segmentSize, keys, 1*memory.KiB.Int(), storj.AESGCM)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
 	buckets := buckets.NewStore(streams)
 
-	return kvmetainfo.New(metainfo, buckets, streams, segments, key), buckets, streams, nil
+	return kvmetainfo.New(metainfo, buckets, streams, segments, keys), buckets, streams, nil
 }
 
 func forAllCiphers(test func(cipher storj.Cipher)) {
@@ -0,0 +1,119 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// CopyObject copies an object to newPath in newBucket. The copy references
+// the same pieces as the source object, so no data is transferred.
+func (db *DB) CopyObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path, copyInfo *storj.CopyObject) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.relocateObject(ctx, bucket, path, newBucket, newPath, copyInfo, true)
+}
+
+// MoveObject moves an object to newPath in newBucket without transferring its data.
+// Moving an object out of a versioned bucket leaves a delete marker in its place.
+func (db *DB) MoveObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket == newBucket && path == newPath {
+		return storj.Object{}, errClass.New("cannot move %q onto itself", path)
+	}
+
+	return db.relocateObject(ctx, bucket, path, newBucket, newPath, nil, false)
+}
+
+// relocateObject copies or moves an object to newPath in newBucket
+func (db *DB) relocateObject(ctx context.Context, bucket string, path storj.Path, newBucket string, newPath storj.Path, copyInfo *storj.CopyObject, copy bool) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	newBucketInfo := bucketInfo
+	if newBucket != bucket {
+		newBucketInfo, err = db.GetBucket(ctx, newBucket)
+		if err != nil {
+			return storj.Object{}, err
+		}
+	}
+
+	obj, _, err := db.getInfo(ctx, committedPrefix, bucket, path)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	if newPath == "" {
+		return storj.Object{}, storj.ErrNoPath.New("")
+	}
+
+	var meta pb.SerializableMeta
+	if err := proto.Unmarshal(obj.streamInfo.Metadata, &meta); err != nil {
+		return storj.Object{}, err
+	}
+
+	if copyInfo != nil {
+		if copyInfo.Metadata != nil {
+			meta.UserDefined = copyInfo.Metadata
+		}
+		if copyInfo.ContentType != "" {
+			meta.ContentType = copyInfo.ContentType
+		}
+	}
+
+	meta.Version = 0
+	meta.DeleteMarker = false
+
+	// a new version is stored where its prior version would be kept, and
+	// replaces the current version only once complete
+	target := newPath
+	if newBucketInfo.Versioning {
+		meta.Version, _, err = db.nextVersion(ctx, newBucketInfo, newPath)
+		if err != nil {
+			return storj.Object{}, err
+		}
+		target = versionPath(newPath, meta.Version)
+	}
+
+	metadata, err := proto.Marshal(&meta)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	// a versioned bucket keeps the moved object as a prior version
+	if copy || bucketInfo.Versioning {
+		_, err = db.streams.Copy(ctx, storj.JoinPaths(newBucket, target), newBucketInfo.PathCipher, obj.fullpath, bucketInfo.PathCipher, metadata)
+	} else {
+		_, err = db.streams.Move(ctx, storj.JoinPaths(newBucket, target), newBucketInfo.PathCipher, obj.fullpath, bucketInfo.PathCipher, metadata)
+	}
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	if newBucketInfo.Versioning {
+		err = db.commitVersion(ctx, newBucketInfo, newPath, meta.Version)
+		if err != nil {
+			return storj.Object{}, err
+		}
+	}
+
+	if !copy && bucketInfo.Versioning {
+		err = db.deleteVersioned(ctx, bucketInfo, path)
+		if err != nil {
+			return storj.Object{}, err
+		}
+	}
+
+	return db.GetObject(ctx, newBucket, newPath)
+}
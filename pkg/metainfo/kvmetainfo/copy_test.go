@@ -0,0 +1,140 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo_test
+
+import (
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/storage/buckets"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+const destBucket = "dest-bucket"
+
+func TestCopyAndMoveObject(t *testing.T) {
+	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		_, err = db.CreateBucket(ctx, destBucket, nil)
+		require.NoError(t, err)
+
+		data := make([]byte, 32*memory.KiB)
+		_, err = rand.Read(data)
+		require.NoError(t, err)
+
+		upload(ctx, t, db, streams, bucket, TestFile, data)
+		upload(ctx, t, db, streams, bucket, "inline-file", []byte("inline"))
+
+		_, err = db.CopyObject(ctx, TestBucket, "missing", destBucket, "copy", nil)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		_, err = db.CopyObject(ctx, TestBucket, TestFile, "missing-bucket", "copy", nil)
+		assert.True(t, storj.ErrBucketNotFound.Has(err))
+
+		copied, err := db.CopyObject(ctx, TestBucket, TestFile, destBucket, "copy", &storj.CopyObject{ContentType: "text/plain"})
+		require.NoError(t, err)
+		assert.Equal(t, "copy", copied.Path)
+		assert.Equal(t, destBucket, copied.Bucket.Name)
+		assert.Equal(t, "text/plain", copied.ContentType)
+		assert.EqualValues(t, len(data), copied.Size)
+
+		_, err = db.CopyObject(ctx, TestBucket, "inline-file", TestBucket, "inline-copy", nil)
+		require.NoError(t, err)
+
+		// the copy keeps the data after the source is deleted
+		err = db.DeleteObject(ctx, TestBucket, TestFile)
+		require.NoError(t, err)
+
+		assertContent(ctx, t, db, streams, destBucket, "copy", data)
+		assertContent(ctx, t, db, streams, TestBucket, "inline-copy", []byte("inline"))
+		assertContent(ctx, t, db, streams, TestBucket, "inline-file", []byte("inline"))
+
+		_, err = db.MoveObject(ctx, destBucket, "copy", destBucket, "copy")
+		assert.Error(t, err)
+
+		moved, err := db.MoveObject(ctx, destBucket, "copy", TestBucket, "moved")
+		require.NoError(t, err)
+		assert.Equal(t, "text/plain", moved.ContentType)
+
+		_, err = db.GetObject(ctx, destBucket, "copy")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		assertContent(ctx, t, db, streams, TestBucket, "moved", data)
+
+		// moving within a versioned bucket keeps the source as a prior version
+		_, err = db.SetBucketVersioning(ctx, TestBucket, true)
+		require.NoError(t, err)
+
+		_, err = db.MoveObject(ctx, TestBucket, "moved", TestBucket, "renamed")
+		require.NoError(t, err)
+
+		_, err = db.GetObject(ctx, TestBucket, "moved")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		assertContent(ctx, t, db, streams, TestBucket, "renamed", data)
+
+		readOnly, err := db.GetObjectVersionStream(ctx, TestBucket, "moved", 0)
+		require.NoError(t, err)
+		assertDownload(ctx, t, readOnly, streams, data)
+
+		// copying onto itself replaces the metadata and keeps the prior version
+		replaced, err := db.CopyObject(ctx, TestBucket, "renamed", TestBucket, "renamed", &storj.CopyObject{
+			Metadata: map[string]string{"key": "value"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"key": "value"}, replaced.Metadata)
+		assert.EqualValues(t, 1, replaced.Version)
+
+		assertContent(ctx, t, db, streams, TestBucket, "renamed", data)
+
+		readOnly, err = db.GetObjectVersionStream(ctx, TestBucket, "renamed", 0)
+		require.NoError(t, err)
+		assertDownload(ctx, t, readOnly, streams, data)
+
+		// copying onto an existing object replaces it without leaving staged streams
+		_, err = db.CopyObject(ctx, TestBucket, "inline-file", destBucket, "replaced", nil)
+		require.NoError(t, err)
+
+		_, err = db.CopyObject(ctx, TestBucket, "renamed", destBucket, "replaced", nil)
+		require.NoError(t, err)
+
+		assertContent(ctx, t, db, streams, destBucket, "replaced", data)
+
+		staged, _, err := streams.List(ctx, destBucket+"/.storj/staging", "", "", bucket.PathCipher, true, 0, meta.None)
+		require.NoError(t, err)
+		assert.Empty(t, staged)
+	})
+}
+
+func assertContent(ctx context.Context, t *testing.T, db *kvmetainfo.DB, streams streams.Store, bucket string, path storj.Path, content []byte) {
+	readOnly, err := db.GetObjectStream(ctx, bucket, path)
+	require.NoError(t, err)
+
+	assertDownload(ctx, t, readOnly, streams, content)
+}
+
+func assertDownload(ctx context.Context, t *testing.T, readOnly storj.ReadOnlyStream, streams streams.Store, content []byte) {
+	download := stream.NewDownload(ctx, readOnly, streams)
+	defer func() {
+		err := download.Close()
+		assert.NoError(t, err)
+	}()
+
+	data, err := ioutil.ReadAll(download)
+	require.NoError(t, err)
+	assert.Equal(t, content, data)
+}
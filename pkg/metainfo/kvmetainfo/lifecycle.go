@@ -0,0 +1,87 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+	"strings"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// SetBucketLifecycle replaces the lifecycle rules of the bucket, no rules remove them.
+// The prefixes of the rules are encrypted, so that they match whole path components only.
+func (db *DB) SetBucketLifecycle(ctx context.Context, bucket string, rules []storj.LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	lifecycle := &pb.BucketLifecycle{}
+	for _, rule := range rules {
+		prefix := strings.Trim(rule.Prefix, "/")
+		if prefix != "" {
+			encrypted, err := db.keys.EncryptPath(storj.JoinPaths(bucket, prefix), bucketInfo.PathCipher)
+			if err != nil {
+				return err
+			}
+			prefix = strings.TrimPrefix(encrypted, bucket+"/")
+		}
+
+		lifecycle.Rules = append(lifecycle.Rules, &pb.LifecycleRule{
+			Id:                        rule.ID,
+			Prefix:                    []byte(prefix),
+			ExpirationDays:            int32(rule.ExpirationDays),
+			AbortIncompleteUploadDays: int32(rule.AbortIncompleteUploadDays),
+		})
+	}
+
+	err = db.metainfo.SetBucketLifecycle(ctx, bucket, lifecycle)
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrBucketNotFound.Wrap(err)
+	}
+	return err
+}
+
+// GetBucketLifecycle returns the lifecycle rules of the bucket
+func (db *DB) GetBucketLifecycle(ctx context.Context, bucket string) (rules []storj.LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle, err := db.metainfo.GetBucketLifecycle(ctx, bucket)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrBucketNotFound.Wrap(err)
+		}
+		return nil, err
+	}
+
+	for _, rule := range lifecycle.GetRules() {
+		prefix := string(rule.Prefix)
+		if prefix != "" {
+			decrypted, err := db.keys.DecryptPath(storj.JoinPaths(bucket, prefix), bucketInfo.PathCipher)
+			if err != nil {
+				return nil, err
+			}
+			prefix = strings.TrimPrefix(decrypted, bucket+"/")
+		}
+
+		rules = append(rules, storj.LifecycleRule{
+			ID:                        rule.Id,
+			Prefix:                    prefix,
+			ExpirationDays:            int(rule.ExpirationDays),
+			AbortIncompleteUploadDays: int(rule.AbortIncompleteUploadDays),
+		})
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/buckets"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+func TestBucketLifecycle(t *testing.T) {
+	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
+		satellite := planet.Satellites[0]
+
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		data := make([]byte, 32*memory.KiB)
+		_, err = rand.Read(data)
+		require.NoError(t, err)
+
+		upload(ctx, t, db, streams, bucket, "logs/old", data)
+		upload(ctx, t, db, streams, bucket, "data/keep", []byte("keep"))
+
+		err = db.SetBucketLifecycle(ctx, "missing-bucket", nil)
+		assert.True(t, storj.ErrBucketNotFound.Has(err))
+
+		rules := []storj.LifecycleRule{
+			{ID: "logs", Prefix: "logs/", ExpirationDays: 30},
+			{ID: "pending", AbortIncompleteUploadDays: 7},
+		}
+		err = db.SetBucketLifecycle(ctx, TestBucket, rules)
+		require.NoError(t, err)
+
+		stored, err := db.GetBucketLifecycle(ctx, TestBucket)
+		require.NoError(t, err)
+		rules[0].Prefix = "logs"
+		assert.Equal(t, rules, stored)
+
+		// a segment without the last segment is a pending upload
+		projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+
+		var projectID string
+		for _, project := range projects {
+			if project.Name == "testProject" {
+				projectID = project.ID.String()
+			}
+		}
+		require.NotEmpty(t, projectID)
+
+		pendingPath := storj.JoinPaths(projectID, "s0", TestBucket, "pending")
+		err = satellite.Metainfo.Service.Put(pendingPath, &pb.Pointer{
+			Type:          pb.Pointer_INLINE,
+			InlineSegment: []byte("pending"),
+		})
+		require.NoError(t, err)
+
+		now := time.Now()
+
+		// nothing is old enough yet
+		err = satellite.Lifecycle.Service.Collect(ctx, now.Add(6*24*time.Hour))
+		require.NoError(t, err)
+
+		_, err = satellite.Metainfo.Service.Get(pendingPath)
+		require.NoError(t, err)
+
+		// the pending upload is aborted, the objects are kept
+		err = satellite.Lifecycle.Service.Collect(ctx, now.Add(8*24*time.Hour))
+		require.NoError(t, err)
+
+		_, err = satellite.Metainfo.Service.Get(pendingPath)
+		assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+		_, err = db.GetObject(ctx, TestBucket, "logs/old")
+		require.NoError(t, err)
+
+		// the objects under the prefix expire
+		err = satellite.Lifecycle.Service.Collect(ctx, now.Add(31*24*time.Hour))
+		require.NoError(t, err)
+
+		_, err = db.GetObject(ctx, TestBucket, "logs/old")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		_, err = db.GetObject(ctx, TestBucket, "data/keep")
+		require.NoError(t, err)
+
+		// removing the rules
+		err = db.SetBucketLifecycle(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		stored, err = db.GetBucketLifecycle(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Empty(t, stored)
+	})
+}
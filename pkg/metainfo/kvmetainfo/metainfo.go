@@ -4,10 +4,13 @@
 package kvmetainfo
 
 import (
+	"io"
+
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/storage/buckets"
 	"storj.io/storj/pkg/storage/segments"
 	"storj.io/storj/pkg/storage/streams"
@@ -33,18 +36,26 @@ type DB struct {
 	streams  streams.Store
 	segments segments.Store
 
-	rootKey *storj.Key
+	keys *encryption.Store
 }
 
 // New creates a new metainfo database
-func New(metainfo metainfo.Client, buckets buckets.Store, streams streams.Store, segments segments.Store, rootKey *storj.Key) *DB {
+func New(metainfo metainfo.Client, buckets buckets.Store, streams streams.Store, segments segments.Store, keys *encryption.Store) *DB {
 	return &DB{
 		Project:  NewProject(buckets),
 		metainfo: metainfo,
 		streams:  streams,
 		segments: segments,
-		rootKey:  rootKey,
+		keys:     keys,
+	}
+}
+
+// Close closes the connection of the metainfo client, when it has one
+func (db *DB) Close() error {
+	if closer, ok := db.metainfo.(io.Closer); ok {
+		return closer.Close()
 	}
+	return nil
 }
 
 // Limits returns limits for this metainfo database
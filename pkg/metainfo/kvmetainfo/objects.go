@@ -14,7 +14,6 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/memory"
-	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storage/objects"
@@ -58,12 +57,17 @@ func (db *DB) GetObject(ctx context.Context, bucket string, path storj.Path) (in
 func (db *DB) GetObjectStream(ctx context.Context, bucket string, path storj.Path) (stream storj.ReadOnlyStream, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	meta, info, err := db.getInfo(ctx, committedPrefix, bucket, path)
+	obj, info, err := db.getInfo(ctx, committedPrefix, bucket, path)
 	if err != nil {
 		return nil, err
 	}
 
-	streamKey, err := encryption.DeriveContentKey(meta.fullpath, db.rootKey)
+	return db.objectStream(obj, info)
+}
+
+// objectStream returns interface for reading the stream of obj
+func (db *DB) objectStream(obj object, info storj.Object) (storj.ReadOnlyStream, error) {
+	streamKey, err := db.keys.DeriveContentKey(obj.fullpath)
 	if err != nil {
 		return nil, err
 	}
@@ -71,8 +75,10 @@ func (db *DB) GetObjectStream(ctx context.Context, bucket string, path storj.Pat
 	return &readonlyStream{
 		db:            db,
 		info:          info,
-		encryptedPath: meta.encryptedPath,
+		encryptedPath: obj.encryptedPath,
 		streamKey:     streamKey,
+		segmentSizes:  streams.SegmentSizes(obj.streamInfo),
+		lastSegment:   obj.streamMeta.LastSegmentMeta,
 	}, nil
 }
 
@@ -94,6 +100,14 @@ func (db *DB) CreateObject(ctx context.Context, bucket string, path storj.Path,
 		Path:   path,
 	}
 
+	if bucketInfo.Versioning {
+		// the current version is archived only once the new one is uploaded
+		info.Version, _, err = db.nextVersion(ctx, bucketInfo, path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if createInfo != nil {
 		info.Metadata = createInfo.Metadata
 		info.ContentType = createInfo.ContentType
@@ -132,6 +146,18 @@ func (db *DB) ModifyObject(ctx context.Context, bucket string, path storj.Path)
 func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	if bucketInfo.Versioning {
+		if path == "" {
+			return storj.ErrNoPath.New("")
+		}
+		return db.deleteVersioned(ctx, bucketInfo, path)
+	}
+
 	store, err := db.buckets.GetObjectStore(ctx, bucket)
 	if err != nil {
 		return err
@@ -189,23 +215,38 @@ func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.List
 		endBefore = "\x7f\x7f\x7f\x7f\x7f\x7f\x7f"
 	}
 
-	items, more, err := objects.List(ctx, options.Prefix, startAfter, endBefore, options.Recursive, options.Limit, meta.All)
-	if err != nil {
-		return storj.ObjectList{}, err
-	}
-
 	list = storj.ObjectList{
 		Bucket: bucket,
 		Prefix: options.Prefix,
-		More:   more,
-		Items:  make([]storj.Object, 0, len(items)),
+		Items:  make([]storj.Object, 0),
 	}
 
-	for _, item := range items {
-		list.Items = append(list.Items, objectFromMeta(bucketInfo, item.Path, item.IsPrefix, item.Meta))
-	}
+	for {
+		items, more, err := objects.List(ctx, options.Prefix, startAfter, endBefore, options.Recursive, options.Limit, meta.All)
+		if err != nil {
+			return storj.ObjectList{}, err
+		}
+		list.More = more
+
+		for _, item := range items {
+			if IsReservedPath(joinPrefix(options.Prefix, item.Path)) {
+				continue
+			}
+			list.Items = append(list.Items, objectFromMeta(bucketInfo, item.Path, item.IsPrefix, item.Meta))
+		}
 
-	return list, nil
+		// continue when only hidden items were listed, so the page has a cursor
+		if len(list.Items) > 0 || !more || len(items) == 0 {
+			return list, nil
+		}
+
+		switch options.Direction {
+		case storj.Before, storj.Backward:
+			endBefore = items[0].Path
+		default:
+			startAfter = items[len(items)-1].Path
+		}
+	}
 }
 
 type object struct {
@@ -230,7 +271,7 @@ func (db *DB) getInfo(ctx context.Context, prefix string, bucket string, path st
 
 	fullpath := bucket + "/" + path
 
-	encryptedPath, err := streams.EncryptAfterBucket(fullpath, bucketInfo.PathCipher, db.rootKey)
+	encryptedPath, err := db.keys.EncryptPath(fullpath, bucketInfo.PathCipher)
 	if err != nil {
 		return object{}, storj.Object{}, err
 	}
@@ -265,7 +306,7 @@ func (db *DB) getInfo(ctx context.Context, prefix string, bucket string, path st
 		Data:       pointer.GetMetadata(),
 	}
 
-	streamInfoData, streamMeta, err := streams.DecryptStreamInfo(ctx, lastSegmentMeta.Data, fullpath, db.rootKey)
+	streamInfoData, streamMeta, err := streams.DecryptStreamInfo(ctx, lastSegmentMeta.Data, fullpath, db.keys)
 	if err != nil {
 		return object{}, storj.Object{}, err
 	}
@@ -293,10 +334,11 @@ func (db *DB) getInfo(ctx context.Context, prefix string, bucket string, path st
 
 func objectFromMeta(bucket storj.Bucket, path storj.Path, isPrefix bool, meta objects.Meta) storj.Object {
 	return storj.Object{
-		Version:  0, // TODO:
-		Bucket:   bucket,
-		Path:     path,
-		IsPrefix: isPrefix,
+		Version:        meta.Version,
+		Bucket:         bucket,
+		Path:           path,
+		IsPrefix:       isPrefix,
+		IsDeleteMarker: meta.DeleteMarker,
 
 		Metadata: meta.UserDefined,
 
@@ -322,11 +364,17 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		return storj.Object{}, err
 	}
 
+	fixedSegmentSize := stream.SegmentsSize
+	if len(stream.SegmentSizes) > 0 {
+		fixedSegmentSize = -1
+	}
+
 	return storj.Object{
-		Version:  0, // TODO:
-		Bucket:   bucket,
-		Path:     path,
-		IsPrefix: false,
+		Version:        serMetaInfo.Version,
+		Bucket:         bucket,
+		Path:           path,
+		IsPrefix:       false,
+		IsDeleteMarker: serMetaInfo.DeleteMarker,
 
 		Metadata: serMetaInfo.UserDefined,
 
@@ -336,11 +384,11 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		Expires:     lastSegment.Expiration, // TODO: use correct field
 
 		Stream: storj.Stream{
-			Size: stream.SegmentsSize*(stream.NumberOfSegments-1) + stream.LastSegmentSize,
+			Size: streams.TotalSize(stream),
 			// Checksum: []byte(object.Checksum),
 
 			SegmentCount:     stream.NumberOfSegments,
-			FixedSegmentSize: stream.SegmentsSize,
+			FixedSegmentSize: fixedSegmentSize,
 
 			RedundancyScheme: segments.RedundancySchemeFromProto(redundancyScheme),
 			EncryptionScheme: storj.EncryptionScheme{
@@ -382,8 +430,11 @@ func (object *mutableObject) CreateStream(ctx context.Context) (storj.MutableStr
 	}, nil
 }
 
+// ContinueStream returns the stream of the object to resume its pending
+// upload. The committed segments are found when the stream is uploaded with
+// streams.Store.Resume.
 func (object *mutableObject) ContinueStream(ctx context.Context) (storj.MutableStream, error) {
-	return nil, errors.New("not implemented")
+	return object.CreateStream(ctx)
 }
 
 func (object *mutableObject) DeleteStream(ctx context.Context) error {
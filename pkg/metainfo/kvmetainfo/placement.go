@@ -0,0 +1,47 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// SetBucketPlacement restricts the pieces of the bucket to nodes in the given countries,
+// no countries remove the restriction. It applies to the segments uploaded afterwards.
+func (db *DB) SetBucketPlacement(ctx context.Context, bucket string, countries []string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket == "" {
+		return storj.ErrNoBucket.New("")
+	}
+
+	err = db.metainfo.SetBucketPlacement(ctx, bucket, &pb.BucketPlacement{Countries: countries})
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrBucketNotFound.Wrap(err)
+	}
+	return err
+}
+
+// GetBucketPlacement returns the countries the pieces of the bucket are restricted to
+func (db *DB) GetBucketPlacement(ctx context.Context, bucket string) (countries []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket == "" {
+		return nil, storj.ErrNoBucket.New("")
+	}
+
+	placement, err := db.metainfo.GetBucketPlacement(ctx, bucket)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrBucketNotFound.Wrap(err)
+		}
+		return nil, err
+	}
+
+	return placement.GetCountries(), nil
+}
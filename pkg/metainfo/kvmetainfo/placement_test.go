@@ -0,0 +1,70 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/storage/buckets"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+func TestBucketPlacement(t *testing.T) {
+	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		data := make([]byte, 32*memory.KiB)
+		_, err = rand.Read(data)
+		require.NoError(t, err)
+
+		err = db.SetBucketPlacement(ctx, "missing-bucket", []string{"DE"})
+		assert.True(t, storj.ErrBucketNotFound.Has(err))
+
+		err = db.SetBucketPlacement(ctx, TestBucket, []string{"Germany"})
+		assert.Error(t, err)
+
+		countries, err := db.GetBucketPlacement(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Empty(t, countries)
+
+		err = db.SetBucketPlacement(ctx, TestBucket, []string{"de", "FR"})
+		require.NoError(t, err)
+
+		countries, err = db.GetBucketPlacement(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"DE", "FR"}, countries)
+
+		// the countries of the nodes of the planet are unknown
+		obj, err := db.CreateObject(ctx, bucket.Name, "placed", nil)
+		require.NoError(t, err)
+		str, err := obj.CreateStream(ctx)
+		require.NoError(t, err)
+		placed := stream.NewUpload(ctx, str, streams)
+		_, err = placed.Write(data)
+		if err == nil {
+			err = placed.Close()
+		}
+		assert.Error(t, err)
+
+		err = db.SetBucketPlacement(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		countries, err = db.GetBucketPlacement(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Empty(t, countries)
+
+		upload(ctx, t, db, streams, bucket, "anywhere", data)
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+func TestResumeUpload(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 4, 1)
+	require.NoError(t, err)
+
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	segmentSize := 16 * memory.KiB.Int64()
+	db, _, streamStore, err := newMetainfoParts(planet, segmentSize)
+	require.NoError(t, err)
+
+	_, err = db.CreateBucket(ctx, TestBucket, nil)
+	require.NoError(t, err)
+
+	data := make([]byte, 40*memory.KiB)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+
+	// the upload is interrupted while uploading the second segment
+	err = interruptedUpload(ctx, db, streamStore, TestFile, data, segmentSize+4*memory.KiB.Int64())
+	require.Error(t, err)
+
+	_, err = db.GetObject(ctx, TestBucket, TestFile)
+	assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+	// the first segment doesn't match other data
+	other := append([]byte{}, data...)
+	other[0]++
+
+	err = resumeUpload(ctx, db, streamStore, TestFile, bytes.NewReader(other))
+	assert.True(t, streams.ErrPendingMismatch.Has(err))
+
+	// the mismatching pending upload was discarded, so the data is uploaded from the start
+	err = resumeUpload(ctx, db, streamStore, TestFile, bytes.NewReader(other))
+	require.NoError(t, err)
+	assertContent(ctx, t, db, streamStore, TestBucket, TestFile, other)
+
+	// an interrupted upload is resumed
+	err = interruptedUpload(ctx, db, streamStore, "resumed", data, segmentSize+4*memory.KiB.Int64())
+	require.Error(t, err)
+
+	err = resumeUpload(ctx, db, streamStore, "resumed", bytes.NewReader(data))
+	require.NoError(t, err)
+	assertContent(ctx, t, db, streamStore, TestBucket, "resumed", data)
+
+	// resuming a committed object overwrites it
+	err = resumeUpload(ctx, db, streamStore, "resumed", bytes.NewReader(data[:10]))
+	require.NoError(t, err)
+	assertContent(ctx, t, db, streamStore, TestBucket, "resumed", data[:10])
+}
+
+// interruptedUpload resumes the upload of data, canceling it after reading
+// limit bytes from data
+func interruptedUpload(ctx context.Context, db *kvmetainfo.DB, streamStore streams.Store, path storj.Path, data []byte, limit int64) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	return resumeUpload(ctx, db, streamStore, path, &cancelReader{
+		reader: io.LimitReader(bytes.NewReader(data), limit),
+		cancel: cancel,
+	})
+}
+
+func resumeUpload(ctx context.Context, db *kvmetainfo.DB, streamStore streams.Store, path storj.Path, data io.Reader) error {
+	obj, err := db.CreateObject(ctx, TestBucket, path, nil)
+	if err != nil {
+		return err
+	}
+
+	mutableStream, err := obj.ContinueStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	upload := stream.NewResumedUpload(ctx, mutableStream, streamStore)
+	_, err = io.Copy(upload, data)
+	if err != nil {
+		// like a connection failure, the upload fails before the stream ends
+		_ = upload.Close()
+		return err
+	}
+
+	return upload.Close()
+}
+
+// cancelReader cancels the context of the upload once reader is exhausted
+type cancelReader struct {
+	reader io.Reader
+	cancel func()
+}
+
+func (r *cancelReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if err == io.EOF {
+		r.cancel()
+		return n, errors.New("connection lost")
+	}
+	return n, err
+}
@@ -22,6 +22,8 @@ type readonlyStream struct {
 	info          storj.Object
 	encryptedPath storj.Path
 	streamKey     *storj.Key // lazySegmentReader derivedKey
+	segmentSizes  []int64
+	lastSegment   *pb.SegmentMeta
 }
 
 func (stream *readonlyStream) Info() storj.Object { return stream.info }
@@ -29,11 +31,18 @@ func (stream *readonlyStream) Info() storj.Object { return stream.info }
 func (stream *readonlyStream) SegmentsAt(ctx context.Context, byteOffset int64, limit int64) (infos []storj.Segment, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if stream.info.FixedSegmentSize <= 0 {
-		return nil, false, errors.New("not implemented")
+	if stream.info.FixedSegmentSize > 0 {
+		index := byteOffset / stream.info.FixedSegmentSize
+		return stream.Segments(ctx, index, limit)
 	}
 
-	index := byteOffset / stream.info.FixedSegmentSize
+	var index int64
+	for offset := int64(0); index < int64(len(stream.segmentSizes))-1; index++ {
+		offset += stream.segmentSizes[index]
+		if byteOffset < offset {
+			break
+		}
+	}
 	return stream.Segments(ctx, index, limit)
 }
 
@@ -45,6 +54,7 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 	}
 
 	var segmentPath storj.Path
+	var contentNonce []byte
 	isLastSegment := segment.Index+1 == stream.info.SegmentCount
 	if !isLastSegment {
 		segmentPath = getSegmentPath(stream.encryptedPath, index)
@@ -59,13 +69,15 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 			return segment, err
 		}
 
-		segment.Size = stream.info.FixedSegmentSize
+		segment.Size = stream.segmentSize(index)
 		copy(segment.EncryptedKeyNonce[:], segmentMeta.KeyNonce)
 		segment.EncryptedKey = segmentMeta.EncryptedKey
+		contentNonce = segmentMeta.ContentNonce
 	} else {
 		segment.Size = stream.info.LastSegment.Size
 		segment.EncryptedKeyNonce = stream.info.LastSegment.EncryptedKeyNonce
 		segment.EncryptedKey = stream.info.LastSegment.EncryptedKey
+		contentNonce = stream.lastSegment.GetContentNonce()
 	}
 
 	contentKey, err := encryption.DecryptKey(segment.EncryptedKey, stream.Info().EncryptionScheme.Cipher, stream.streamKey, &segment.EncryptedKeyNonce)
@@ -74,9 +86,13 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 	}
 
 	nonce := new(storj.Nonce)
-	_, err = encryption.Increment(nonce, index+1)
-	if err != nil {
-		return segment, err
+	if len(contentNonce) > 0 {
+		copy(nonce[:], contentNonce)
+	} else {
+		_, err = encryption.Increment(nonce, index+1)
+		if err != nil {
+			return segment, err
+		}
 	}
 
 	pathComponents := storj.SplitPath(stream.encryptedPath)
@@ -107,6 +123,14 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 	return segment, nil
 }
 
+// segmentSize returns the size of the segment at index
+func (stream *readonlyStream) segmentSize(index int64) int64 {
+	if stream.info.FixedSegmentSize > 0 {
+		return stream.info.FixedSegmentSize
+	}
+	return stream.segmentSizes[index]
+}
+
 func (stream *readonlyStream) Segments(ctx context.Context, index int64, limit int64) (infos []storj.Segment, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -141,6 +165,26 @@ type mutableStream struct {
 
 func (stream *mutableStream) Info() storj.Object { return stream.info }
 
+// StagingPath returns the path where the stream is uploaded. A new version of
+// an object in a versioned bucket is uploaded to where its prior version would
+// be kept, so the current version is kept until the upload is complete.
+func (stream *mutableStream) StagingPath() storj.Path {
+	if !stream.info.Bucket.Versioning {
+		return stream.info.Path
+	}
+	return versionPath(stream.info.Path, stream.info.Version)
+}
+
+// CommitStaged makes the uploaded version the current version of the object
+func (stream *mutableStream) CommitStaged(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !stream.info.Bucket.Versioning {
+		return nil
+	}
+	return stream.db.commitVersion(ctx, stream.info.Bucket, stream.info.Path, stream.info.Version)
+}
+
 func (stream *mutableStream) AddSegments(ctx context.Context, segments ...storj.Segment) error {
 	return errors.New("not implemented")
 }
@@ -0,0 +1,435 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storage/objects"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+const (
+	// ReservedPrefix is the prefix of the paths inside a bucket that are used
+	// internally and hidden from listings
+	ReservedPrefix = ".storj/"
+	// versionsPrefix is the prefix where the prior versions of objects are kept
+	versionsPrefix = ReservedPrefix + "versions"
+)
+
+// objectVersion is a prior version of an object
+type objectVersion struct {
+	version uint32
+	meta    objects.Meta
+}
+
+// SetBucketVersioning enables or suspends keeping prior versions of objects in the bucket.
+// Suspending versioning keeps the existing versions, but new uploads replace the current one.
+func (db *Project) SetBucketVersioning(ctx context.Context, bucketName string, enabled bool) (bucketInfo storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucketName == "" {
+		return storj.Bucket{}, storj.ErrNoBucket.New("")
+	}
+
+	meta, err := db.buckets.SetVersioning(ctx, bucketName, enabled)
+	if err != nil {
+		return storj.Bucket{}, err
+	}
+
+	return bucketFromMeta(bucketName, meta), nil
+}
+
+// GetObjectVersion returns information about a specific version of an object
+func (db *DB) GetObjectVersion(ctx context.Context, bucket string, path storj.Path, version uint32) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, info, err = db.getVersionInfo(ctx, bucket, path, version)
+
+	return info, err
+}
+
+// GetObjectVersionStream returns interface for reading a specific version of the object stream.
+// The path of the stream info is the path where the version is stored.
+func (db *DB) GetObjectVersionStream(ctx context.Context, bucket string, path storj.Path, version uint32) (stream storj.ReadOnlyStream, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	obj, info, err := db.getVersionInfo(ctx, bucket, path, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDeleteMarker {
+		return nil, storj.ErrObjectNotFound.New("version %d of %q is a delete marker", version, path)
+	}
+
+	// the stream is read from where the version is stored
+	info.Path = strings.TrimPrefix(obj.fullpath, bucket+"/")
+
+	return db.objectStream(obj, info)
+}
+
+// DeleteObjectVersion permanently deletes a specific version of an object.
+// When the current version is deleted, the latest prior version replaces it.
+func (db *DB) DeleteObjectVersion(ctx context.Context, bucket string, path storj.Path, version uint32) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	if path == "" {
+		return storj.ErrNoPath.New("")
+	}
+
+	store, err := db.buckets.GetObjectStore(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	_, info, err := db.getInfo(ctx, committedPrefix, bucket, path)
+	if err != nil && !storj.ErrObjectNotFound.Has(err) {
+		return err
+	}
+	current := err == nil
+
+	if current && info.Version == version {
+		err = store.Delete(ctx, path)
+	} else {
+		err = store.Delete(ctx, versionPath(path, version))
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrObjectNotFound.Wrap(err)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if current && info.Version != version {
+		// the current version is still in place
+		return nil
+	}
+
+	// promote the latest prior version, unless it records a deletion
+	versions, err := db.listVersions(ctx, store, path)
+	if err != nil || len(versions) == 0 {
+		return err
+	}
+
+	latest := versions[len(versions)-1]
+	if latest.meta.DeleteMarker {
+		return nil
+	}
+
+	metadata, err := proto.Marshal(&latest.meta.SerializableMeta)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.streams.Concat(ctx, storj.JoinPaths(bucket, path), bucketInfo.PathCipher,
+		[]storj.Path{storj.JoinPaths(bucket, versionPath(path, latest.version))}, metadata)
+	return err
+}
+
+// ListObjectVersions lists all versions of the objects in bucket based on the ListOptions.
+// The versions of an object are listed from the latest to the oldest and are never split
+// across pages. Only the After and Forward directions are supported.
+func (db *DB) ListObjectVersions(ctx context.Context, bucket string, options storj.ListOptions) (list storj.ObjectList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return storj.ObjectList{}, err
+	}
+
+	if options.Direction != storj.After && options.Direction != storj.Forward {
+		return storj.ObjectList{}, errClass.New("unsupported direction %d", options.Direction)
+	}
+
+	store, err := db.buckets.GetObjectStore(ctx, bucket)
+	if err != nil {
+		return storj.ObjectList{}, err
+	}
+
+	current, err := listAll(ctx, store, options.Prefix, options.Recursive)
+	if err != nil {
+		return storj.ObjectList{}, err
+	}
+
+	prior, err := listAll(ctx, store, storj.JoinPaths(versionsPrefix, options.Prefix), true)
+	if err != nil {
+		return storj.ObjectList{}, err
+	}
+
+	var items []storj.Object
+	prefixes := map[storj.Path]bool{}
+	for _, item := range current {
+		if IsReservedPath(joinPrefix(options.Prefix, item.Path)) {
+			continue
+		}
+		if item.IsPrefix {
+			prefixes[item.Path] = true
+		}
+		items = append(items, objectFromMeta(bucketInfo, item.Path, item.IsPrefix, item.Meta))
+	}
+
+	for _, item := range prior {
+		i := strings.LastIndexByte(item.Path, '/')
+		if item.IsPrefix || i < 0 {
+			continue
+		}
+		if _, err := strconv.ParseUint(item.Path[i+1:], 10, 32); err != nil {
+			continue
+		}
+
+		path := item.Path[:i]
+		if j := strings.IndexByte(path, '/'); !options.Recursive && j >= 0 {
+			// the object is within a nested prefix
+			prefix := path[:j+1]
+			if !prefixes[prefix] {
+				prefixes[prefix] = true
+				items = append(items, storj.Object{Bucket: bucketInfo, Path: prefix, IsPrefix: true})
+			}
+			continue
+		}
+
+		items = append(items, objectFromMeta(bucketInfo, path, false, item.Meta))
+	}
+
+	sort.SliceStable(items, func(i, k int) bool {
+		if items[i].Path != items[k].Path {
+			return items[i].Path < items[k].Path
+		}
+		return items[i].Version > items[k].Version
+	})
+
+	list = storj.ObjectList{
+		Bucket: bucket,
+		Prefix: options.Prefix,
+		Items:  make([]storj.Object, 0, len(items)),
+	}
+
+	for _, item := range items {
+		if options.Direction == storj.After && item.Path <= options.Cursor ||
+			options.Direction == storj.Forward && item.Path < options.Cursor {
+			continue
+		}
+
+		if options.Limit > 0 && len(list.Items) >= options.Limit && item.Path != list.Items[len(list.Items)-1].Path {
+			list.More = true
+			break
+		}
+
+		list.Items = append(list.Items, item)
+	}
+
+	return list, nil
+}
+
+// getVersionInfo returns the information about a specific version of an object
+func (db *DB) getVersionInfo(ctx context.Context, bucket string, path storj.Path, version uint32) (obj object, info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	obj, info, err = db.getInfo(ctx, committedPrefix, bucket, path)
+	if err == nil && info.Version == version {
+		return obj, info, nil
+	}
+	if err != nil && !storj.ErrObjectNotFound.Has(err) {
+		return object{}, storj.Object{}, err
+	}
+
+	obj, info, err = db.getInfo(ctx, committedPrefix, bucket, versionPath(path, version))
+	if err != nil {
+		return object{}, storj.Object{}, err
+	}
+	info.Path = path
+
+	return obj, info, nil
+}
+
+// nextVersion returns the version for the next revision of an object, and
+// whether any version of the object exists
+func (db *DB) nextVersion(ctx context.Context, bucket storj.Bucket, path storj.Path) (next uint32, exists bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store, err := db.buckets.GetObjectStore(ctx, bucket.Name)
+	if err != nil {
+		return 0, false, err
+	}
+
+	versions, err := db.listVersions(ctx, store, path)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(versions) > 0 {
+		next = versions[len(versions)-1].version + 1
+	}
+
+	_, info, err := db.getInfo(ctx, committedPrefix, bucket.Name, path)
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			return next, len(versions) > 0, nil
+		}
+		return 0, false, err
+	}
+
+	if info.Version >= next {
+		next = info.Version + 1
+	}
+
+	return next, true, nil
+}
+
+// archiveObject moves the current version of an object, if any, to the prior versions
+func (db *DB) archiveObject(ctx context.Context, bucket storj.Bucket, path storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	obj, info, err := db.getInfo(ctx, committedPrefix, bucket.Name, path)
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+
+	_, err = db.streams.Concat(ctx, storj.JoinPaths(bucket.Name, versionPath(path, info.Version)), bucket.PathCipher,
+		[]storj.Path{obj.fullpath}, obj.streamInfo.Metadata)
+	return err
+}
+
+// commitVersion makes the revision of an object stored at the path of the
+// given version its current version, archiving the previous current version
+func (db *DB) commitVersion(ctx context.Context, bucket storj.Bucket, path storj.Path, version uint32) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	obj, _, err := db.getInfo(ctx, committedPrefix, bucket.Name, versionPath(path, version))
+	if err != nil {
+		return err
+	}
+
+	err = db.archiveObject(ctx, bucket, path)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.streams.Concat(ctx, storj.JoinPaths(bucket.Name, path), bucket.PathCipher,
+		[]storj.Path{obj.fullpath}, obj.streamInfo.Metadata)
+	return err
+}
+
+// deleteVersioned replaces the current version of an object with a delete marker
+func (db *DB) deleteVersioned(ctx context.Context, bucket storj.Bucket, path storj.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	next, exists, err := db.nextVersion(ctx, bucket, path)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return storj.ErrObjectNotFound.New("%q", path)
+	}
+
+	err = db.archiveObject(ctx, bucket, path)
+	if err != nil {
+		return err
+	}
+
+	store, err := db.buckets.GetObjectStore(ctx, bucket.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.Put(ctx, versionPath(path, next), bytes.NewReader(nil), pb.SerializableMeta{
+		Version:      next,
+		DeleteMarker: true,
+	}, time.Time{})
+	return err
+}
+
+// listVersions returns the prior versions of an object, sorted from the oldest
+func (db *DB) listVersions(ctx context.Context, store objects.Store, path storj.Path) (versions []objectVersion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	items, err := listAll(ctx, store, storj.JoinPaths(versionsPrefix, path), false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item.IsPrefix {
+			continue
+		}
+
+		version, err := strconv.ParseUint(item.Path, 10, 32)
+		if err != nil {
+			continue
+		}
+
+		versions = append(versions, objectVersion{
+			version: uint32(version),
+			meta:    item.Meta,
+		})
+	}
+
+	sort.Slice(versions, func(i, k int) bool {
+		return versions[i].version < versions[k].version
+	})
+
+	return versions, nil
+}
+
+// listAll lists all items under prefix
+func listAll(ctx context.Context, store objects.Store, prefix storj.Path, recursive bool) (items []objects.ListItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	startAfter := ""
+	for {
+		list, more, err := store.List(ctx, prefix, startAfter, "", recursive, 0, meta.All)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				return items, nil
+			}
+			return nil, err
+		}
+
+		items = append(items, list...)
+
+		if !more || len(list) == 0 {
+			return items, nil
+		}
+		startAfter = list[len(list)-1].Path
+	}
+}
+
+// versionPath returns the path where a prior version of an object is kept
+func versionPath(path storj.Path, version uint32) storj.Path {
+	return storj.JoinPaths(versionsPrefix, path, fmt.Sprintf("%010d", version))
+}
+
+// joinPrefix returns the full path of a listed item
+func joinPrefix(prefix, path storj.Path) storj.Path {
+	if prefix == "" {
+		return path
+	}
+	return strings.TrimSuffix(prefix, "/") + "/" + path
+}
+
+// IsReservedPath checks whether path is within the hidden reserved prefix
+func IsReservedPath(path storj.Path) bool {
+	return strings.HasPrefix(path+"/", ReservedPrefix)
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kvmetainfo_test
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/storage/buckets"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+func TestObjectVersions(t *testing.T) {
+	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+		assert.False(t, bucket.Versioning)
+
+		// the object uploaded before enabling versioning gets version 0
+		upload(ctx, t, db, streams, bucket, TestFile, []byte("first"))
+
+		bucket, err = db.SetBucketVersioning(ctx, TestBucket, true)
+		require.NoError(t, err)
+		assert.True(t, bucket.Versioning)
+
+		bucket, err = db.GetBucket(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.True(t, bucket.Versioning)
+
+		// an upload that is never completed keeps the current version
+		_, err = db.CreateObject(ctx, TestBucket, TestFile, nil)
+		require.NoError(t, err)
+
+		object, err := db.GetObject(ctx, TestBucket, TestFile)
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, object.Version)
+
+		upload(ctx, t, db, streams, bucket, TestFile, []byte("second"))
+		upload(ctx, t, db, streams, bucket, "other-file", []byte("other"))
+
+		object, err = db.GetObject(ctx, TestBucket, TestFile)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, object.Version)
+
+		assertVersion(ctx, t, db, streams, TestFile, 0, []byte("first"))
+		assertVersion(ctx, t, db, streams, TestFile, 1, []byte("second"))
+
+		// deleting places a delete marker
+		err = db.DeleteObject(ctx, TestBucket, TestFile)
+		require.NoError(t, err)
+
+		_, err = db.GetObject(ctx, TestBucket, TestFile)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		marker, err := db.GetObjectVersion(ctx, TestBucket, TestFile, 2)
+		require.NoError(t, err)
+		assert.True(t, marker.IsDeleteMarker)
+
+		_, err = db.GetObjectVersionStream(ctx, TestBucket, TestFile, 2)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		// the prior versions are not listed as objects
+		list, err := db.ListObjects(ctx, TestBucket, storj.ListOptions{Direction: storj.After, Recursive: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other-file"}, objectPaths(list))
+
+		list, err = db.ListObjectVersions(ctx, TestBucket, storj.ListOptions{Direction: storj.After})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other-file", TestFile, TestFile, TestFile}, objectPaths(list))
+		assert.Equal(t, []uint32{0, 2, 1, 0}, objectVersions(list))
+		assert.True(t, list.Items[1].IsDeleteMarker)
+
+		list, err = db.ListObjectVersions(ctx, TestBucket, storj.ListOptions{Direction: storj.After, Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"other-file"}, objectPaths(list))
+		assert.True(t, list.More)
+
+		list, err = db.ListObjectVersions(ctx, TestBucket, storj.ListOptions{Direction: storj.After, Cursor: "other-file", Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []string{TestFile, TestFile, TestFile}, objectPaths(list))
+		assert.False(t, list.More)
+
+		// removing the delete marker restores the latest version
+		err = db.DeleteObjectVersion(ctx, TestBucket, TestFile, 2)
+		require.NoError(t, err)
+
+		object, err = db.GetObject(ctx, TestBucket, TestFile)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, object.Version)
+		assertVersion(ctx, t, db, streams, TestFile, 1, []byte("second"))
+
+		// removing an old version keeps the current one
+		err = db.DeleteObjectVersion(ctx, TestBucket, TestFile, 0)
+		require.NoError(t, err)
+
+		_, err = db.GetObjectVersion(ctx, TestBucket, TestFile, 0)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		err = db.DeleteObjectVersion(ctx, TestBucket, TestFile, 0)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		// removing the last version removes the object
+		err = db.DeleteObjectVersion(ctx, TestBucket, TestFile, 1)
+		require.NoError(t, err)
+
+		_, err = db.GetObject(ctx, TestBucket, TestFile)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		err = db.DeleteObject(ctx, TestBucket, TestFile)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+	})
+}
+
+func assertVersion(ctx context.Context, t *testing.T, db *kvmetainfo.DB, streams streams.Store, path storj.Path, version uint32, content []byte) {
+	readOnly, err := db.GetObjectVersionStream(ctx, TestBucket, path, version)
+	require.NoError(t, err)
+
+	assert.Equal(t, version, readOnly.Info().Version)
+
+	download := stream.NewDownload(ctx, readOnly, streams)
+	defer func() {
+		err = download.Close()
+		assert.NoError(t, err)
+	}()
+
+	data, err := ioutil.ReadAll(download)
+	require.NoError(t, err)
+	assert.Equal(t, content, data)
+}
+
+func objectPaths(list storj.ObjectList) (paths []string) {
+	for _, item := range list.Items {
+		paths = append(paths, item.Path)
+	}
+	return paths
+}
+
+func objectVersions(list storj.ObjectList) (versions []uint32) {
+	for _, item := range list.Items {
+		versions = append(versions, item.Version)
+	}
+	return versions
+}
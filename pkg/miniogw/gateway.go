@@ -15,6 +15,7 @@ import (
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/stream"
@@ -35,7 +36,6 @@ func NewStorjGateway(metainfo storj.Metainfo, streams streams.Store, pathCipher
 		pathCipher: pathCipher,
 		encryption: encryption,
 		redundancy: redundancy,
-		multipart:  NewMultipartUploads(),
 	}
 }
 
@@ -46,7 +46,6 @@ type Gateway struct {
 	pathCipher storj.Cipher
 	encryption storj.EncryptionScheme
 	redundancy storj.RedundancyScheme
-	multipart  *MultipartUploads
 }
 
 // Name implements cmd.Gateway
@@ -213,6 +212,9 @@ func (layer *gatewayLayer) ListObjects(ctx context.Context, bucket, prefix, mark
 			if recursive && prefix != "" {
 				path = storj.JoinPaths(strings.TrimSuffix(prefix, "/"), path)
 			}
+			if kvmetainfo.IsReservedPath(path) {
+				continue
+			}
 			if item.IsPrefix {
 				prefixes = append(prefixes, path)
 				continue
@@ -282,6 +284,9 @@ func (layer *gatewayLayer) ListObjectsV2(ctx context.Context, bucket, prefix, co
 			if recursive && prefix != "" {
 				path = storj.JoinPaths(strings.TrimSuffix(prefix, "/"), path)
 			}
+			if kvmetainfo.IsReservedPath(path) {
+				continue
+			}
 			if item.IsPrefix {
 				prefixes = append(prefixes, path)
 				continue
@@ -340,24 +345,34 @@ func (layer *gatewayLayer) MakeBucketWithLocation(ctx context.Context, bucket st
 func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject, destBucket, destObject string, srcInfo minio.ObjectInfo) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	readOnlyStream, err := layer.gateway.metainfo.GetObjectStream(ctx, srcBucket, srcObject)
+	// check the source object and the destination bucket first to report the right error
+	_, err = layer.gateway.metainfo.GetObject(ctx, srcBucket, srcObject)
 	if err != nil {
 		return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
 	}
 
-	download := stream.NewDownload(ctx, readOnlyStream, layer.gateway.streams)
-	defer func() { err = errs.Combine(err, download.Close()) }()
+	_, err = layer.gateway.metainfo.GetBucket(ctx, destBucket)
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, destBucket, "")
+	}
 
-	info := readOnlyStream.Info()
-	createInfo := storj.CreateObject{
-		ContentType:      info.ContentType,
-		Expires:          info.Expires,
-		Metadata:         info.Metadata,
-		RedundancyScheme: info.RedundancyScheme,
-		EncryptionScheme: info.EncryptionScheme,
+	info, err := layer.gateway.metainfo.CopyObject(ctx, srcBucket, srcObject, destBucket, destObject, &storj.CopyObject{
+		ContentType: srcInfo.ContentType,
+		Metadata:    srcInfo.UserDefined,
+	})
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, destBucket, destObject)
 	}
 
-	return layer.putObject(ctx, destBucket, destObject, download, &createInfo)
+	return minio.ObjectInfo{
+		Name:        destObject,
+		Bucket:      destBucket,
+		ModTime:     info.Modified,
+		Size:        info.Size,
+		ETag:        hex.EncodeToString(info.Checksum),
+		ContentType: info.ContentType,
+		UserDefined: info.Metadata,
+	}, nil
 }
 
 func (layer *gatewayLayer) putObject(ctx context.Context, bucket, object string, reader io.Reader, createInfo *storj.CreateObject) (objInfo minio.ObjectInfo, err error) {
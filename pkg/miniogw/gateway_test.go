@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/buckets"
@@ -634,6 +635,141 @@ func testListObjects(t *testing.T, listObjects func(context.Context, minio.Objec
 	})
 }
 
+func TestMultipartUpload(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, metainfo storj.Metainfo, streams streams.Store) {
+		_, err := metainfo.CreateBucket(ctx, TestBucket, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		uploadID, err := layer.NewMultipartUpload(ctx, TestBucket, TestFile, map[string]string{"content-type": "text/plain"})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		// Check the error when using an unknown upload ID
+		_, err = layer.ListObjectParts(ctx, TestBucket, TestFile, "unknown", 0, 10)
+		assert.Equal(t, minio.InvalidUploadID{UploadID: "unknown"}, err)
+
+		parts := [][]byte{
+			bytes.Repeat([]byte{'a'}, 5*memory.KiB.Int()),
+			bytes.Repeat([]byte{'b'}, 6*memory.KiB.Int()),
+			[]byte("tail"),
+		}
+
+		// Upload the parts out of order, retrying the second one
+		etags := make([]string, len(parts))
+		for _, partID := range []int{3, 1, 2, 2} {
+			data, err := hash.NewReader(bytes.NewReader(parts[partID-1]), int64(len(parts[partID-1])), "", "")
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			info, err := layer.PutObjectPart(ctx, TestBucket, TestFile, uploadID, partID, data)
+			if assert.NoError(t, err) {
+				assert.Equal(t, partID, info.PartNumber)
+				assert.Equal(t, int64(len(parts[partID-1])), info.Size)
+				etags[partID-1] = info.ETag
+			}
+		}
+
+		listParts, err := layer.ListObjectParts(ctx, TestBucket, TestFile, uploadID, 0, 10)
+		if assert.NoError(t, err) && assert.Len(t, listParts.Parts, len(parts)) {
+			for i, part := range listParts.Parts {
+				assert.Equal(t, i+1, part.PartNumber)
+				assert.Equal(t, etags[i], part.ETag)
+			}
+		}
+
+		uploads, err := layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "", 10)
+		if assert.NoError(t, err) && assert.Len(t, uploads.Uploads, 1) {
+			assert.Equal(t, TestFile, uploads.Uploads[0].Object)
+			assert.Equal(t, uploadID, uploads.Uploads[0].UploadID)
+		}
+
+		// Check that the staged parts are not visible as objects
+		objects, err := layer.ListObjects(ctx, TestBucket, "", "", "", 10)
+		if assert.NoError(t, err) {
+			assert.Empty(t, objects.Objects)
+			assert.Empty(t, objects.Prefixes)
+		}
+
+		// Check the error when completing with parts out of order
+		_, err = layer.CompleteMultipartUpload(ctx, TestBucket, TestFile, uploadID, []minio.CompletePart{
+			{PartNumber: 2, ETag: etags[1]},
+			{PartNumber: 1, ETag: etags[0]},
+		})
+		assert.Equal(t, minio.InvalidPart{}, err)
+
+		var completeParts []minio.CompletePart
+		for i, etag := range etags {
+			completeParts = append(completeParts, minio.CompletePart{PartNumber: i + 1, ETag: etag})
+		}
+
+		info, err := layer.CompleteMultipartUpload(ctx, TestBucket, TestFile, uploadID, completeParts)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		expected := bytes.Join(parts, nil)
+		assert.Equal(t, TestFile, info.Name)
+		assert.Equal(t, int64(len(expected)), info.Size)
+		assert.Equal(t, "text/plain", info.ContentType)
+
+		var buf bytes.Buffer
+		err = layer.GetObject(ctx, TestBucket, TestFile, 0, -1, &buf, "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, expected, buf.Bytes())
+		}
+
+		// Check a ranged read across the part boundaries
+		buf.Reset()
+		offset := int64(len(parts[0]) - 2)
+		length := int64(len(parts[1]) + 4)
+		err = layer.GetObject(ctx, TestBucket, TestFile, offset, length, &buf, "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, expected[offset:offset+length], buf.Bytes())
+		}
+
+		uploads, err = layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "", 10)
+		if assert.NoError(t, err) {
+			assert.Empty(t, uploads.Uploads)
+		}
+	})
+}
+
+func TestAbortMultipartUpload(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, metainfo storj.Metainfo, streams streams.Store) {
+		_, err := metainfo.CreateBucket(ctx, TestBucket, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		uploadID, err := layer.NewMultipartUpload(ctx, TestBucket, TestFile, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		data, err := hash.NewReader(bytes.NewReader([]byte("part")), int64(len("part")), "", "")
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		_, err = layer.PutObjectPart(ctx, TestBucket, TestFile, uploadID, 1, data)
+		assert.NoError(t, err)
+
+		err = layer.AbortMultipartUpload(ctx, TestBucket, TestFile, uploadID)
+		assert.NoError(t, err)
+
+		_, err = layer.ListObjectParts(ctx, TestBucket, TestFile, uploadID, 0, 10)
+		assert.Equal(t, minio.InvalidUploadID{UploadID: uploadID}, err)
+
+		// Check that the bucket is empty and can be deleted
+		err = layer.DeleteBucket(ctx, TestBucket)
+		assert.NoError(t, err)
+	})
+}
+
 func runTest(t *testing.T, test func(context.Context, minio.ObjectLayer, storj.Metainfo, streams.Store)) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -698,15 +834,16 @@ func initEnv(planet *testplanet.Planet) (minio.ObjectLayer, storj.Metainfo, stre
 
 	key := new(storj.Key)
 	copy(key[:], TestEncKey)
+	keys := encryption.NewStore(key)
 
-	streams, err := streams.NewStreamStore(segments, 64*memory.MiB.Int64(), key, 1*memory.KiB.Int(), storj.AESGCM)
+	streams, err := streams.NewStreamStore(segments, 64*memory.MiB.Int64(), keys, 1*memory.KiB.Int(), storj.AESGCM)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
 	buckets := buckets.NewStore(streams)
 
-	kvmetainfo := kvmetainfo.New(metainfo, buckets, streams, segments, key)
+	kvmetainfo := kvmetainfo.New(metainfo, buckets, streams, segments, keys)
 
 	gateway := NewStorjGateway(
 		kvmetainfo,
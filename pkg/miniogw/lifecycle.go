@@ -0,0 +1,306 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	_ "unsafe" // for go:linkname
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/policy"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/storj"
+)
+
+// BucketLifecycle is the S3 lifecycle configuration of a bucket
+type BucketLifecycle struct {
+	XMLName xml.Name        `xml:"LifecycleConfiguration"`
+	Rules   []LifecycleRule `xml:"Rule"`
+}
+
+// LifecycleRule is a rule of the S3 lifecycle configuration
+type LifecycleRule struct {
+	ID                             string                          `xml:"ID,omitempty"`
+	Prefix                         string                          `xml:"Prefix,omitempty"`
+	Filter                         *LifecycleFilter                `xml:"Filter,omitempty"`
+	Status                         string                          `xml:"Status"`
+	Expiration                     *LifecycleExpiration            `xml:"Expiration,omitempty"`
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
+}
+
+// LifecycleFilter limits a lifecycle rule to the objects with a prefix
+type LifecycleFilter struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// LifecycleExpiration is the age in days after which objects are deleted
+type LifecycleExpiration struct {
+	Days int `xml:"Days"`
+}
+
+// AbortIncompleteMultipartUpload is the age in days after which multipart uploads are aborted
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+}
+
+const (
+	// lifecycleEnabled is the status of the rules that are applied
+	lifecycleEnabled = "Enabled"
+	// multipartRulePrefix is the ID prefix of the rules that abort the gateway multipart uploads
+	multipartRulePrefix = kvmetainfo.ReservedPrefix + "multipart-"
+	// maxLifecycleSize is the largest lifecycle configuration accepted
+	maxLifecycleSize = 1 << 20
+)
+
+// The vendored minio release neither routes the lifecycle requests to the
+// object layer nor exports a way to add routes or to authenticate requests,
+// so the lifecycle handler is linked into its handler chain.
+
+//go:linkname globalHandlers github.com/minio/minio/cmd.globalHandlers
+var globalHandlers []minio.HandlerFunc
+
+//go:linkname notimplementedBucketResourceNames github.com/minio/minio/cmd.notimplementedBucketResourceNames
+var notimplementedBucketResourceNames map[string]bool
+
+//go:linkname checkRequestAuthType github.com/minio/minio/cmd.checkRequestAuthType
+func checkRequestAuthType(ctx context.Context, r *http.Request, action policy.Action, bucketName, objectName string) minio.APIErrorCode
+
+//go:linkname writeErrorResponse github.com/minio/minio/cmd.writeErrorResponse
+func writeErrorResponse(w http.ResponseWriter, errorCode minio.APIErrorCode, reqURL *url.URL)
+
+// RegisterLifecycleHandler adds the lifecycle handler to the handlers minio
+// wraps its router with. It must be called before minio starts the gateway.
+func RegisterLifecycleHandler(log *zap.Logger, metainfo storj.Metainfo) {
+	delete(notimplementedBucketResourceNames, "lifecycle")
+	// the first handler wraps the router, so the lifecycle requests go
+	// through every other minio handler first
+	globalHandlers = append([]minio.HandlerFunc{func(next http.Handler) http.Handler {
+		return NewLifecycleHandler(log, metainfo, next)
+	}}, globalHandlers...)
+}
+
+// LifecycleHandler serves the S3 bucket lifecycle API, which the vendored
+// minio release doesn't route to the object layer, and passes every other
+// request to next.
+type LifecycleHandler struct {
+	log      *zap.Logger
+	metainfo storj.Metainfo
+	next     http.Handler
+
+	// authenticate and writeAuthError are minio's, which the tests replace
+	// as they need its global configuration
+	authenticate   func(ctx context.Context, r *http.Request, action policy.Action, bucket, object string) minio.APIErrorCode
+	writeAuthError func(w http.ResponseWriter, code minio.APIErrorCode, url *url.URL)
+}
+
+// NewLifecycleHandler creates a handler serving the lifecycle requests
+// authenticated by minio in front of next
+func NewLifecycleHandler(log *zap.Logger, metainfo storj.Metainfo, next http.Handler) *LifecycleHandler {
+	return &LifecycleHandler{
+		log:            log,
+		metainfo:       metainfo,
+		next:           next,
+		authenticate:   checkRequestAuthType,
+		writeAuthError: writeErrorResponse,
+	}
+}
+
+// lifecycleActions are the policy actions minio authorizes for the
+// lifecycle requests. Its policies don't have lifecycle actions, so the
+// bucket policy ones are used like for the other bucket configurations.
+var lifecycleActions = map[string]policy.Action{
+	http.MethodGet:    policy.GetBucketPolicyAction,
+	http.MethodPut:    policy.PutBucketPolicyAction,
+	http.MethodDelete: policy.DeleteBucketPolicyAction,
+}
+
+// ServeHTTP serves the lifecycle requests of path-style bucket URLs
+func (handler *LifecycleHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if _, ok := r.URL.Query()["lifecycle"]; !ok || bucket == "" || strings.Contains(bucket, "/") {
+		handler.next.ServeHTTP(w, r)
+		return
+	}
+
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	action, ok := lifecycleActions[r.Method]
+	if !ok {
+		handler.writeError(w, r, bucket, http.StatusMethodNotAllowed, "MethodNotAllowed", "the method is not allowed on the lifecycle configuration")
+		return
+	}
+
+	payload, err := ioutil.ReadAll(io.LimitReader(r.Body, maxLifecycleSize+1))
+	if err != nil {
+		handler.writeError(w, r, bucket, http.StatusBadRequest, "IncompleteBody", err.Error())
+		return
+	}
+	if len(payload) > maxLifecycleSize {
+		handler.writeError(w, r, bucket, http.StatusBadRequest, "MalformedXML", "the lifecycle configuration is too large")
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(payload))
+
+	if code := handler.authenticate(ctx, r, action, bucket, ""); code != minio.ErrNone {
+		handler.writeAuthError(w, code, r.URL)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		var config BucketLifecycle
+		config, err = handler.getBucketLifecycle(ctx, bucket)
+		if err != nil {
+			break
+		}
+		if len(config.Rules) == 0 {
+			handler.writeError(w, r, bucket, http.StatusNotFound, "NoSuchLifecycleConfiguration", "the lifecycle configuration does not exist")
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = io.WriteString(w, xml.Header)
+		err = xml.NewEncoder(w).Encode(config)
+		return
+	case http.MethodPut:
+		var config BucketLifecycle
+		if xmlErr := xml.Unmarshal(payload, &config); xmlErr != nil {
+			handler.writeError(w, r, bucket, http.StatusBadRequest, "MalformedXML", xmlErr.Error())
+			return
+		}
+		var rules []storj.LifecycleRule
+		rules, err = config.storjRules()
+		if err != nil {
+			handler.writeError(w, r, bucket, http.StatusBadRequest, "InvalidRequest", err.Error())
+			return
+		}
+		err = handler.metainfo.SetBucketLifecycle(ctx, bucket, rules)
+		if err == nil {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	case http.MethodDelete:
+		err = handler.metainfo.SetBucketLifecycle(ctx, bucket, nil)
+		if err == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	switch {
+	case storj.ErrNoBucket.Has(err):
+		handler.writeError(w, r, bucket, http.StatusBadRequest, "InvalidBucketName", err.Error())
+	case storj.ErrBucketNotFound.Has(err):
+		handler.writeError(w, r, bucket, http.StatusNotFound, "NoSuchBucket", "the bucket does not exist")
+	default:
+		handler.log.Error("failed to serve bucket lifecycle", zap.String("bucket", bucket), zap.Error(err))
+		handler.writeError(w, r, bucket, http.StatusInternalServerError, "InternalError", "internal server error")
+	}
+}
+
+// getBucketLifecycle returns the lifecycle configuration of a bucket
+func (handler *LifecycleHandler) getBucketLifecycle(ctx context.Context, bucket string) (config BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rules, err := handler.metainfo.GetBucketLifecycle(ctx, bucket)
+	if err != nil {
+		return BucketLifecycle{}, err
+	}
+
+	index := map[string]int{}
+	for _, rule := range rules {
+		id := strings.TrimPrefix(rule.ID, multipartRulePrefix)
+		i, ok := index[id]
+		if !ok {
+			i = len(config.Rules)
+			index[id] = i
+			config.Rules = append(config.Rules, LifecycleRule{
+				ID:     id,
+				Filter: &LifecycleFilter{},
+				Status: lifecycleEnabled,
+			})
+		}
+
+		if strings.HasPrefix(rule.ID, multipartRulePrefix) {
+			config.Rules[i].AbortIncompleteMultipartUpload = &AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: rule.ExpirationDays,
+			}
+			continue
+		}
+
+		config.Rules[i].Filter.Prefix = rule.Prefix
+		config.Rules[i].Expiration = &LifecycleExpiration{Days: rule.ExpirationDays}
+	}
+
+	return config, nil
+}
+
+// storjRules converts the configuration to the rules of a bucket. Disabled
+// rules are not kept. Multipart uploads are stored apart from their objects,
+// so aborting them is supported for the whole bucket only.
+func (config BucketLifecycle) storjRules() (rules []storj.LifecycleRule, err error) {
+	for _, rule := range config.Rules {
+		if rule.Status != lifecycleEnabled {
+			continue
+		}
+
+		prefix := rule.Prefix
+		if rule.Filter != nil {
+			prefix = rule.Filter.Prefix
+		}
+
+		converted := storj.LifecycleRule{ID: rule.ID, Prefix: prefix}
+		if rule.Expiration != nil {
+			converted.ExpirationDays = rule.Expiration.Days
+		}
+		if converted.ExpirationDays > 0 {
+			rules = append(rules, converted)
+		}
+
+		if rule.AbortIncompleteMultipartUpload == nil {
+			continue
+		}
+		if prefix != "" {
+			return nil, Error.New("aborting multipart uploads is supported for the whole bucket only")
+		}
+		rules = append(rules, storj.LifecycleRule{
+			ID:             multipartRulePrefix + rule.ID,
+			Prefix:         multipartPrefix,
+			ExpirationDays: rule.AbortIncompleteMultipartUpload.DaysAfterInitiation,
+		})
+	}
+	return rules, nil
+}
+
+// lifecycleError is the body of the S3 error responses
+type lifecycleError struct {
+	XMLName    xml.Name `xml:"Error"`
+	Code       string   `xml:"Code"`
+	Message    string   `xml:"Message"`
+	BucketName string   `xml:"BucketName"`
+	Resource   string   `xml:"Resource"`
+}
+
+// writeError responds with an S3 error
+func (handler *LifecycleHandler) writeError(w http.ResponseWriter, r *http.Request, bucket string, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, xml.Header)
+	_ = xml.NewEncoder(w).Encode(lifecycleError{
+		Code:       code,
+		Message:    message,
+		BucketName: bucket,
+		Resource:   r.URL.Path,
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestLifecycleHandler(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, metainfo storj.Metainfo, streams streams.Store) {
+		_, err := metainfo.CreateBucket(ctx, TestBucket, &storj.Bucket{PathCipher: storj.AESGCM})
+		require.NoError(t, err)
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+		handler := NewLifecycleHandler(zaptest.NewLogger(t), metainfo, next)
+
+		var actions []policy.Action
+		handler.authenticate = func(ctx context.Context, r *http.Request, action policy.Action, bucket, object string) minio.APIErrorCode {
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+			if r.Header.Get("Authorization") != "secret" {
+				return minio.ErrSignatureDoesNotMatch
+			}
+			actions = append(actions, action)
+			return minio.ErrNone
+		}
+		handler.writeAuthError = func(w http.ResponseWriter, code minio.APIErrorCode, url *url.URL) {
+			assert.Equal(t, minio.ErrSignatureDoesNotMatch, code)
+			w.WriteHeader(http.StatusForbidden)
+		}
+
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		do := func(method, path, secret string, body []byte) (int, []byte) {
+			request, err := http.NewRequest(method, server.URL+path, bytes.NewReader(body))
+			require.NoError(t, err)
+			request.Header.Set("Authorization", secret)
+
+			response, err := http.DefaultClient.Do(request)
+			require.NoError(t, err)
+			defer func() { assert.NoError(t, response.Body.Close()) }()
+
+			data, err := ioutil.ReadAll(response.Body)
+			require.NoError(t, err)
+			return response.StatusCode, data
+		}
+
+		// the other requests are served by minio
+		status, _ := do(http.MethodGet, "/"+TestBucket+"?versioning", "secret", nil)
+		assert.Equal(t, http.StatusTeapot, status)
+
+		status, _ = do(http.MethodGet, "/"+TestBucket+"?lifecycle", "secret", nil)
+		assert.Equal(t, http.StatusNotFound, status)
+
+		config, err := xml.Marshal(BucketLifecycle{Rules: []LifecycleRule{
+			{ID: "logs", Status: lifecycleEnabled, Filter: &LifecycleFilter{Prefix: "logs"}, Expiration: &LifecycleExpiration{Days: 30}},
+			{ID: "uploads", Status: lifecycleEnabled, AbortIncompleteMultipartUpload: &AbortIncompleteMultipartUpload{DaysAfterInitiation: 7}},
+			{ID: "disabled", Status: "Disabled", Expiration: &LifecycleExpiration{Days: 1}},
+		}})
+		require.NoError(t, err)
+
+		// requests signed with another secret are rejected
+		status, _ = do(http.MethodPut, "/"+TestBucket+"?lifecycle", "other", config)
+		assert.Equal(t, http.StatusForbidden, status)
+
+		status, _ = do(http.MethodPut, "/"+TestBucket+"?lifecycle", "secret", config)
+		assert.Equal(t, http.StatusOK, status)
+
+		rules, err := metainfo.GetBucketLifecycle(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Equal(t, []storj.LifecycleRule{
+			{ID: "logs", Prefix: "logs", ExpirationDays: 30},
+			{ID: multipartRulePrefix + "uploads", Prefix: strings.TrimSuffix(multipartPrefix, "/"), ExpirationDays: 7},
+		}, rules)
+
+		status, data := do(http.MethodGet, "/"+TestBucket+"?lifecycle", "secret", nil)
+		require.Equal(t, http.StatusOK, status)
+
+		var got BucketLifecycle
+		require.NoError(t, xml.Unmarshal(data, &got))
+		require.Len(t, got.Rules, 2)
+		assert.Equal(t, "logs", got.Rules[0].ID)
+		assert.Equal(t, "logs", got.Rules[0].Filter.Prefix)
+		assert.Equal(t, 30, got.Rules[0].Expiration.Days)
+		assert.Equal(t, "uploads", got.Rules[1].ID)
+		assert.Equal(t, 7, got.Rules[1].AbortIncompleteMultipartUpload.DaysAfterInitiation)
+
+		status, _ = do(http.MethodDelete, "/"+TestBucket+"?lifecycle", "secret", nil)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		rules, err = metainfo.GetBucketLifecycle(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+
+		status, _ = do(http.MethodGet, "/"+DestBucket+"?lifecycle", "secret", nil)
+		assert.Equal(t, http.StatusNotFound, status)
+
+		status, _ = do(http.MethodPost, "/"+TestBucket+"?lifecycle", "secret", nil)
+		assert.Equal(t, http.StatusMethodNotAllowed, status)
+
+		assert.Equal(t, []policy.Action{
+			policy.GetBucketPolicyAction,
+			policy.PutBucketPolicyAction,
+			policy.GetBucketPolicyAction,
+			policy.DeleteBucketPolicyAction,
+			policy.GetBucketPolicyAction,
+		}, actions)
+	})
+}
+
+func TestRegisterLifecycleHandler(t *testing.T) {
+	handlers := globalHandlers
+	defer func() { globalHandlers = handlers }()
+
+	RegisterLifecycleHandler(zaptest.NewLogger(t), nil)
+	require.Len(t, globalHandlers, len(handlers)+1)
+	assert.False(t, notimplementedBucketResourceNames["lifecycle"])
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	assert.IsType(t, &LifecycleHandler{}, globalHandlers[0](next))
+}
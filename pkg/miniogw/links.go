@@ -0,0 +1,191 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrLink is the errs class of presigned link errors
+var ErrLink = errs.Class("presigned link error")
+
+// LinkConfig determines how presigned download links are served
+type LinkConfig struct {
+	Address string `help:"address to serve presigned download links over, disabled when empty" default:""`
+	URL     string `help:"public url of the presigned download links, defaults to http://<links.address>" default:""`
+}
+
+// LinkSigner signs and verifies presigned download links
+type LinkSigner struct {
+	secret []byte
+}
+
+// NewLinkSigner creates a link signer using secret for the signatures
+func NewLinkSigner(secret []byte) *LinkSigner {
+	return &LinkSigner{secret: secret}
+}
+
+// Sign returns the path and query of a link to download the object at path
+// in bucket until expires, to be appended to the url of the link server
+func (signer *LinkSigner) Sign(bucket string, path storj.Path, expires time.Time) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	query.Set("signature", signer.signature(bucket, path, expires.Unix()))
+
+	link := url.URL{Path: "/" + bucket + "/" + path, RawQuery: query.Encode()}
+	return link.String()
+}
+
+// Verify checks that the signature was created for the object at path in
+// bucket with the expiration time and that the link hasn't expired at now
+func (signer *LinkSigner) Verify(bucket string, path storj.Path, expires int64, signature string, now time.Time) error {
+	expected := signer.signature(bucket, path, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrLink.New("invalid signature")
+	}
+	if now.Unix() > expires {
+		return ErrLink.New("link expired")
+	}
+	return nil
+}
+
+// signature returns the HMAC of the download of the object with the expiration time
+func (signer *LinkSigner) signature(bucket string, path storj.Path, expires int64) string {
+	mac := hmac.New(sha256.New, signer.secret)
+	_, _ = mac.Write([]byte("GET\n" + bucket + "\n" + path + "\n" + strconv.FormatInt(expires, 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// OpenFunc opens the metainfo and the streams store of the gateway with its
+// API key restricted by caveat. The metainfo is closed once the request is served.
+type OpenFunc func(ctx context.Context, caveat macaroon.Caveat) (*kvmetainfo.DB, streams.Store, error)
+
+// LinkHandler serves the downloads of presigned links. The signature of a
+// link is mapped to an API key restricted to reading the bucket until the
+// link expires, so the satellite enforces the link as well.
+type LinkHandler struct {
+	log    *zap.Logger
+	signer *LinkSigner
+	open   OpenFunc
+}
+
+// NewLinkHandler creates a handler for the links signed by signer
+func NewLinkHandler(log *zap.Logger, signer *LinkSigner, open OpenFunc) *LinkHandler {
+	return &LinkHandler{
+		log:    log,
+		signer: signer,
+		open:   open,
+	}
+}
+
+// ServeHTTP serves the ranged download of the object of a presigned link
+func (handler *LinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		http.Error(w, "invalid link", http.StatusBadRequest)
+		return
+	}
+	bucket, path := parts[0], parts[1]
+
+	query := r.URL.Query()
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid link", http.StatusBadRequest)
+		return
+	}
+
+	err = handler.signer.Verify(bucket, path, expires, query.Get("signature"), time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	caveat, err := handler.caveat(bucket, expires)
+	if err != nil {
+		handler.serverError(w, err)
+		return
+	}
+
+	db, streamStore, err := handler.open(ctx, caveat)
+	if err != nil {
+		handler.serverError(w, err)
+		return
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	object, err := db.GetObject(ctx, bucket, path)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) || storj.ErrObjectNotFound.Has(err) {
+			http.Error(w, "object not found", http.StatusNotFound)
+			return
+		}
+		handler.serverError(w, err)
+		return
+	}
+
+	rr, _, err := streamStore.Get(ctx, storj.JoinPaths(bucket, path), object.Bucket.PathCipher)
+	if err != nil {
+		handler.serverError(w, err)
+		return
+	}
+
+	if object.ContentType != "" {
+		w.Header().Set("Content-Type", object.ContentType)
+	}
+	ranger.ServeContent(ctx, w, r, path, object.Modified, rr)
+}
+
+// caveat restricts the API key to reading the bucket until the link expires
+func (handler *LinkHandler) caveat(bucket string, expires int64) (macaroon.Caveat, error) {
+	caveat, err := macaroon.NewCaveat()
+	if err != nil {
+		return caveat, err
+	}
+
+	notAfter, err := ptypes.TimestampProto(time.Unix(expires, 0))
+	if err != nil {
+		return caveat, ErrLink.Wrap(err)
+	}
+
+	caveat.DisallowWrites = true
+	caveat.DisallowLists = true
+	caveat.DisallowDeletes = true
+	caveat.AllowedPaths = []*macaroon.CaveatPath{{Bucket: []byte(bucket)}}
+	caveat.NotAfter = notAfter
+
+	return caveat, nil
+}
+
+// serverError logs err and responds with an internal server error
+func (handler *LinkHandler) serverError(w http.ResponseWriter, err error) {
+	handler.log.Error("failed to serve presigned link", zap.Error(err))
+	http.Error(w, "internal server error", http.StatusInternalServerError)
+}
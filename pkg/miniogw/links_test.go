@@ -0,0 +1,130 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestLinkSigner(t *testing.T) {
+	signer := NewLinkSigner([]byte("secret"))
+	now := time.Now()
+	expires := now.Add(time.Hour)
+
+	link := signer.Sign(TestBucket, "dir/file name", expires)
+	assert.Contains(t, link, "/"+TestBucket+"/dir/file%20name?")
+
+	signature := signer.signature(TestBucket, "dir/file name", expires.Unix())
+	assert.NoError(t, signer.Verify(TestBucket, "dir/file name", expires.Unix(), signature, now))
+
+	// expired
+	assert.Error(t, signer.Verify(TestBucket, "dir/file name", expires.Unix(), signature, expires.Add(time.Second)))
+
+	// tampered
+	assert.Error(t, signer.Verify(TestBucket, "dir/other", expires.Unix(), signature, now))
+	assert.Error(t, signer.Verify(DestBucket, "dir/file name", expires.Unix(), signature, now))
+	assert.Error(t, signer.Verify(TestBucket, "dir/file name", expires.Add(time.Hour).Unix(), signature, now))
+	assert.Error(t, NewLinkSigner([]byte("other")).Verify(TestBucket, "dir/file name", expires.Unix(), signature, now))
+}
+
+func TestLinkHandler(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		config := planet.Uplinks[0].GetConfig(planet.Satellites[0])
+		identity := planet.Uplinks[0].Identity
+
+		metainfo, streamStore, err := config.GetMetainfo(ctx, identity)
+		require.NoError(t, err)
+
+		_, err = metainfo.CreateBucket(ctx, TestBucket, &storj.Bucket{PathCipher: storj.AESGCM})
+		require.NoError(t, err)
+
+		data := []byte("presigned link data")
+		_, err = createFile(ctx, metainfo, streamStore, TestBucket, "dir/"+TestFile, &storj.CreateObject{ContentType: "text/plain"}, data)
+		require.NoError(t, err)
+
+		var caveats []macaroon.Caveat
+		var opened []*kvmetainfo.DB
+		signer := NewLinkSigner([]byte("secret"))
+		handler := NewLinkHandler(zaptest.NewLogger(t), signer,
+			func(ctx context.Context, caveat macaroon.Caveat) (*kvmetainfo.DB, streams.Store, error) {
+				caveats = append(caveats, caveat)
+				restricted, err := config.Restrict(caveat)
+				if err != nil {
+					return nil, nil, err
+				}
+				db, streamStore, err := restricted.GetMetainfo(ctx, identity)
+				opened = append(opened, db)
+				return db, streamStore, err
+			})
+
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		get := func(link string, header http.Header) (int, []byte) {
+			req, err := http.NewRequest(http.MethodGet, server.URL+link, nil)
+			require.NoError(t, err)
+			for key, values := range header {
+				req.Header[key] = values
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { assert.NoError(t, resp.Body.Close()) }()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			return resp.StatusCode, body
+		}
+
+		link := signer.Sign(TestBucket, "dir/"+TestFile, time.Now().Add(time.Hour))
+
+		status, body := get(link, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, data, body)
+
+		status, body = get(link, http.Header{"Range": {"bytes=4-11"}})
+		assert.Equal(t, http.StatusPartialContent, status)
+		assert.Equal(t, data[4:12], body)
+
+		// the credential is read only and expires with the link
+		require.NotEmpty(t, caveats)
+		assert.True(t, caveats[0].DisallowWrites)
+		assert.True(t, caveats[0].DisallowDeletes)
+		assert.NotNil(t, caveats[0].NotAfter)
+
+		// the connections to the satellite are closed with the requests
+		_, err = opened[0].GetObject(ctx, TestBucket, "dir/"+TestFile)
+		assert.Error(t, err)
+
+		status, _ = get(signer.Sign(TestBucket, "dir/missing", time.Now().Add(time.Hour)), nil)
+		assert.Equal(t, http.StatusNotFound, status)
+
+		status, _ = get(signer.Sign(TestBucket, "dir/"+TestFile, time.Now().Add(-time.Hour)), nil)
+		assert.Equal(t, http.StatusForbidden, status)
+
+		status, _ = get(NewLinkSigner([]byte("other")).Sign(TestBucket, "dir/"+TestFile, time.Now().Add(time.Hour)), nil)
+		assert.Equal(t, http.StatusForbidden, status)
+
+		status, _ = get("/"+TestBucket+"/dir/"+TestFile, nil)
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+}
@@ -4,133 +4,249 @@
 package miniogw
 
 import (
+	"bytes"
 	"context"
-	"io"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"sort"
 	"strconv"
-	"sync"
-	"sync/atomic"
+	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	minio "github.com/minio/minio/cmd"
 	"github.com/minio/minio/pkg/hash"
 
+	"storj.io/storj/pkg/metainfo/kvmetainfo"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+	"storj.io/storj/storage"
+)
+
+const (
+	// multipartPrefix is the path prefix where pending multipart uploads are stored.
+	// Every upload has a marker object at <multipartPrefix><uploadID> and its
+	// parts are stored as separate objects at <multipartPrefix><uploadID>/<part number>.
+	multipartPrefix = kvmetainfo.ReservedPrefix + "multipart/"
+	// multipartObjectKey is the metadata key of the upload marker that keeps the object name
+	multipartObjectKey = "storj-multipart-object"
+	// partETagKey is the metadata key of a part that keeps the ETag of the part
+	partETagKey = "etag"
 )
 
 func (layer *gatewayLayer) NewMultipartUpload(ctx context.Context, bucket, object string, metadata map[string]string) (uploadID string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	// Check that the bucket exists
-	_, err = layer.gateway.metainfo.GetBucket(ctx, bucket)
+	bucketInfo, err := layer.gateway.metainfo.GetBucket(ctx, bucket)
 	if err != nil {
 		return "", convertError(err, bucket, "")
 	}
 
-	uploads := layer.gateway.multipart
+	if object == "" {
+		return "", minio.ObjectNameInvalid{Bucket: bucket, Object: object}
+	}
 
-	upload, err := uploads.Create(bucket, object, metadata)
+	var id [16]byte
+	_, err = rand.Read(id[:])
 	if err != nil {
-		return "", err
+		return "", Error.Wrap(err)
 	}
+	uploadID = hex.EncodeToString(id[:])
 
-	go func() {
-		contentType := metadata["content-type"]
-		delete(metadata, "content-type")
-
-		createInfo := storj.CreateObject{
-			ContentType:      contentType,
-			Metadata:         metadata,
-			RedundancyScheme: layer.gateway.redundancy,
-			EncryptionScheme: layer.gateway.encryption,
-		}
-		objInfo, err := layer.putObject(ctx, bucket, object, upload.Stream, &createInfo)
+	userDefined := make(map[string]string, len(metadata)+1)
+	for key, value := range metadata {
+		userDefined[key] = value
+	}
+	contentType := userDefined["content-type"]
+	delete(userDefined, "content-type")
+	userDefined[multipartObjectKey] = object
 
-		uploads.RemoveByID(upload.ID)
+	serMetaInfo, err := proto.Marshal(&pb.SerializableMeta{
+		ContentType: contentType,
+		UserDefined: userDefined,
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
 
-		if err != nil {
-			upload.fail(err)
-		} else {
-			upload.complete(objInfo)
-		}
-	}()
+	_, err = layer.gateway.streams.Put(ctx, uploadPath(bucket, uploadID), bucketInfo.PathCipher, bytes.NewReader(nil), serMetaInfo, time.Time{})
+	if err != nil {
+		return "", err
+	}
 
-	return upload.ID, nil
+	return uploadID, nil
 }
 
 func (layer *gatewayLayer) PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int, data *hash.Reader) (info minio.PartInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
-
-	upload, err := uploads.Get(bucket, object, uploadID)
+	upload, err := layer.getUpload(ctx, bucket, object, uploadID)
 	if err != nil {
 		return minio.PartInfo{}, err
 	}
 
-	part, err := upload.Stream.AddPart(partID, data)
-	if err != nil {
-		return minio.PartInfo{}, err
+	if partID < 1 {
+		return minio.PartInfo{}, minio.InvalidPart{}
 	}
 
-	err = <-part.Done
+	// Uploading a part with the same number replaces the previous one.
+	// This happens when a client retries a failed part upload.
+	partMeta, err := layer.gateway.streams.Put(ctx, partPath(bucket, uploadID, partID), upload.pathCipher, data, nil, time.Time{})
 	if err != nil {
 		return minio.PartInfo{}, err
 	}
 
-	partInfo := minio.PartInfo{
-		PartNumber:   part.Number,
-		LastModified: time.Now(),
-		ETag:         data.SHA256HexString(),
-		Size:         atomic.LoadInt64(&part.Size),
-	}
+	etag := data.SHA256HexString()
 
-	upload.addCompletedPart(partInfo)
+	// the ETag is stored in the object at the part path with a suffix,
+	// since the stream metadata is written before the data is hashed
+	err = layer.putPartETag(ctx, bucket, uploadID, partID, upload.pathCipher, etag)
+	if err != nil {
+		return minio.PartInfo{}, err
+	}
 
-	return partInfo, nil
+	return minio.PartInfo{
+		PartNumber:   partID,
+		LastModified: partMeta.Modified,
+		ETag:         etag,
+		Size:         partMeta.Size,
+	}, nil
 }
 
 func (layer *gatewayLayer) AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
+	upload, err := layer.getUpload(ctx, bucket, object, uploadID)
+	if err != nil {
+		return err
+	}
 
-	upload, err := uploads.Remove(bucket, object, uploadID)
+	parts, err := layer.listParts(ctx, bucket, uploadID, upload.pathCipher)
 	if err != nil {
 		return err
 	}
 
-	errAbort := Error.New("abort")
-	upload.Stream.Abort(errAbort)
-	r := <-upload.Done
-	if r.Error != errAbort {
-		return r.Error
+	for _, part := range parts {
+		err = layer.deletePart(ctx, bucket, uploadID, part.PartNumber, upload.pathCipher)
+		if err != nil {
+			return err
+		}
 	}
-	return nil
+
+	return layer.gateway.streams.Delete(ctx, uploadPath(bucket, uploadID), upload.pathCipher)
 }
 
 func (layer *gatewayLayer) CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, uploadedParts []minio.CompletePart) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
-	upload, err := uploads.Remove(bucket, object, uploadID)
+	upload, err := layer.getUpload(ctx, bucket, object, uploadID)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+
+	if len(uploadedParts) == 0 {
+		return minio.ObjectInfo{}, minio.InvalidPart{}
+	}
+
+	parts, err := layer.listParts(ctx, bucket, uploadID, upload.pathCipher)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+
+	existing := make(map[int]minio.PartInfo, len(parts))
+	for _, part := range parts {
+		existing[part.PartNumber] = part
+	}
+
+	sources := make([]storj.Path, 0, len(uploadedParts))
+	used := make(map[int]bool, len(uploadedParts))
+	for i, uploaded := range uploadedParts {
+		if i > 0 && uploaded.PartNumber <= uploadedParts[i-1].PartNumber {
+			return minio.ObjectInfo{}, minio.InvalidPart{}
+		}
+
+		part, ok := existing[uploaded.PartNumber]
+		if !ok || !etagMatches(part.ETag, uploaded.ETag) {
+			return minio.ObjectInfo{}, minio.InvalidPart{}
+		}
+
+		sources = append(sources, partPath(bucket, uploadID, uploaded.PartNumber))
+		used[uploaded.PartNumber] = true
+	}
+
+	mutableObject, err := layer.gateway.metainfo.CreateObject(ctx, bucket, object, nil)
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucket, object)
+	}
+
+	mutableStream, err := mutableObject.CreateStream(ctx)
 	if err != nil {
 		return minio.ObjectInfo{}, err
 	}
 
-	// notify stream that there aren't more parts coming
-	upload.Stream.Close()
-	// wait for completion
-	result := <-upload.Done
-	// return the final info
-	return result.Info, result.Error
+	// the current version of the object in versioned buckets is replaced
+	// only once the parts are concatenated
+	target := object
+	staged, isStaged := mutableStream.(stream.StagedStream)
+	if isStaged {
+		target = staged.StagingPath()
+	}
+
+	serMetaInfo, err := proto.Marshal(&pb.SerializableMeta{
+		ContentType: upload.contentType,
+		UserDefined: upload.metadata,
+		Version:     mutableObject.Info().Version,
+	})
+	if err != nil {
+		return minio.ObjectInfo{}, Error.Wrap(err)
+	}
+
+	_, err = layer.gateway.streams.Concat(ctx, storj.JoinPaths(bucket, target), upload.pathCipher, sources, serMetaInfo)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+
+	if isStaged {
+		err = staged.CommitStaged(ctx)
+		if err != nil {
+			return minio.ObjectInfo{}, err
+		}
+	}
+
+	// clean up the parts that were not part of the completed object
+	for _, part := range parts {
+		if used[part.PartNumber] {
+			err = layer.deletePartETag(ctx, bucket, uploadID, part.PartNumber, upload.pathCipher)
+		} else {
+			err = layer.deletePart(ctx, bucket, uploadID, part.PartNumber, upload.pathCipher)
+		}
+		if err != nil {
+			return minio.ObjectInfo{}, err
+		}
+	}
+
+	err = layer.gateway.streams.Delete(ctx, uploadPath(bucket, uploadID), upload.pathCipher)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+
+	return layer.GetObjectInfo(ctx, bucket, object)
 }
 
 func (layer *gatewayLayer) ListObjectParts(ctx context.Context, bucket, object, uploadID string, partNumberMarker int, maxParts int) (result minio.ListPartsInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
-	upload, err := uploads.Get(bucket, object, uploadID)
+	upload, err := layer.getUpload(ctx, bucket, object, uploadID)
+	if err != nil {
+		return minio.ListPartsInfo{}, err
+	}
+
+	parts, err := layer.listParts(ctx, bucket, uploadID, upload.pathCipher)
 	if err != nil {
 		return minio.ListPartsInfo{}, err
 	}
@@ -142,316 +258,316 @@ func (layer *gatewayLayer) ListObjectParts(ctx context.Context, bucket, object,
 	list.UploadID = uploadID
 	list.PartNumberMarker = partNumberMarker
 	list.MaxParts = maxParts
-	list.UserDefined = upload.Metadata
-	list.Parts = upload.getCompletedParts()
-
-	sort.Slice(list.Parts, func(i, k int) bool {
-		return list.Parts[i].PartNumber < list.Parts[k].PartNumber
-	})
+	list.UserDefined = upload.metadata
 
-	var first int
-	for i, p := range list.Parts {
-		first = i
-		if partNumberMarker <= p.PartNumber {
-			break
+	for _, part := range parts {
+		if part.PartNumber > partNumberMarker {
+			list.Parts = append(list.Parts, part)
 		}
 	}
 
-	list.Parts = list.Parts[first:]
 	if len(list.Parts) > maxParts {
-		list.NextPartNumberMarker = list.Parts[maxParts].PartNumber
 		list.Parts = list.Parts[:maxParts]
+		list.NextPartNumberMarker = list.Parts[maxParts-1].PartNumber
 		list.IsTruncated = true
 	}
 
 	return list, nil
 }
 
-// TODO: implement
-// func (layer *gatewayLayer) ListMultipartUploads(ctx context.Context, bucket, prefix, keyMarker, uploadIDMarker, delimiter string, maxUploads int) (result minio.ListMultipartsInfo, err error) {
-// func (layer *gatewayLayer) CopyObjectPart(ctx context.Context, srcBucket, srcObject, destBucket, destObject string, uploadID string, partID int, startOffset int64, length int64, srcInfo minio.ObjectInfo) (info minio.PartInfo, err error) {
+func (layer *gatewayLayer) ListMultipartUploads(ctx context.Context, bucket, prefix, keyMarker, uploadIDMarker, delimiter string, maxUploads int) (result minio.ListMultipartsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-// MultipartUploads manages pending multipart uploads
-type MultipartUploads struct {
-	mu      sync.RWMutex
-	lastID  int
-	pending map[string]*MultipartUpload
-}
+	if delimiter != "" && delimiter != "/" {
+		return minio.ListMultipartsInfo{}, minio.UnsupportedDelimiter{Delimiter: delimiter}
+	}
 
-// NewMultipartUploads creates new MultipartUploads
-func NewMultipartUploads() *MultipartUploads {
-	return &MultipartUploads{
-		pending: map[string]*MultipartUpload{},
+	bucketInfo, err := layer.gateway.metainfo.GetBucket(ctx, bucket)
+	if err != nil {
+		return minio.ListMultipartsInfo{}, convertError(err, bucket, "")
 	}
-}
 
-// Create creates a new upload
-func (uploads *MultipartUploads) Create(bucket, object string, metadata map[string]string) (*MultipartUpload, error) {
-	uploads.mu.Lock()
-	defer uploads.mu.Unlock()
+	items, err := layer.listAll(ctx, storj.JoinPaths(bucket, multipartPrefix), bucketInfo.PathCipher, false)
+	if err != nil {
+		return minio.ListMultipartsInfo{}, err
+	}
+
+	var uploads []minio.MultipartInfo
+	for _, item := range items {
+		if item.IsPrefix {
+			continue
+		}
+
+		upload, err := parseUpload(item.Meta.Data)
+		if err != nil {
+			return minio.ListMultipartsInfo{}, err
+		}
 
-	for id, upload := range uploads.pending {
-		if upload.Bucket == bucket && upload.Object == object {
-			upload.Stream.Abort(Error.New("aborted by another upload to the same location"))
-			delete(uploads.pending, id)
+		if !strings.HasPrefix(upload.object, prefix) {
+			continue
 		}
+
+		uploads = append(uploads, minio.MultipartInfo{
+			Object:    upload.object,
+			UploadID:  item.Path,
+			Initiated: item.Meta.Modified,
+		})
 	}
 
-	uploads.lastID++
-	uploadID := "Upload" + strconv.Itoa(uploads.lastID)
+	sort.Slice(uploads, func(i, k int) bool {
+		if uploads[i].Object == uploads[k].Object {
+			return uploads[i].UploadID < uploads[k].UploadID
+		}
+		return uploads[i].Object < uploads[k].Object
+	})
 
-	upload := NewMultipartUpload(uploadID, bucket, object, metadata)
-	uploads.pending[uploadID] = upload
+	result = minio.ListMultipartsInfo{
+		KeyMarker:      keyMarker,
+		UploadIDMarker: uploadIDMarker,
+		MaxUploads:     maxUploads,
+		Prefix:         prefix,
+		Delimiter:      delimiter,
+	}
 
-	return upload, nil
-}
+	prefixes := map[string]bool{}
+	for _, upload := range uploads {
+		if keyMarker != "" {
+			if upload.Object < keyMarker {
+				continue
+			}
+			if upload.Object == keyMarker && (uploadIDMarker == "" || upload.UploadID <= uploadIDMarker) {
+				continue
+			}
+		}
 
-// Get finds a pending upload
-func (uploads *MultipartUploads) Get(bucket, object, uploadID string) (*MultipartUpload, error) {
-	uploads.mu.Lock()
-	defer uploads.mu.Unlock()
+		if len(result.Uploads)+len(result.CommonPrefixes) >= maxUploads {
+			result.IsTruncated = true
+			break
+		}
 
-	upload, ok := uploads.pending[uploadID]
-	if !ok {
-		return nil, Error.New("pending upload %q missing", uploadID)
-	}
-	if upload.Bucket != bucket || upload.Object != object {
-		return nil, Error.New("pending upload %q bucket/object name mismatch", uploadID)
+		if delimiter != "" {
+			if i := strings.Index(upload.Object[len(prefix):], delimiter); i >= 0 {
+				commonPrefix := upload.Object[:len(prefix)+i+len(delimiter)]
+				if !prefixes[commonPrefix] {
+					prefixes[commonPrefix] = true
+					result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix)
+				}
+				continue
+			}
+		}
+
+		result.Uploads = append(result.Uploads, upload)
+		result.NextKeyMarker = upload.Object
+		result.NextUploadIDMarker = upload.UploadID
 	}
 
-	return upload, nil
+	return result, nil
 }
 
-// Remove returns and removes a pending upload
-func (uploads *MultipartUploads) Remove(bucket, object, uploadID string) (*MultipartUpload, error) {
-	uploads.mu.RLock()
-	defer uploads.mu.RUnlock()
+// TODO: implement
+// func (layer *gatewayLayer) CopyObjectPart(ctx context.Context, srcBucket, srcObject, destBucket, destObject string, uploadID string, partID int, startOffset int64, length int64, srcInfo minio.ObjectInfo) (info minio.PartInfo, err error) {
 
-	upload, ok := uploads.pending[uploadID]
-	if !ok {
-		return nil, Error.New("pending upload %q missing", uploadID)
-	}
-	if upload.Bucket != bucket || upload.Object != object {
-		return nil, Error.New("pending upload %q bucket/object name mismatch", uploadID)
+// multipartUpload is the information about a pending upload stored in its marker
+type multipartUpload struct {
+	object      string
+	contentType string
+	metadata    map[string]string
+	pathCipher  storj.Cipher
+}
+
+// getUpload loads the pending upload and checks that it belongs to the object
+func (layer *gatewayLayer) getUpload(ctx context.Context, bucket, object, uploadID string) (upload multipartUpload, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := layer.gateway.metainfo.GetBucket(ctx, bucket)
+	if err != nil {
+		return multipartUpload{}, convertError(err, bucket, "")
 	}
 
-	delete(uploads.pending, uploadID)
+	if uploadID == "" || strings.Contains(uploadID, "/") {
+		return multipartUpload{}, minio.InvalidUploadID{UploadID: uploadID}
+	}
 
-	return upload, nil
-}
+	marker, err := layer.gateway.streams.Meta(ctx, uploadPath(bucket, uploadID), bucketInfo.PathCipher)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return multipartUpload{}, minio.InvalidUploadID{UploadID: uploadID}
+		}
+		return multipartUpload{}, err
+	}
 
-// RemoveByID removes pending upload by id
-func (uploads *MultipartUploads) RemoveByID(uploadID string) {
-	uploads.mu.RLock()
-	defer uploads.mu.RUnlock()
-	delete(uploads.pending, uploadID)
-}
+	upload, err = parseUpload(marker.Data)
+	if err != nil {
+		return multipartUpload{}, err
+	}
 
-// MultipartUpload is partial info about a pending upload
-type MultipartUpload struct {
-	ID       string
-	Bucket   string
-	Object   string
-	Metadata map[string]string
-	Done     chan (*MultipartUploadResult)
-	Stream   *MultipartStream
-
-	mu        sync.Mutex
-	completed []minio.PartInfo
-}
+	if upload.object != object {
+		return multipartUpload{}, minio.InvalidUploadID{UploadID: uploadID}
+	}
 
-// MultipartUploadResult contains either an Error or the uploaded ObjectInfo
-type MultipartUploadResult struct {
-	Error error
-	Info  minio.ObjectInfo
+	upload.pathCipher = bucketInfo.PathCipher
+	return upload, nil
 }
 
-// NewMultipartUpload creates a new MultipartUpload
-func NewMultipartUpload(uploadID string, bucket, object string, metadata map[string]string) *MultipartUpload {
-	upload := &MultipartUpload{
-		ID:       uploadID,
-		Bucket:   bucket,
-		Object:   object,
-		Metadata: metadata,
-		Done:     make(chan *MultipartUploadResult, 1),
-		Stream:   NewMultipartStream(),
-	}
-	return upload
-}
+// parseUpload parses the metadata of an upload marker
+func parseUpload(data []byte) (multipartUpload, error) {
+	serMetaInfo := pb.SerializableMeta{}
+	err := proto.Unmarshal(data, &serMetaInfo)
+	if err != nil {
+		return multipartUpload{}, Error.Wrap(err)
+	}
 
-// addCompletedPart adds a completed part to the list
-func (upload *MultipartUpload) addCompletedPart(part minio.PartInfo) {
-	upload.mu.Lock()
-	defer upload.mu.Unlock()
+	metadata := serMetaInfo.UserDefined
+	object := metadata[multipartObjectKey]
+	delete(metadata, multipartObjectKey)
 
-	upload.completed = append(upload.completed, part)
+	return multipartUpload{
+		object:      object,
+		contentType: serMetaInfo.ContentType,
+		metadata:    metadata,
+	}, nil
 }
 
-func (upload *MultipartUpload) getCompletedParts() []minio.PartInfo {
-	upload.mu.Lock()
-	defer upload.mu.Unlock()
+// listParts lists the uploaded parts of a pending upload sorted by part number
+func (layer *gatewayLayer) listParts(ctx context.Context, bucket, uploadID string, pathCipher storj.Cipher) (parts []minio.PartInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	return append([]minio.PartInfo{}, upload.completed...)
-}
+	items, err := layer.listAll(ctx, uploadPath(bucket, uploadID), pathCipher, false)
+	if err != nil {
+		return nil, err
+	}
 
-// fail aborts the upload with an error
-func (upload *MultipartUpload) fail(err error) {
-	upload.Done <- &MultipartUploadResult{Error: err}
-	close(upload.Done)
-}
+	etags := map[int]string{}
+	for _, item := range items {
+		if item.IsPrefix || !strings.HasSuffix(item.Path, etagSuffix) {
+			continue
+		}
 
-// complete completes the upload
-func (upload *MultipartUpload) complete(info minio.ObjectInfo) {
-	upload.Done <- &MultipartUploadResult{Info: info}
-	close(upload.Done)
-}
+		partNumber, err := strconv.Atoi(strings.TrimSuffix(item.Path, etagSuffix))
+		if err != nil {
+			continue
+		}
 
-// MultipartStream serializes multiple readers into a single reader
-type MultipartStream struct {
-	mu          sync.Mutex
-	moreParts   sync.Cond
-	err         error
-	closed      bool
-	finished    bool
-	nextID      int
-	nextNumber  int
-	currentPart *StreamPart
-	parts       []*StreamPart
-}
+		serMetaInfo := pb.SerializableMeta{}
+		err = proto.Unmarshal(item.Meta.Data, &serMetaInfo)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
 
-// StreamPart is a reader waiting in MultipartStream
-type StreamPart struct {
-	Number int
-	ID     int
-	Size   int64
-	Reader *hash.Reader
-	Done   chan error
-}
+		etags[partNumber] = serMetaInfo.UserDefined[partETagKey]
+	}
 
-// NewMultipartStream creates a new MultipartStream
-func NewMultipartStream() *MultipartStream {
-	stream := &MultipartStream{}
-	stream.moreParts.L = &stream.mu
-	stream.nextID = 1
-	return stream
-}
+	for _, item := range items {
+		if item.IsPrefix {
+			continue
+		}
 
-// Abort aborts the stream reading
-func (stream *MultipartStream) Abort(err error) {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
+		partNumber, err := strconv.Atoi(item.Path)
+		if err != nil {
+			continue
+		}
 
-	if stream.finished {
-		return
-	}
+		etag, ok := etags[partNumber]
+		if !ok {
+			// the part upload has not finished yet
+			continue
+		}
 
-	if stream.err == nil {
-		stream.err = err
+		parts = append(parts, minio.PartInfo{
+			PartNumber:   partNumber,
+			LastModified: item.Meta.Modified,
+			ETag:         etag,
+			Size:         item.Meta.Size,
+		})
 	}
-	stream.finished = true
-	stream.closed = true
 
-	for _, part := range stream.parts {
-		part.Done <- err
-		close(part.Done)
-	}
-	stream.parts = nil
+	sort.Slice(parts, func(i, k int) bool {
+		return parts[i].PartNumber < parts[k].PartNumber
+	})
 
-	stream.moreParts.Broadcast()
+	return parts, nil
 }
 
-// Close closes the stream, but lets it complete
-func (stream *MultipartStream) Close() {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
-
-	stream.closed = true
-	stream.moreParts.Broadcast()
-}
+// listAll lists all items under prefix
+func (layer *gatewayLayer) listAll(ctx context.Context, prefix storj.Path, pathCipher storj.Cipher, recursive bool) (items []streams.ListItem, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-// Read implements io.Reader interface, blocking when there's no part
-func (stream *MultipartStream) Read(data []byte) (n int, err error) {
-	stream.mu.Lock()
+	startAfter := ""
 	for {
-		// has an error occurred?
-		if stream.err != nil {
-			stream.mu.Unlock()
-			return 0, Error.Wrap(err)
-		}
-		// still uploading the current part?
-		if stream.currentPart != nil {
-			break
-		}
-		// do we have the next part?
-		if len(stream.parts) > 0 && stream.nextID == stream.parts[0].ID {
-			stream.currentPart = stream.parts[0]
-			stream.parts = stream.parts[1:]
-			stream.nextID++
-			break
-		}
-		// we don't have the next part and are closed, hence we are complete
-		if stream.closed {
-			stream.finished = true
-			stream.mu.Unlock()
-			return 0, io.EOF
+		list, more, err := layer.gateway.streams.List(ctx, prefix, startAfter, "", pathCipher, recursive, 0, meta.All)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				return items, nil
+			}
+			return nil, err
 		}
 
-		stream.moreParts.Wait()
+		items = append(items, list...)
+
+		if !more || len(list) == 0 {
+			return items, nil
+		}
+		startAfter = list[len(list)-1].Path
 	}
-	stream.mu.Unlock()
+}
+
+// etagSuffix is the suffix of the object that keeps the ETag of a part
+const etagSuffix = ".etag"
 
-	// read as much as we can
-	n, err = stream.currentPart.Reader.Read(data)
-	atomic.AddInt64(&stream.currentPart.Size, int64(n))
+// putPartETag stores the ETag of an uploaded part
+func (layer *gatewayLayer) putPartETag(ctx context.Context, bucket, uploadID string, partID int, pathCipher storj.Cipher, etag string) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	if err == io.EOF {
-		// the part completed, hence advance to the next one
-		err = nil
-		close(stream.currentPart.Done)
-		stream.currentPart = nil
-	} else if err != nil {
-		// something bad happened, abort the whole thing
-		stream.Abort(err)
-		return n, Error.Wrap(err)
+	serMetaInfo, err := proto.Marshal(&pb.SerializableMeta{
+		UserDefined: map[string]string{partETagKey: etag},
+	})
+	if err != nil {
+		return Error.Wrap(err)
 	}
 
-	return n, err
+	_, err = layer.gateway.streams.Put(ctx, partPath(bucket, uploadID, partID)+etagSuffix, pathCipher, bytes.NewReader(nil), serMetaInfo, time.Time{})
+	return err
 }
 
-// AddPart adds a new part to the stream to wait
-func (stream *MultipartStream) AddPart(partID int, data *hash.Reader) (*StreamPart, error) {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
+// deletePartETag deletes the ETag of a part
+func (layer *gatewayLayer) deletePartETag(ctx context.Context, bucket, uploadID string, partID int, pathCipher storj.Cipher) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	if partID < stream.nextID {
-		return nil, Error.New("part %d already uploaded, next part ID is %d", partID, stream.nextID)
+	err = layer.gateway.streams.Delete(ctx, partPath(bucket, uploadID, partID)+etagSuffix, pathCipher)
+	if storage.ErrKeyNotFound.Has(err) {
+		return nil
 	}
+	return err
+}
 
-	for _, p := range stream.parts {
-		if p.ID == partID {
-			// Replace the reader of this part with the new one.
-			// This could happen if the read timeout for this part has expired
-			// and the client tries to upload the part again.
-			p.Reader = data
-			return p, nil
-		}
+// deletePart deletes an uploaded part together with its ETag
+func (layer *gatewayLayer) deletePart(ctx context.Context, bucket, uploadID string, partID int, pathCipher storj.Cipher) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = layer.deletePartETag(ctx, bucket, uploadID, partID, pathCipher)
+	if err != nil {
+		return err
 	}
 
-	stream.nextNumber++
-	part := &StreamPart{
-		Number: stream.nextNumber - 1,
-		ID:     partID,
-		Size:   0,
-		Reader: data,
-		Done:   make(chan error, 1),
+	err = layer.gateway.streams.Delete(ctx, partPath(bucket, uploadID, partID), pathCipher)
+	if storage.ErrKeyNotFound.Has(err) {
+		return nil
 	}
+	return err
+}
 
-	stream.parts = append(stream.parts, part)
-	sort.Slice(stream.parts, func(i, k int) bool {
-		return stream.parts[i].ID < stream.parts[k].ID
-	})
+// uploadPath returns the path of the marker of a pending upload
+func uploadPath(bucket, uploadID string) storj.Path {
+	return storj.JoinPaths(bucket, multipartPrefix+uploadID)
+}
 
-	stream.moreParts.Broadcast()
+// partPath returns the path of an uploaded part
+func partPath(bucket, uploadID string, partID int) storj.Path {
+	return storj.JoinPaths(uploadPath(bucket, uploadID), fmt.Sprintf("%05d", partID))
+}
 
-	return part, nil
+// etagMatches compares the ETag of a part with the one sent by the client
+func etagMatches(etag, clientETag string) bool {
+	clientETag = strings.Trim(clientETag, "\"")
+	return clientETag == "" || clientETag == etag
 }
@@ -6,6 +6,7 @@ package overlay
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -38,6 +39,9 @@ var ErrNotEnoughNodes = errs.Class("not enough nodes")
 // OverlayError creates class of errors for stack traces
 var OverlayError = errs.Class("overlay error")
 
+// ErrInvalidPlacement is returned when a bucket placement has invalid country codes
+var ErrInvalidPlacement = errs.Class("invalid placement")
+
 // DB implements the database for overlay.Cache
 type DB interface {
 	// SelectStorageNodes looks up nodes based on criteria
@@ -53,19 +57,25 @@ type DB interface {
 	List(ctx context.Context, cursor storj.NodeID, limit int) ([]*NodeDossier, error)
 	// Paginate will page through the database nodes
 	Paginate(ctx context.Context, offset int64, limit int) ([]*NodeDossier, bool, error)
-	// Update updates node information
-	Update(ctx context.Context, value *pb.Node) error
+	// Update updates node information and the network it was reached at,
+	// new nodes start with the initial reputation of defaults
+	Update(ctx context.Context, value *pb.Node, network NodeNetwork, defaults NodeSelectionConfig) error
 
 	// CreateStats initializes the stats for node.
 	CreateStats(ctx context.Context, nodeID storj.NodeID, initial *NodeStats) (stats *NodeStats, err error)
-	// FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements.
+	// FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements or are disqualified.
 	FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *NodeStats) (invalid storj.NodeIDList, err error)
 	// UpdateStats all parts of single storagenode's stats.
 	UpdateStats(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error)
 	// UpdateOperator updates the email and wallet for a given node ID for satellite payments.
 	UpdateOperator(ctx context.Context, node storj.NodeID, updatedOperator pb.NodeOperator) (stats *NodeDossier, err error)
 	// UpdateUptime updates a single storagenode's uptime stats.
-	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error)
+	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight, uptimeDQ float64) (stats *NodeStats, err error)
+
+	// SetBucketPlacement sets the countries the pieces of a bucket must be placed in, no countries remove the constraint
+	SetBucketPlacement(ctx context.Context, bucketID []byte, countries []string) error
+	// GetBucketPlacement returns the countries the pieces of a bucket must be placed in, nil when unconstrained
+	GetBucketPlacement(ctx context.Context, bucketID []byte) ([]string, error)
 }
 
 // FindStorageNodesRequest defines easy request parameters.
@@ -77,6 +87,9 @@ type FindStorageNodesRequest struct {
 	FreeDisk      int64
 
 	ExcludedNodes []storj.NodeID
+
+	// BucketID selects only the nodes allowed by the placement of the bucket, when set
+	BucketID []byte
 }
 
 // NodeCriteria are the requirements for selecting nodes
@@ -90,6 +103,10 @@ type NodeCriteria struct {
 	UptimeSuccessRatio float64
 
 	Excluded []storj.NodeID
+
+	DistinctIP       bool
+	DistinctOperator bool
+	Countries        []string
 }
 
 // NewNodeCriteria are the requirement for selecting new nodes
@@ -100,6 +117,10 @@ type NewNodeCriteria struct {
 	AuditThreshold int64
 
 	Excluded []storj.NodeID
+
+	DistinctIP       bool
+	DistinctOperator bool
+	Countries        []string
 }
 
 // UpdateRequest is used to update a node status.
@@ -107,6 +128,14 @@ type UpdateRequest struct {
 	NodeID       storj.NodeID
 	AuditSuccess bool
 	IsUp         bool
+
+	// the reputation parameters are filled in by the Cache from its configuration
+	AuditLambda  float64
+	AuditWeight  float64
+	AuditDQ      float64
+	UptimeLambda float64
+	UptimeWeight float64
+	UptimeDQ     float64
 }
 
 // NodeDossier is the complete info that the satellite tracks for a storage node
@@ -116,6 +145,7 @@ type NodeDossier struct {
 	Operator   pb.NodeOperator
 	Capacity   pb.NodeCapacity
 	Reputation NodeStats
+	Network    NodeNetwork
 }
 
 // Online checks if a node is online based on the collected statistics.
@@ -127,17 +157,45 @@ func (node *NodeDossier) Online() bool {
 		node.Reputation.LastContactSuccess.After(node.Reputation.LastContactFailure)
 }
 
+// Disqualified returns whether the node has been disqualified.
+func (node *NodeDossier) Disqualified() bool {
+	return node.Reputation.Disqualified != nil
+}
+
 // NodeStats contains statistics about a node.
 type NodeStats struct {
-	Latency90          int64
-	AuditSuccessRatio  float64
-	AuditSuccessCount  int64
-	AuditCount         int64
-	UptimeRatio        float64
-	UptimeSuccessCount int64
-	UptimeCount        int64
-	LastContactSuccess time.Time
-	LastContactFailure time.Time
+	Latency90             int64
+	AuditSuccessRatio     float64
+	AuditSuccessCount     int64
+	AuditCount            int64
+	UptimeRatio           float64
+	UptimeSuccessCount    int64
+	UptimeCount           int64
+	LastContactSuccess    time.Time
+	LastContactFailure    time.Time
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+	Disqualified          *time.Time
+}
+
+// AuditReputation returns the decaying audit reputation score of the node, between 0 and 1.
+func (stats *NodeStats) AuditReputation() float64 {
+	return reputationScore(stats.AuditReputationAlpha, stats.AuditReputationBeta)
+}
+
+// UptimeReputation returns the decaying uptime reputation score of the node, between 0 and 1.
+func (stats *NodeStats) UptimeReputation() float64 {
+	return reputationScore(stats.UptimeReputationAlpha, stats.UptimeReputationBeta)
+}
+
+// reputationScore returns the mean of the beta distribution with the given shapes
+func reputationScore(alpha, beta float64) float64 {
+	if alpha+beta == 0 {
+		return 0
+	}
+	return alpha / (alpha + beta)
 }
 
 // Cache is used to store and handle node information
@@ -145,14 +203,18 @@ type Cache struct {
 	log         *zap.Logger
 	db          DB
 	preferences NodeSelectionConfig
+	geoip       *GeoIP
+	hosts       *hostCache
 }
 
-// NewCache returns a new Cache
-func NewCache(log *zap.Logger, db DB, preferences NodeSelectionConfig) *Cache {
+// NewCache returns a new Cache, geoip may be nil when the countries of the nodes aren't tracked
+func NewCache(log *zap.Logger, db DB, preferences NodeSelectionConfig, geoip *GeoIP) *Cache {
 	return &Cache{
 		log:         log,
 		db:          db,
 		preferences: preferences,
+		geoip:       geoip,
+		hosts:       newHostCache(hostCacheTTL, maxCachedHosts),
 	}
 }
 
@@ -225,6 +287,14 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 		reputableNodeCount = req.RequestedCount
 	}
 
+	var countries []string
+	if len(req.BucketID) > 0 {
+		countries, err = cache.db.GetBucketPlacement(ctx, req.BucketID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	auditCount := preferences.AuditCount
 	if auditCount < preferences.NewNodeAuditThreshold {
 		auditCount = preferences.NewNodeAuditThreshold
@@ -240,11 +310,21 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 		UptimeSuccessRatio: preferences.UptimeRatio,
 
 		Excluded: req.ExcludedNodes,
+
+		DistinctIP:       preferences.DistinctIP,
+		DistinctOperator: preferences.DistinctOperator,
+		Countries:        countries,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// the new nodes must not share networks or operators with the reputable ones either
+	excluded := append([]storj.NodeID{}, req.ExcludedNodes...)
+	for _, node := range reputableNodes {
+		excluded = append(excluded, node.Id)
+	}
+
 	newNodeCount := int64(float64(reputableNodeCount) * preferences.NewNodePercentage)
 	newNodes, err := cache.db.SelectNewStorageNodes(ctx, int(newNodeCount), &NewNodeCriteria{
 		FreeBandwidth: req.FreeBandwidth,
@@ -252,7 +332,11 @@ func (cache *Cache) FindStorageNodesWithPreferences(ctx context.Context, req Fin
 
 		AuditThreshold: preferences.NewNodeAuditThreshold,
 
-		Excluded: req.ExcludedNodes,
+		Excluded: excluded,
+
+		DistinctIP:       preferences.DistinctIP,
+		DistinctOperator: preferences.DistinctOperator,
+		Countries:        countries,
 	})
 	if err != nil {
 		return nil, err
@@ -293,7 +377,51 @@ func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node)
 		return errors.New("invalid request")
 	}
 
-	return cache.db.Update(ctx, &value)
+	var network NodeNetwork
+	ip, lastNet, err := cache.hosts.resolveNetwork(ctx, value.GetAddress().GetAddress(), time.Now())
+	if err != nil {
+		cache.log.Debug("failed resolving node address", zap.Stringer("node", nodeID), zap.Error(err))
+	} else {
+		network.LastNet = lastNet
+		network.CountryCode = cache.geoip.Country(ip)
+	}
+
+	return cache.db.Update(ctx, &value, network, cache.preferences)
+}
+
+// SetBucketPlacement sets the countries the pieces of a bucket must be placed in, no countries remove the constraint
+func (cache *Cache) SetBucketPlacement(ctx context.Context, bucketID []byte, countries []string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	normalized := make([]string, 0, len(countries))
+	for _, country := range countries {
+		country = strings.ToUpper(strings.TrimSpace(country))
+		if !isCountryCode(country) {
+			return ErrInvalidPlacement.New("%q is not an ISO 3166-1 alpha-2 country code", country)
+		}
+		normalized = append(normalized, country)
+	}
+
+	return cache.db.SetBucketPlacement(ctx, bucketID, normalized)
+}
+
+// GetBucketPlacement returns the countries the pieces of a bucket must be placed in, nil when unconstrained
+func (cache *Cache) GetBucketPlacement(ctx context.Context, bucketID []byte) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.GetBucketPlacement(ctx, bucketID)
+}
+
+// isCountryCode checks whether code looks like an ISO 3166-1 alpha-2 country code
+func isCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
 }
 
 // Create adds a new stats entry for node.
@@ -311,6 +439,14 @@ func (cache *Cache) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDLi
 // UpdateStats all parts of single storagenode's stats.
 func (cache *Cache) UpdateStats(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	request.AuditLambda = cache.preferences.AuditReputationLambda
+	request.AuditWeight = cache.preferences.AuditReputationWeight
+	request.AuditDQ = cache.preferences.AuditReputationDQ
+	request.UptimeLambda = cache.preferences.UptimeReputationLambda
+	request.UptimeWeight = cache.preferences.UptimeReputationWeight
+	request.UptimeDQ = cache.preferences.UptimeReputationDQ
+
 	return cache.db.UpdateStats(ctx, request)
 }
 
@@ -323,7 +459,10 @@ func (cache *Cache) UpdateOperator(ctx context.Context, node storj.NodeID, updat
 // UpdateUptime updates a single storagenode's uptime stats.
 func (cache *Cache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return cache.db.UpdateUptime(ctx, nodeID, isUp)
+	return cache.db.UpdateUptime(ctx, nodeID, isUp,
+		cache.preferences.UptimeReputationLambda,
+		cache.preferences.UptimeReputationWeight,
+		cache.preferences.UptimeReputationDQ)
 }
 
 // ConnFailure implements the Transport Observer `ConnFailure` function
@@ -334,7 +473,7 @@ func (cache *Cache) ConnFailure(ctx context.Context, node *pb.Node, failureError
 	// TODO: Kademlia paper specifies 5 unsuccessful PINGs before removing the node
 	// from our routing table, but this is the cache so maybe we want to treat
 	// it differently.
-	_, err = cache.db.UpdateUptime(ctx, node.Id, false)
+	_, err = cache.UpdateUptime(ctx, node.Id, false)
 	if err != nil {
 		zap.L().Debug("error updating uptime for node", zap.Error(err))
 	}
@@ -349,7 +488,7 @@ func (cache *Cache) ConnSuccess(ctx context.Context, node *pb.Node) {
 	if err != nil {
 		zap.L().Debug("error updating uptime for node", zap.Error(err))
 	}
-	_, err = cache.db.UpdateUptime(ctx, node.Id, true)
+	_, err = cache.UpdateUptime(ctx, node.Id, true)
 	if err != nil {
 		zap.L().Debug("error updating node connection info", zap.Error(err))
 	}
@@ -40,7 +40,7 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB) {
 	_, _ = rand.Read(valid2ID[:])
 	_, _ = rand.Read(missingID[:])
 
-	cache := overlay.NewCache(zaptest.NewLogger(t), store, overlay.NodeSelectionConfig{})
+	cache := overlay.NewCache(zaptest.NewLogger(t), store, overlay.NodeSelectionConfig{}, nil)
 
 	{ // Put
 		err := cache.Put(ctx, valid1ID, pb.Node{Id: valid1ID})
@@ -151,9 +151,9 @@ func testRandomizedSelection(t *testing.T, reputable bool) {
 				Type:         pb.NodeType_STORAGE,
 				Restrictions: &pb.NodeRestrictions{},
 				Reputation:   &pb.NodeStats{},
-			})
+			}, overlay.NodeNetwork{}, reputationConfig)
 			require.NoError(t, err)
-			_, err = cache.UpdateUptime(ctx, newID, true)
+			_, err = cache.UpdateUptime(ctx, newID, true, 1, 1, 0)
 			require.NoError(t, err)
 			allIDs[i] = newID
 			nodeCounts[newID] = 0
@@ -204,3 +204,174 @@ func testRandomizedSelection(t *testing.T, reputable bool) {
 		}
 	})
 }
+
+func TestDistinctSelection(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := db.OverlayCache()
+
+		nodes := []struct {
+			network overlay.NodeNetwork
+			wallet  string
+		}{
+			{overlay.NodeNetwork{LastNet: "10.0.1.0", CountryCode: "DE"}, "0x1"},
+			{overlay.NodeNetwork{LastNet: "10.0.1.0", CountryCode: "DE"}, "0x2"},
+			{overlay.NodeNetwork{LastNet: "10.0.2.0", CountryCode: "DE"}, "0x2"},
+			{overlay.NodeNetwork{LastNet: "10.0.3.0", CountryCode: "FR"}, "0x3"},
+			{overlay.NodeNetwork{LastNet: "10.0.4.0", CountryCode: "US"}, "0x4"},
+		}
+
+		ids := make(storj.NodeIDList, len(nodes))
+		for i, node := range nodes {
+			_, _ = rand.Read(ids[i][:])
+			err := cache.Update(ctx, &pb.Node{
+				Id:           ids[i],
+				Type:         pb.NodeType_STORAGE,
+				Metadata:     &pb.NodeMetadata{Wallet: node.wallet},
+				Restrictions: &pb.NodeRestrictions{},
+				Reputation:   &pb.NodeStats{},
+			}, node.network, reputationConfig)
+			require.NoError(t, err)
+			_, err = cache.UpdateUptime(ctx, ids[i], true, 1, 1, 0)
+			require.NoError(t, err)
+		}
+
+		dossier, err := cache.Get(ctx, ids[3])
+		require.NoError(t, err)
+		assert.Equal(t, nodes[3].network, dossier.Network)
+
+		selected, err := cache.SelectStorageNodes(ctx, len(nodes), &overlay.NodeCriteria{})
+		require.NoError(t, err)
+		assert.Len(t, selected, 5)
+
+		for i := 0; i < 10; i++ {
+			selected, err = cache.SelectStorageNodes(ctx, len(nodes), &overlay.NodeCriteria{
+				DistinctIP:       true,
+				DistinctOperator: true,
+			})
+			require.NoError(t, err)
+			// the first three nodes share networks and operators, so at most two of them are selected
+			assert.Contains(t, []int{3, 4}, len(selected))
+
+			nets, wallets := map[string]bool{}, map[string]bool{}
+			for _, node := range selected {
+				for j, id := range ids {
+					if node.Id == id {
+						assert.False(t, nets[nodes[j].network.LastNet])
+						assert.False(t, wallets[nodes[j].wallet])
+						nets[nodes[j].network.LastNet] = true
+						wallets[nodes[j].wallet] = true
+					}
+				}
+			}
+		}
+
+		// the excluded nodes take their networks with them
+		selected, err = cache.SelectStorageNodes(ctx, len(nodes), &overlay.NodeCriteria{
+			Excluded:   storj.NodeIDList{ids[0], ids[3]},
+			DistinctIP: true,
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []storj.NodeID{ids[2], ids[4]}, nodeIDs(selected))
+
+		selected, err = cache.SelectStorageNodes(ctx, len(nodes), &overlay.NodeCriteria{
+			Countries: []string{"FR", "US"},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []storj.NodeID{ids[3], ids[4]}, nodeIDs(selected))
+	})
+}
+
+func TestBucketPlacement(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(zaptest.NewLogger(t), db.OverlayCache(), overlay.NodeSelectionConfig{}, nil)
+		bucketID := []byte("project/bucket")
+
+		countries, err := cache.GetBucketPlacement(ctx, bucketID)
+		require.NoError(t, err)
+		assert.Nil(t, countries)
+
+		err = cache.SetBucketPlacement(ctx, bucketID, []string{"germany"})
+		assert.True(t, overlay.ErrInvalidPlacement.Has(err))
+
+		err = cache.SetBucketPlacement(ctx, bucketID, []string{"de", "FR"})
+		require.NoError(t, err)
+		countries, err = cache.GetBucketPlacement(ctx, bucketID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"DE", "FR"}, countries)
+
+		err = cache.SetBucketPlacement(ctx, bucketID, []string{"US"})
+		require.NoError(t, err)
+		countries, err = cache.GetBucketPlacement(ctx, bucketID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"US"}, countries)
+
+		err = cache.SetBucketPlacement(ctx, bucketID, nil)
+		require.NoError(t, err)
+		countries, err = cache.GetBucketPlacement(ctx, bucketID)
+		require.NoError(t, err)
+		assert.Nil(t, countries)
+	})
+}
+
+func nodeIDs(nodes []*pb.Node) (ids []storj.NodeID) {
+	for _, node := range nodes {
+		ids = append(ids, node.Id)
+	}
+	return ids
+}
+
+func TestDisqualifiedNodesNotSelected(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := db.OverlayCache()
+
+		good, disqualified := storj.NodeID{1}, storj.NodeID{2}
+		for _, id := range []storj.NodeID{good, disqualified} {
+			err := cache.Update(ctx, &pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Restrictions: &pb.NodeRestrictions{},
+				Reputation:   &pb.NodeStats{},
+			}, overlay.NodeNetwork{}, reputationConfig)
+			require.NoError(t, err)
+		}
+
+		for _, id := range []storj.NodeID{good, disqualified} {
+			stats, err := cache.UpdateStats(ctx, &overlay.UpdateRequest{
+				NodeID:       id,
+				AuditSuccess: id == good,
+				IsUp:         true,
+				AuditLambda:  reputationConfig.AuditReputationLambda,
+				AuditWeight:  reputationConfig.AuditReputationWeight,
+				AuditDQ:      reputationConfig.AuditReputationDQ,
+				UptimeLambda: reputationConfig.UptimeReputationLambda,
+				UptimeWeight: reputationConfig.UptimeReputationWeight,
+				UptimeDQ:     reputationConfig.UptimeReputationDQ,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, id == disqualified, stats.Disqualified != nil)
+		}
+
+		nodes, err := cache.SelectStorageNodes(ctx, 2, &overlay.NodeCriteria{})
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, good, nodes[0].Id)
+
+		nodes, err = cache.SelectNewStorageNodes(ctx, 2, &overlay.NewNodeCriteria{AuditThreshold: 10})
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, good, nodes[0].Id)
+	})
+}
@@ -21,7 +21,8 @@ var (
 // Config is a configuration struct for everything you need to start the
 // Overlay cache responsibility.
 type Config struct {
-	Node NodeSelectionConfig
+	Node  NodeSelectionConfig
+	GeoIP string `help:"path to a CSV file of network,country code lines used to locate the nodes" default:""`
 }
 
 // LookupConfig is a configuration struct for querying the overlay cache with one or more node IDs
@@ -40,6 +41,21 @@ type NodeSelectionConfig struct {
 
 	NewNodeAuditThreshold int64   `help:"the number of audits a node must have to not be considered a New Node" default:"0"`
 	NewNodePercentage     float64 `help:"the percentage of new nodes allowed per request" default:"0.05"` // TODO: fix, this is not percentage, it's ratio
+
+	DistinctIP       bool `help:"select at most one node per /24 subnet (/64 for IPv6) for a segment" devDefault:"false" default:"true"`
+	DistinctOperator bool `help:"select at most one node per operator wallet for a segment" devDefault:"false" default:"true"`
+
+	AuditReputationAlpha0 float64 `help:"the initial shape 'alpha' of the audit reputation of new nodes" default:"1"`
+	AuditReputationBeta0  float64 `help:"the initial shape 'beta' of the audit reputation of new nodes" default:"0"`
+	AuditReputationLambda float64 `help:"the forgetting factor of the audit reputation, lower values forget the history faster" default:"0.95"`
+	AuditReputationWeight float64 `help:"the weight of a single audit in the audit reputation" default:"1"`
+	AuditReputationDQ     float64 `help:"nodes with an audit reputation below this are disqualified" default:"0.6"`
+
+	UptimeReputationAlpha0 float64 `help:"the initial shape 'alpha' of the uptime reputation of new nodes" default:"1"`
+	UptimeReputationBeta0  float64 `help:"the initial shape 'beta' of the uptime reputation of new nodes" default:"0"`
+	UptimeReputationLambda float64 `help:"the forgetting factor of the uptime reputation, lower values forget the history faster" default:"0.99"`
+	UptimeReputationWeight float64 `help:"the weight of a single uptime check in the uptime reputation" default:"1"`
+	UptimeReputationDQ     float64 `help:"nodes with an uptime reputation below this are disqualified, 0 disables it" default:"0"`
 }
 
 // ParseIDs converts the base58check encoded node ID strings from the config into node IDs
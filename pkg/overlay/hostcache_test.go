@@ -0,0 +1,51 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+)
+
+func TestHostCache(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	cache := newHostCache(time.Hour, 1)
+	now := time.Now()
+
+	// ip addresses aren't looked up, so they aren't cached
+	_, lastNet, err := cache.resolveNetwork(ctx, "192.0.2.17:28967", now)
+	require.NoError(t, err)
+	assert.Equal(t, "192.0.2.0", lastNet)
+	assert.Empty(t, cache.hosts)
+
+	_, lastNet, err = cache.resolveNetwork(ctx, "localhost:28967", now)
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.0", lastNet)
+	require.Contains(t, cache.hosts, "localhost")
+
+	// the cached lookup is reused until it expires
+	cache.hosts["localhost"] = cachedHost{lastNet: "198.51.100.0", expires: now.Add(time.Hour)}
+	_, lastNet, err = cache.resolveNetwork(ctx, "localhost:28967", now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, "198.51.100.0", lastNet)
+
+	_, lastNet, err = cache.resolveNetwork(ctx, "localhost:28967", now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.0", lastNet)
+
+	// the cache doesn't grow past its limit
+	cache.hosts["other"] = cachedHost{expires: now.Add(time.Hour)}
+	delete(cache.hosts, "localhost")
+	_, _, err = cache.resolveNetwork(ctx, "localhost:28967", now)
+	require.NoError(t, err)
+	assert.Len(t, cache.hosts, 1)
+	assert.Contains(t, cache.hosts, "localhost")
+}
@@ -6,6 +6,7 @@ package overlay
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/pb"
@@ -45,12 +46,28 @@ func (srv *Inspector) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*p
 		return nil, err
 	}
 
-	return &pb.GetStatsResponse{
-		AuditCount:  node.GetReputation().GetAuditCount(),
-		AuditRatio:  node.GetReputation().GetAuditSuccessRatio(),
-		UptimeCount: node.GetReputation().GetUptimeCount(),
-		UptimeRatio: node.GetReputation().GetUptimeRatio(),
-	}, nil
+	stats := &node.Reputation
+	response := &pb.GetStatsResponse{
+		AuditCount:            stats.AuditCount,
+		AuditRatio:            stats.AuditSuccessRatio,
+		UptimeCount:           stats.UptimeCount,
+		UptimeRatio:           stats.UptimeRatio,
+		AuditReputationAlpha:  stats.AuditReputationAlpha,
+		AuditReputationBeta:   stats.AuditReputationBeta,
+		AuditReputation:       stats.AuditReputation(),
+		UptimeReputationAlpha: stats.UptimeReputationAlpha,
+		UptimeReputationBeta:  stats.UptimeReputationBeta,
+		UptimeReputation:      stats.UptimeReputation(),
+	}
+
+	if stats.Disqualified != nil {
+		response.Disqualified, err = ptypes.TimestampProto(*stats.Disqualified)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
 }
 
 // CreateStats creates a node with specified stats
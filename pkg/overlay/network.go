@@ -0,0 +1,196 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// NodeNetwork is the network information the satellite tracks for a node
+type NodeNetwork struct {
+	// LastNet is the /24 subnet for IPv4 or the /64 subnet for IPv6 of the
+	// last known address of the node, empty when it couldn't be resolved
+	LastNet string
+	// CountryCode is the ISO 3166-1 alpha-2 code of the country the node is in, empty when unknown
+	CountryCode string
+}
+
+// ResolveNetwork resolves the host of address and returns its ip and the subnet it belongs to
+func ResolveNetwork(ctx context.Context, address string) (ip net.IP, lastNet string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, "", Error.Wrap(err)
+	}
+
+	ip = net.ParseIP(host)
+	if ip == nil {
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, "", Error.Wrap(err)
+		}
+		if len(addrs) == 0 {
+			return nil, "", Error.New("no ip addresses for %q", host)
+		}
+		ip = addrs[0].IP
+	}
+
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return ip, ipv4.Mask(net.CIDRMask(24, 32)).String(), nil
+	}
+	return ip, ip.Mask(net.CIDRMask(64, 128)).String(), nil
+}
+
+const (
+	// hostCacheTTL is how long the lookups of the node host names are reused
+	hostCacheTTL = time.Hour
+	// maxCachedHosts is how many host names are cached at most
+	maxCachedHosts = 100000
+)
+
+// hostCache caches the lookups of the node host names, so that the node
+// check-ins don't wait on the resolver every time
+type hostCache struct {
+	ttl   time.Duration
+	limit int
+
+	mu    sync.Mutex
+	hosts map[string]cachedHost
+}
+
+// cachedHost is the result of a host name lookup
+type cachedHost struct {
+	ip      net.IP
+	lastNet string
+	err     error
+	expires time.Time
+}
+
+// newHostCache creates a cache keeping up to limit lookups for ttl
+func newHostCache(ttl time.Duration, limit int) *hostCache {
+	return &hostCache{
+		ttl:   ttl,
+		limit: limit,
+		hosts: map[string]cachedHost{},
+	}
+}
+
+// resolveNetwork resolves the network of address like ResolveNetwork, reusing
+// the lookups of the host name made in the last ttl, failed ones included
+func (cache *hostCache) resolveNetwork(ctx context.Context, address string, now time.Time) (ip net.IP, lastNet string, err error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil || net.ParseIP(host) != nil {
+		return ResolveNetwork(ctx, address)
+	}
+
+	cache.mu.Lock()
+	cached, ok := cache.hosts[host]
+	cache.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.ip, cached.lastNet, cached.err
+	}
+
+	ip, lastNet, err = ResolveNetwork(ctx, address)
+	if ctx.Err() != nil {
+		return ip, lastNet, err
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if len(cache.hosts) >= cache.limit {
+		for host, cached := range cache.hosts {
+			if !now.Before(cached.expires) {
+				delete(cache.hosts, host)
+			}
+		}
+	}
+	if len(cache.hosts) >= cache.limit {
+		for host := range cache.hosts {
+			delete(cache.hosts, host)
+			break
+		}
+	}
+	cache.hosts[host] = cachedHost{ip: ip, lastNet: lastNet, err: err, expires: now.Add(cache.ttl)}
+
+	return ip, lastNet, err
+}
+
+// GeoIP locates nodes by the networks of their ip addresses
+type GeoIP struct {
+	networks []geoNetwork
+}
+
+// geoNetwork is a network in a country
+type geoNetwork struct {
+	network *net.IPNet
+	country string
+}
+
+// LoadGeoIP loads the networks of the countries from a CSV file with
+// lines of `network,country code`, e.g. `192.0.2.0/24,DE`. An empty path
+// returns a GeoIP that doesn't locate any node.
+func LoadGeoIP(path string) (_ *GeoIP, err error) {
+	geoip := &GeoIP{}
+	if path == "" {
+		return geoip, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, ",")
+		if len(fields) != 2 {
+			return nil, Error.New("%s:%d: expected network,country", path, line)
+		}
+
+		_, network, err := net.ParseCIDR(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, Error.New("%s:%d: %v", path, line, err)
+		}
+
+		geoip.networks = append(geoip.networks, geoNetwork{
+			network: network,
+			country: strings.ToUpper(strings.TrimSpace(fields[1])),
+		})
+	}
+
+	return geoip, Error.Wrap(scanner.Err())
+}
+
+// Country returns the country code of the most specific network containing ip, empty when unknown
+func (geoip *GeoIP) Country(ip net.IP) string {
+	if geoip == nil || ip == nil {
+		return ""
+	}
+
+	country, longest := "", -1
+	for _, geo := range geoip.networks {
+		if !geo.network.Contains(ip) {
+			continue
+		}
+		if ones, _ := geo.network.Mask.Size(); ones > longest {
+			country, longest = geo.country, ones
+		}
+	}
+	return country
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"io/ioutil"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/overlay"
+)
+
+func TestResolveNetwork(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	for _, tt := range []struct {
+		address string
+		lastNet string
+	}{
+		{"192.0.2.17:28967", "192.0.2.0"},
+		{"[2001:db8:1:2:3:4:5:6]:28967", "2001:db8:1:2::"},
+	} {
+		ip, lastNet, err := overlay.ResolveNetwork(ctx, tt.address)
+		require.NoError(t, err)
+		assert.NotNil(t, ip)
+		assert.Equal(t, tt.lastNet, lastNet)
+	}
+
+	_, _, err := overlay.ResolveNetwork(ctx, "no-port")
+	assert.Error(t, err)
+}
+
+func TestGeoIP(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	path := ctx.File("networks.csv")
+	err := ioutil.WriteFile(path, []byte("# network,country\n192.0.2.0/24,de\n192.0.2.128/25,FR\n2001:db8::/32,US\n"), 0644)
+	require.NoError(t, err)
+
+	geoip, err := overlay.LoadGeoIP(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, "DE", geoip.Country(net.ParseIP("192.0.2.1")))
+	assert.Equal(t, "FR", geoip.Country(net.ParseIP("192.0.2.200")))
+	assert.Equal(t, "US", geoip.Country(net.ParseIP("2001:db8::1")))
+	assert.Equal(t, "", geoip.Country(net.ParseIP("198.51.100.1")))
+
+	var none *overlay.GeoIP
+	assert.Equal(t, "", none.Country(net.ParseIP("192.0.2.1")))
+
+	err = ioutil.WriteFile(path, []byte("192.0.2.0/24\n"), 0644)
+	require.NoError(t, err)
+	_, err = overlay.LoadGeoIP(path)
+	assert.Error(t, err)
+}
@@ -18,6 +18,21 @@ import (
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
+// reputationConfig contains the reputation parameters used by the tests
+var reputationConfig = overlay.NodeSelectionConfig{
+	AuditReputationAlpha0: 1,
+	AuditReputationBeta0:  0,
+	AuditReputationLambda: 0.95,
+	AuditReputationWeight: 1,
+	AuditReputationDQ:     0.6,
+
+	UptimeReputationAlpha0: 1,
+	UptimeReputationBeta0:  0,
+	UptimeReputationLambda: 0.99,
+	UptimeReputationWeight: 1,
+	UptimeReputationDQ:     0,
+}
+
 func getRatio(success, total int64) (ratio float64) {
 	ratio = float64(success) / float64(total)
 	return ratio
@@ -52,7 +67,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 			UptimeSuccessCount: currUptimeSuccess,
 		}
 
-		err := cache.Update(ctx, &pb.Node{Id: nodeID})
+		err := cache.Update(ctx, &pb.Node{Id: nodeID}, overlay.NodeNetwork{}, reputationConfig)
 		require.NoError(t, err)
 
 		stats, err := cache.CreateStats(ctx, nodeID, nodeStats)
@@ -105,7 +120,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 				UptimeSuccessCount: tt.uptimeSuccessCount,
 			}
 
-			err := cache.Update(ctx, &pb.Node{Id: tt.nodeID})
+			err := cache.Update(ctx, &pb.Node{Id: tt.nodeID}, overlay.NodeNetwork{}, reputationConfig)
 			require.NoError(t, err)
 
 			_, err = cache.CreateStats(ctx, tt.nodeID, nodeStats)
@@ -133,7 +148,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 
 	{ // TestUpdateOperator
 		nodeID := storj.NodeID{10}
-		err := cache.Update(ctx, &pb.Node{Id: nodeID})
+		err := cache.Update(ctx, &pb.Node{Id: nodeID}, overlay.NodeNetwork{}, reputationConfig)
 		require.NoError(t, err)
 
 		update, err := cache.UpdateOperator(ctx, nodeID, pb.NodeOperator{
@@ -218,7 +233,7 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		assert.EqualValues(t, currUptimeSuccess, node.Reputation.UptimeSuccessCount)
 		assert.EqualValues(t, uptimeRatio, node.Reputation.UptimeRatio)
 
-		stats, err := cache.UpdateUptime(ctx, nodeID, false)
+		stats, err := cache.UpdateUptime(ctx, nodeID, false, 1, 1, 0)
 		require.NoError(t, err)
 
 		currUptimeCount++
@@ -256,4 +271,73 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		newUptimeRatio := getRatio(stats.UptimeSuccessCount, stats.UptimeCount)
 		assert.EqualValues(t, newUptimeRatio, stats.UptimeRatio)
 	}
+
+	{ // TestReputationDisqualification
+		nodeID := storj.NodeID{20}
+		err := cache.Update(ctx, &pb.Node{Id: nodeID}, overlay.NodeNetwork{}, reputationConfig)
+		require.NoError(t, err)
+
+		node, err := cache.Get(ctx, nodeID)
+		require.NoError(t, err)
+		assert.EqualValues(t, reputationConfig.AuditReputationAlpha0, node.Reputation.AuditReputationAlpha)
+		assert.EqualValues(t, reputationConfig.AuditReputationBeta0, node.Reputation.AuditReputationBeta)
+		assert.False(t, node.Disqualified())
+
+		request := func(auditSuccess bool) *overlay.UpdateRequest {
+			return &overlay.UpdateRequest{
+				NodeID:       nodeID,
+				AuditSuccess: auditSuccess,
+				IsUp:         true,
+				AuditLambda:  reputationConfig.AuditReputationLambda,
+				AuditWeight:  reputationConfig.AuditReputationWeight,
+				AuditDQ:      reputationConfig.AuditReputationDQ,
+				UptimeLambda: reputationConfig.UptimeReputationLambda,
+				UptimeWeight: reputationConfig.UptimeReputationWeight,
+				UptimeDQ:     reputationConfig.UptimeReputationDQ,
+			}
+		}
+
+		// a long history of successful audits
+		alpha, beta := reputationConfig.AuditReputationAlpha0, reputationConfig.AuditReputationBeta0
+		for i := 0; i < 50; i++ {
+			stats, err := cache.UpdateStats(ctx, request(true))
+			require.NoError(t, err)
+
+			alpha = reputationConfig.AuditReputationLambda*alpha + reputationConfig.AuditReputationWeight
+			beta = reputationConfig.AuditReputationLambda * beta
+			assert.InDelta(t, alpha, stats.AuditReputationAlpha, 1e-9)
+			assert.InDelta(t, beta, stats.AuditReputationBeta, 1e-9)
+			assert.Nil(t, stats.Disqualified)
+		}
+
+		// the recent failures outweigh the history, even though the lifetime ratio is still good
+		var stats *overlay.NodeStats
+		for i := 0; i < 20; i++ {
+			stats, err = cache.UpdateStats(ctx, request(false))
+			require.NoError(t, err)
+			if stats.Disqualified != nil {
+				break
+			}
+		}
+		require.NotNil(t, stats.Disqualified)
+		assert.True(t, stats.AuditReputation() < reputationConfig.AuditReputationDQ)
+		assert.True(t, stats.AuditSuccessRatio > reputationConfig.AuditReputationDQ)
+
+		// disqualification is permanent
+		for i := 0; i < 50; i++ {
+			stats, err = cache.UpdateStats(ctx, request(true))
+			require.NoError(t, err)
+		}
+		assert.True(t, stats.AuditReputation() > reputationConfig.AuditReputationDQ)
+		assert.NotNil(t, stats.Disqualified)
+
+		node, err = cache.Get(ctx, nodeID)
+		require.NoError(t, err)
+		assert.True(t, node.Disqualified())
+
+		// disqualified nodes are invalid, so their pieces are repaired
+		invalid, err := cache.FindInvalidNodes(ctx, storj.NodeIDList{nodeID}, &overlay.NodeStats{})
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{nodeID}, invalid)
+	}
 }
@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: access.proto
+
+package pb
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Access is the serialized form of everything needed to access a project or
+// the paths shared from it
+type Access struct {
+	SatelliteAddr        string            `protobuf:"bytes,1,opt,name=satellite_addr,json=satelliteAddr,proto3" json:"satellite_addr,omitempty"`
+	ApiKey               string            `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	EncryptionAccess     *EncryptionAccess `protobuf:"bytes,3,opt,name=encryption_access,json=encryptionAccess,proto3" json:"encryption_access,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Access) Reset()         { *m = Access{} }
+func (m *Access) String() string { return proto.CompactTextString(m) }
+func (*Access) ProtoMessage()    {}
+func (*Access) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a098e900d2c3a6f2, []int{0}
+}
+func (m *Access) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Access.Unmarshal(m, b)
+}
+func (m *Access) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Access.Marshal(b, m, deterministic)
+}
+func (m *Access) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Access.Merge(m, src)
+}
+func (m *Access) XXX_Size() int {
+	return xxx_messageInfo_Access.Size(m)
+}
+func (m *Access) XXX_DiscardUnknown() {
+	xxx_messageInfo_Access.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Access proto.InternalMessageInfo
+
+func (m *Access) GetSatelliteAddr() string {
+	if m != nil {
+		return m.SatelliteAddr
+	}
+	return ""
+}
+
+func (m *Access) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+func (m *Access) GetEncryptionAccess() *EncryptionAccess {
+	if m != nil {
+		return m.EncryptionAccess
+	}
+	return nil
+}
+
+// EncryptionAccess holds the keys for encrypting and decrypting paths and content
+type EncryptionAccess struct {
+	// default_key is empty when only the shared prefixes can be accessed
+	DefaultKey           []byte                           `protobuf:"bytes,1,opt,name=default_key,json=defaultKey,proto3" json:"default_key,omitempty"`
+	SharedPrefixes       []*EncryptionAccess_SharedPrefix `protobuf:"bytes,2,rep,name=shared_prefixes,json=sharedPrefixes,proto3" json:"shared_prefixes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *EncryptionAccess) Reset()         { *m = EncryptionAccess{} }
+func (m *EncryptionAccess) String() string { return proto.CompactTextString(m) }
+func (*EncryptionAccess) ProtoMessage()    {}
+func (*EncryptionAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a098e900d2c3a6f2, []int{1}
+}
+func (m *EncryptionAccess) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncryptionAccess.Unmarshal(m, b)
+}
+func (m *EncryptionAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncryptionAccess.Marshal(b, m, deterministic)
+}
+func (m *EncryptionAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionAccess.Merge(m, src)
+}
+func (m *EncryptionAccess) XXX_Size() int {
+	return xxx_messageInfo_EncryptionAccess.Size(m)
+}
+func (m *EncryptionAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionAccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionAccess proto.InternalMessageInfo
+
+func (m *EncryptionAccess) GetDefaultKey() []byte {
+	if m != nil {
+		return m.DefaultKey
+	}
+	return nil
+}
+
+func (m *EncryptionAccess) GetSharedPrefixes() []*EncryptionAccess_SharedPrefix {
+	if m != nil {
+		return m.SharedPrefixes
+	}
+	return nil
+}
+
+// SharedPrefix is a path prefix with the keys derived for it
+type EncryptionAccess_SharedPrefix struct {
+	// unencrypted and encrypted paths start with the bucket name
+	UnencryptedPath      string   `protobuf:"bytes,1,opt,name=unencrypted_path,json=unencryptedPath,proto3" json:"unencrypted_path,omitempty"`
+	EncryptedPath        string   `protobuf:"bytes,2,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	Key                  []byte   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	BucketKey            []byte   `protobuf:"bytes,4,opt,name=bucket_key,json=bucketKey,proto3" json:"bucket_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncryptionAccess_SharedPrefix) Reset()         { *m = EncryptionAccess_SharedPrefix{} }
+func (m *EncryptionAccess_SharedPrefix) String() string { return proto.CompactTextString(m) }
+func (*EncryptionAccess_SharedPrefix) ProtoMessage()    {}
+func (*EncryptionAccess_SharedPrefix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a098e900d2c3a6f2, []int{1, 0}
+}
+func (m *EncryptionAccess_SharedPrefix) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EncryptionAccess_SharedPrefix.Unmarshal(m, b)
+}
+func (m *EncryptionAccess_SharedPrefix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EncryptionAccess_SharedPrefix.Marshal(b, m, deterministic)
+}
+func (m *EncryptionAccess_SharedPrefix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptionAccess_SharedPrefix.Merge(m, src)
+}
+func (m *EncryptionAccess_SharedPrefix) XXX_Size() int {
+	return xxx_messageInfo_EncryptionAccess_SharedPrefix.Size(m)
+}
+func (m *EncryptionAccess_SharedPrefix) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptionAccess_SharedPrefix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncryptionAccess_SharedPrefix proto.InternalMessageInfo
+
+func (m *EncryptionAccess_SharedPrefix) GetUnencryptedPath() string {
+	if m != nil {
+		return m.UnencryptedPath
+	}
+	return ""
+}
+
+func (m *EncryptionAccess_SharedPrefix) GetEncryptedPath() string {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return ""
+}
+
+func (m *EncryptionAccess_SharedPrefix) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *EncryptionAccess_SharedPrefix) GetBucketKey() []byte {
+	if m != nil {
+		return m.BucketKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Access)(nil), "access.Access")
+	proto.RegisterType((*EncryptionAccess)(nil), "access.EncryptionAccess")
+	proto.RegisterType((*EncryptionAccess_SharedPrefix)(nil), "access.EncryptionAccess.SharedPrefix")
+}
+
+func init() { proto.RegisterFile("access.proto", fileDescriptor_a098e900d2c3a6f2) }
+
+var fileDescriptor_a098e900d2c3a6f2 = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x6b, 0xb3, 0x40,
+	0x10, 0xc6, 0x51, 0x83, 0x2f, 0x99, 0xfc, 0xf3, 0xdd, 0x4b, 0xa5, 0x50, 0x2a, 0x81, 0x80, 0xbd,
+	0x78, 0x48, 0x3f, 0x41, 0x0a, 0x39, 0x05, 0x4a, 0xb0, 0xb7, 0x5e, 0x64, 0x75, 0x27, 0x28, 0x11,
+	0x5d, 0x76, 0x57, 0xa8, 0x9f, 0xa2, 0xf4, 0xd4, 0xaf, 0x5b, 0x76, 0x57, 0x82, 0x15, 0x7a, 0x9b,
+	0x79, 0xe6, 0xc7, 0x3e, 0x33, 0xcf, 0xc2, 0x92, 0x16, 0x05, 0x4a, 0x99, 0x70, 0xd1, 0xaa, 0x96,
+	0xf8, 0xb6, 0xdb, 0x7e, 0x3a, 0xe0, 0x1f, 0x4c, 0x49, 0x76, 0xb0, 0x96, 0x54, 0x61, 0x5d, 0x57,
+	0x0a, 0x33, 0xca, 0x98, 0x08, 0x9d, 0xc8, 0x89, 0xe7, 0xe9, 0xea, 0xa6, 0x1e, 0x18, 0x13, 0xe4,
+	0x0e, 0xfe, 0x51, 0x5e, 0x65, 0x57, 0xec, 0x43, 0xd7, 0xcc, 0x7d, 0xca, 0xab, 0x13, 0xf6, 0xe4,
+	0x08, 0xff, 0xb1, 0x29, 0x44, 0xcf, 0x55, 0xd5, 0x36, 0x99, 0x7d, 0x3f, 0xf4, 0x22, 0x27, 0x5e,
+	0xec, 0xc3, 0x64, 0x30, 0x3f, 0xde, 0x00, 0x6b, 0x9a, 0x06, 0x38, 0x51, 0xb6, 0xdf, 0x2e, 0x04,
+	0x53, 0x8c, 0x3c, 0xc2, 0x82, 0xe1, 0x85, 0x76, 0xb5, 0x32, 0xc6, 0x7a, 0xb1, 0x65, 0x0a, 0x83,
+	0xa4, 0xcd, 0x5f, 0x61, 0x23, 0x4b, 0x2a, 0x90, 0x65, 0x5c, 0xe0, 0xa5, 0xfa, 0x40, 0x19, 0xba,
+	0x91, 0x17, 0x2f, 0xf6, 0xbb, 0xbf, 0xac, 0x93, 0x37, 0xc3, 0x9f, 0x0d, 0x9e, 0xae, 0xe5, 0xa8,
+	0x43, 0x79, 0xff, 0xe5, 0xc0, 0x72, 0x0c, 0x90, 0x27, 0x08, 0xba, 0x66, 0x58, 0x56, 0xbb, 0x50,
+	0x55, 0x0e, 0xf9, 0x6c, 0x46, 0xfa, 0x99, 0xaa, 0x52, 0x07, 0x39, 0x01, 0x6d, 0x50, 0xab, 0xdf,
+	0x58, 0x00, 0x9e, 0xbe, 0xc5, 0x33, 0xb7, 0xe8, 0x92, 0x3c, 0x00, 0xe4, 0x5d, 0x71, 0x45, 0x7b,
+	0xe4, 0xcc, 0x0c, 0xe6, 0x56, 0x39, 0x61, 0xff, 0x32, 0x7b, 0x77, 0x79, 0x9e, 0xfb, 0xe6, 0x03,
+	0x9f, 0x7f, 0x06, 0x00, 0xdb, 0x8b, 0xea, 0x98, 0xd0, 0x01, 0x00, 0x00,
+}
@@ -22,8 +22,13 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // InjuredSegment is the queue item used for the data repair queue
 type InjuredSegment struct {
-	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	LostPieces           []int32  `protobuf:"varint,2,rep,packed,name=lost_pieces,json=lostPieces,proto3" json:"lost_pieces,omitempty"`
+	Path       string  `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	LostPieces []int32 `protobuf:"varint,2,rep,packed,name=lost_pieces,json=lostPieces,proto3" json:"lost_pieces,omitempty"`
+	// number of pieces on healthy nodes when the segment was checked
+	NumHealthyPieces int32 `protobuf:"varint,3,opt,name=num_healthy_pieces,json=numHealthyPieces,proto3" json:"num_healthy_pieces,omitempty"`
+	RepairThreshold  int32 `protobuf:"varint,4,opt,name=repair_threshold,json=repairThreshold,proto3" json:"repair_threshold,omitempty"`
+	// number of times the segment was dequeued for repair, set by the queue
+	Attempts             int32    `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -67,6 +72,27 @@ func (m *InjuredSegment) GetLostPieces() []int32 {
 	return nil
 }
 
+func (m *InjuredSegment) GetNumHealthyPieces() int32 {
+	if m != nil {
+		return m.NumHealthyPieces
+	}
+	return 0
+}
+
+func (m *InjuredSegment) GetRepairThreshold() int32 {
+	if m != nil {
+		return m.RepairThreshold
+	}
+	return 0
+}
+
+func (m *InjuredSegment) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*InjuredSegment)(nil), "repair.InjuredSegment")
 }
@@ -74,13 +100,17 @@ func init() {
 func init() { proto.RegisterFile("datarepair.proto", fileDescriptor_b1b08e6fe9398aa6) }
 
 var fileDescriptor_b1b08e6fe9398aa6 = []byte{
-	// 119 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x48, 0x49, 0x2c, 0x49,
-	0x2c, 0x4a, 0x2d, 0x48, 0xcc, 0x2c, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xf0,
-	0x94, 0x5c, 0xb9, 0xf8, 0x3c, 0xf3, 0xb2, 0x4a, 0x8b, 0x52, 0x53, 0x82, 0x53, 0xd3, 0x73, 0x53,
-	0xf3, 0x4a, 0x84, 0x84, 0xb8, 0x58, 0x0a, 0x12, 0x4b, 0x32, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38,
-	0x83, 0xc0, 0x6c, 0x21, 0x79, 0x2e, 0xee, 0x9c, 0xfc, 0xe2, 0x92, 0xf8, 0x82, 0xcc, 0xd4, 0xe4,
-	0xd4, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0xd6, 0x20, 0x2e, 0x90, 0x50, 0x00, 0x58, 0xc4, 0x89,
-	0x25, 0x8a, 0xa9, 0x20, 0x29, 0x89, 0x0d, 0x6c, 0xb6, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x13,
-	0xff, 0xff, 0x1e, 0x6f, 0x00, 0x00, 0x00,
+	// 192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x8f, 0xbd, 0x6a, 0xc3, 0x30,
+	0x10, 0x80, 0x91, 0xff, 0x68, 0xaf, 0xd0, 0x1a, 0x4d, 0xa2, 0x4b, 0x4d, 0x27, 0x17, 0x4a, 0x97,
+	0xbe, 0x41, 0xa7, 0x76, 0x2b, 0x4e, 0xa6, 0x2c, 0x46, 0x8e, 0x8f, 0xc8, 0xc1, 0xfa, 0x41, 0x3a,
+	0x0f, 0x79, 0xb5, 0x3c, 0x5d, 0x88, 0x14, 0x67, 0xbb, 0xef, 0xbb, 0x0f, 0x8e, 0x83, 0x7a, 0x94,
+	0x24, 0x3d, 0x3a, 0x39, 0xf9, 0x2f, 0xe7, 0x2d, 0x59, 0x5e, 0x25, 0x7a, 0x3f, 0x33, 0x78, 0xfe,
+	0x33, 0xc7, 0xc5, 0xe3, 0xb8, 0xc1, 0x83, 0x46, 0x43, 0x9c, 0x43, 0xe1, 0x24, 0x29, 0xc1, 0x1a,
+	0xd6, 0x3e, 0x76, 0x71, 0xe6, 0x6f, 0xf0, 0x34, 0xdb, 0x40, 0xbd, 0x9b, 0x70, 0x8f, 0x41, 0x64,
+	0x4d, 0xde, 0x96, 0x1d, 0x5c, 0xd5, 0x7f, 0x34, 0xfc, 0x13, 0xb8, 0x59, 0x74, 0xaf, 0x50, 0xce,
+	0xa4, 0x4e, 0x6b, 0x97, 0x37, 0xac, 0x2d, 0xbb, 0xda, 0x2c, 0xfa, 0x37, 0x2d, 0x6e, 0xf5, 0x07,
+	0xd4, 0xe9, 0x7e, 0x4f, 0xca, 0x63, 0x50, 0x76, 0x1e, 0x45, 0x11, 0xdb, 0x97, 0xe4, 0xb7, 0xab,
+	0xe6, 0xaf, 0xf0, 0x20, 0x89, 0x50, 0x3b, 0x0a, 0xa2, 0x8c, 0xc9, 0x9d, 0x7f, 0x8a, 0x5d, 0xe6,
+	0x86, 0xa1, 0x8a, 0x1f, 0x7d, 0x5f, 0x06, 0x00, 0xa1, 0xf4, 0xf5, 0xfb, 0xe5, 0x00, 0x00, 0x00,
 }
@@ -213,13 +213,21 @@ func (m *GetStatsRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_GetStatsRequest proto.InternalMessageInfo
 
 type GetStatsResponse struct {
-	AuditCount           int64    `protobuf:"varint,1,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
-	AuditRatio           float64  `protobuf:"fixed64,2,opt,name=audit_ratio,json=auditRatio,proto3" json:"audit_ratio,omitempty"`
-	UptimeCount          int64    `protobuf:"varint,3,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
-	UptimeRatio          float64  `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	AuditCount            int64   `protobuf:"varint,1,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
+	AuditRatio            float64 `protobuf:"fixed64,2,opt,name=audit_ratio,json=auditRatio,proto3" json:"audit_ratio,omitempty"`
+	UptimeCount           int64   `protobuf:"varint,3,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
+	UptimeRatio           float64 `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+	AuditReputationAlpha  float64 `protobuf:"fixed64,5,opt,name=audit_reputation_alpha,json=auditReputationAlpha,proto3" json:"audit_reputation_alpha,omitempty"`
+	AuditReputationBeta   float64 `protobuf:"fixed64,6,opt,name=audit_reputation_beta,json=auditReputationBeta,proto3" json:"audit_reputation_beta,omitempty"`
+	AuditReputation       float64 `protobuf:"fixed64,7,opt,name=audit_reputation,json=auditReputation,proto3" json:"audit_reputation,omitempty"`
+	UptimeReputationAlpha float64 `protobuf:"fixed64,8,opt,name=uptime_reputation_alpha,json=uptimeReputationAlpha,proto3" json:"uptime_reputation_alpha,omitempty"`
+	UptimeReputationBeta  float64 `protobuf:"fixed64,9,opt,name=uptime_reputation_beta,json=uptimeReputationBeta,proto3" json:"uptime_reputation_beta,omitempty"`
+	UptimeReputation      float64 `protobuf:"fixed64,10,opt,name=uptime_reputation,json=uptimeReputation,proto3" json:"uptime_reputation,omitempty"`
+	// the time the node was disqualified, unset when it isn't disqualified
+	Disqualified         *timestamp.Timestamp `protobuf:"bytes,11,opt,name=disqualified,proto3" json:"disqualified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *GetStatsResponse) Reset()         { *m = GetStatsResponse{} }
@@ -274,6 +282,55 @@ func (m *GetStatsResponse) GetUptimeRatio() float64 {
 	return 0
 }
 
+func (m *GetStatsResponse) GetAuditReputationAlpha() float64 {
+	if m != nil {
+		return m.AuditReputationAlpha
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetAuditReputationBeta() float64 {
+	if m != nil {
+		return m.AuditReputationBeta
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetAuditReputation() float64 {
+	if m != nil {
+		return m.AuditReputation
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetUptimeReputationAlpha() float64 {
+	if m != nil {
+		return m.UptimeReputationAlpha
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetUptimeReputationBeta() float64 {
+	if m != nil {
+		return m.UptimeReputationBeta
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetUptimeReputation() float64 {
+	if m != nil {
+		return m.UptimeReputation
+	}
+	return 0
+}
+
+func (m *GetStatsResponse) GetDisqualified() *timestamp.Timestamp {
+	if m != nil {
+		return m.Disqualified
+	}
+	return nil
+}
+
 // CreateStats
 type CreateStatsRequest struct {
 	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
@@ -1614,112 +1671,119 @@ func init() {
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 1671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x72, 0x1b, 0xc7,
-	0x11, 0xd6, 0xe2, 0x4f, 0x44, 0x03, 0xc4, 0xcf, 0x80, 0x92, 0x10, 0x48, 0x22, 0x98, 0xcd, 0x8f,
-	0x28, 0x29, 0x05, 0x29, 0x88, 0x72, 0x50, 0x54, 0x3a, 0x88, 0x64, 0x24, 0xa1, 0xc4, 0x48, 0xd4,
-	0x52, 0xb9, 0xa4, 0x54, 0x41, 0x0d, 0xb0, 0x43, 0x70, 0x43, 0x60, 0x67, 0xb5, 0x3b, 0x50, 0xc4,
-	0x17, 0x70, 0xd9, 0x2f, 0xe0, 0x2a, 0xfb, 0xea, 0x97, 0x70, 0xd9, 0x2f, 0xe0, 0x9b, 0xef, 0x3e,
-	0xe8, 0xe2, 0x2a, 0xfb, 0x19, 0x7c, 0x73, 0x4d, 0xcf, 0xec, 0x2f, 0x00, 0x93, 0xe5, 0x9f, 0x1b,
-	0xf6, 0xeb, 0x6f, 0x7a, 0xba, 0x7b, 0x66, 0x7a, 0xbe, 0x01, 0xd4, 0x1d, 0x37, 0xf0, 0xd8, 0x58,
-	0x70, 0xbf, 0xe7, 0xf9, 0x5c, 0x70, 0x52, 0x8e, 0x80, 0x0e, 0x4c, 0xf8, 0x84, 0x2b, 0xb8, 0x03,
-	0x2e, 0xb7, 0x99, 0xfe, 0x5d, 0xf7, 0xb8, 0xe3, 0x0a, 0xe6, 0xdb, 0x23, 0x0d, 0x6c, 0x4e, 0x38,
-	0x9f, 0x4c, 0xd9, 0x1d, 0xfc, 0x1a, 0xcd, 0x8f, 0xee, 0xd8, 0x73, 0x9f, 0x0a, 0x87, 0xbb, 0xda,
-	0xde, 0xcd, 0xda, 0x85, 0x33, 0x63, 0x81, 0xa0, 0x33, 0x4f, 0x11, 0xcc, 0xe7, 0xb0, 0xb9, 0xef,
-	0x04, 0x62, 0xe0, 0xfb, 0xcc, 0xa3, 0x3e, 0x1d, 0x4d, 0xd9, 0x21, 0x9b, 0xcc, 0x98, 0x2b, 0x02,
-	0x8b, 0xbd, 0x99, 0xb3, 0x40, 0x90, 0x0d, 0x28, 0x4e, 0x9d, 0x99, 0x23, 0xda, 0xc6, 0x96, 0xb1,
-	0x5d, 0xb4, 0xd4, 0x07, 0xb9, 0x0c, 0x25, 0x7e, 0x74, 0x14, 0x30, 0xd1, 0xce, 0x21, 0xac, 0xbf,
-	0xcc, 0xef, 0x0c, 0x20, 0x8b, 0xce, 0x08, 0x81, 0x82, 0x47, 0xc5, 0x31, 0xfa, 0xa8, 0x5a, 0xf8,
-	0x9b, 0xdc, 0x87, 0x5a, 0xa0, 0xcc, 0x43, 0x9b, 0x09, 0xea, 0x4c, 0xd1, 0x55, 0xa5, 0x4f, 0x7a,
-	0x71, 0x96, 0x07, 0xea, 0x97, 0xb5, 0xae, 0x99, 0x7b, 0x48, 0x24, 0x5d, 0xa8, 0x4c, 0x79, 0x20,
-	0x86, 0x9e, 0xc3, 0xc6, 0x2c, 0x68, 0xe7, 0x31, 0x04, 0x90, 0xd0, 0x01, 0x22, 0xa4, 0x07, 0xad,
-	0x29, 0x0d, 0xc4, 0x50, 0x06, 0xe2, 0xf8, 0x43, 0x2a, 0x04, 0x9b, 0x79, 0xa2, 0x5d, 0xd8, 0x32,
-	0xb6, 0xf3, 0x56, 0x53, 0x9a, 0x2c, 0xb4, 0x3c, 0x52, 0x06, 0x72, 0x17, 0x36, 0xd2, 0xd4, 0xe1,
-	0x98, 0xcf, 0x5d, 0xd1, 0x2e, 0xe2, 0x00, 0xe2, 0x27, 0xc9, 0xbb, 0xd2, 0x62, 0xbe, 0x86, 0xee,
-	0xca, 0xc2, 0x05, 0x1e, 0x77, 0x03, 0x46, 0xee, 0xc3, 0x9a, 0x0e, 0x3b, 0x68, 0x1b, 0x5b, 0xf9,
-	0xed, 0x4a, 0xff, 0x7a, 0x2f, 0x5e, 0xf4, 0xc5, 0x91, 0x56, 0x44, 0x37, 0xff, 0x01, 0xf5, 0x27,
-	0x4c, 0x1c, 0x0a, 0x1a, 0xaf, 0xc3, 0x0d, 0xb8, 0x28, 0x77, 0xc2, 0xd0, 0xb1, 0x55, 0x15, 0x77,
-	0x6a, 0x5f, 0xbd, 0xef, 0x5e, 0xf8, 0xe6, 0x7d, 0xb7, 0xf4, 0x9c, 0xdb, 0x6c, 0xb0, 0x67, 0x95,
-	0xa4, 0x79, 0x60, 0x9b, 0x9f, 0x1a, 0xd0, 0x88, 0x07, 0xeb, 0x58, 0xba, 0x50, 0xa1, 0x73, 0xdb,
-	0x09, 0xf3, 0x32, 0x30, 0x2f, 0x40, 0x08, 0xf3, 0x89, 0x09, 0xb8, 0x7f, 0x70, 0x29, 0x0c, 0x4d,
-	0xb0, 0x24, 0x42, 0x7e, 0x0f, 0xd5, 0xb9, 0x27, 0xb7, 0x8f, 0x76, 0x91, 0x47, 0x17, 0x15, 0x85,
-	0x29, 0x1f, 0x31, 0x45, 0x39, 0x29, 0xa0, 0x13, 0x4d, 0x41, 0x2f, 0xe6, 0xb7, 0x06, 0x90, 0x5d,
-	0x9f, 0x51, 0xc1, 0x7e, 0x56, 0x72, 0xd9, 0x3c, 0x72, 0x0b, 0x79, 0xf4, 0xa0, 0xa5, 0x08, 0xc1,
-	0x7c, 0x3c, 0x66, 0x41, 0x90, 0x8a, 0xb6, 0x89, 0xa6, 0x43, 0x65, 0xc9, 0xc6, 0xac, 0x88, 0x85,
-	0xc5, 0xb4, 0xee, 0xc2, 0x86, 0xa6, 0xa4, 0x7d, 0xea, 0xcd, 0xa1, 0x6c, 0x49, 0xa7, 0xe6, 0x25,
-	0x68, 0xa5, 0x92, 0x54, 0x8b, 0x60, 0xde, 0x02, 0x82, 0x76, 0x99, 0x53, 0xbc, 0x34, 0x1b, 0x50,
-	0x4c, 0x2e, 0x8a, 0xfa, 0x30, 0x5b, 0xd0, 0x4c, 0x72, 0xb1, 0x4c, 0x12, 0x7c, 0xc2, 0xc4, 0xce,
-	0x7c, 0x7c, 0xc2, 0xa2, 0xda, 0x99, 0x4f, 0x81, 0x24, 0xc1, 0xd8, 0xab, 0xe0, 0x82, 0x4e, 0x43,
-	0xaf, 0xf8, 0x41, 0xae, 0x41, 0xde, 0xb1, 0x83, 0x76, 0x6e, 0x2b, 0xbf, 0x5d, 0xdd, 0x81, 0x44,
-	0x7d, 0x25, 0x6c, 0xf6, 0x71, 0xe3, 0x28, 0x4f, 0xe1, 0xca, 0x6c, 0x42, 0x6e, 0xe5, 0xa2, 0xe4,
-	0x1c, 0xdb, 0xfc, 0x77, 0x22, 0xa4, 0x68, 0xf2, 0x33, 0x06, 0x91, 0x2d, 0x28, 0xca, 0xf5, 0x54,
-	0x81, 0x54, 0xfa, 0xd0, 0xc3, 0x1e, 0x27, 0x09, 0x96, 0x32, 0x98, 0xb7, 0xa0, 0xa4, 0x7c, 0x9e,
-	0x83, 0xdb, 0x03, 0x50, 0x5c, 0x79, 0x20, 0x63, 0xbe, 0xb1, 0x8a, 0xff, 0x0c, 0xea, 0x07, 0x8e,
-	0x3b, 0x41, 0xe8, 0x7c, 0x59, 0x92, 0x36, 0x5c, 0xa4, 0xb6, 0xed, 0xb3, 0x20, 0xc0, 0x2d, 0x57,
-	0xb6, 0xc2, 0x4f, 0xd3, 0x84, 0x46, 0xec, 0x4c, 0xa7, 0x5f, 0x83, 0x1c, 0x3f, 0x41, 0x6f, 0x6b,
-	0x56, 0x8e, 0x9f, 0x98, 0x0f, 0xa1, 0xb9, 0xcf, 0xf9, 0xc9, 0xdc, 0x4b, 0x4e, 0x59, 0x8b, 0xa6,
-	0x2c, 0x9f, 0x31, 0xc5, 0x6b, 0x20, 0xc9, 0xe1, 0x51, 0x8d, 0x0b, 0x32, 0x1d, 0xf4, 0x90, 0x4e,
-	0x13, 0x71, 0xf2, 0x67, 0x28, 0xcc, 0x98, 0xa0, 0x51, 0x53, 0x8d, 0xec, 0xff, 0x62, 0x82, 0xda,
-	0x54, 0x50, 0x0b, 0xed, 0xe6, 0x7f, 0xa1, 0x8e, 0x89, 0xba, 0x47, 0xfc, 0xbc, 0xd5, 0xb8, 0x9d,
-	0x0e, 0xb5, 0xd2, 0x6f, 0xc6, 0xde, 0x1f, 0x29, 0x43, 0x1c, 0xfd, 0xc7, 0x06, 0x34, 0xe2, 0x09,
-	0x74, 0xf0, 0x26, 0x14, 0xc4, 0xa9, 0xa7, 0x82, 0xaf, 0xf5, 0x6b, 0xf1, 0xf0, 0x57, 0xa7, 0x1e,
-	0xb3, 0xd0, 0x46, 0x7a, 0xb0, 0xc6, 0x3d, 0xe6, 0x53, 0xc1, 0xfd, 0xc5, 0x24, 0x5e, 0x68, 0x8b,
-	0x15, 0x71, 0x24, 0x7f, 0x4c, 0x3d, 0x3a, 0x76, 0xc4, 0x29, 0x1e, 0xf7, 0x14, 0x7f, 0x57, 0x5b,
-	0xac, 0x88, 0x63, 0xce, 0xa0, 0xfe, 0xd8, 0x71, 0xed, 0xe7, 0x8c, 0xfa, 0xe7, 0x4d, 0xfc, 0x8f,
-	0x50, 0x0c, 0x04, 0xf5, 0x55, 0xdf, 0x59, 0xa4, 0x28, 0x63, 0x7c, 0x63, 0xaa, 0xa6, 0xa3, 0x3e,
-	0xcc, 0x7b, 0xd0, 0x88, 0xa7, 0xd3, 0x65, 0x38, 0x7b, 0x6f, 0x13, 0x68, 0xec, 0xcd, 0x67, 0x5e,
-	0xaa, 0x0b, 0xfc, 0x1d, 0x9a, 0x09, 0x2c, 0xeb, 0x6a, 0xe5, 0xb6, 0xaf, 0x41, 0x35, 0xd9, 0x73,
-	0xcd, 0x1f, 0x0c, 0x68, 0x49, 0xe0, 0x70, 0x3e, 0x9b, 0x51, 0xff, 0x34, 0xf2, 0x74, 0x1d, 0x60,
-	0x1e, 0x30, 0x7b, 0x18, 0x78, 0x74, 0xcc, 0x74, 0xfb, 0x28, 0x4b, 0xe4, 0x50, 0x02, 0xe4, 0x06,
-	0xd4, 0xe9, 0x5b, 0xea, 0x4c, 0xe5, 0xc5, 0xa5, 0x39, 0xaa, 0x0b, 0xd7, 0x22, 0x58, 0x11, 0x65,
-	0x67, 0x95, 0x7e, 0x1c, 0x77, 0x82, 0x5b, 0x25, 0xbc, 0x30, 0x02, 0x66, 0x0f, 0x14, 0x24, 0xbb,
-	0x39, 0x52, 0x98, 0x62, 0xa8, 0xde, 0x8b, 0xb3, 0xff, 0x53, 0x11, 0xfe, 0x04, 0x35, 0x24, 0x8c,
-	0xa8, 0x6b, 0xff, 0xdf, 0xb1, 0xc5, 0xb1, 0x6e, 0xba, 0xeb, 0x12, 0xdd, 0x09, 0x41, 0x72, 0x07,
-	0x5a, 0x71, 0x4c, 0x31, 0xb7, 0xa4, 0x1a, 0x74, 0x64, 0x8a, 0x06, 0x60, 0x59, 0x69, 0x70, 0x3c,
-	0xe2, 0xd4, 0xb7, 0xc3, 0x7a, 0x7c, 0x9d, 0x87, 0x66, 0x02, 0xd4, 0xd5, 0x38, 0xf7, 0xcd, 0x74,
-	0x13, 0x1a, 0x48, 0x1c, 0x73, 0xd7, 0x65, 0x63, 0xa9, 0xc1, 0x02, 0x5d, 0x98, 0xba, 0xc4, 0x77,
-	0x63, 0x98, 0xdc, 0x86, 0xe6, 0x88, 0x73, 0x11, 0x08, 0x9f, 0x7a, 0xc3, 0xf0, 0x24, 0xe5, 0xf1,
-	0xd0, 0x37, 0x22, 0x83, 0x3e, 0x48, 0xd2, 0x2f, 0x6a, 0x20, 0x97, 0x4e, 0x23, 0x6e, 0x01, 0xb9,
-	0xf5, 0x10, 0x4f, 0x50, 0xd9, 0xbb, 0x0c, 0xb5, 0xa8, 0xa8, 0x21, 0x1e, 0x52, 0xef, 0xe1, 0x4e,
-	0x16, 0x01, 0xd6, 0xa8, 0xd2, 0xdf, 0x4c, 0x08, 0x93, 0x25, 0x7b, 0xc2, 0x52, 0x64, 0xf2, 0x57,
-	0x28, 0xa9, 0xdb, 0xae, 0x7d, 0x11, 0x87, 0xfd, 0xae, 0xa7, 0xf4, 0x65, 0x2f, 0xd4, 0x97, 0xbd,
-	0x3d, 0xad, 0x3f, 0x2d, 0x4d, 0x24, 0x0f, 0xa0, 0x82, 0x4a, 0xcc, 0x73, 0xdc, 0x09, 0xb3, 0xdb,
-	0x6b, 0x38, 0xae, 0xb3, 0x30, 0xee, 0x55, 0xa8, 0x4b, 0x2d, 0x90, 0xf4, 0x03, 0x64, 0x93, 0x87,
-	0x50, 0xc5, 0xc1, 0x6f, 0xe6, 0xcc, 0x77, 0x98, 0xdd, 0x2e, 0x9f, 0x39, 0x1a, 0x27, 0x7b, 0xa9,
-	0xe8, 0xe6, 0x27, 0x06, 0x6c, 0x68, 0x6d, 0xf5, 0x94, 0xd1, 0xa9, 0x38, 0x0e, 0xcf, 0xf9, 0x65,
-	0x28, 0x8d, 0xf0, 0xc6, 0xd0, 0x82, 0x54, 0x7f, 0xc9, 0xed, 0xc6, 0xdc, 0xb1, 0x7f, 0xea, 0x09,
-	0x66, 0x0f, 0x51, 0xb0, 0xe2, 0x41, 0xb7, 0xd6, 0x23, 0xf4, 0x40, 0x2a, 0xd7, 0x3f, 0x40, 0xa8,
-	0x47, 0x87, 0x8e, 0x6b, 0xb3, 0x77, 0x7a, 0x6b, 0x57, 0x35, 0x38, 0x90, 0x98, 0x3c, 0x46, 0x9e,
-	0xcf, 0xff, 0xc7, 0xc6, 0x42, 0xee, 0x9d, 0x02, 0xfa, 0x29, 0x6b, 0x64, 0x60, 0x9b, 0xfb, 0xb0,
-	0x9e, 0x0a, 0x4d, 0x1e, 0x17, 0xee, 0x4e, 0x1d, 0x97, 0x0d, 0xc3, 0x73, 0x2c, 0x45, 0x6d, 0x45,
-	0x61, 0x78, 0xd6, 0xe5, 0x15, 0xa1, 0xa7, 0xd0, 0x71, 0x85, 0x9f, 0xe6, 0x07, 0x06, 0x5c, 0xca,
-	0x64, 0xaa, 0xf7, 0xef, 0x5d, 0x28, 0x1d, 0x23, 0xa2, 0x2f, 0x8a, 0x76, 0x72, 0xa5, 0x53, 0x23,
-	0x34, 0x8f, 0x3c, 0x00, 0xf0, 0x99, 0x3d, 0x77, 0x6d, 0xea, 0x8e, 0x4f, 0x75, 0xe7, 0xbd, 0x9a,
-	0xd0, 0xe4, 0x56, 0x64, 0x3c, 0x1c, 0x1f, 0xb3, 0x19, 0xb3, 0x12, 0x74, 0xf3, 0x7b, 0x03, 0x5a,
-	0x2f, 0x46, 0x32, 0xc7, 0x74, 0xc5, 0x17, 0x2b, 0x6b, 0x2c, 0xab, 0x6c, 0xbc, 0x30, 0xb9, 0xd4,
-	0xc2, 0xa4, 0x8b, 0x99, 0xcf, 0x14, 0x53, 0x8a, 0x3e, 0x6c, 0xbd, 0x43, 0x7a, 0x24, 0x98, 0x3f,
-	0x0c, 0x8b, 0xa4, 0xe5, 0x3e, 0x9a, 0x1e, 0x49, 0x4b, 0xf8, 0x1c, 0xf9, 0x0b, 0x10, 0xe6, 0xda,
-	0xc3, 0x11, 0x3b, 0xe2, 0x3e, 0x8b, 0xe8, 0xaa, 0xb5, 0x34, 0x98, 0x6b, 0xef, 0xa0, 0x21, 0x64,
-	0x47, 0xfd, 0xbc, 0x94, 0x78, 0x01, 0x99, 0x1f, 0x19, 0xb0, 0x91, 0xce, 0x54, 0x57, 0xfc, 0xde,
-	0x82, 0xec, 0x5f, 0x5d, 0xf3, 0x88, 0xf9, 0x8b, 0xaa, 0xde, 0xff, 0x32, 0x0f, 0xd5, 0x67, 0xd4,
-	0x1e, 0x84, 0xb3, 0x90, 0x01, 0x40, 0xac, 0x1e, 0xc9, 0xb5, 0xc4, 0xfc, 0x0b, 0xa2, 0xb2, 0x73,
-	0x7d, 0x85, 0x55, 0xa7, 0xb3, 0x0b, 0x6b, 0xa1, 0xc0, 0x21, 0x9d, 0x04, 0x35, 0x23, 0xa1, 0x3a,
-	0x57, 0x97, 0xda, 0xb4, 0x93, 0x01, 0x40, 0x2c, 0x61, 0x52, 0xf1, 0x2c, 0x08, 0xa3, 0x54, 0x3c,
-	0x4b, 0x74, 0xcf, 0x2e, 0xac, 0x85, 0x72, 0x22, 0x15, 0x4f, 0x46, 0xc4, 0xa4, 0xe2, 0x59, 0xd0,
-	0x1f, 0xbb, 0xb0, 0x16, 0x5e, 0xc6, 0x29, 0x27, 0x19, 0x41, 0x90, 0x72, 0xb2, 0x70, 0x7b, 0x3f,
-	0x86, 0x72, 0x74, 0x0f, 0x93, 0x24, 0x33, 0x7b, 0x63, 0x77, 0xae, 0x2d, 0x37, 0x2a, 0x3f, 0xfd,
-	0xcf, 0x73, 0xd0, 0x78, 0xf1, 0x96, 0xf9, 0x53, 0x7a, 0xfa, 0x9b, 0xac, 0xe0, 0xaf, 0x14, 0xa7,
-	0x2c, 0x5a, 0xf8, 0xae, 0x4c, 0x15, 0x2d, 0xf3, 0x52, 0x4d, 0x15, 0x6d, 0xe1, 0x21, 0xba, 0x0f,
-	0x95, 0xc4, 0xd3, 0x88, 0xa4, 0x42, 0x5f, 0x78, 0x17, 0x76, 0x36, 0x57, 0x99, 0x75, 0xe9, 0x3e,
-	0x33, 0xa0, 0x85, 0x4f, 0xfe, 0x43, 0xc1, 0x7d, 0x16, 0x57, 0x6f, 0x07, 0x8a, 0xca, 0xff, 0x95,
-	0xcc, 0xc5, 0xb6, 0xd4, 0xf3, 0x92, 0x1b, 0xcf, 0xbc, 0x40, 0x9e, 0x42, 0x39, 0x92, 0x03, 0xe9,
-	0xb2, 0x65, 0x94, 0x43, 0xba, 0x6c, 0x59, 0x05, 0x61, 0x5e, 0xe8, 0x7f, 0x68, 0xc0, 0x46, 0xe2,
-	0xb9, 0x1f, 0x87, 0xe9, 0xc1, 0x95, 0x15, 0x7f, 0x22, 0x90, 0x9b, 0xc9, 0x53, 0xf0, 0x93, 0xff,
-	0xd0, 0x74, 0x6e, 0x9d, 0x87, 0xaa, 0x0b, 0xf6, 0x85, 0x01, 0x75, 0xd5, 0x7b, 0xe2, 0x28, 0x5e,
-	0x42, 0x35, 0xd9, 0xc8, 0x48, 0xb2, 0x34, 0x4b, 0x7a, 0x79, 0xa7, 0xbb, 0xd2, 0x1e, 0xd5, 0xee,
-	0x55, 0xf6, 0x76, 0xeb, 0xae, 0x6c, 0x81, 0xda, 0xe9, 0xd6, 0x6a, 0x42, 0xe8, 0x75, 0xa7, 0xf0,
-	0x9f, 0x9c, 0x37, 0x1a, 0x95, 0xf0, 0xda, 0xff, 0xdb, 0x8f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9c,
-	0x24, 0x48, 0x7d, 0x41, 0x13, 0x00, 0x00,
+	// 1778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x72, 0x1b, 0x4b,
+	0x11, 0x3e, 0x2b, 0xc9, 0x8a, 0xd5, 0x92, 0xf5, 0x33, 0xb2, 0x1d, 0x21, 0xff, 0xc8, 0x2c, 0x3f,
+	0xc7, 0xc9, 0xa1, 0x94, 0x20, 0x0c, 0x55, 0x87, 0x54, 0xa8, 0xb2, 0x6c, 0x92, 0xa8, 0x62, 0x12,
+	0x67, 0x1d, 0x6e, 0xa8, 0x14, 0x5b, 0x23, 0xed, 0x58, 0x5e, 0x2c, 0xed, 0x6e, 0x76, 0x67, 0x43,
+	0xfc, 0x02, 0x14, 0xf0, 0x00, 0x54, 0x71, 0xcd, 0x4b, 0x50, 0xf0, 0x02, 0xdc, 0x71, 0xcf, 0x45,
+	0x6e, 0xa8, 0x82, 0x67, 0xe0, 0x8e, 0x9a, 0x9e, 0xd9, 0x5f, 0x49, 0xd8, 0x05, 0x9c, 0x3b, 0x6d,
+	0x7f, 0x5f, 0xf7, 0x74, 0xf7, 0xf4, 0xf4, 0xf4, 0x08, 0x1a, 0xb6, 0x13, 0x78, 0x6c, 0xc2, 0x5d,
+	0xbf, 0xef, 0xf9, 0x2e, 0x77, 0x49, 0x25, 0x16, 0x74, 0x61, 0xea, 0x4e, 0x5d, 0x29, 0xee, 0x82,
+	0xe3, 0x5a, 0x4c, 0xfd, 0x6e, 0x78, 0xae, 0xed, 0x70, 0xe6, 0x5b, 0x63, 0x25, 0xd8, 0x9f, 0xba,
+	0xee, 0x74, 0xc6, 0x1e, 0xe1, 0xd7, 0x38, 0xbc, 0x7c, 0x64, 0x85, 0x3e, 0xe5, 0xb6, 0xeb, 0x28,
+	0xbc, 0x97, 0xc7, 0xb9, 0x3d, 0x67, 0x01, 0xa7, 0x73, 0x4f, 0x12, 0xf4, 0x57, 0xb0, 0x7f, 0x66,
+	0x07, 0x7c, 0xe4, 0xfb, 0xcc, 0xa3, 0x3e, 0x1d, 0xcf, 0xd8, 0x05, 0x9b, 0xce, 0x99, 0xc3, 0x03,
+	0x83, 0xbd, 0x0f, 0x59, 0xc0, 0xc9, 0x26, 0xac, 0xcd, 0xec, 0xb9, 0xcd, 0x3b, 0xda, 0x81, 0x76,
+	0xb8, 0x66, 0xc8, 0x0f, 0xb2, 0x0d, 0x65, 0xf7, 0xf2, 0x32, 0x60, 0xbc, 0x53, 0x40, 0xb1, 0xfa,
+	0xd2, 0xff, 0xa1, 0x01, 0x59, 0x34, 0x46, 0x08, 0x94, 0x3c, 0xca, 0xaf, 0xd0, 0x46, 0xcd, 0xc0,
+	0xdf, 0xe4, 0x4b, 0xa8, 0x07, 0x12, 0x36, 0x2d, 0xc6, 0xa9, 0x3d, 0x43, 0x53, 0xd5, 0x01, 0xe9,
+	0x27, 0x51, 0x9e, 0xcb, 0x5f, 0xc6, 0x86, 0x62, 0x9e, 0x22, 0x91, 0xf4, 0xa0, 0x3a, 0x73, 0x03,
+	0x6e, 0x7a, 0x36, 0x9b, 0xb0, 0xa0, 0x53, 0x44, 0x17, 0x40, 0x88, 0xce, 0x51, 0x42, 0xfa, 0xd0,
+	0x9e, 0xd1, 0x80, 0x9b, 0xc2, 0x11, 0xdb, 0x37, 0x29, 0xe7, 0x6c, 0xee, 0xf1, 0x4e, 0xe9, 0x40,
+	0x3b, 0x2c, 0x1a, 0x2d, 0x01, 0x19, 0x88, 0x1c, 0x4b, 0x80, 0x3c, 0x86, 0xcd, 0x2c, 0xd5, 0x9c,
+	0xb8, 0xa1, 0xc3, 0x3b, 0x6b, 0xa8, 0x40, 0xfc, 0x34, 0xf9, 0x44, 0x20, 0xfa, 0x3b, 0xe8, 0xad,
+	0x4c, 0x5c, 0xe0, 0xb9, 0x4e, 0xc0, 0xc8, 0x97, 0xb0, 0xae, 0xdc, 0x0e, 0x3a, 0xda, 0x41, 0xf1,
+	0xb0, 0x3a, 0xd8, 0xeb, 0x27, 0x9b, 0xbe, 0xa8, 0x69, 0xc4, 0x74, 0xfd, 0x87, 0xd0, 0x78, 0xce,
+	0xf8, 0x05, 0xa7, 0xc9, 0x3e, 0x7c, 0x0e, 0xf7, 0x44, 0x25, 0x98, 0xb6, 0x25, 0xb3, 0x38, 0xac,
+	0xff, 0xe5, 0x53, 0xef, 0xb3, 0xbf, 0x7d, 0xea, 0x95, 0x5f, 0xb9, 0x16, 0x1b, 0x9d, 0x1a, 0x65,
+	0x01, 0x8f, 0x2c, 0xfd, 0xb7, 0x25, 0x68, 0x26, 0xca, 0xca, 0x97, 0x1e, 0x54, 0x69, 0x68, 0xd9,
+	0x51, 0x5c, 0x1a, 0xc6, 0x05, 0x28, 0xc2, 0x78, 0x12, 0x02, 0xd6, 0x0f, 0x6e, 0x85, 0xa6, 0x08,
+	0x86, 0x90, 0x90, 0xaf, 0x43, 0x2d, 0xf4, 0x44, 0xf9, 0x28, 0x13, 0x45, 0x34, 0x51, 0x95, 0x32,
+	0x69, 0x23, 0xa1, 0x48, 0x23, 0x25, 0x34, 0xa2, 0x28, 0xd2, 0xca, 0x11, 0x6c, 0xab, 0x65, 0x98,
+	0x17, 0x72, 0x21, 0x72, 0x4c, 0x3a, 0xf3, 0xae, 0x28, 0xa6, 0x5a, 0x33, 0x36, 0xe5, 0x8a, 0x31,
+	0x78, 0x2c, 0x30, 0x32, 0x80, 0xad, 0x05, 0xad, 0x31, 0xe3, 0xb4, 0x53, 0x46, 0xa5, 0x76, 0x4e,
+	0x69, 0xc8, 0x38, 0x25, 0x0f, 0xa0, 0x99, 0xd7, 0xe9, 0xdc, 0x43, 0x7a, 0x23, 0x47, 0x27, 0x3f,
+	0x80, 0xfb, 0x91, 0xdf, 0x79, 0xaf, 0xd6, 0x51, 0x63, 0x4b, 0x85, 0x90, 0x73, 0xeb, 0x08, 0xb6,
+	0x17, 0xf5, 0xd0, 0xaf, 0x8a, 0x0c, 0x26, 0xaf, 0x86, 0x8e, 0x7d, 0x01, 0xad, 0x05, 0xad, 0x0e,
+	0xa0, 0x42, 0x33, 0xaf, 0x40, 0x7e, 0x04, 0x35, 0xcb, 0x0e, 0xde, 0x87, 0x74, 0x66, 0x5f, 0xda,
+	0xcc, 0xea, 0x54, 0xf1, 0x88, 0x74, 0xfb, 0xf2, 0x5c, 0xf7, 0xa3, 0x73, 0xdd, 0x7f, 0x1b, 0x9d,
+	0x6b, 0x23, 0xc3, 0xd7, 0xff, 0xae, 0x01, 0x39, 0xf1, 0x19, 0xe5, 0xec, 0xbf, 0x2a, 0xa6, 0x7c,
+	0xdd, 0x14, 0x16, 0xea, 0xa6, 0x0f, 0x32, 0xfb, 0x66, 0x10, 0x4e, 0x26, 0x2c, 0x08, 0x32, 0xd5,
+	0xd1, 0x42, 0xe8, 0x42, 0x22, 0xf9, 0x1a, 0x91, 0xc4, 0xd2, 0x62, 0x19, 0x3d, 0x06, 0x95, 0xb8,
+	0x9c, 0x4d, 0x75, 0x18, 0x25, 0x96, 0x36, 0xaa, 0x6f, 0x41, 0x3b, 0x13, 0xa4, 0x2c, 0x7a, 0xfd,
+	0x21, 0x10, 0xc4, 0x45, 0x4c, 0xb1, 0x54, 0x34, 0xb4, 0xf4, 0x21, 0x90, 0x1f, 0x7a, 0x1b, 0x5a,
+	0x69, 0x2e, 0xa6, 0x49, 0x08, 0x9f, 0x33, 0x3e, 0x0c, 0x27, 0xd7, 0x2c, 0xce, 0x9d, 0xfe, 0x02,
+	0x48, 0x5a, 0x98, 0x58, 0xe5, 0x2e, 0xa7, 0xb3, 0xc8, 0x2a, 0x7e, 0x90, 0x5d, 0x28, 0xda, 0x56,
+	0xd0, 0x29, 0x1c, 0x14, 0x0f, 0x6b, 0x43, 0x48, 0xe5, 0x57, 0x88, 0xf5, 0x01, 0x34, 0x63, 0x4b,
+	0xd1, 0xce, 0xec, 0x43, 0x61, 0xe5, 0xa6, 0x14, 0x6c, 0x4b, 0xff, 0x69, 0xca, 0xa5, 0x78, 0xf1,
+	0x5b, 0x94, 0xc8, 0x01, 0xac, 0x89, 0xfd, 0x94, 0x8e, 0x54, 0x07, 0xd0, 0x17, 0x5f, 0x7d, 0x41,
+	0x30, 0x24, 0xa0, 0x3f, 0x84, 0xb2, 0xb4, 0x79, 0x07, 0x6e, 0x1f, 0x40, 0x72, 0x45, 0x03, 0x4c,
+	0xf8, 0xda, 0x2a, 0xfe, 0x4b, 0x68, 0x9c, 0xdb, 0xce, 0x14, 0x45, 0x77, 0x8b, 0x92, 0x74, 0xe0,
+	0x1e, 0xb5, 0x2c, 0x9f, 0x05, 0x01, 0x96, 0x5c, 0xc5, 0x88, 0x3e, 0x75, 0x1d, 0x9a, 0x89, 0x31,
+	0x15, 0x7e, 0x1d, 0x0a, 0xee, 0x35, 0x5a, 0x5b, 0x37, 0x0a, 0xee, 0xb5, 0xfe, 0x14, 0x5a, 0x67,
+	0xae, 0x7b, 0x1d, 0x7a, 0xe9, 0x25, 0xeb, 0xf1, 0x92, 0x95, 0x5b, 0x96, 0x78, 0x07, 0x24, 0xad,
+	0x1e, 0xe7, 0xb8, 0x24, 0xc2, 0x41, 0x0b, 0xd9, 0x30, 0x51, 0x4e, 0xbe, 0x0d, 0xa5, 0xb9, 0x38,
+	0xfa, 0xd1, 0x25, 0x16, 0xe3, 0x3f, 0x61, 0x9c, 0x5a, 0x94, 0x53, 0x03, 0x71, 0xfd, 0xe7, 0xd0,
+	0xc0, 0x40, 0x9d, 0x4b, 0xf7, 0xae, 0xd9, 0xf8, 0x22, 0xeb, 0x6a, 0x75, 0xd0, 0x4a, 0xac, 0x1f,
+	0x4b, 0x20, 0xf1, 0xfe, 0x77, 0x1a, 0x34, 0x93, 0x05, 0x94, 0xf3, 0x3a, 0x94, 0xf8, 0x8d, 0x27,
+	0x9d, 0xaf, 0x0f, 0xea, 0x89, 0xfa, 0xdb, 0x1b, 0x8f, 0x19, 0x88, 0x91, 0x3e, 0xac, 0xbb, 0x1e,
+	0xf3, 0x29, 0x77, 0xfd, 0xc5, 0x20, 0x5e, 0x2b, 0xc4, 0x88, 0x39, 0x82, 0x3f, 0xa1, 0x1e, 0x9d,
+	0xd8, 0xfc, 0xa6, 0x53, 0xcc, 0xf3, 0x4f, 0x14, 0x62, 0xc4, 0x1c, 0x7d, 0x0e, 0x8d, 0x67, 0xb6,
+	0x63, 0xbd, 0x62, 0xd4, 0xbf, 0x6b, 0xe0, 0xdf, 0x84, 0xb5, 0x80, 0x53, 0x5f, 0xf6, 0x9d, 0x45,
+	0x8a, 0x04, 0x93, 0x09, 0x45, 0x36, 0x1d, 0xf9, 0xa1, 0x1f, 0x41, 0x33, 0x59, 0x4e, 0xa5, 0xe1,
+	0xf6, 0xda, 0x26, 0xd0, 0x3c, 0x0d, 0xe7, 0x5e, 0xa6, 0x0b, 0x7c, 0x1f, 0x5a, 0x29, 0x59, 0xde,
+	0xd4, 0xca, 0xb2, 0xaf, 0x43, 0x2d, 0xdd, 0x73, 0xf5, 0x7f, 0x69, 0xd0, 0x16, 0x82, 0x8b, 0x70,
+	0x3e, 0xa7, 0xfe, 0x4d, 0x6c, 0x69, 0x0f, 0x20, 0x0c, 0x98, 0x65, 0x06, 0x1e, 0x9d, 0x30, 0xd5,
+	0x3e, 0x2a, 0x42, 0x72, 0x21, 0x04, 0xe4, 0x73, 0x68, 0xd0, 0x0f, 0xd4, 0x9e, 0x89, 0x41, 0x41,
+	0x71, 0x64, 0x17, 0xae, 0xc7, 0x62, 0x49, 0x14, 0x9d, 0x55, 0xd8, 0xb1, 0x9d, 0x29, 0x96, 0x4a,
+	0x74, 0x41, 0x07, 0xcc, 0x1a, 0x49, 0x91, 0xe8, 0xe6, 0x48, 0x61, 0x92, 0x21, 0x7b, 0x2f, 0xae,
+	0xfe, 0x63, 0x49, 0xf8, 0x16, 0xd4, 0x91, 0x30, 0xa6, 0x8e, 0xf5, 0x4b, 0xdb, 0xe2, 0x57, 0xaa,
+	0xe9, 0x6e, 0x08, 0xe9, 0x30, 0x12, 0x92, 0x47, 0xd0, 0x4e, 0x7c, 0x4a, 0xb8, 0x65, 0xe4, 0x92,
+	0x18, 0x8a, 0x15, 0x30, 0xad, 0x34, 0xb8, 0x1a, 0xbb, 0xd4, 0xb7, 0xa2, 0x7c, 0xfc, 0xb5, 0x08,
+	0xad, 0x94, 0x50, 0x65, 0xe3, 0xce, 0x37, 0xd3, 0x03, 0x68, 0x22, 0x71, 0xe2, 0x3a, 0x0e, 0x9b,
+	0x88, 0xcb, 0x32, 0x50, 0x89, 0x69, 0x08, 0xf9, 0x49, 0x22, 0x16, 0x37, 0xee, 0xd8, 0x75, 0x79,
+	0xc0, 0x7d, 0xea, 0x99, 0xd1, 0x49, 0x2a, 0xe2, 0xa1, 0x6f, 0xc6, 0x80, 0x3a, 0x48, 0xc2, 0x2e,
+	0xce, 0x9c, 0x0e, 0x9d, 0xc5, 0xdc, 0x12, 0x72, 0x1b, 0x91, 0x3c, 0x45, 0x65, 0x1f, 0x73, 0xd4,
+	0x35, 0x49, 0x65, 0x1f, 0xb3, 0xd4, 0x23, 0xac, 0x64, 0x1e, 0x60, 0x8e, 0xaa, 0x83, 0xfd, 0xd4,
+	0x20, 0xb8, 0xa4, 0x26, 0x0c, 0x49, 0x26, 0xdf, 0x85, 0xb2, 0xbc, 0xed, 0x70, 0x72, 0xa9, 0x0e,
+	0xbe, 0xb6, 0x70, 0xef, 0x9f, 0xaa, 0x79, 0xdf, 0x50, 0x44, 0xf2, 0x04, 0xaa, 0x38, 0xf9, 0x7a,
+	0xb6, 0x33, 0x65, 0x56, 0x67, 0xfd, 0xd6, 0x79, 0x01, 0x04, 0xfd, 0x1c, 0xd9, 0xe4, 0x29, 0xd4,
+	0x50, 0xf9, 0x7d, 0xc8, 0x7c, 0x31, 0x6d, 0x54, 0x6e, 0xd5, 0xc6, 0xc5, 0xde, 0x48, 0xba, 0xfe,
+	0x7b, 0x0d, 0x36, 0xd5, 0x2c, 0xfb, 0x82, 0xd1, 0x19, 0xbf, 0x8a, 0xce, 0xf9, 0x36, 0x94, 0xc7,
+	0x78, 0x63, 0xa8, 0x07, 0x80, 0xfa, 0x12, 0xe5, 0xc6, 0x9c, 0x89, 0x7f, 0xe3, 0x71, 0x66, 0x99,
+	0xf8, 0x40, 0xc0, 0x83, 0x6e, 0x6c, 0xc4, 0xd2, 0x73, 0xf1, 0x52, 0xf8, 0x06, 0x44, 0xf3, 0xbf,
+	0x69, 0x3b, 0x16, 0xfb, 0xa8, 0x4a, 0xbb, 0xa6, 0x84, 0x23, 0x21, 0x13, 0xc7, 0xc8, 0xf3, 0xdd,
+	0x5f, 0xb0, 0x09, 0x17, 0xb5, 0x53, 0x42, 0x3b, 0x15, 0x25, 0x19, 0x59, 0xfa, 0x19, 0x6c, 0x64,
+	0x5c, 0x13, 0xc7, 0xc5, 0x75, 0x66, 0xb6, 0xc3, 0xcc, 0xe8, 0x1c, 0x8b, 0x47, 0x44, 0x55, 0xca,
+	0xf0, 0xac, 0x8b, 0x2b, 0x42, 0x2d, 0xa1, 0xfc, 0x8a, 0x3e, 0xf5, 0x5f, 0x69, 0xb0, 0x95, 0x8b,
+	0x54, 0xd5, 0xef, 0x63, 0x28, 0x5f, 0xa1, 0x44, 0x5d, 0x14, 0x9d, 0xf4, 0x4e, 0x67, 0x34, 0x14,
+	0x8f, 0x3c, 0x01, 0xf0, 0x99, 0x15, 0x3a, 0x16, 0x75, 0x26, 0x37, 0xaa, 0xf3, 0xee, 0xa4, 0xde,
+	0x40, 0x46, 0x0c, 0x5e, 0x4c, 0xae, 0xd8, 0x9c, 0x19, 0x29, 0xba, 0xfe, 0x4f, 0x0d, 0xda, 0xaf,
+	0xc7, 0x22, 0xc6, 0x6c, 0xc6, 0x17, 0x33, 0xab, 0x2d, 0xcb, 0x6c, 0xb2, 0x31, 0x85, 0xcc, 0xc6,
+	0x64, 0x93, 0x59, 0xcc, 0x25, 0x53, 0x0c, 0x7d, 0xd8, 0x7a, 0x4d, 0x7a, 0xc9, 0x99, 0x6f, 0x46,
+	0x49, 0x52, 0xcf, 0x2b, 0x84, 0x8e, 0x05, 0x12, 0x3d, 0xff, 0xbe, 0x03, 0x84, 0x39, 0x96, 0x39,
+	0x66, 0x97, 0xae, 0xcf, 0x62, 0xba, 0x6c, 0x2d, 0x4d, 0xe6, 0x58, 0x43, 0x04, 0x22, 0x76, 0xdc,
+	0xcf, 0xcb, 0xa9, 0x17, 0xa7, 0xfe, 0x1b, 0x0d, 0x36, 0xb3, 0x91, 0xaa, 0x8c, 0x1f, 0x2d, 0x3c,
+	0xb3, 0x56, 0xe7, 0x3c, 0x66, 0xfe, 0x4f, 0x59, 0x1f, 0xfc, 0xb9, 0x08, 0xb5, 0x97, 0xd4, 0x1a,
+	0x45, 0xab, 0x90, 0x11, 0x40, 0x32, 0x3d, 0x92, 0xdd, 0xd4, 0xfa, 0x0b, 0x43, 0x65, 0x77, 0x6f,
+	0x05, 0xaa, 0xc2, 0x39, 0x81, 0xf5, 0x68, 0xc0, 0x21, 0xdd, 0x14, 0x35, 0x37, 0x42, 0x75, 0x77,
+	0x96, 0x62, 0xca, 0xc8, 0x08, 0x20, 0x19, 0x61, 0x32, 0xfe, 0x2c, 0x0c, 0x46, 0xdd, 0xbd, 0x15,
+	0x68, 0xe2, 0x4f, 0x34, 0x4e, 0x64, 0xfc, 0xc9, 0x0d, 0x31, 0xdd, 0x9d, 0xa5, 0x58, 0x62, 0x24,
+	0xba, 0x8c, 0x33, 0x46, 0x72, 0x03, 0x41, 0x77, 0x67, 0x29, 0xa6, 0x8c, 0x3c, 0x83, 0x4a, 0x7c,
+	0x0f, 0x93, 0x34, 0x33, 0x7f, 0x63, 0x77, 0x77, 0x97, 0x83, 0xd2, 0xce, 0xe0, 0x8f, 0x05, 0x68,
+	0xbe, 0xfe, 0xc0, 0xfc, 0x19, 0xbd, 0xf9, 0x4a, 0x76, 0xf0, 0xff, 0xe4, 0xa7, 0x48, 0x5a, 0xf4,
+	0x8e, 0xcf, 0x24, 0x2d, 0xf7, 0xcf, 0x40, 0x77, 0x67, 0x29, 0xa6, 0x8c, 0x9c, 0x41, 0x35, 0xf5,
+	0x34, 0x22, 0x19, 0xd7, 0x17, 0xde, 0x85, 0xdd, 0xfd, 0x55, 0xb0, 0x4a, 0xdd, 0x1f, 0x34, 0x68,
+	0xe3, 0x5f, 0x2c, 0x17, 0xdc, 0xf5, 0x59, 0x92, 0xbd, 0x21, 0xac, 0x49, 0xfb, 0xf7, 0x73, 0x17,
+	0xdb, 0x52, 0xcb, 0x4b, 0x6e, 0x3c, 0xfd, 0x33, 0xf2, 0x02, 0x2a, 0xf1, 0x38, 0x90, 0x4d, 0x5b,
+	0x6e, 0x72, 0xe8, 0xee, 0x2e, 0x07, 0x23, 0x4b, 0x83, 0x5f, 0x6b, 0xb0, 0x99, 0xfa, 0x7b, 0x25,
+	0x71, 0xd3, 0x83, 0xfb, 0x2b, 0xfe, 0xb4, 0x21, 0x0f, 0xd2, 0xa7, 0xe0, 0x3f, 0xfe, 0x23, 0xd6,
+	0x7d, 0x78, 0x17, 0xaa, 0x4a, 0xd8, 0x9f, 0x34, 0x68, 0xc8, 0xde, 0x93, 0x78, 0xf1, 0x06, 0x6a,
+	0xe9, 0x46, 0x46, 0xd2, 0xa9, 0x59, 0xd2, 0xcb, 0xbb, 0xbd, 0x95, 0x78, 0x9c, 0xbb, 0xb7, 0xf9,
+	0xdb, 0xad, 0xb7, 0xb2, 0x05, 0x2a, 0xa3, 0x07, 0xab, 0x09, 0x91, 0xd5, 0x61, 0xe9, 0x67, 0x05,
+	0x6f, 0x3c, 0x2e, 0xe3, 0xb5, 0xff, 0xbd, 0x7f, 0x0f, 0x00, 0xa1, 0x13, 0x26, 0x27, 0xb1, 0x14,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -0,0 +1,261 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: macaroon.proto
+
+package pb
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Macaroon is the serialized form of an API key, a chain of caveats authenticated by HMACs
+type Macaroon struct {
+	// head identifies the root key the chain is derived from
+	Head []byte `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	// caveats are the serialized caveats restricting the key
+	Caveats [][]byte `protobuf:"bytes,2,rep,name=caveats,proto3" json:"caveats,omitempty"`
+	// tail is the HMAC of the last caveat keyed with the tail before it
+	Tail                 []byte   `protobuf:"bytes,3,opt,name=tail,proto3" json:"tail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Macaroon) Reset()         { *m = Macaroon{} }
+func (m *Macaroon) String() string { return proto.CompactTextString(m) }
+func (*Macaroon) ProtoMessage()    {}
+func (*Macaroon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_546010ed3a9cf83d, []int{0}
+}
+func (m *Macaroon) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Macaroon.Unmarshal(m, b)
+}
+func (m *Macaroon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Macaroon.Marshal(b, m, deterministic)
+}
+func (m *Macaroon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Macaroon.Merge(m, src)
+}
+func (m *Macaroon) XXX_Size() int {
+	return xxx_messageInfo_Macaroon.Size(m)
+}
+func (m *Macaroon) XXX_DiscardUnknown() {
+	xxx_messageInfo_Macaroon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Macaroon proto.InternalMessageInfo
+
+func (m *Macaroon) GetHead() []byte {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *Macaroon) GetCaveats() [][]byte {
+	if m != nil {
+		return m.Caveats
+	}
+	return nil
+}
+
+func (m *Macaroon) GetTail() []byte {
+	if m != nil {
+		return m.Tail
+	}
+	return nil
+}
+
+// Caveat restricts the actions an API key allows
+type Caveat struct {
+	// disallow the given types of operations
+	DisallowReads   bool `protobuf:"varint,1,opt,name=disallow_reads,json=disallowReads,proto3" json:"disallow_reads,omitempty"`
+	DisallowWrites  bool `protobuf:"varint,2,opt,name=disallow_writes,json=disallowWrites,proto3" json:"disallow_writes,omitempty"`
+	DisallowLists   bool `protobuf:"varint,3,opt,name=disallow_lists,json=disallowLists,proto3" json:"disallow_lists,omitempty"`
+	DisallowDeletes bool `protobuf:"varint,4,opt,name=disallow_deletes,json=disallowDeletes,proto3" json:"disallow_deletes,omitempty"`
+	// when not empty, restricts the actions to the given paths
+	AllowedPaths []*Caveat_Path `protobuf:"bytes,10,rep,name=allowed_paths,json=allowedPaths,proto3" json:"allowed_paths,omitempty"`
+	// the time window the key is valid in
+	NotAfter  *timestamp.Timestamp `protobuf:"bytes,20,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	NotBefore *timestamp.Timestamp `protobuf:"bytes,21,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	// nonce makes otherwise equal caveats distinct, e.g. to revoke them independently
+	Nonce                []byte   `protobuf:"bytes,30,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Caveat) Reset()         { *m = Caveat{} }
+func (m *Caveat) String() string { return proto.CompactTextString(m) }
+func (*Caveat) ProtoMessage()    {}
+func (*Caveat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_546010ed3a9cf83d, []int{1}
+}
+func (m *Caveat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Caveat.Unmarshal(m, b)
+}
+func (m *Caveat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Caveat.Marshal(b, m, deterministic)
+}
+func (m *Caveat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Caveat.Merge(m, src)
+}
+func (m *Caveat) XXX_Size() int {
+	return xxx_messageInfo_Caveat.Size(m)
+}
+func (m *Caveat) XXX_DiscardUnknown() {
+	xxx_messageInfo_Caveat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Caveat proto.InternalMessageInfo
+
+func (m *Caveat) GetDisallowReads() bool {
+	if m != nil {
+		return m.DisallowReads
+	}
+	return false
+}
+
+func (m *Caveat) GetDisallowWrites() bool {
+	if m != nil {
+		return m.DisallowWrites
+	}
+	return false
+}
+
+func (m *Caveat) GetDisallowLists() bool {
+	if m != nil {
+		return m.DisallowLists
+	}
+	return false
+}
+
+func (m *Caveat) GetDisallowDeletes() bool {
+	if m != nil {
+		return m.DisallowDeletes
+	}
+	return false
+}
+
+func (m *Caveat) GetAllowedPaths() []*Caveat_Path {
+	if m != nil {
+		return m.AllowedPaths
+	}
+	return nil
+}
+
+func (m *Caveat) GetNotAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.NotAfter
+	}
+	return nil
+}
+
+func (m *Caveat) GetNotBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *Caveat) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+// Path is a bucket and an encrypted path prefix in it
+type Caveat_Path struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPathPrefix  []byte   `protobuf:"bytes,2,opt,name=encrypted_path_prefix,json=encryptedPathPrefix,proto3" json:"encrypted_path_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Caveat_Path) Reset()         { *m = Caveat_Path{} }
+func (m *Caveat_Path) String() string { return proto.CompactTextString(m) }
+func (*Caveat_Path) ProtoMessage()    {}
+func (*Caveat_Path) Descriptor() ([]byte, []int) {
+	return fileDescriptor_546010ed3a9cf83d, []int{1, 0}
+}
+func (m *Caveat_Path) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Caveat_Path.Unmarshal(m, b)
+}
+func (m *Caveat_Path) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Caveat_Path.Marshal(b, m, deterministic)
+}
+func (m *Caveat_Path) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Caveat_Path.Merge(m, src)
+}
+func (m *Caveat_Path) XXX_Size() int {
+	return xxx_messageInfo_Caveat_Path.Size(m)
+}
+func (m *Caveat_Path) XXX_DiscardUnknown() {
+	xxx_messageInfo_Caveat_Path.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Caveat_Path proto.InternalMessageInfo
+
+func (m *Caveat_Path) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *Caveat_Path) GetEncryptedPathPrefix() []byte {
+	if m != nil {
+		return m.EncryptedPathPrefix
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Macaroon)(nil), "macaroon.Macaroon")
+	proto.RegisterType((*Caveat)(nil), "macaroon.Caveat")
+	proto.RegisterType((*Caveat_Path)(nil), "macaroon.Caveat.Path")
+}
+
+func init() { proto.RegisterFile("macaroon.proto", fileDescriptor_546010ed3a9cf83d) }
+
+var fileDescriptor_546010ed3a9cf83d = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcf, 0x6b, 0xdb, 0x30,
+	0x14, 0xc7, 0x49, 0xec, 0x65, 0xde, 0x8b, 0x93, 0x0d, 0x2d, 0x19, 0x22, 0x87, 0xcd, 0x04, 0xc6,
+	0xbc, 0x8b, 0x03, 0xd9, 0x61, 0x6c, 0xb7, 0xa6, 0x3d, 0xa6, 0x10, 0x44, 0xa1, 0xd0, 0x8b, 0x91,
+	0xed, 0xe7, 0xc4, 0xd4, 0xb1, 0x8c, 0xa4, 0x34, 0xed, 0xff, 0xd7, 0x3f, 0xac, 0x48, 0xfe, 0x01,
+	0x39, 0xf5, 0xa6, 0xef, 0xf7, 0x7d, 0xde, 0x7b, 0xd2, 0x57, 0x30, 0x3d, 0xf2, 0x94, 0x4b, 0x21,
+	0xaa, 0xa8, 0x96, 0x42, 0x0b, 0xe2, 0x75, 0x7a, 0xf1, 0x63, 0x2f, 0xc4, 0xbe, 0xc4, 0x95, 0xf5,
+	0x93, 0x53, 0xbe, 0xd2, 0xc5, 0x11, 0x95, 0xe6, 0xc7, 0xba, 0x41, 0x97, 0x5b, 0xf0, 0x6e, 0x5b,
+	0x98, 0x10, 0x70, 0x0f, 0xc8, 0x33, 0x3a, 0x08, 0x06, 0xa1, 0xcf, 0xec, 0x99, 0x50, 0xf8, 0x98,
+	0xf2, 0x27, 0xe4, 0x5a, 0xd1, 0x61, 0xe0, 0x84, 0x3e, 0xeb, 0xa4, 0xa1, 0x35, 0x2f, 0x4a, 0xea,
+	0x34, 0xb4, 0x39, 0x2f, 0x5f, 0x1d, 0x18, 0x5d, 0xdb, 0x3a, 0xf9, 0x09, 0xd3, 0xac, 0x50, 0xbc,
+	0x2c, 0xc5, 0x39, 0x96, 0xc8, 0x33, 0x65, 0xc7, 0x7a, 0x6c, 0xd2, 0xb9, 0xcc, 0x98, 0xe4, 0x17,
+	0x7c, 0xee, 0xb1, 0xb3, 0x2c, 0x34, 0x9a, 0x3d, 0x86, 0xeb, 0xbb, 0xef, 0xad, 0x7b, 0x31, 0xaf,
+	0x2c, 0x94, 0x56, 0xd4, 0xb9, 0x9c, 0xb7, 0x35, 0x26, 0xf9, 0x0d, 0x5f, 0x7a, 0x2c, 0xc3, 0x12,
+	0xcd, 0x40, 0xd7, 0x82, 0xfd, 0x9e, 0x9b, 0xc6, 0x26, 0xff, 0x61, 0x62, 0x35, 0x66, 0x71, 0xcd,
+	0xf5, 0x41, 0x51, 0x08, 0x9c, 0x70, 0xbc, 0x9e, 0x47, 0x7d, 0x9a, 0xcd, 0x53, 0xa2, 0x1d, 0xd7,
+	0x07, 0xe6, 0xb7, 0xac, 0x11, 0x8a, 0xfc, 0x85, 0x4f, 0x95, 0xd0, 0x31, 0xcf, 0x35, 0x4a, 0x3a,
+	0x0b, 0x06, 0xe1, 0x78, 0xbd, 0x88, 0x9a, 0xac, 0xa3, 0x2e, 0xeb, 0xe8, 0xae, 0xcb, 0x9a, 0x79,
+	0x95, 0xd0, 0x57, 0x86, 0x25, 0xff, 0x00, 0x4c, 0x63, 0x82, 0xb9, 0x90, 0x48, 0xe7, 0xef, 0x76,
+	0x9a, 0x35, 0x1b, 0x0b, 0x93, 0x19, 0x7c, 0xa8, 0x44, 0x95, 0x22, 0xfd, 0x6e, 0x13, 0x6f, 0xc4,
+	0x82, 0x81, 0x6b, 0xae, 0x44, 0xbe, 0xc1, 0x28, 0x39, 0xa5, 0x8f, 0xa8, 0xdb, 0xef, 0x6b, 0x15,
+	0x59, 0xc3, 0x1c, 0xab, 0x54, 0xbe, 0xd4, 0xba, 0x7d, 0x67, 0x5c, 0x4b, 0xcc, 0x8b, 0x67, 0x1b,
+	0xb3, 0xcf, 0xbe, 0xf6, 0x45, 0x33, 0x65, 0x67, 0x4b, 0x1b, 0xf7, 0x61, 0x58, 0x27, 0xc9, 0xc8,
+	0x5e, 0xe7, 0xcf, 0xdb, 0x00, 0x70, 0x65, 0x35, 0xcb, 0x5e, 0x02, 0x00, 0x00,
+}
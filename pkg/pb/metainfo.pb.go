@@ -765,6 +765,776 @@ func (m *ListSegmentsResponse_Item) GetIsPrefix() bool {
 	return false
 }
 
+type SegmentMoveRequest struct {
+	Bucket      []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path        []byte `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Segment     int64  `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	NewPath     []byte `protobuf:"bytes,4,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	NewSegment  int64  `protobuf:"varint,5,opt,name=new_segment,json=newSegment,proto3" json:"new_segment,omitempty"`
+	NewMetadata []byte `protobuf:"bytes,6,opt,name=new_metadata,json=newMetadata,proto3" json:"new_metadata,omitempty"`
+	// new_bucket is the bucket to move to, when different from bucket
+	NewBucket            []byte   `protobuf:"bytes,7,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentMoveRequest) Reset()         { *m = SegmentMoveRequest{} }
+func (m *SegmentMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentMoveRequest) ProtoMessage()    {}
+func (*SegmentMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{13}
+}
+func (m *SegmentMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentMoveRequest.Unmarshal(m, b)
+}
+func (m *SegmentMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentMoveRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentMoveRequest.Merge(m, src)
+}
+func (m *SegmentMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentMoveRequest.Size(m)
+}
+func (m *SegmentMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentMoveRequest proto.InternalMessageInfo
+
+func (m *SegmentMoveRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SegmentMoveRequest) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *SegmentMoveRequest) GetSegment() int64 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *SegmentMoveRequest) GetNewPath() []byte {
+	if m != nil {
+		return m.NewPath
+	}
+	return nil
+}
+
+func (m *SegmentMoveRequest) GetNewSegment() int64 {
+	if m != nil {
+		return m.NewSegment
+	}
+	return 0
+}
+
+func (m *SegmentMoveRequest) GetNewMetadata() []byte {
+	if m != nil {
+		return m.NewMetadata
+	}
+	return nil
+}
+
+func (m *SegmentMoveRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+type SegmentMoveResponse struct {
+	Pointer              *Pointer `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentMoveResponse) Reset()         { *m = SegmentMoveResponse{} }
+func (m *SegmentMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentMoveResponse) ProtoMessage()    {}
+func (*SegmentMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{14}
+}
+func (m *SegmentMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentMoveResponse.Unmarshal(m, b)
+}
+func (m *SegmentMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentMoveResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentMoveResponse.Merge(m, src)
+}
+func (m *SegmentMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentMoveResponse.Size(m)
+}
+func (m *SegmentMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentMoveResponse proto.InternalMessageInfo
+
+func (m *SegmentMoveResponse) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
+	}
+	return nil
+}
+
+type SegmentCopyRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path                 []byte   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Segment              int64    `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	NewBucket            []byte   `protobuf:"bytes,4,opt,name=new_bucket,json=newBucket,proto3" json:"new_bucket,omitempty"`
+	NewPath              []byte   `protobuf:"bytes,5,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	NewSegment           int64    `protobuf:"varint,6,opt,name=new_segment,json=newSegment,proto3" json:"new_segment,omitempty"`
+	NewMetadata          []byte   `protobuf:"bytes,7,opt,name=new_metadata,json=newMetadata,proto3" json:"new_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentCopyRequest) Reset()         { *m = SegmentCopyRequest{} }
+func (m *SegmentCopyRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentCopyRequest) ProtoMessage()    {}
+func (*SegmentCopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{15}
+}
+func (m *SegmentCopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentCopyRequest.Unmarshal(m, b)
+}
+func (m *SegmentCopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentCopyRequest.Marshal(b, m, deterministic)
+}
+func (m *SegmentCopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentCopyRequest.Merge(m, src)
+}
+func (m *SegmentCopyRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentCopyRequest.Size(m)
+}
+func (m *SegmentCopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentCopyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentCopyRequest proto.InternalMessageInfo
+
+func (m *SegmentCopyRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SegmentCopyRequest) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *SegmentCopyRequest) GetSegment() int64 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *SegmentCopyRequest) GetNewBucket() []byte {
+	if m != nil {
+		return m.NewBucket
+	}
+	return nil
+}
+
+func (m *SegmentCopyRequest) GetNewPath() []byte {
+	if m != nil {
+		return m.NewPath
+	}
+	return nil
+}
+
+func (m *SegmentCopyRequest) GetNewSegment() int64 {
+	if m != nil {
+		return m.NewSegment
+	}
+	return 0
+}
+
+func (m *SegmentCopyRequest) GetNewMetadata() []byte {
+	if m != nil {
+		return m.NewMetadata
+	}
+	return nil
+}
+
+type SegmentCopyResponse struct {
+	Pointer              *Pointer `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentCopyResponse) Reset()         { *m = SegmentCopyResponse{} }
+func (m *SegmentCopyResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentCopyResponse) ProtoMessage()    {}
+func (*SegmentCopyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{16}
+}
+func (m *SegmentCopyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentCopyResponse.Unmarshal(m, b)
+}
+func (m *SegmentCopyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentCopyResponse.Marshal(b, m, deterministic)
+}
+func (m *SegmentCopyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentCopyResponse.Merge(m, src)
+}
+func (m *SegmentCopyResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentCopyResponse.Size(m)
+}
+func (m *SegmentCopyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentCopyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentCopyResponse proto.InternalMessageInfo
+
+func (m *SegmentCopyResponse) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
+	}
+	return nil
+}
+
+// LifecycleRule describes when the objects of a bucket are removed automatically
+type LifecycleRule struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// prefix is the encrypted path prefix within the bucket the rule applies to,
+	// it is matched by whole path components
+	Prefix []byte `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// expiration_days is the age in days after which objects are deleted
+	ExpirationDays int32 `protobuf:"varint,3,opt,name=expiration_days,json=expirationDays,proto3" json:"expiration_days,omitempty"`
+	// abort_incomplete_upload_days is the age in days after which the segments
+	// of uploads that were never committed are deleted
+	AbortIncompleteUploadDays int32    `protobuf:"varint,4,opt,name=abort_incomplete_upload_days,json=abortIncompleteUploadDays,proto3" json:"abort_incomplete_upload_days,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{17}
+}
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleRule.Unmarshal(m, b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+}
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return xxx_messageInfo_LifecycleRule.Size(m)
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *LifecycleRule) GetExpirationDays() int32 {
+	if m != nil {
+		return m.ExpirationDays
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetAbortIncompleteUploadDays() int32 {
+	if m != nil {
+		return m.AbortIncompleteUploadDays
+	}
+	return 0
+}
+
+type BucketLifecycle struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BucketLifecycle) Reset()         { *m = BucketLifecycle{} }
+func (m *BucketLifecycle) String() string { return proto.CompactTextString(m) }
+func (*BucketLifecycle) ProtoMessage()    {}
+func (*BucketLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{18}
+}
+func (m *BucketLifecycle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketLifecycle.Unmarshal(m, b)
+}
+func (m *BucketLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketLifecycle.Marshal(b, m, deterministic)
+}
+func (m *BucketLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketLifecycle.Merge(m, src)
+}
+func (m *BucketLifecycle) XXX_Size() int {
+	return xxx_messageInfo_BucketLifecycle.Size(m)
+}
+func (m *BucketLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketLifecycle proto.InternalMessageInfo
+
+func (m *BucketLifecycle) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type SetBucketLifecycleRequest struct {
+	Bucket []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// an empty lifecycle removes the rules of the bucket
+	Lifecycle            *BucketLifecycle `protobuf:"bytes,2,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetBucketLifecycleRequest) Reset()         { *m = SetBucketLifecycleRequest{} }
+func (m *SetBucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleRequest) ProtoMessage()    {}
+func (*SetBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{19}
+}
+func (m *SetBucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleRequest.Merge(m, src)
+}
+func (m *SetBucketLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleRequest.Size(m)
+}
+func (m *SetBucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *SetBucketLifecycleRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetBucketLifecycleRequest) GetLifecycle() *BucketLifecycle {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return nil
+}
+
+type SetBucketLifecycleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketLifecycleResponse) Reset()         { *m = SetBucketLifecycleResponse{} }
+func (m *SetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleResponse) ProtoMessage()    {}
+func (*SetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{20}
+}
+func (m *SetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleResponse.Merge(m, src)
+}
+func (m *SetBucketLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleResponse.Size(m)
+}
+func (m *SetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleResponse proto.InternalMessageInfo
+
+type GetBucketLifecycleRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketLifecycleRequest) Reset()         { *m = GetBucketLifecycleRequest{} }
+func (m *GetBucketLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLifecycleRequest) ProtoMessage()    {}
+func (*GetBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{21}
+}
+func (m *GetBucketLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Unmarshal(m, b)
+}
+func (m *GetBucketLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLifecycleRequest.Merge(m, src)
+}
+func (m *GetBucketLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLifecycleRequest.Size(m)
+}
+func (m *GetBucketLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLifecycleRequest proto.InternalMessageInfo
+
+func (m *GetBucketLifecycleRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+type GetBucketLifecycleResponse struct {
+	Lifecycle            *BucketLifecycle `protobuf:"bytes,1,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetBucketLifecycleResponse) Reset()         { *m = GetBucketLifecycleResponse{} }
+func (m *GetBucketLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLifecycleResponse) ProtoMessage()    {}
+func (*GetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{22}
+}
+func (m *GetBucketLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Unmarshal(m, b)
+}
+func (m *GetBucketLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLifecycleResponse.Merge(m, src)
+}
+func (m *GetBucketLifecycleResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLifecycleResponse.Size(m)
+}
+func (m *GetBucketLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLifecycleResponse proto.InternalMessageInfo
+
+func (m *GetBucketLifecycleResponse) GetLifecycle() *BucketLifecycle {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return nil
+}
+
+// BucketPlacement restricts the nodes the pieces of a bucket are stored on
+type BucketPlacement struct {
+	// countries are the ISO 3166-1 alpha-2 codes of the countries of the nodes
+	Countries            []string `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketPlacement) Reset()         { *m = BucketPlacement{} }
+func (m *BucketPlacement) String() string { return proto.CompactTextString(m) }
+func (*BucketPlacement) ProtoMessage()    {}
+func (*BucketPlacement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{23}
+}
+func (m *BucketPlacement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketPlacement.Unmarshal(m, b)
+}
+func (m *BucketPlacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketPlacement.Marshal(b, m, deterministic)
+}
+func (m *BucketPlacement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketPlacement.Merge(m, src)
+}
+func (m *BucketPlacement) XXX_Size() int {
+	return xxx_messageInfo_BucketPlacement.Size(m)
+}
+func (m *BucketPlacement) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketPlacement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketPlacement proto.InternalMessageInfo
+
+func (m *BucketPlacement) GetCountries() []string {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+type SetBucketPlacementRequest struct {
+	Bucket []byte `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// an empty placement removes the constraints of the bucket
+	Placement            *BucketPlacement `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetBucketPlacementRequest) Reset()         { *m = SetBucketPlacementRequest{} }
+func (m *SetBucketPlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketPlacementRequest) ProtoMessage()    {}
+func (*SetBucketPlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{24}
+}
+func (m *SetBucketPlacementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketPlacementRequest.Unmarshal(m, b)
+}
+func (m *SetBucketPlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketPlacementRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketPlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketPlacementRequest.Merge(m, src)
+}
+func (m *SetBucketPlacementRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketPlacementRequest.Size(m)
+}
+func (m *SetBucketPlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketPlacementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketPlacementRequest proto.InternalMessageInfo
+
+func (m *SetBucketPlacementRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SetBucketPlacementRequest) GetPlacement() *BucketPlacement {
+	if m != nil {
+		return m.Placement
+	}
+	return nil
+}
+
+type SetBucketPlacementResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketPlacementResponse) Reset()         { *m = SetBucketPlacementResponse{} }
+func (m *SetBucketPlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketPlacementResponse) ProtoMessage()    {}
+func (*SetBucketPlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{25}
+}
+func (m *SetBucketPlacementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketPlacementResponse.Unmarshal(m, b)
+}
+func (m *SetBucketPlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketPlacementResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketPlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketPlacementResponse.Merge(m, src)
+}
+func (m *SetBucketPlacementResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketPlacementResponse.Size(m)
+}
+func (m *SetBucketPlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketPlacementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketPlacementResponse proto.InternalMessageInfo
+
+type GetBucketPlacementRequest struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketPlacementRequest) Reset()         { *m = GetBucketPlacementRequest{} }
+func (m *GetBucketPlacementRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketPlacementRequest) ProtoMessage()    {}
+func (*GetBucketPlacementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{26}
+}
+func (m *GetBucketPlacementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketPlacementRequest.Unmarshal(m, b)
+}
+func (m *GetBucketPlacementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketPlacementRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketPlacementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketPlacementRequest.Merge(m, src)
+}
+func (m *GetBucketPlacementRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketPlacementRequest.Size(m)
+}
+func (m *GetBucketPlacementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketPlacementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketPlacementRequest proto.InternalMessageInfo
+
+func (m *GetBucketPlacementRequest) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+type GetBucketPlacementResponse struct {
+	Placement            *BucketPlacement `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetBucketPlacementResponse) Reset()         { *m = GetBucketPlacementResponse{} }
+func (m *GetBucketPlacementResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketPlacementResponse) ProtoMessage()    {}
+func (*GetBucketPlacementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{27}
+}
+func (m *GetBucketPlacementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketPlacementResponse.Unmarshal(m, b)
+}
+func (m *GetBucketPlacementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketPlacementResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketPlacementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketPlacementResponse.Merge(m, src)
+}
+func (m *GetBucketPlacementResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketPlacementResponse.Size(m)
+}
+func (m *GetBucketPlacementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketPlacementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketPlacementResponse proto.InternalMessageInfo
+
+func (m *GetBucketPlacementResponse) GetPlacement() *BucketPlacement {
+	if m != nil {
+		return m.Placement
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	// the serialized api key to revoke, restricted from the api key of the request
+	ApiKey               string   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyRequest) Reset()         { *m = RevokeAPIKeyRequest{} }
+func (m *RevokeAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyRequest) ProtoMessage()    {}
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{28}
+}
+func (m *RevokeAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyRequest.Merge(m, src)
+}
+func (m *RevokeAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Size(m)
+}
+func (m *RevokeAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeAPIKeyRequest) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+type RevokeAPIKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyResponse) Reset()         { *m = RevokeAPIKeyResponse{} }
+func (m *RevokeAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyResponse) ProtoMessage()    {}
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{29}
+}
+func (m *RevokeAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyResponse.Merge(m, src)
+}
+func (m *RevokeAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Size(m)
+}
+func (m *RevokeAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddressedOrderLimit)(nil), "metainfo.AddressedOrderLimit")
 	proto.RegisterType((*SegmentWriteRequest)(nil), "metainfo.SegmentWriteRequest")
@@ -780,66 +1550,112 @@ func init() {
 	proto.RegisterType((*ListSegmentsRequest)(nil), "metainfo.ListSegmentsRequest")
 	proto.RegisterType((*ListSegmentsResponse)(nil), "metainfo.ListSegmentsResponse")
 	proto.RegisterType((*ListSegmentsResponse_Item)(nil), "metainfo.ListSegmentsResponse.Item")
+	proto.RegisterType((*SegmentMoveRequest)(nil), "metainfo.SegmentMoveRequest")
+	proto.RegisterType((*SegmentMoveResponse)(nil), "metainfo.SegmentMoveResponse")
+	proto.RegisterType((*SegmentCopyRequest)(nil), "metainfo.SegmentCopyRequest")
+	proto.RegisterType((*SegmentCopyResponse)(nil), "metainfo.SegmentCopyResponse")
+	proto.RegisterType((*LifecycleRule)(nil), "metainfo.LifecycleRule")
+	proto.RegisterType((*BucketLifecycle)(nil), "metainfo.BucketLifecycle")
+	proto.RegisterType((*SetBucketLifecycleRequest)(nil), "metainfo.SetBucketLifecycleRequest")
+	proto.RegisterType((*SetBucketLifecycleResponse)(nil), "metainfo.SetBucketLifecycleResponse")
+	proto.RegisterType((*GetBucketLifecycleRequest)(nil), "metainfo.GetBucketLifecycleRequest")
+	proto.RegisterType((*GetBucketLifecycleResponse)(nil), "metainfo.GetBucketLifecycleResponse")
+	proto.RegisterType((*BucketPlacement)(nil), "metainfo.BucketPlacement")
+	proto.RegisterType((*SetBucketPlacementRequest)(nil), "metainfo.SetBucketPlacementRequest")
+	proto.RegisterType((*SetBucketPlacementResponse)(nil), "metainfo.SetBucketPlacementResponse")
+	proto.RegisterType((*GetBucketPlacementRequest)(nil), "metainfo.GetBucketPlacementRequest")
+	proto.RegisterType((*GetBucketPlacementResponse)(nil), "metainfo.GetBucketPlacementResponse")
+	proto.RegisterType((*RevokeAPIKeyRequest)(nil), "metainfo.RevokeAPIKeyRequest")
+	proto.RegisterType((*RevokeAPIKeyResponse)(nil), "metainfo.RevokeAPIKeyResponse")
 }
 
 func init() { proto.RegisterFile("metainfo.proto", fileDescriptor_631e2f30a93cd64e) }
 
 var fileDescriptor_631e2f30a93cd64e = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0x67, 0xed, 0x38, 0x4e, 0x9e, 0x9d, 0x1a, 0x26, 0x21, 0x5d, 0x6d, 0x1b, 0x6c, 0x96, 0x4b,
-	0x90, 0xd0, 0x56, 0x4a, 0x4f, 0x50, 0x2e, 0x4d, 0x52, 0x44, 0x50, 0x5b, 0xa2, 0x09, 0x02, 0xa9,
-	0x42, 0xac, 0xc6, 0xde, 0xe7, 0xed, 0x08, 0xef, 0xce, 0x32, 0x33, 0x86, 0xb4, 0x77, 0x3e, 0x40,
-	0x0f, 0x7c, 0xa7, 0x1e, 0xf8, 0x00, 0x88, 0x43, 0xbe, 0x03, 0xdf, 0x00, 0xed, 0xec, 0x8c, 0xbd,
-	0x89, 0x6d, 0x42, 0x25, 0xdf, 0xe6, 0xbd, 0xf9, 0xcd, 0xfb, 0xf7, 0x7b, 0xef, 0x0d, 0xdc, 0xc9,
-	0x50, 0x33, 0x9e, 0x8f, 0x45, 0x54, 0x48, 0xa1, 0x05, 0xd9, 0x72, 0x72, 0x00, 0xa9, 0x48, 0xad,
-	0x36, 0xe8, 0xa7, 0x42, 0xa4, 0x13, 0x7c, 0x60, 0xa4, 0xe1, 0x74, 0xfc, 0x40, 0xf3, 0x0c, 0x95,
-	0x66, 0x59, 0x61, 0x01, 0x90, 0x8b, 0x04, 0xed, 0xb9, 0x57, 0x08, 0x9e, 0x6b, 0x94, 0xc9, 0xd0,
-	0x2a, 0xba, 0x42, 0x26, 0x28, 0x55, 0x25, 0x85, 0xbf, 0x7b, 0xb0, 0xfb, 0x38, 0x49, 0x24, 0x2a,
-	0x85, 0xc9, 0xb7, 0xe5, 0xcd, 0x53, 0x9e, 0x71, 0x4d, 0x3e, 0x85, 0xd6, 0xa4, 0x3c, 0xf8, 0xde,
-	0xc0, 0x3b, 0xec, 0x1c, 0xed, 0x46, 0xf6, 0xd5, 0x1c, 0x72, 0x44, 0x2b, 0x04, 0x39, 0x81, 0x3d,
-	0xa5, 0x85, 0x64, 0x29, 0xc6, 0xa5, 0xdf, 0x98, 0x55, 0xe6, 0xfc, 0x86, 0x79, 0xf9, 0x41, 0x64,
-	0x82, 0x79, 0x2e, 0x12, 0xb4, 0x7e, 0x28, 0xb1, 0xf0, 0x9a, 0x2e, 0x7c, 0xd3, 0x80, 0xdd, 0x0b,
-	0x4c, 0x33, 0xcc, 0xf5, 0x0f, 0x92, 0x6b, 0xa4, 0xf8, 0xcb, 0x14, 0x95, 0x26, 0xfb, 0xb0, 0x39,
-	0x9c, 0x8e, 0x7e, 0xc6, 0x2a, 0x90, 0x2e, 0xb5, 0x12, 0x21, 0xb0, 0x51, 0x30, 0xfd, 0xd2, 0x38,
-	0xe9, 0x52, 0x73, 0x26, 0x3e, 0xb4, 0x55, 0x65, 0xc2, 0x6f, 0x0e, 0xbc, 0xc3, 0x26, 0x75, 0x22,
-	0x79, 0x04, 0x20, 0x31, 0x99, 0xe6, 0x09, 0xcb, 0x47, 0xaf, 0xfc, 0x0d, 0x13, 0xd8, 0xbd, 0x68,
-	0x5e, 0x19, 0x3a, 0xbb, 0xbc, 0x18, 0xbd, 0xc4, 0x0c, 0x69, 0x0d, 0x4e, 0x1e, 0x41, 0x90, 0xb1,
-	0xcb, 0x18, 0xf3, 0x91, 0x7c, 0x55, 0x68, 0x4c, 0x62, 0x6b, 0x35, 0x56, 0xfc, 0x35, 0xfa, 0x2d,
-	0xe3, 0xe9, 0x6e, 0xc6, 0x2e, 0x9f, 0x38, 0x80, 0xcd, 0xe3, 0x82, 0xbf, 0x46, 0xf2, 0x05, 0x00,
-	0x5e, 0x16, 0x5c, 0x32, 0xcd, 0x45, 0xee, 0x6f, 0x1a, 0xcf, 0x41, 0x54, 0x11, 0x18, 0x39, 0x02,
-	0xa3, 0xef, 0x1c, 0x81, 0xb4, 0x86, 0x0e, 0xff, 0xf0, 0x60, 0xef, 0x7a, 0x4d, 0x54, 0x21, 0x72,
-	0x85, 0xe4, 0x6b, 0x78, 0x9f, 0x39, 0xce, 0x62, 0x43, 0x82, 0xf2, 0xbd, 0x41, 0xf3, 0xb0, 0x73,
-	0x74, 0x10, 0xcd, 0x3a, 0x68, 0x09, 0xab, 0xb4, 0x37, 0x7b, 0x66, 0x64, 0x45, 0x1e, 0xc2, 0x8e,
-	0x14, 0x42, 0xc7, 0x05, 0xc7, 0x11, 0xc6, 0x3c, 0xa9, 0xea, 0x79, 0xdc, 0x7b, 0x7b, 0xd5, 0x7f,
-	0xef, 0xef, 0xab, 0x7e, 0xfb, 0xbc, 0xd4, 0x9f, 0x9d, 0xd2, 0x4e, 0x89, 0xaa, 0x84, 0x24, 0x7c,
-	0x3b, 0x8f, 0xeb, 0x44, 0x64, 0xa5, 0xdd, 0xb5, 0x92, 0xf5, 0x19, 0xb4, 0x2d, 0x33, 0x96, 0x29,
-	0x52, 0x63, 0xea, 0xbc, 0x3a, 0x51, 0x07, 0x21, 0x5f, 0x42, 0x4f, 0x48, 0x9e, 0xf2, 0x9c, 0x4d,
-	0x5c, 0x29, 0x5a, 0xa6, 0x14, 0x4b, 0x5b, 0xf6, 0x8e, 0xc3, 0x56, 0xf9, 0x87, 0x4f, 0xe0, 0xc3,
-	0x1b, 0x99, 0xd8, 0x12, 0xd7, 0x82, 0xf0, 0x6e, 0x0d, 0x22, 0xfc, 0x09, 0xf6, 0xad, 0x99, 0x53,
-	0xf1, 0x5b, 0x3e, 0x11, 0x2c, 0x59, 0x6b, 0x49, 0xc2, 0x37, 0x1e, 0xdc, 0x5d, 0x70, 0xb0, 0xf6,
-	0x66, 0xa8, 0xe5, 0xdc, 0xb8, 0x3d, 0xe7, 0x17, 0x40, 0x6c, 0x48, 0x67, 0xf9, 0x58, 0xac, 0x37,
-	0xdf, 0x93, 0xd9, 0x32, 0xa8, 0x6c, 0x2f, 0x92, 0xf2, 0x3f, 0x02, 0xfc, 0x71, 0xd6, 0xa5, 0xa7,
-	0x38, 0xc1, 0x35, 0xaf, 0x94, 0x90, 0xcd, 0x3a, 0xc7, 0x59, 0x5f, 0x37, 0x1f, 0xe1, 0x5f, 0x1e,
-	0xec, 0x3e, 0xe5, 0x4a, 0x5b, 0x3f, 0xea, 0xb6, 0x04, 0xf6, 0x61, 0xb3, 0x90, 0x38, 0xe6, 0x97,
-	0x36, 0x05, 0x2b, 0x91, 0x3e, 0x74, 0x94, 0x66, 0x52, 0xc7, 0x6c, 0x5c, 0x96, 0xae, 0x69, 0x2e,
-	0xc1, 0xa8, 0x1e, 0x97, 0x1a, 0x72, 0x00, 0x80, 0x79, 0x12, 0x0f, 0x71, 0x2c, 0x24, 0x9a, 0xa1,
-	0xeb, 0xd2, 0x6d, 0xcc, 0x93, 0x63, 0xa3, 0x20, 0xf7, 0x61, 0x5b, 0xe2, 0x68, 0x2a, 0x15, 0xff,
-	0xb5, 0xda, 0x77, 0x5b, 0x74, 0xae, 0x20, 0x7b, 0xee, 0xa7, 0x28, 0x97, 0x5b, 0xcb, 0x7d, 0x0a,
-	0x07, 0x00, 0x65, 0xb2, 0xf1, 0x78, 0xc2, 0x52, 0xe5, 0xb7, 0x07, 0xde, 0x61, 0x9b, 0x6e, 0x97,
-	0x9a, 0xaf, 0x4a, 0x45, 0xf8, 0xa7, 0x07, 0x7b, 0xd7, 0x53, 0xb3, 0xd5, 0xfb, 0x1c, 0x5a, 0x5c,
-	0x63, 0xe6, 0x4a, 0xf6, 0xc9, 0xbc, 0x64, 0xcb, 0xe0, 0xd1, 0x99, 0xc6, 0x8c, 0x56, 0x2f, 0x4a,
-	0xfe, 0xb2, 0x32, 0xfe, 0x86, 0x89, 0xd0, 0x9c, 0x03, 0x84, 0x8d, 0x12, 0x32, 0xe3, 0xd6, 0xab,
-	0x71, 0xfb, 0x4e, 0xdd, 0x44, 0xee, 0xc1, 0x36, 0x57, 0xb1, 0xad, 0x6f, 0xd3, 0xb8, 0xd8, 0xe2,
-	0xea, 0xdc, 0xc8, 0x47, 0xff, 0x34, 0x61, 0xeb, 0x99, 0x0d, 0x94, 0x3c, 0x87, 0x9d, 0x13, 0x89,
-	0x4c, 0xa3, 0x8d, 0x96, 0xd4, 0x78, 0x5f, 0xf2, 0xc5, 0x05, 0x1f, 0xad, 0xba, 0xb6, 0x25, 0x39,
-	0x87, 0x9d, 0x6a, 0x39, 0x39, 0x7b, 0x8b, 0x0f, 0xae, 0xad, 0xe1, 0xa0, 0xbf, 0xf2, 0xde, 0x5a,
-	0xfc, 0x06, 0x3a, 0xb5, 0xf1, 0x22, 0xf7, 0x17, 0xf0, 0xb5, 0x89, 0x0e, 0x0e, 0x56, 0xdc, 0x5a,
-	0x5b, 0xdf, 0x43, 0xcf, 0xad, 0x24, 0x17, 0xdf, 0x60, 0xe1, 0xc5, 0x8d, 0xad, 0x18, 0x7c, 0xfc,
-	0x1f, 0x88, 0x79, 0xd6, 0xd5, 0x60, 0xad, 0xce, 0xfa, 0xda, 0x58, 0x2f, 0xc9, 0xfa, 0xc6, 0x60,
-	0x3e, 0x83, 0x6e, 0xbd, 0x87, 0xea, 0xb4, 0x2c, 0x99, 0xb2, 0x3a, 0x2d, 0xcb, 0x5a, 0xef, 0x78,
-	0xe3, 0x45, 0xa3, 0x18, 0x0e, 0x37, 0xcd, 0x1f, 0xfe, 0xf0, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xbd, 0xa9, 0x5c, 0xa6, 0xba, 0x09, 0x00, 0x00,
+	// 1315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0xdb, 0xc6,
+	0x12, 0x3e, 0x94, 0x2d, 0xcb, 0x1a, 0xcb, 0xd6, 0x39, 0x6b, 0xc7, 0x96, 0x19, 0x3b, 0x72, 0x98,
+	0x00, 0xc7, 0x07, 0x38, 0x55, 0x00, 0xe7, 0xa2, 0x68, 0x53, 0xa0, 0x8d, 0xed, 0xd4, 0x75, 0x13,
+	0xa7, 0x02, 0xdd, 0xb4, 0x40, 0x50, 0x94, 0xa0, 0xc4, 0x91, 0xb2, 0x08, 0xc9, 0x65, 0x49, 0x2a,
+	0xb6, 0x72, 0xdf, 0x07, 0xc8, 0x45, 0x1f, 0xa0, 0x6f, 0x93, 0x8b, 0x3e, 0x40, 0x51, 0x14, 0x79,
+	0x96, 0x62, 0x7f, 0x28, 0x92, 0x92, 0x28, 0xdb, 0x81, 0xee, 0xb8, 0x33, 0xdf, 0xfc, 0x7d, 0xb3,
+	0x3b, 0xbb, 0x84, 0x35, 0x0f, 0x63, 0x9b, 0xfa, 0x3d, 0xd6, 0x0a, 0x42, 0x16, 0x33, 0xb2, 0x9c,
+	0xac, 0x75, 0xe8, 0xb3, 0xbe, 0x92, 0xea, 0xcd, 0x3e, 0x63, 0x7d, 0x17, 0x1f, 0x88, 0x55, 0x67,
+	0xd0, 0x7b, 0x10, 0x53, 0x0f, 0xa3, 0xd8, 0xf6, 0x02, 0x05, 0x00, 0x9f, 0x39, 0xa8, 0xbe, 0xeb,
+	0x01, 0xa3, 0x7e, 0x8c, 0xa1, 0xd3, 0x51, 0x82, 0x1a, 0x0b, 0x1d, 0x0c, 0x23, 0xb9, 0x32, 0x7e,
+	0xd5, 0x60, 0xfd, 0xb1, 0xe3, 0x84, 0x18, 0x45, 0xe8, 0x7c, 0xc7, 0x35, 0xcf, 0xa8, 0x47, 0x63,
+	0xf2, 0x3f, 0x28, 0xbb, 0xfc, 0xa3, 0xa1, 0xed, 0x69, 0xfb, 0x2b, 0x07, 0xeb, 0x2d, 0x65, 0x95,
+	0x42, 0x0e, 0x4c, 0x89, 0x20, 0x47, 0xb0, 0x11, 0xc5, 0x2c, 0xb4, 0xfb, 0x68, 0xf1, 0xb8, 0x96,
+	0x2d, 0xdd, 0x35, 0x4a, 0xc2, 0xf2, 0x3f, 0x2d, 0x91, 0xcc, 0x73, 0xe6, 0xa0, 0x8a, 0x63, 0x12,
+	0x05, 0xcf, 0xc8, 0x8c, 0x77, 0x25, 0x58, 0x3f, 0xc7, 0xbe, 0x87, 0x7e, 0xfc, 0x63, 0x48, 0x63,
+	0x34, 0xf1, 0x97, 0x01, 0x46, 0x31, 0xd9, 0x84, 0xa5, 0xce, 0xa0, 0xfb, 0x1a, 0x65, 0x22, 0x35,
+	0x53, 0xad, 0x08, 0x81, 0xc5, 0xc0, 0x8e, 0x5f, 0x89, 0x20, 0x35, 0x53, 0x7c, 0x93, 0x06, 0x54,
+	0x22, 0xe9, 0xa2, 0xb1, 0xb0, 0xa7, 0xed, 0x2f, 0x98, 0xc9, 0x92, 0x3c, 0x02, 0x08, 0xd1, 0x19,
+	0xf8, 0x8e, 0xed, 0x77, 0x87, 0x8d, 0x45, 0x91, 0xd8, 0xed, 0x56, 0xca, 0x8c, 0x39, 0x52, 0x9e,
+	0x77, 0x5f, 0xa1, 0x87, 0x66, 0x06, 0x4e, 0x1e, 0x81, 0xee, 0xd9, 0x97, 0x16, 0xfa, 0xdd, 0x70,
+	0x18, 0xc4, 0xe8, 0x58, 0xca, 0xab, 0x15, 0xd1, 0xb7, 0xd8, 0x28, 0x8b, 0x48, 0x5b, 0x9e, 0x7d,
+	0xf9, 0x24, 0x01, 0xa8, 0x3a, 0xce, 0xe9, 0x5b, 0x24, 0x9f, 0x03, 0xe0, 0x65, 0x40, 0x43, 0x3b,
+	0xa6, 0xcc, 0x6f, 0x2c, 0x89, 0xc8, 0x7a, 0x4b, 0x36, 0xb0, 0x95, 0x34, 0xb0, 0xf5, 0x7d, 0xd2,
+	0x40, 0x33, 0x83, 0x36, 0x7e, 0xd3, 0x60, 0x23, 0xcf, 0x49, 0x14, 0x30, 0x3f, 0x42, 0xf2, 0x0d,
+	0xfc, 0xdb, 0x4e, 0x7a, 0x66, 0x89, 0x26, 0x44, 0x0d, 0x6d, 0x6f, 0x61, 0x7f, 0xe5, 0x60, 0xb7,
+	0x35, 0xda, 0x41, 0x53, 0xba, 0x6a, 0xd6, 0x47, 0x66, 0x62, 0x1d, 0x91, 0x87, 0xb0, 0x1a, 0x32,
+	0x16, 0x5b, 0x01, 0xc5, 0x2e, 0x5a, 0xd4, 0x91, 0x7c, 0x1e, 0xd6, 0xdf, 0x7f, 0x68, 0xfe, 0xeb,
+	0xaf, 0x0f, 0xcd, 0x4a, 0x9b, 0xcb, 0x4f, 0x8f, 0xcd, 0x15, 0x8e, 0x92, 0x0b, 0xc7, 0x78, 0x9f,
+	0xe6, 0x75, 0xc4, 0x3c, 0xee, 0x77, 0xae, 0xcd, 0xfa, 0x3f, 0x54, 0x54, 0x67, 0x54, 0xa7, 0x48,
+	0xa6, 0x53, 0x6d, 0xf9, 0x65, 0x26, 0x10, 0xf2, 0x05, 0xd4, 0x59, 0x48, 0xfb, 0xd4, 0xb7, 0xdd,
+	0x84, 0x8a, 0xf2, 0xde, 0x42, 0xd1, 0x96, 0x5d, 0x4b, 0xb0, 0xb2, 0x7e, 0xe3, 0x09, 0xdc, 0x1a,
+	0xab, 0x44, 0x51, 0x9c, 0x49, 0x42, 0xbb, 0x32, 0x09, 0xe3, 0x67, 0xd8, 0x54, 0x6e, 0x8e, 0xd9,
+	0x85, 0xef, 0x32, 0xdb, 0x99, 0x2b, 0x25, 0xc6, 0x3b, 0x0d, 0xb6, 0x26, 0x02, 0xcc, 0x7d, 0x33,
+	0x64, 0x6a, 0x2e, 0x5d, 0x5d, 0xf3, 0x4b, 0x20, 0x2a, 0xa5, 0x53, 0xbf, 0xc7, 0xe6, 0x5b, 0xef,
+	0x11, 0xac, 0xe7, 0x7c, 0x4f, 0x36, 0xe5, 0x1a, 0x09, 0xfe, 0x34, 0xda, 0xa5, 0xc7, 0xe8, 0xe2,
+	0x9c, 0x47, 0x8a, 0x61, 0xc3, 0xad, 0x31, 0xef, 0xf3, 0xee, 0x87, 0xf1, 0xa7, 0x06, 0xeb, 0xcf,
+	0x68, 0x14, 0xab, 0x38, 0xd1, 0x55, 0x05, 0x6c, 0xc2, 0x52, 0x10, 0x62, 0x8f, 0x5e, 0xaa, 0x12,
+	0xd4, 0x8a, 0x34, 0x61, 0x25, 0x8a, 0xed, 0x30, 0xb6, 0xec, 0x1e, 0xa7, 0x6e, 0x41, 0x28, 0x41,
+	0x88, 0x1e, 0x73, 0x09, 0xd9, 0x05, 0x40, 0xdf, 0xb1, 0x3a, 0xd8, 0x63, 0x21, 0x8a, 0x43, 0x57,
+	0x33, 0xab, 0xe8, 0x3b, 0x87, 0x42, 0x40, 0x76, 0xa0, 0x1a, 0x62, 0x77, 0x10, 0x46, 0xf4, 0x8d,
+	0x9c, 0x77, 0xcb, 0x66, 0x2a, 0x20, 0x1b, 0xc9, 0x4d, 0xc1, 0x87, 0x5b, 0x39, 0xb9, 0x14, 0x76,
+	0x01, 0x78, 0xb1, 0x56, 0xcf, 0xb5, 0xfb, 0x51, 0xa3, 0xb2, 0xa7, 0xed, 0x57, 0xcc, 0x2a, 0x97,
+	0x7c, 0xcd, 0x05, 0xc6, 0x1f, 0x1a, 0x6c, 0xe4, 0x4b, 0x53, 0xec, 0x7d, 0x06, 0x65, 0x1a, 0xa3,
+	0x97, 0x50, 0x76, 0x2f, 0xa5, 0x6c, 0x1a, 0xbc, 0x75, 0x1a, 0xa3, 0x67, 0x4a, 0x0b, 0xde, 0x3f,
+	0x8f, 0xe7, 0x5f, 0x12, 0x19, 0x8a, 0x6f, 0x1d, 0x61, 0x91, 0x43, 0x46, 0xbd, 0xd5, 0x32, 0xbd,
+	0xbd, 0xd1, 0x6e, 0x22, 0xb7, 0xa1, 0x4a, 0x23, 0x4b, 0xf1, 0xbb, 0x20, 0x42, 0x2c, 0xd3, 0xa8,
+	0x2d, 0xd6, 0xbc, 0x53, 0xc9, 0x61, 0x38, 0x63, 0x6f, 0xe6, 0x7c, 0x79, 0x6d, 0xc3, 0xb2, 0x8f,
+	0x17, 0x96, 0xb0, 0x90, 0xbd, 0xa9, 0xf8, 0x78, 0xd1, 0xe6, 0x46, 0x4d, 0x58, 0xe1, 0xaa, 0xc4,
+	0x50, 0xde, 0x45, 0xe0, 0xe3, 0x85, 0x4a, 0x86, 0xdc, 0x85, 0x1a, 0x07, 0x70, 0x12, 0x1d, 0x3b,
+	0xb6, 0x45, 0x8f, 0x6a, 0x26, 0x37, 0x3a, 0x53, 0x22, 0xde, 0x29, 0x0e, 0x51, 0x89, 0x56, 0x64,
+	0xf3, 0x7d, 0xbc, 0x38, 0x14, 0x82, 0xcc, 0x51, 0x94, 0x95, 0x7d, 0xd4, 0x7c, 0xcc, 0xf0, 0x73,
+	0xc4, 0x82, 0xe1, 0x7c, 0xf9, 0xc9, 0x17, 0xb0, 0x38, 0x56, 0x40, 0x8e, 0xbe, 0xf2, 0x4c, 0xfa,
+	0x96, 0xae, 0xa4, 0xaf, 0x32, 0x41, 0x5f, 0x86, 0x1f, 0x59, 0xd9, 0x47, 0xf1, 0xf3, 0xbb, 0x06,
+	0xab, 0xcf, 0x68, 0x0f, 0xbb, 0xc3, 0xae, 0x8b, 0xe6, 0xc0, 0x45, 0xb2, 0x06, 0x25, 0xea, 0x08,
+	0xd3, 0xaa, 0x59, 0xa2, 0x4e, 0xe1, 0xd9, 0xfe, 0x2f, 0xd4, 0xd3, 0x17, 0x83, 0xe5, 0xd8, 0xc3,
+	0x48, 0xd0, 0x53, 0x36, 0xd7, 0x52, 0xf1, 0xb1, 0x3d, 0x8c, 0xc8, 0x97, 0xb0, 0x63, 0x77, 0x58,
+	0x18, 0x5b, 0xd4, 0xef, 0x32, 0x2f, 0x70, 0x31, 0x46, 0x6b, 0x10, 0xf0, 0x9b, 0x44, 0x5a, 0x2d,
+	0x0a, 0xab, 0x6d, 0x81, 0x39, 0x1d, 0x41, 0x5e, 0x08, 0x04, 0x77, 0x60, 0x7c, 0x05, 0x75, 0xc9,
+	0xe8, 0x28, 0x51, 0xf2, 0x09, 0x94, 0xc3, 0x81, 0x8b, 0xc9, 0x61, 0xdd, 0xca, 0x1e, 0xd6, 0x4c,
+	0x31, 0xa6, 0x44, 0x19, 0x2e, 0x6c, 0x9f, 0x63, 0x3c, 0xe6, 0xe4, 0xaa, 0xbd, 0xf0, 0x29, 0x54,
+	0xdd, 0x04, 0xab, 0xce, 0xe9, 0x76, 0x1a, 0x67, 0xdc, 0x59, 0x8a, 0x35, 0x76, 0x40, 0x9f, 0x16,
+	0x4d, 0xf6, 0xc7, 0x78, 0x08, 0xdb, 0x27, 0x37, 0xcd, 0xc5, 0x78, 0x01, 0xfa, 0x49, 0xa1, 0xcb,
+	0x7c, 0xa6, 0xda, 0x0d, 0x32, 0x7d, 0x90, 0x30, 0xdb, 0x76, 0xed, 0x2e, 0x8a, 0x8d, 0xb7, 0x03,
+	0xd5, 0x2e, 0x1b, 0xf8, 0x71, 0x48, 0x15, 0xbb, 0x55, 0x33, 0x15, 0xe4, 0x88, 0x1c, 0xd9, 0x5c,
+	0x83, 0xc8, 0x20, 0xc1, 0x16, 0x11, 0x99, 0x3a, 0x4b, 0xb1, 0x39, 0x22, 0x33, 0xd1, 0xa6, 0x10,
+	0x79, 0xdd, 0x5c, 0x72, 0x44, 0x4e, 0xb8, 0xcc, 0x67, 0xaa, 0xdd, 0x20, 0xd3, 0x16, 0xac, 0x9b,
+	0xf8, 0x86, 0xbd, 0xc6, 0xc7, 0xed, 0xd3, 0xa7, 0x38, 0x1a, 0x33, 0x5b, 0x50, 0xb1, 0x03, 0x6a,
+	0xbd, 0xc6, 0xa1, 0x3a, 0x50, 0x4b, 0x76, 0x40, 0x9f, 0xe2, 0xd0, 0xd8, 0x84, 0x8d, 0x3c, 0x5e,
+	0x26, 0x70, 0xf0, 0xf7, 0x32, 0x2c, 0x9f, 0xa9, 0x78, 0xe4, 0x39, 0xac, 0x1e, 0x85, 0x68, 0xc7,
+	0x98, 0x0c, 0x85, 0xcc, 0x35, 0x3e, 0xe5, 0x8f, 0x45, 0xbf, 0x53, 0xa4, 0x56, 0xd5, 0xb5, 0x61,
+	0x55, 0xbe, 0x35, 0x13, 0x7f, 0x93, 0x06, 0xb9, 0x57, 0xb5, 0xde, 0x2c, 0xd4, 0x2b, 0x8f, 0xdf,
+	0xc2, 0x4a, 0xe6, 0xb5, 0x44, 0x76, 0x26, 0xf0, 0x99, 0x07, 0x9a, 0xbe, 0x5b, 0xa0, 0x55, 0xbe,
+	0x7e, 0x80, 0x7a, 0xf2, 0xc2, 0x4c, 0xf2, 0xdb, 0x9b, 0xb0, 0x18, 0x7b, 0xe4, 0xea, 0x77, 0x67,
+	0x20, 0xd2, 0xaa, 0xe5, 0x3b, 0xa9, 0xb8, 0xea, 0xdc, 0x2b, 0x4d, 0x6f, 0x16, 0xea, 0x95, 0xc7,
+	0x33, 0xa8, 0x65, 0x9f, 0x04, 0xd9, 0xb6, 0x4c, 0x79, 0x34, 0xe9, 0x77, 0x8a, 0xd4, 0x29, 0x89,
+	0xfc, 0x82, 0x4b, 0xd2, 0x9b, 0x24, 0x31, 0x73, 0xb1, 0xeb, 0xbb, 0x05, 0xda, 0xd4, 0x17, 0xbf,
+	0x0c, 0x8a, 0x7d, 0x65, 0x2e, 0x41, 0x7d, 0xb7, 0x40, 0xab, 0x7c, 0x59, 0xfc, 0xe6, 0x1c, 0x9f,
+	0x39, 0xe4, 0x5e, 0xd6, 0xa8, 0x60, 0x8c, 0xe9, 0xf7, 0x67, 0x83, 0xd2, 0x00, 0x27, 0x33, 0x03,
+	0x9c, 0x5c, 0x27, 0xc0, 0xc9, 0xcc, 0x00, 0x93, 0xf3, 0x63, 0x6a, 0x05, 0xe3, 0xf3, 0x43, 0xbf,
+	0x3f, 0x1b, 0x34, 0xa5, 0x82, 0xa9, 0x01, 0x4e, 0xae, 0x13, 0x60, 0xc6, 0x40, 0x3a, 0x83, 0x5a,
+	0x76, 0x4e, 0x64, 0xb7, 0xda, 0x94, 0x79, 0xa3, 0xdf, 0x29, 0x52, 0x4b, 0x77, 0x87, 0x8b, 0x2f,
+	0x4b, 0x41, 0xa7, 0xb3, 0x24, 0xfe, 0xfe, 0x1f, 0xfe, 0x33, 0x00, 0x21, 0xab, 0x2a, 0x3b, 0xf4,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -860,6 +1676,13 @@ type MetainfoClient interface {
 	DownloadSegment(ctx context.Context, in *SegmentDownloadRequest, opts ...grpc.CallOption) (*SegmentDownloadResponse, error)
 	DeleteSegment(ctx context.Context, in *SegmentDeleteRequest, opts ...grpc.CallOption) (*SegmentDeleteResponse, error)
 	ListSegments(ctx context.Context, in *ListSegmentsRequest, opts ...grpc.CallOption) (*ListSegmentsResponse, error)
+	MoveSegment(ctx context.Context, in *SegmentMoveRequest, opts ...grpc.CallOption) (*SegmentMoveResponse, error)
+	CopySegment(ctx context.Context, in *SegmentCopyRequest, opts ...grpc.CallOption) (*SegmentCopyResponse, error)
+	SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest, opts ...grpc.CallOption) (*SetBucketLifecycleResponse, error)
+	GetBucketLifecycle(ctx context.Context, in *GetBucketLifecycleRequest, opts ...grpc.CallOption) (*GetBucketLifecycleResponse, error)
+	SetBucketPlacement(ctx context.Context, in *SetBucketPlacementRequest, opts ...grpc.CallOption) (*SetBucketPlacementResponse, error)
+	GetBucketPlacement(ctx context.Context, in *GetBucketPlacementRequest, opts ...grpc.CallOption) (*GetBucketPlacementResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
 }
 
 type metainfoClient struct {
@@ -924,6 +1747,69 @@ func (c *metainfoClient) ListSegments(ctx context.Context, in *ListSegmentsReque
 	return out, nil
 }
 
+func (c *metainfoClient) MoveSegment(ctx context.Context, in *SegmentMoveRequest, opts ...grpc.CallOption) (*SegmentMoveResponse, error) {
+	out := new(SegmentMoveResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/MoveSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) CopySegment(ctx context.Context, in *SegmentCopyRequest, opts ...grpc.CallOption) (*SegmentCopyResponse, error) {
+	out := new(SegmentCopyResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/CopySegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest, opts ...grpc.CallOption) (*SetBucketLifecycleResponse, error) {
+	out := new(SetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) GetBucketLifecycle(ctx context.Context, in *GetBucketLifecycleRequest, opts ...grpc.CallOption) (*GetBucketLifecycleResponse, error) {
+	out := new(GetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/GetBucketLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) SetBucketPlacement(ctx context.Context, in *SetBucketPlacementRequest, opts ...grpc.CallOption) (*SetBucketPlacementResponse, error) {
+	out := new(SetBucketPlacementResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/SetBucketPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) GetBucketPlacement(ctx context.Context, in *GetBucketPlacementRequest, opts ...grpc.CallOption) (*GetBucketPlacementResponse, error) {
+	out := new(GetBucketPlacementResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/GetBucketPlacement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetainfoServer is the server API for Metainfo service.
 type MetainfoServer interface {
 	CreateSegment(context.Context, *SegmentWriteRequest) (*SegmentWriteResponse, error)
@@ -932,6 +1818,13 @@ type MetainfoServer interface {
 	DownloadSegment(context.Context, *SegmentDownloadRequest) (*SegmentDownloadResponse, error)
 	DeleteSegment(context.Context, *SegmentDeleteRequest) (*SegmentDeleteResponse, error)
 	ListSegments(context.Context, *ListSegmentsRequest) (*ListSegmentsResponse, error)
+	MoveSegment(context.Context, *SegmentMoveRequest) (*SegmentMoveResponse, error)
+	CopySegment(context.Context, *SegmentCopyRequest) (*SegmentCopyResponse, error)
+	SetBucketLifecycle(context.Context, *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error)
+	GetBucketLifecycle(context.Context, *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error)
+	SetBucketPlacement(context.Context, *SetBucketPlacementRequest) (*SetBucketPlacementResponse, error)
+	GetBucketPlacement(context.Context, *GetBucketPlacementRequest) (*GetBucketPlacementResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
 }
 
 func RegisterMetainfoServer(s *grpc.Server, srv MetainfoServer) {
@@ -1046,6 +1939,132 @@ func _Metainfo_ListSegments_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metainfo_MoveSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).MoveSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/MoveSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).MoveSegment(ctx, req.(*SegmentMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_CopySegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).CopySegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/CopySegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).CopySegment(ctx, req.(*SegmentCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_SetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).SetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/SetBucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).SetBucketLifecycle(ctx, req.(*SetBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_GetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).GetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/GetBucketLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).GetBucketLifecycle(ctx, req.(*GetBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_SetBucketPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).SetBucketPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/SetBucketPlacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).SetBucketPlacement(ctx, req.(*SetBucketPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_GetBucketPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).GetBucketPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/GetBucketPlacement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).GetBucketPlacement(ctx, req.(*GetBucketPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Metainfo_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "metainfo.Metainfo",
 	HandlerType: (*MetainfoServer)(nil),
@@ -1074,6 +2093,34 @@ var _Metainfo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListSegments",
 			Handler:    _Metainfo_ListSegments_Handler,
 		},
+		{
+			MethodName: "MoveSegment",
+			Handler:    _Metainfo_MoveSegment_Handler,
+		},
+		{
+			MethodName: "CopySegment",
+			Handler:    _Metainfo_CopySegment_Handler,
+		},
+		{
+			MethodName: "SetBucketLifecycle",
+			Handler:    _Metainfo_SetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "GetBucketLifecycle",
+			Handler:    _Metainfo_GetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "SetBucketPlacement",
+			Handler:    _Metainfo_SetBucketPlacement_Handler,
+		},
+		{
+			MethodName: "GetBucketPlacement",
+			Handler:    _Metainfo_GetBucketPlacement_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _Metainfo_RevokeAPIKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "metainfo.proto",
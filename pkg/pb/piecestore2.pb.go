@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	math "math"
 )
@@ -24,13 +25,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // Expected order of messages from uplink:
-//   OrderLimit ->
-//   repeated
-//      Order ->
-//      Chunk ->
-//   PieceHash signed by uplink ->
-//      <- PieceHash signed by storage node
 //
+//	OrderLimit ->
+//	repeated
+//	   Order ->
+//	   Chunk ->
+//	PieceHash signed by uplink ->
+//	   <- PieceHash signed by storage node
 type PieceUploadRequest struct {
 	// first message to show that we are allowed to upload
 	Limit *OrderLimit2 `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -182,11 +183,12 @@ func (m *PieceUploadResponse) GetDone() *PieceHash {
 }
 
 // Expected order of messages from uplink:
-//   {OrderLimit, Chunk} ->
-//   go repeated
-//      Order -> (async)
-//   go repeated
-//      <- PieceDownloadResponse.Chunk
+//
+//	{OrderLimit, Chunk} ->
+//	go repeated
+//	   Order -> (async)
+//	go repeated
+//	   <- PieceDownloadResponse.Chunk
 type PieceDownloadRequest struct {
 	// first message to show that we are allowed to upload
 	Limit *OrderLimit2 `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -444,6 +446,85 @@ func (m *PieceDeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PieceDeleteResponse proto.InternalMessageInfo
 
+// RetainRequest is sent by the satellite with a bloom filter of the pieces
+// the storage node should keep. Pieces created before creation_date that are
+// not in the filter are garbage.
+type RetainRequest struct {
+	CreationDate         *timestamp.Timestamp `protobuf:"bytes,1,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	Filter               []byte               `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RetainRequest) Reset()         { *m = RetainRequest{} }
+func (m *RetainRequest) String() string { return proto.CompactTextString(m) }
+func (*RetainRequest) ProtoMessage()    {}
+func (*RetainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{6}
+}
+func (m *RetainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainRequest.Unmarshal(m, b)
+}
+func (m *RetainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainRequest.Marshal(b, m, deterministic)
+}
+func (m *RetainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainRequest.Merge(m, src)
+}
+func (m *RetainRequest) XXX_Size() int {
+	return xxx_messageInfo_RetainRequest.Size(m)
+}
+func (m *RetainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainRequest proto.InternalMessageInfo
+
+func (m *RetainRequest) GetCreationDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreationDate
+	}
+	return nil
+}
+
+func (m *RetainRequest) GetFilter() []byte {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type RetainResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainResponse) Reset()         { *m = RetainResponse{} }
+func (m *RetainResponse) String() string { return proto.CompactTextString(m) }
+func (*RetainResponse) ProtoMessage()    {}
+func (*RetainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23ff32dd550c2439, []int{7}
+}
+func (m *RetainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainResponse.Unmarshal(m, b)
+}
+func (m *RetainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainResponse.Marshal(b, m, deterministic)
+}
+func (m *RetainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainResponse.Merge(m, src)
+}
+func (m *RetainResponse) XXX_Size() int {
+	return xxx_messageInfo_RetainResponse.Size(m)
+}
+func (m *RetainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*PieceUploadRequest)(nil), "piecestore.PieceUploadRequest")
 	proto.RegisterType((*PieceUploadRequest_Chunk)(nil), "piecestore.PieceUploadRequest.Chunk")
@@ -454,38 +535,46 @@ func init() {
 	proto.RegisterType((*PieceDownloadResponse_Chunk)(nil), "piecestore.PieceDownloadResponse.Chunk")
 	proto.RegisterType((*PieceDeleteRequest)(nil), "piecestore.PieceDeleteRequest")
 	proto.RegisterType((*PieceDeleteResponse)(nil), "piecestore.PieceDeleteResponse")
+	proto.RegisterType((*RetainRequest)(nil), "piecestore.RetainRequest")
+	proto.RegisterType((*RetainResponse)(nil), "piecestore.RetainResponse")
 }
 
 func init() { proto.RegisterFile("piecestore2.proto", fileDescriptor_23ff32dd550c2439) }
 
 var fileDescriptor_23ff32dd550c2439 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x51, 0x4f, 0xea, 0x30,
-	0x14, 0xc7, 0x29, 0x6c, 0xcb, 0xbd, 0xe7, 0x92, 0x9b, 0x50, 0xc4, 0x2c, 0x4b, 0x54, 0x5c, 0x50,
-	0xf1, 0x65, 0x31, 0xe3, 0xcd, 0xa0, 0x26, 0xca, 0x83, 0x89, 0x1a, 0x49, 0x0d, 0x2f, 0xbe, 0x98,
-	0xc1, 0x0a, 0x2c, 0xe2, 0x3a, 0xd7, 0x11, 0x13, 0xbe, 0x82, 0x9f, 0xd3, 0x8f, 0x61, 0x34, 0x6b,
-	0x37, 0xc8, 0x00, 0x21, 0x9a, 0xf8, 0x04, 0x3d, 0xe7, 0x7f, 0xfa, 0xff, 0xf5, 0xdf, 0x66, 0x50,
-	0x0a, 0x3c, 0xda, 0xa3, 0x3c, 0x62, 0x21, 0xb5, 0xad, 0x20, 0x64, 0x11, 0xc3, 0x30, 0x2b, 0x19,
-	0x30, 0x60, 0x03, 0x26, 0xeb, 0x46, 0x91, 0x85, 0x2e, 0x0d, 0xb9, 0x5c, 0x99, 0xef, 0x08, 0x70,
-	0x3b, 0x16, 0x76, 0x82, 0x11, 0x73, 0x5c, 0x42, 0x9f, 0xc7, 0x94, 0x47, 0xf8, 0x10, 0xd4, 0x91,
-	0xf7, 0xe4, 0x45, 0x3a, 0xaa, 0xa2, 0xfa, 0x3f, 0xbb, 0x6c, 0x25, 0x43, 0xb7, 0xf1, 0xcf, 0x75,
-	0xdc, 0xb1, 0x89, 0x54, 0xe0, 0x1a, 0xa8, 0xa2, 0xa9, 0xe7, 0x85, 0xf4, 0x7f, 0x46, 0x6a, 0x13,
-	0xd9, 0xc4, 0xc7, 0xa0, 0xf6, 0x86, 0x63, 0xff, 0x51, 0x2f, 0x08, 0x55, 0xcd, 0x9a, 0xd1, 0x59,
-	0x8b, 0xfe, 0xd6, 0x45, 0xac, 0x25, 0x72, 0x04, 0xef, 0x81, 0xe2, 0x32, 0x9f, 0xea, 0x8a, 0x18,
-	0x2d, 0xa5, 0x06, 0x62, 0xec, 0xd2, 0xe1, 0x43, 0x22, 0xda, 0x46, 0x03, 0x54, 0x31, 0x86, 0x37,
-	0x41, 0x63, 0xfd, 0x3e, 0xa7, 0x92, 0xbe, 0x40, 0x92, 0x15, 0xc6, 0xa0, 0xb8, 0x4e, 0xe4, 0x08,
-	0xd0, 0x22, 0x11, 0xff, 0xcd, 0x26, 0x94, 0x33, 0xf6, 0x3c, 0x60, 0x3e, 0xa7, 0x53, 0x4b, 0xb4,
-	0xd2, 0xd2, 0x7c, 0x43, 0xb0, 0x21, 0x6a, 0x2d, 0xf6, 0xe2, 0xff, 0x6a, 0x7e, 0xcd, 0x6c, 0x7e,
-	0xfb, 0x0b, 0xf9, 0xcd, 0x11, 0x64, 0x12, 0x34, 0x4e, 0xd7, 0x45, 0xb3, 0x05, 0x20, 0x94, 0x0f,
-	0xdc, 0x9b, 0x50, 0x41, 0x52, 0x20, 0x7f, 0x45, 0xe5, 0xce, 0x9b, 0x50, 0xf3, 0x15, 0x41, 0x65,
-	0xce, 0x25, 0x09, 0xea, 0x24, 0xe5, 0x92, 0x07, 0x3d, 0x58, 0xc1, 0x25, 0x27, 0xb2, 0x60, 0x3f,
-	0xba, 0xb3, 0xb3, 0xe4, 0xc9, 0xb6, 0xe8, 0x88, 0x46, 0xf4, 0xfb, 0x91, 0x9b, 0x95, 0xe4, 0xd2,
-	0xd3, 0x0d, 0x24, 0x99, 0xfd, 0x81, 0x00, 0xda, 0x53, 0x7c, 0x7c, 0x03, 0x9a, 0x7c, 0x15, 0x78,
-	0x7b, 0xf5, 0x6b, 0x35, 0x76, 0xbe, 0xec, 0xcb, 0x9d, 0xcd, 0x5c, 0x1d, 0xe1, 0x0e, 0xfc, 0x49,
-	0xb3, 0xc0, 0xd5, 0x75, 0xd7, 0x67, 0xec, 0xae, 0x0d, 0x32, 0xde, 0xf4, 0x08, 0xe1, 0x2b, 0xd0,
-	0xe4, 0x31, 0x96, 0x50, 0x66, 0x02, 0x5a, 0x42, 0x99, 0x3d, 0xbf, 0x99, 0x3b, 0x57, 0xee, 0xf3,
-	0x41, 0xb7, 0xab, 0x89, 0x4f, 0x43, 0xe3, 0x33, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xfa, 0xf7, 0xbe,
-	0x55, 0x04, 0x00, 0x00,
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x26, 0xfd, 0x89, 0xe0, 0xd0, 0x4d, 0xcc, 0x65, 0xa8, 0x58, 0x82, 0x8e, 0x68, 0xc0, 0xb8,
+	0xc9, 0x50, 0x76, 0x87, 0x06, 0x13, 0xd0, 0x0b, 0x24, 0x40, 0x4c, 0x86, 0xdd, 0x70, 0x33, 0xb9,
+	0xcd, 0x69, 0x6a, 0x91, 0xc6, 0x21, 0x76, 0x85, 0xb4, 0x57, 0xe0, 0xad, 0x78, 0x17, 0x1e, 0x03,
+	0x09, 0xc5, 0x8e, 0x37, 0xbc, 0x9f, 0x56, 0x20, 0x71, 0x95, 0xd8, 0xe7, 0x3b, 0xe7, 0xfb, 0xfc,
+	0x9d, 0x73, 0x60, 0xa3, 0x14, 0x38, 0x41, 0xa5, 0x65, 0x85, 0x49, 0x5c, 0x56, 0x52, 0x4b, 0x02,
+	0x67, 0x57, 0x14, 0x32, 0x99, 0x49, 0x7b, 0x4f, 0x87, 0x99, 0x94, 0x59, 0x8e, 0xbb, 0xe6, 0x34,
+	0x5e, 0x4c, 0x77, 0xb5, 0x98, 0xa3, 0xd2, 0x7c, 0x5e, 0x36, 0x80, 0x9e, 0xac, 0x52, 0xac, 0x94,
+	0x3d, 0x45, 0xbf, 0x02, 0x20, 0x87, 0x75, 0xa5, 0xa3, 0x32, 0x97, 0x3c, 0x65, 0xf8, 0x75, 0x81,
+	0x4a, 0x93, 0x27, 0xd0, 0xcd, 0xc5, 0x5c, 0xe8, 0x41, 0xb0, 0x15, 0xec, 0xdc, 0x4c, 0xfa, 0x71,
+	0x93, 0xf4, 0xa1, 0xfe, 0xbc, 0xab, 0x23, 0x09, 0xb3, 0x08, 0xb2, 0x0d, 0x5d, 0x13, 0x1c, 0xb4,
+	0x0c, 0x74, 0xdd, 0x83, 0x26, 0xcc, 0x06, 0xc9, 0x33, 0xe8, 0x4e, 0x66, 0x8b, 0xe2, 0xcb, 0xa0,
+	0x6d, 0x50, 0xdb, 0xf1, 0x99, 0xfc, 0xf8, 0x22, 0x7f, 0xfc, 0xba, 0xc6, 0x32, 0x9b, 0x42, 0x1e,
+	0x42, 0x27, 0x95, 0x05, 0x0e, 0x3a, 0x26, 0x75, 0xc3, 0x11, 0x98, 0xb4, 0x37, 0x5c, 0xcd, 0x98,
+	0x09, 0xd3, 0x3d, 0xe8, 0x9a, 0x34, 0x72, 0x07, 0x42, 0x39, 0x9d, 0x2a, 0xb4, 0xea, 0xdb, 0xac,
+	0x39, 0x11, 0x02, 0x9d, 0x94, 0x6b, 0x6e, 0x84, 0xf6, 0x98, 0xf9, 0x8f, 0xf6, 0xa1, 0xef, 0xd1,
+	0xab, 0x52, 0x16, 0x0a, 0x4f, 0x29, 0x83, 0xa5, 0x94, 0xd1, 0xcf, 0x00, 0x6e, 0x9b, 0xbb, 0x91,
+	0xfc, 0x56, 0xfc, 0x57, 0xff, 0xf6, 0x7d, 0xff, 0x1e, 0x5d, 0xf0, 0xef, 0x9c, 0x02, 0xcf, 0x41,
+	0xfa, 0x62, 0x95, 0x35, 0xf7, 0x00, 0x0c, 0xf2, 0x58, 0x89, 0x13, 0x34, 0x4a, 0xda, 0xec, 0x86,
+	0xb9, 0xf9, 0x28, 0x4e, 0x30, 0xfa, 0x1e, 0xc0, 0xe6, 0x39, 0x96, 0xc6, 0xa8, 0xe7, 0x4e, 0x97,
+	0x7d, 0xe8, 0xe3, 0x25, 0xba, 0x6c, 0x86, 0x2f, 0xec, 0x9f, 0x7a, 0x76, 0xd0, 0x8c, 0xec, 0x08,
+	0x73, 0xd4, 0xf8, 0xf7, 0x96, 0x47, 0x9b, 0xd0, 0xf7, 0x0a, 0x58, 0x65, 0xd1, 0x0c, 0xd6, 0x18,
+	0x6a, 0x2e, 0x0a, 0x57, 0xf2, 0x00, 0xd6, 0x26, 0x15, 0x72, 0x2d, 0x64, 0x71, 0x9c, 0x72, 0xed,
+	0xc6, 0x81, 0xc6, 0x76, 0xc7, 0x62, 0xb7, 0x63, 0xf1, 0x27, 0xb7, 0x63, 0xac, 0xe7, 0x12, 0x46,
+	0x5c, 0x63, 0xfd, 0xaa, 0xa9, 0xc8, 0x75, 0xd3, 0xdc, 0x1e, 0x6b, 0x4e, 0xd1, 0x2d, 0x58, 0x77,
+	0x4c, 0x96, 0x3b, 0xf9, 0xd1, 0x02, 0x38, 0x3c, 0xb5, 0x8e, 0xbc, 0x87, 0xd0, 0x4e, 0x24, 0xb9,
+	0xbf, 0x7c, 0x53, 0xe8, 0xf0, 0xca, 0x78, 0xf3, 0xaa, 0x6b, 0x3b, 0x01, 0x39, 0x82, 0xeb, 0xae,
+	0x0f, 0x64, 0x6b, 0xd5, 0xe8, 0xd0, 0x07, 0x2b, 0x9b, 0x58, 0x17, 0x7d, 0x1a, 0x90, 0xb7, 0x10,
+	0x5a, 0x0b, 0x2f, 0x51, 0xe9, 0x35, 0x87, 0x0e, 0xaf, 0x8c, 0xbb, 0x82, 0xe4, 0x25, 0x84, 0xd6,
+	0x13, 0x72, 0xf7, 0x4f, 0xb0, 0xd7, 0x11, 0x4a, 0x2f, 0x0b, 0xb9, 0x12, 0xaf, 0x3a, 0x9f, 0x5b,
+	0xe5, 0x78, 0x1c, 0x9a, 0xb6, 0xec, 0xfd, 0x1e, 0x00, 0x69, 0x06, 0x1f, 0x5e, 0x35, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -503,6 +592,7 @@ type PiecestoreClient interface {
 	Upload(ctx context.Context, opts ...grpc.CallOption) (Piecestore_UploadClient, error)
 	Download(ctx context.Context, opts ...grpc.CallOption) (Piecestore_DownloadClient, error)
 	Delete(ctx context.Context, in *PieceDeleteRequest, opts ...grpc.CallOption) (*PieceDeleteResponse, error)
+	Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error)
 }
 
 type piecestoreClient struct {
@@ -587,11 +677,21 @@ func (c *piecestoreClient) Delete(ctx context.Context, in *PieceDeleteRequest, o
 	return out, nil
 }
 
+func (c *piecestoreClient) Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error) {
+	out := new(RetainResponse)
+	err := c.cc.Invoke(ctx, "/piecestore.Piecestore/Retain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PiecestoreServer is the server API for Piecestore service.
 type PiecestoreServer interface {
 	Upload(Piecestore_UploadServer) error
 	Download(Piecestore_DownloadServer) error
 	Delete(context.Context, *PieceDeleteRequest) (*PieceDeleteResponse, error)
+	Retain(context.Context, *RetainRequest) (*RetainResponse, error)
 }
 
 func RegisterPiecestoreServer(s *grpc.Server, srv PiecestoreServer) {
@@ -668,6 +768,24 @@ func _Piecestore_Delete_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Piecestore_Retain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PiecestoreServer).Retain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestore.Piecestore/Retain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PiecestoreServer).Retain(ctx, req.(*RetainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Piecestore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "piecestore.Piecestore",
 	HandlerType: (*PiecestoreServer)(nil),
@@ -676,6 +794,10 @@ var _Piecestore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Piecestore_Delete_Handler,
 		},
+		{
+			MethodName: "Retain",
+			Handler:    _Piecestore_Retain_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -251,16 +251,19 @@ func (m *RemoteSegment) GetMerkleRoot() []byte {
 }
 
 type Pointer struct {
-	Type                 Pointer_DataType     `protobuf:"varint,1,opt,name=type,proto3,enum=pointerdb.Pointer_DataType" json:"type,omitempty"`
-	InlineSegment        []byte               `protobuf:"bytes,3,opt,name=inline_segment,json=inlineSegment,proto3" json:"inline_segment,omitempty"`
-	Remote               *RemoteSegment       `protobuf:"bytes,4,opt,name=remote,proto3" json:"remote,omitempty"`
-	SegmentSize          int64                `protobuf:"varint,5,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
-	CreationDate         *timestamp.Timestamp `protobuf:"bytes,6,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
-	ExpirationDate       *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
-	Metadata             []byte               `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Type           Pointer_DataType     `protobuf:"varint,1,opt,name=type,proto3,enum=pointerdb.Pointer_DataType" json:"type,omitempty"`
+	InlineSegment  []byte               `protobuf:"bytes,3,opt,name=inline_segment,json=inlineSegment,proto3" json:"inline_segment,omitempty"`
+	Remote         *RemoteSegment       `protobuf:"bytes,4,opt,name=remote,proto3" json:"remote,omitempty"`
+	SegmentSize    int64                `protobuf:"varint,5,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	CreationDate   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	ExpirationDate *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	Metadata       []byte               `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// shared_with are the paths of the other pointers referencing the pieces
+	// of the remote segment, the pieces are deleted only with the last of them
+	SharedWith           []string `protobuf:"bytes,9,rep,name=shared_with,json=sharedWith,proto3" json:"shared_with,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Pointer) Reset()         { *m = Pointer{} }
@@ -336,6 +339,13 @@ func (m *Pointer) GetMetadata() []byte {
 	return nil
 }
 
+func (m *Pointer) GetSharedWith() []string {
+	if m != nil {
+		return m.SharedWith
+	}
+	return nil
+}
+
 // ListResponse is a response message for the List rpc call
 type ListResponse struct {
 	Items                []*ListResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -451,50 +461,52 @@ func init() {
 func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_75fef806d28fc810) }
 
 var fileDescriptor_75fef806d28fc810 = []byte{
-	// 720 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x6e, 0xfe, 0x9c, 0xf4, 0xd8, 0xf9, 0xb9, 0xa3, 0x2b, 0xb0, 0x72, 0x91, 0x52, 0x2c, 0x5d,
-	0x28, 0xe2, 0xca, 0x45, 0xbe, 0x3b, 0xba, 0x40, 0x2a, 0xa9, 0x44, 0xa4, 0x12, 0xaa, 0x49, 0x56,
-	0x6c, 0xac, 0x49, 0x7c, 0x1a, 0x8f, 0x88, 0x3d, 0xee, 0xcc, 0x44, 0x6a, 0xfb, 0x26, 0x3c, 0x0c,
-	0x7b, 0x9e, 0x81, 0x45, 0x79, 0x11, 0x16, 0xc8, 0x33, 0x76, 0x92, 0x52, 0x89, 0xbb, 0xb1, 0xcf,
-	0xcf, 0x77, 0xfe, 0xbe, 0x73, 0x06, 0x86, 0x85, 0xe0, 0xb9, 0x46, 0x99, 0xac, 0xc2, 0x42, 0x0a,
-	0x2d, 0xc8, 0xe9, 0xde, 0x30, 0x9e, 0x6c, 0x84, 0xd8, 0x6c, 0xf1, 0xc2, 0x38, 0x56, 0xbb, 0xbb,
-	0x0b, 0xcd, 0x33, 0x54, 0x9a, 0x65, 0x85, 0xc5, 0x8e, 0x61, 0x23, 0x36, 0xa2, 0x96, 0x73, 0x91,
-	0x60, 0x25, 0x8f, 0x0a, 0x8e, 0x6b, 0x54, 0x5a, 0xc8, 0xda, 0xe2, 0x09, 0x99, 0xa0, 0x54, 0x56,
-	0x0b, 0x7e, 0x6f, 0xc2, 0x88, 0x62, 0xb2, 0xcb, 0x13, 0x96, 0xaf, 0x1f, 0x17, 0xeb, 0x14, 0x33,
-	0x24, 0xdf, 0x43, 0x5b, 0x3f, 0x16, 0xe8, 0x37, 0xce, 0x1a, 0xe7, 0x83, 0xe8, 0xab, 0xf0, 0xd0,
-	0xd8, 0x7f, 0xa1, 0xa1, 0xfd, 0x2d, 0x1f, 0x0b, 0xa4, 0x26, 0x86, 0x7c, 0x0e, 0xdd, 0x8c, 0xe7,
-	0xb1, 0xc4, 0x7b, 0xbf, 0x79, 0xd6, 0x38, 0xef, 0x50, 0x27, 0xe3, 0x39, 0xc5, 0x7b, 0xf2, 0x16,
-	0x3a, 0x5a, 0x68, 0xb6, 0xf5, 0x5b, 0xc6, 0x6c, 0x15, 0xf2, 0x0d, 0x8c, 0x24, 0x16, 0x8c, 0xcb,
-	0x58, 0xa7, 0x12, 0x55, 0x2a, 0xb6, 0x89, 0xdf, 0x36, 0x80, 0xa1, 0xb5, 0x2f, 0x6b, 0x33, 0xf9,
-	0x16, 0xde, 0xa8, 0xdd, 0x7a, 0x8d, 0x4a, 0x1d, 0x61, 0x3b, 0x06, 0x3b, 0xaa, 0x1c, 0x07, 0xf0,
-	0x07, 0x20, 0x28, 0x99, 0xda, 0x49, 0x8c, 0x55, 0xca, 0xca, 0x2f, 0x7f, 0x42, 0xdf, 0xb1, 0xe8,
-	0xca, 0xb3, 0x28, 0x1d, 0x0b, 0xfe, 0x84, 0xc1, 0x5b, 0x80, 0xc3, 0x20, 0xc4, 0x81, 0x26, 0x5d,
-	0x8c, 0x4e, 0x82, 0x27, 0x70, 0x29, 0x66, 0x42, 0xe3, 0x6d, 0xc9, 0x21, 0x79, 0x07, 0xa7, 0x86,
-	0xcc, 0x38, 0xdf, 0x65, 0x86, 0x9a, 0x0e, 0xed, 0x19, 0xc3, 0x7c, 0x97, 0x91, 0xaf, 0xa1, 0x5b,
-	0xb2, 0x1e, 0xf3, 0xc4, 0x8c, 0xed, 0x5d, 0x0d, 0xfe, 0x7c, 0x9e, 0x9c, 0xfc, 0xf5, 0x3c, 0x71,
-	0xe6, 0x22, 0xc1, 0xd9, 0x94, 0x3a, 0xa5, 0x7b, 0x96, 0x90, 0xf7, 0xd0, 0x4e, 0x99, 0x4a, 0x0d,
-	0x0b, 0x6e, 0xf4, 0x26, 0xac, 0xb6, 0x61, 0x4a, 0xfc, 0xc4, 0x54, 0x4a, 0x8d, 0x3b, 0xf8, 0xbb,
-	0x01, 0x7d, 0x5b, 0x7c, 0x81, 0x9b, 0x0c, 0x73, 0x4d, 0x2e, 0x01, 0xe4, 0x9e, 0x7d, 0x53, 0xdf,
-	0x8d, 0xde, 0xfd, 0xcf, 0x6a, 0xe8, 0x11, 0x9c, 0x7c, 0x84, 0xbe, 0x14, 0x42, 0xc7, 0x76, 0x80,
-	0x7d, 0x93, 0xc3, 0xaa, 0xc9, 0xae, 0x29, 0x3f, 0x9b, 0x52, 0xb7, 0x44, 0x59, 0x25, 0x21, 0x97,
-	0xd0, 0x97, 0xa6, 0x05, 0x1b, 0xa6, 0xfc, 0xd6, 0x59, 0xeb, 0xdc, 0x8d, 0x3e, 0x7b, 0x51, 0x74,
-	0xcf, 0x0f, 0xf5, 0xe4, 0x41, 0x51, 0x64, 0x02, 0x6e, 0x86, 0xf2, 0xb7, 0x2d, 0xc6, 0x65, 0x4a,
-	0xb3, 0x53, 0x8f, 0x82, 0x35, 0x51, 0x21, 0x74, 0xf0, 0x4f, 0x13, 0xba, 0xb7, 0x36, 0x11, 0xb9,
-	0x78, 0x71, 0x70, 0xc7, 0x53, 0x55, 0x88, 0x70, 0xca, 0x34, 0x3b, 0xba, 0xb2, 0xf7, 0x30, 0xe0,
-	0xf9, 0x96, 0xe7, 0x18, 0x2b, 0x4b, 0x8f, 0xe1, 0xd3, 0xa3, 0x7d, 0x6b, 0xad, 0x39, 0xfb, 0x0e,
-	0x1c, 0xdb, 0x94, 0xa9, 0xef, 0x46, 0xfe, 0xab, 0xd6, 0x2b, 0x24, 0xad, 0x70, 0xe4, 0x4b, 0xf0,
-	0xaa, 0x8c, 0xf6, 0x62, 0xca, 0xfb, 0x6a, 0x51, 0xb7, 0xb2, 0x95, 0xc7, 0x42, 0x7e, 0x80, 0xfe,
-	0x5a, 0x22, 0xd3, 0x5c, 0xe4, 0x71, 0xc2, 0xb4, 0xbd, 0x2a, 0x37, 0x1a, 0x87, 0xf6, 0x8d, 0x86,
-	0xf5, 0x1b, 0x0d, 0x97, 0xf5, 0x1b, 0xa5, 0x5e, 0x1d, 0x30, 0x65, 0x1a, 0xc9, 0x8f, 0x30, 0xc4,
-	0x87, 0x82, 0xcb, 0xa3, 0x14, 0xdd, 0x4f, 0xa6, 0x18, 0x1c, 0x42, 0x4c, 0x92, 0x31, 0xf4, 0x32,
-	0xd4, 0x2c, 0x61, 0x9a, 0xf9, 0x3d, 0x33, 0xfb, 0x5e, 0x0f, 0x02, 0xe8, 0xd5, 0x7c, 0x11, 0x00,
-	0x67, 0x36, 0xbf, 0x99, 0xcd, 0xaf, 0x47, 0x27, 0xa5, 0x4c, 0xaf, 0x7f, 0xfe, 0x65, 0x79, 0x3d,
-	0x6a, 0x04, 0x7f, 0x34, 0xc0, 0xbb, 0xe1, 0x4a, 0x53, 0x54, 0x85, 0xc8, 0x15, 0x92, 0x08, 0x3a,
-	0x5c, 0x63, 0xa6, 0xfc, 0x86, 0xd9, 0xf2, 0x17, 0x47, 0x54, 0x1d, 0xe3, 0xc2, 0x99, 0xc6, 0x8c,
-	0x5a, 0x28, 0x21, 0xd0, 0xce, 0x84, 0x44, 0x73, 0x4d, 0x3d, 0x6a, 0xe4, 0x31, 0x42, 0xbb, 0x84,
-	0x94, 0xbe, 0x82, 0xe9, 0xd4, 0xec, 0xf4, 0x94, 0x1a, 0x99, 0x7c, 0x80, 0x6e, 0x95, 0xd5, 0x84,
-	0xb8, 0x11, 0x79, 0xbd, 0x6a, 0x5a, 0x43, 0xca, 0x07, 0xc7, 0x55, 0x5c, 0x48, 0xbc, 0xe3, 0x0f,
-	0x66, 0xbf, 0x3d, 0xda, 0xe3, 0xea, 0xd6, 0xe8, 0x57, 0xed, 0x5f, 0x9b, 0xc5, 0x6a, 0xe5, 0x18,
-	0xa6, 0x3e, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x8e, 0xe4, 0xf2, 0x3c, 0x05, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xdd, 0x8e, 0xe3, 0x34,
+	0x14, 0x9e, 0xfe, 0xa5, 0xed, 0x49, 0xfa, 0xb3, 0xd6, 0x0a, 0xa2, 0x2e, 0x52, 0x4b, 0xa4, 0x85,
+	0x22, 0x56, 0x19, 0x94, 0xbd, 0x63, 0x2f, 0x90, 0x96, 0x8e, 0x44, 0xa5, 0xa5, 0x8c, 0xdc, 0x4a,
+	0x48, 0xdc, 0x44, 0x6e, 0x73, 0xb6, 0xb1, 0x68, 0xe2, 0xac, 0xed, 0x8a, 0x9d, 0x79, 0x13, 0x2e,
+	0x78, 0x14, 0xee, 0x79, 0x06, 0x2e, 0x96, 0x57, 0x41, 0xb1, 0x93, 0xb6, 0xc3, 0x48, 0xec, 0x4d,
+	0xe2, 0xf3, 0x9d, 0xcf, 0xe7, 0x1c, 0x7f, 0xfe, 0x0c, 0xa3, 0x42, 0xf0, 0x5c, 0xa3, 0x4c, 0xb6,
+	0x61, 0x21, 0x85, 0x16, 0xa4, 0x7f, 0x02, 0x26, 0xd3, 0xbd, 0x10, 0xfb, 0x03, 0x5e, 0x9b, 0xc4,
+	0xf6, 0xf8, 0xf6, 0x5a, 0xf3, 0x0c, 0x95, 0x66, 0x59, 0x61, 0xb9, 0x13, 0xd8, 0x8b, 0xbd, 0xa8,
+	0xd7, 0xb9, 0x48, 0xb0, 0x5a, 0x8f, 0x0b, 0x8e, 0x3b, 0x54, 0x5a, 0xc8, 0x1a, 0xf1, 0x84, 0x4c,
+	0x50, 0x2a, 0x1b, 0x05, 0xbf, 0x37, 0x61, 0x4c, 0x31, 0x39, 0xe6, 0x09, 0xcb, 0x77, 0x77, 0xeb,
+	0x5d, 0x8a, 0x19, 0x92, 0x6f, 0xa1, 0xad, 0xef, 0x0a, 0xf4, 0x1b, 0xb3, 0xc6, 0x7c, 0x18, 0x7d,
+	0x11, 0x9e, 0x07, 0xfb, 0x2f, 0x35, 0xb4, 0xbf, 0xcd, 0x5d, 0x81, 0xd4, 0xec, 0x21, 0x9f, 0x42,
+	0x37, 0xe3, 0x79, 0x2c, 0xf1, 0x9d, 0xdf, 0x9c, 0x35, 0xe6, 0x1d, 0xea, 0x64, 0x3c, 0xa7, 0xf8,
+	0x8e, 0x3c, 0x85, 0x8e, 0x16, 0x9a, 0x1d, 0xfc, 0x96, 0x81, 0x6d, 0x40, 0xbe, 0x82, 0xb1, 0xc4,
+	0x82, 0x71, 0x19, 0xeb, 0x54, 0xa2, 0x4a, 0xc5, 0x21, 0xf1, 0xdb, 0x86, 0x30, 0xb2, 0xf8, 0xa6,
+	0x86, 0xc9, 0xd7, 0xf0, 0x44, 0x1d, 0x77, 0x3b, 0x54, 0xea, 0x82, 0xdb, 0x31, 0xdc, 0x71, 0x95,
+	0x38, 0x93, 0x5f, 0x00, 0x41, 0xc9, 0xd4, 0x51, 0x62, 0xac, 0x52, 0x56, 0x7e, 0xf9, 0x3d, 0xfa,
+	0x8e, 0x65, 0x57, 0x99, 0x75, 0x99, 0x58, 0xf3, 0x7b, 0x0c, 0x9e, 0x02, 0x9c, 0x0f, 0x42, 0x1c,
+	0x68, 0xd2, 0xf5, 0xf8, 0x2a, 0xb8, 0x07, 0x97, 0x62, 0x26, 0x34, 0xde, 0x96, 0x1a, 0x92, 0x67,
+	0xd0, 0x37, 0x62, 0xc6, 0xf9, 0x31, 0x33, 0xd2, 0x74, 0x68, 0xcf, 0x00, 0xab, 0x63, 0x46, 0xbe,
+	0x84, 0x6e, 0xa9, 0x7a, 0xcc, 0x13, 0x73, 0x6c, 0xef, 0xf5, 0xf0, 0xaf, 0x0f, 0xd3, 0xab, 0xbf,
+	0x3f, 0x4c, 0x9d, 0x95, 0x48, 0x70, 0xb9, 0xa0, 0x4e, 0x99, 0x5e, 0x26, 0xe4, 0x39, 0xb4, 0x53,
+	0xa6, 0x52, 0xa3, 0x82, 0x1b, 0x3d, 0x09, 0xab, 0xdb, 0x30, 0x2d, 0x7e, 0x60, 0x2a, 0xa5, 0x26,
+	0x1d, 0xfc, 0xd3, 0x80, 0x81, 0x6d, 0xbe, 0xc6, 0x7d, 0x86, 0xb9, 0x26, 0xaf, 0x00, 0xe4, 0x49,
+	0x7d, 0xd3, 0xdf, 0x8d, 0x9e, 0xfd, 0xcf, 0xd5, 0xd0, 0x0b, 0x3a, 0x79, 0x09, 0x03, 0x29, 0x84,
+	0x8e, 0xed, 0x01, 0x4e, 0x43, 0x8e, 0xaa, 0x21, 0xbb, 0xa6, 0xfd, 0x72, 0x41, 0xdd, 0x92, 0x65,
+	0x83, 0x84, 0xbc, 0x82, 0x81, 0x34, 0x23, 0xd8, 0x6d, 0xca, 0x6f, 0xcd, 0x5a, 0x73, 0x37, 0xfa,
+	0xe4, 0x41, 0xd3, 0x93, 0x3e, 0xd4, 0x93, 0xe7, 0x40, 0x91, 0x29, 0xb8, 0x19, 0xca, 0x5f, 0x0f,
+	0x18, 0x97, 0x25, 0xcd, 0x9d, 0x7a, 0x14, 0x2c, 0x44, 0x85, 0xd0, 0xc1, 0x1f, 0x2d, 0xe8, 0xde,
+	0xda, 0x42, 0xe4, 0xfa, 0x81, 0xe1, 0x2e, 0x4f, 0x55, 0x31, 0xc2, 0x05, 0xd3, 0xec, 0xc2, 0x65,
+	0xcf, 0x61, 0xc8, 0xf3, 0x03, 0xcf, 0x31, 0x56, 0x56, 0x1e, 0xa3, 0xa7, 0x47, 0x07, 0x16, 0xad,
+	0x35, 0xfb, 0x06, 0x1c, 0x3b, 0x94, 0xe9, 0xef, 0x46, 0xfe, 0xa3, 0xd1, 0x2b, 0x26, 0xad, 0x78,
+	0xe4, 0x73, 0xf0, 0xaa, 0x8a, 0xd6, 0x31, 0xa5, 0xbf, 0x5a, 0xd4, 0xad, 0xb0, 0xd2, 0x2c, 0xe4,
+	0x3b, 0x18, 0xec, 0x24, 0x32, 0xcd, 0x45, 0x1e, 0x27, 0x4c, 0x5b, 0x57, 0xb9, 0xd1, 0x24, 0xb4,
+	0x6f, 0x34, 0xac, 0xdf, 0x68, 0xb8, 0xa9, 0xdf, 0x28, 0xf5, 0xea, 0x0d, 0x0b, 0xa6, 0x91, 0x7c,
+	0x0f, 0x23, 0x7c, 0x5f, 0x70, 0x79, 0x51, 0xa2, 0xfb, 0xd1, 0x12, 0xc3, 0xf3, 0x16, 0x53, 0x64,
+	0x02, 0xbd, 0x0c, 0x35, 0x4b, 0x98, 0x66, 0x7e, 0xcf, 0x9c, 0xfd, 0x14, 0x97, 0xda, 0x1b, 0xd3,
+	0x27, 0xf1, 0x6f, 0x5c, 0xa7, 0x7e, 0x7f, 0xd6, 0x9a, 0xf7, 0x29, 0x58, 0xe8, 0x67, 0xae, 0xd3,
+	0x20, 0x80, 0x5e, 0x2d, 0x28, 0x01, 0x70, 0x96, 0xab, 0x37, 0xcb, 0xd5, 0xcd, 0xf8, 0xaa, 0x5c,
+	0xd3, 0x9b, 0x1f, 0x7f, 0xda, 0xdc, 0x8c, 0x1b, 0xc1, 0x9f, 0x0d, 0xf0, 0xde, 0x70, 0xa5, 0x29,
+	0xaa, 0x42, 0xe4, 0x0a, 0x49, 0x04, 0x1d, 0xae, 0x31, 0x53, 0x7e, 0xc3, 0xd8, 0xe0, 0xb3, 0x0b,
+	0x2d, 0x2f, 0x79, 0xe1, 0x52, 0x63, 0x46, 0x2d, 0x95, 0x10, 0x68, 0x67, 0x42, 0xa2, 0xb1, 0x5b,
+	0x8f, 0x9a, 0xf5, 0x04, 0xa1, 0x5d, 0x52, 0xca, 0x5c, 0xc1, 0x74, 0x6a, 0x2e, 0xbd, 0x4f, 0xcd,
+	0x9a, 0xbc, 0x80, 0x6e, 0x55, 0xd5, 0x6c, 0x71, 0x23, 0xf2, 0xd8, 0x0b, 0xb4, 0xa6, 0x94, 0x2f,
+	0x92, 0xab, 0xb8, 0x90, 0xf8, 0x96, 0xbf, 0x37, 0x06, 0xe8, 0xd1, 0x1e, 0x57, 0xb7, 0x26, 0x7e,
+	0xdd, 0xfe, 0xa5, 0x59, 0x6c, 0xb7, 0x8e, 0x91, 0xf2, 0xe5, 0xbf, 0x03, 0x00, 0xaa, 0x9a, 0x2a,
+	0x13, 0x5d, 0x05, 0x00, 0x00,
 }
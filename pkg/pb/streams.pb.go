@@ -21,8 +21,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type SegmentMeta struct {
-	EncryptedKey         []byte   `protobuf:"bytes,1,opt,name=encrypted_key,json=encryptedKey,proto3" json:"encrypted_key,omitempty"`
-	KeyNonce             []byte   `protobuf:"bytes,2,opt,name=key_nonce,json=keyNonce,proto3" json:"key_nonce,omitempty"`
+	EncryptedKey []byte `protobuf:"bytes,1,opt,name=encrypted_key,json=encryptedKey,proto3" json:"encrypted_key,omitempty"`
+	KeyNonce     []byte `protobuf:"bytes,2,opt,name=key_nonce,json=keyNonce,proto3" json:"key_nonce,omitempty"`
+	// content_nonce is the starting nonce of the segment content,
+	// when it differs from the one derived from the segment index
+	ContentNonce []byte `protobuf:"bytes,3,opt,name=content_nonce,json=contentNonce,proto3" json:"content_nonce,omitempty"`
+	// encrypted_checksum is the SHA-256 of the segment content, encrypted
+	// with the content key and the zero nonce, to verify resumed uploads
+	EncryptedChecksum    []byte   `protobuf:"bytes,4,opt,name=encrypted_checksum,json=encryptedChecksum,proto3" json:"encrypted_checksum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -66,11 +72,28 @@ func (m *SegmentMeta) GetKeyNonce() []byte {
 	return nil
 }
 
+func (m *SegmentMeta) GetContentNonce() []byte {
+	if m != nil {
+		return m.ContentNonce
+	}
+	return nil
+}
+
+func (m *SegmentMeta) GetEncryptedChecksum() []byte {
+	if m != nil {
+		return m.EncryptedChecksum
+	}
+	return nil
+}
+
 type StreamInfo struct {
-	NumberOfSegments     int64    `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
-	SegmentsSize         int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
-	LastSegmentSize      int64    `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
-	Metadata             []byte   `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	NumberOfSegments int64  `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
+	SegmentsSize     int64  `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
+	LastSegmentSize  int64  `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
+	Metadata         []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// segment_sizes lists the size of each segment,
+	// when segments are not all of size segments_size
+	SegmentSizes         []int64  `protobuf:"varint,5,rep,packed,name=segment_sizes,json=segmentSizes,proto3" json:"segment_sizes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -128,14 +151,24 @@ func (m *StreamInfo) GetMetadata() []byte {
 	return nil
 }
 
+func (m *StreamInfo) GetSegmentSizes() []int64 {
+	if m != nil {
+		return m.SegmentSizes
+	}
+	return nil
+}
+
 type StreamMeta struct {
-	EncryptedStreamInfo  []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
-	EncryptionType       int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
-	EncryptionBlockSize  int32        `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
-	LastSegmentMeta      *SegmentMeta `protobuf:"bytes,4,opt,name=last_segment_meta,json=lastSegmentMeta,proto3" json:"last_segment_meta,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	EncryptedStreamInfo []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
+	EncryptionType      int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
+	EncryptionBlockSize int32        `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
+	LastSegmentMeta     *SegmentMeta `protobuf:"bytes,4,opt,name=last_segment_meta,json=lastSegmentMeta,proto3" json:"last_segment_meta,omitempty"`
+	// stream_info_nonce is the nonce used for encrypting the stream info,
+	// when it differs from the zero nonce
+	StreamInfoNonce      []byte   `protobuf:"bytes,5,opt,name=stream_info_nonce,json=streamInfoNonce,proto3" json:"stream_info_nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StreamMeta) Reset()         { *m = StreamMeta{} }
@@ -190,6 +223,13 @@ func (m *StreamMeta) GetLastSegmentMeta() *SegmentMeta {
 	return nil
 }
 
+func (m *StreamMeta) GetStreamInfoNonce() []byte {
+	if m != nil {
+		return m.StreamInfoNonce
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SegmentMeta)(nil), "streams.SegmentMeta")
 	proto.RegisterType((*StreamInfo)(nil), "streams.StreamInfo")
@@ -199,24 +239,28 @@ func init() {
 func init() { proto.RegisterFile("streams.proto", fileDescriptor_c6bbf8af0ec331d6) }
 
 var fileDescriptor_c6bbf8af0ec331d6 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xcb, 0x4e, 0xc3, 0x30,
-	0x10, 0x54, 0x5f, 0x50, 0xb6, 0x29, 0x05, 0x03, 0x52, 0x04, 0x17, 0x14, 0x0e, 0x20, 0x84, 0x7a,
-	0x28, 0x3f, 0x80, 0x7a, 0x43, 0x08, 0x2a, 0x25, 0x9c, 0xb8, 0x58, 0x4e, 0xba, 0x41, 0x51, 0x1a,
-	0x3b, 0x8a, 0xcd, 0xc1, 0xfd, 0x21, 0x3e, 0x8b, 0x5f, 0x41, 0xb6, 0xf3, 0x82, 0xe3, 0xce, 0x8c,
-	0x66, 0x67, 0x76, 0x61, 0x2e, 0x55, 0x85, 0xac, 0x90, 0xcb, 0xb2, 0x12, 0x4a, 0x90, 0xc3, 0x7a,
-	0x0c, 0x36, 0x30, 0x8b, 0xf0, 0xb3, 0x40, 0xae, 0x5e, 0x51, 0x31, 0x72, 0x03, 0x73, 0xe4, 0x49,
-	0xa5, 0x4b, 0x85, 0x5b, 0x9a, 0xa3, 0xf6, 0x07, 0xd7, 0x83, 0x3b, 0x2f, 0xf4, 0x5a, 0xf0, 0x05,
-	0x35, 0xb9, 0x82, 0xa3, 0x1c, 0x35, 0xe5, 0x82, 0x27, 0xe8, 0x0f, 0xad, 0x60, 0x9a, 0xa3, 0x7e,
-	0x33, 0x73, 0xf0, 0x3d, 0x00, 0x88, 0xac, 0xf9, 0x33, 0x4f, 0x05, 0x79, 0x00, 0xc2, 0xbf, 0x8a,
-	0x18, 0x2b, 0x2a, 0x52, 0x2a, 0xdd, 0x26, 0x69, 0x5d, 0x47, 0xe1, 0x89, 0x63, 0x36, 0x69, 0x9d,
-	0x40, 0x9a, 0xf5, 0x8d, 0x86, 0xca, 0x6c, 0xef, 0xdc, 0x47, 0xa1, 0xd7, 0x80, 0x51, 0xb6, 0x47,
-	0x72, 0x0f, 0xa7, 0x3b, 0x26, 0x55, 0xe3, 0xe6, 0x84, 0x23, 0x2b, 0x5c, 0x18, 0xa2, 0x76, 0xb3,
-	0xda, 0x4b, 0x98, 0x16, 0xa8, 0xd8, 0x96, 0x29, 0xe6, 0x8f, 0x5d, 0xd2, 0x66, 0x0e, 0x7e, 0xda,
-	0xa4, 0xb6, 0xfa, 0x0a, 0x2e, 0xba, 0xea, 0xee, 0x3c, 0x34, 0xe3, 0xa9, 0xa8, 0x4f, 0x70, 0xd6,
-	0x92, 0xbd, 0x76, 0xb7, 0xb0, 0xa8, 0xe1, 0x4c, 0x70, 0xaa, 0x74, 0xe9, 0x12, 0x4f, 0xc2, 0xe3,
-	0x0e, 0x7e, 0xd7, 0x25, 0xf6, 0xcc, 0x8d, 0x30, 0xde, 0x89, 0x24, 0xef, 0x72, 0x4f, 0x5a, 0xf3,
-	0x4c, 0xf0, 0xb5, 0xe1, 0x6c, 0xf6, 0xa7, 0x7f, 0x3d, 0x4d, 0x70, 0x5b, 0x62, 0xb6, 0x3a, 0x5f,
-	0x36, 0xef, 0xec, 0x3d, 0xef, 0x4f, 0x7b, 0x03, 0xac, 0xc7, 0x1f, 0xc3, 0x32, 0x8e, 0x0f, 0xec,
-	0xcb, 0x1f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x5e, 0x32, 0x31, 0x03, 0x02, 0x00, 0x00,
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0x4d, 0x4e, 0xf3, 0x30,
+	0x14, 0x54, 0x9a, 0xe6, 0xfb, 0x8a, 0xdb, 0x52, 0x6a, 0x40, 0x8a, 0x60, 0x53, 0x95, 0x05, 0xa8,
+	0x82, 0x2e, 0xca, 0x05, 0x50, 0x59, 0x21, 0x04, 0x48, 0x09, 0x2b, 0x36, 0x56, 0x92, 0xbe, 0x40,
+	0x94, 0xc6, 0x8e, 0x62, 0x77, 0xe1, 0x5e, 0x81, 0x63, 0x70, 0x1d, 0x0e, 0x85, 0xfc, 0x93, 0x1f,
+	0x58, 0xfa, 0xcd, 0x64, 0xde, 0xcc, 0xe4, 0xa1, 0x31, 0x17, 0x15, 0x44, 0x05, 0x5f, 0x96, 0x15,
+	0x13, 0x0c, 0xff, 0xb7, 0xcf, 0xf9, 0x97, 0x83, 0x86, 0x21, 0xbc, 0x17, 0x40, 0xc5, 0x13, 0x88,
+	0x08, 0x5f, 0xa0, 0x31, 0xd0, 0xa4, 0x92, 0xa5, 0x80, 0x0d, 0xc9, 0x41, 0xfa, 0xce, 0xcc, 0xb9,
+	0x1a, 0x05, 0xa3, 0x66, 0xf8, 0x08, 0x12, 0x9f, 0xa3, 0x83, 0x1c, 0x24, 0xa1, 0x8c, 0x26, 0xe0,
+	0xf7, 0x34, 0x61, 0x90, 0x83, 0x7c, 0x56, 0x6f, 0xa5, 0x90, 0x30, 0x2a, 0x80, 0x0a, 0x4b, 0x70,
+	0x8d, 0x82, 0x1d, 0x1a, 0xd2, 0x0d, 0xc2, 0xed, 0x9a, 0xe4, 0x03, 0x92, 0x9c, 0xef, 0x0a, 0xbf,
+	0xaf, 0x99, 0xd3, 0x06, 0xb9, 0xb7, 0xc0, 0xfc, 0xdb, 0x41, 0x28, 0xd4, 0x8e, 0x1f, 0x68, 0xca,
+	0xf0, 0x35, 0xc2, 0x74, 0x57, 0xc4, 0x50, 0x11, 0x96, 0x12, 0x6e, 0xdc, 0x73, 0xed, 0xd4, 0x0d,
+	0x8e, 0x0c, 0xf2, 0x92, 0xda, 0x54, 0x5c, 0x19, 0xaa, 0x39, 0x84, 0x67, 0x7b, 0xe3, 0xd8, 0x0d,
+	0x46, 0xf5, 0x30, 0xcc, 0xf6, 0x80, 0x17, 0x68, 0xba, 0x8d, 0xb8, 0xa8, 0xd5, 0x0c, 0xd1, 0xd5,
+	0xc4, 0x89, 0x02, 0xac, 0x9a, 0xe6, 0x9e, 0xa1, 0x41, 0x01, 0x22, 0xda, 0x44, 0x22, 0xb2, 0x96,
+	0x9b, 0x77, 0x67, 0x99, 0x96, 0xe0, 0xbe, 0x37, 0x73, 0x3b, 0xcb, 0xd4, 0xf7, 0x7c, 0xfe, 0xd9,
+	0xab, 0xe3, 0xe8, 0xce, 0x57, 0xe8, 0xb4, 0x2d, 0xc3, 0xfc, 0x18, 0x92, 0xd1, 0x94, 0xd9, 0xee,
+	0x8f, 0x1b, 0xb0, 0x53, 0xc1, 0x25, 0x9a, 0xd8, 0x71, 0xc6, 0x28, 0x11, 0xb2, 0x34, 0xb1, 0xbc,
+	0xe0, 0xb0, 0x1d, 0xbf, 0xca, 0x12, 0x3a, 0xe2, 0x8a, 0x18, 0x6f, 0x59, 0x92, 0xb7, 0xe1, 0xbc,
+	0x46, 0x3c, 0x63, 0x74, 0xad, 0x30, 0x1d, 0xf0, 0xee, 0x4f, 0x19, 0x05, 0xd8, 0xa4, 0xc3, 0xd5,
+	0xc9, 0xb2, 0x3e, 0xa4, 0xce, 0xd5, 0xfc, 0xaa, 0x48, 0x47, 0x5a, 0xa0, 0x69, 0x27, 0x88, 0x3d,
+	0x04, 0x4f, 0xc7, 0x99, 0xf0, 0x26, 0x85, 0xbe, 0x85, 0x75, 0xff, 0xad, 0x57, 0xc6, 0xf1, 0x3f,
+	0x7d, 0x98, 0xb7, 0x3f, 0x03, 0x00, 0xff, 0x5c, 0x36, 0xab, 0xa9, 0x02, 0x00, 0x00,
 }
@@ -11,6 +11,7 @@ import (
 
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
 )
 
@@ -45,20 +46,311 @@ func (s *Service) Put(path string, pointer *pb.Pointer) (err error) {
 	return nil
 }
 
-// Get gets pointer from db
-func (s *Service) Get(path string) (pointer *pb.Pointer, err error) {
-	pointerBytes, err := s.DB.Get([]byte(path))
+// Replace puts pointer to db under specific path, keeping its creation date
+func (s *Service) Replace(path string, pointer *pb.Pointer) (err error) {
+	pointerBytes, err := proto.Marshal(pointer)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	pointer = &pb.Pointer{}
-	err = proto.Unmarshal(pointerBytes, pointer)
+	return s.DB.Put([]byte(path), pointerBytes)
+}
+
+// Move moves the pointer at oldPath to newPath with new metadata, keeping
+// its creation date. The pointers sharing its pieces record the new path.
+func (s *Service) Move(oldPath, newPath string, metadata []byte) (moved *pb.Pointer, err error) {
+	for {
+		oldBytes, pointer, err := s.get(oldPath)
+		if err != nil {
+			return nil, err
+		}
+		pointer.Metadata = metadata
+
+		if oldPath == newPath {
+			err = s.swap(oldPath, oldBytes, pointer)
+		} else {
+			if err := s.Replace(newPath, pointer); err != nil {
+				return nil, err
+			}
+			if isRemote(pointer) {
+				for _, sharer := range pointer.SharedWith {
+					_, err := s.updateSharedWith(sharer, pointer.Remote.RootPieceId, func(sharedWith []string) []string {
+						return addPath(removePath(sharedWith, oldPath), newPath)
+					})
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			// the pointer is removed only if it wasn't changed meanwhile, so
+			// the copies made during the move aren't lost
+			err = s.DB.CompareAndSwap([]byte(oldPath), oldBytes, nil)
+			if storage.ErrValueChanged.Has(err) {
+				if _, getErr := s.DB.Get([]byte(oldPath)); storage.ErrKeyNotFound.Has(getErr) {
+					// the pointer was deleted during the move
+					_, _, releaseErr := s.Release(newPath)
+					return nil, errs.Combine(getErr, releaseErr)
+				}
+			}
+		}
+
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return pointer, nil
+	}
+}
+
+// Copy puts a copy of the pointer at path to newPath with new metadata. A
+// copy of a remote segment references the same pieces, so all the pointers
+// referencing the pieces record the paths of each other.
+func (s *Service) Copy(path, newPath string, metadata []byte) (copied *pb.Pointer, err error) {
+	pointerBytes, pointer, err := s.get(path)
 	if err != nil {
+		return nil, err
+	}
+
+	copied = &pb.Pointer{}
+	if err := proto.Unmarshal(pointerBytes, copied); err != nil {
 		return nil, errs.New("error unmarshaling pointer: %v", err)
 	}
+	copied.Metadata = metadata
+	if !isRemote(pointer) {
+		return copied, s.Put(newPath, copied)
+	}
+
+	rootPieceID := pointer.Remote.RootPieceId
+	copied.SharedWith = addPath(removePath(copied.SharedWith, newPath), path)
+	if err := s.Put(newPath, copied); err != nil {
+		return nil, err
+	}
 
-	return pointer, nil
+	// the copy is recorded by the copied pointer only once the copy exists,
+	// so a concurrent release of the copied pointer either sees the copy or
+	// the copy is undone
+	source, err := s.update(path, func(source *pb.Pointer) bool {
+		if !sharesPieces(source, rootPieceID) {
+			return false
+		}
+		source.SharedWith = addPath(source.SharedWith, newPath)
+		return true
+	})
+	if err == nil && !sharesPieces(source, rootPieceID) {
+		err = storage.ErrKeyNotFound.New("%s", path)
+	}
+	if err != nil {
+		_, _, releaseErr := s.Release(newPath)
+		return nil, errs.Combine(err, releaseErr)
+	}
+
+	// the pointers sharing the pieces since the copy was created are added
+	sharers := removePath(source.SharedWith, newPath)
+	copied, err = s.update(newPath, func(copied *pb.Pointer) (changed bool) {
+		for _, sharer := range sharers {
+			if !containsPath(copied.SharedWith, sharer) {
+				copied.SharedWith = append(copied.SharedWith, sharer)
+				changed = true
+			}
+		}
+		return changed
+	})
+	if err != nil {
+		return nil, err
+	}
+	if copied == nil {
+		return nil, storage.ErrKeyNotFound.New("%s", newPath)
+	}
+
+	for _, sharer := range sharers {
+		_, err := s.updateSharedWith(sharer, rootPieceID, func(sharedWith []string) []string {
+			return addPath(sharedWith, newPath)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return copied, nil
+}
+
+// Release deletes the pointer at path and removes it from the pointers
+// sharing its pieces. It returns the deleted pointer and whether its pieces
+// are still referenced by other pointers, in which case they must not be
+// deleted from the nodes.
+func (s *Service) Release(path string) (pointer *pb.Pointer, shared bool, err error) {
+	for {
+		var oldBytes []byte
+		oldBytes, pointer, err = s.get(path)
+		if err != nil {
+			return nil, false, err
+		}
+
+		err = s.DB.CompareAndSwap([]byte(path), oldBytes, nil)
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		break
+	}
+
+	if !isRemote(pointer) {
+		return pointer, false, nil
+	}
+
+	for _, sharer := range pointer.SharedWith {
+		found, err := s.updateSharedWith(sharer, pointer.Remote.RootPieceId, func(sharedWith []string) []string {
+			return removePath(sharedWith, path)
+		})
+		if err != nil {
+			return pointer, true, err
+		}
+		shared = shared || found
+	}
+
+	return pointer, shared, nil
+}
+
+// UpdatePieces sets the remote pieces of the pointer at path and of the
+// pointers sharing its pieces, after they were repaired. Only the pointers
+// still referencing the pieces of rootPieceID are updated, keeping their
+// other fields.
+func (s *Service) UpdatePieces(path string, rootPieceID storj.PieceID, pieces []*pb.RemotePiece) (err error) {
+	setPieces := func(pointer *pb.Pointer) bool {
+		if !sharesPieces(pointer, rootPieceID) {
+			return false
+		}
+		pointer.Remote.RemotePieces = pieces
+		return true
+	}
+
+	pointer, err := s.update(path, setPieces)
+	if err != nil || !sharesPieces(pointer, rootPieceID) {
+		return err
+	}
+
+	for _, sharer := range pointer.SharedWith {
+		if _, err := s.update(sharer, setPieces); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateSharedWith updates the paths sharing the pieces of the pointer at
+// path, it returns whether the pointer exists and references the pieces of
+// rootPieceID
+func (s *Service) updateSharedWith(path string, rootPieceID storj.PieceID, update func(sharedWith []string) []string) (found bool, err error) {
+	pointer, err := s.update(path, func(pointer *pb.Pointer) bool {
+		if !sharesPieces(pointer, rootPieceID) {
+			return false
+		}
+		pointer.SharedWith = update(pointer.SharedWith)
+		return true
+	})
+	return sharesPieces(pointer, rootPieceID), err
+}
+
+// update atomically updates the pointer at path with fn, which returns
+// whether it changed the pointer. fn is called again with the current pointer
+// if the pointer is changed concurrently. The updated pointer is returned,
+// it's nil if the pointer doesn't exist.
+func (s *Service) update(path string, fn func(pointer *pb.Pointer) (changed bool)) (pointer *pb.Pointer, err error) {
+	for {
+		oldBytes, pointer, err := s.get(path)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		if !fn(pointer) {
+			return pointer, nil
+		}
+
+		err = s.swap(path, oldBytes, pointer)
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return pointer, nil
+	}
+}
+
+// swap replaces the pointer at path with pointer if its value is still oldBytes
+func (s *Service) swap(path string, oldBytes []byte, pointer *pb.Pointer) error {
+	newBytes, err := proto.Marshal(pointer)
+	if err != nil {
+		return err
+	}
+	return s.DB.CompareAndSwap([]byte(path), oldBytes, newBytes)
+}
+
+// get returns the pointer at path and its marshaled value
+func (s *Service) get(path string) (pointerBytes []byte, pointer *pb.Pointer, err error) {
+	pointerBytes, err = s.DB.Get([]byte(path))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pointer = &pb.Pointer{}
+	if err := proto.Unmarshal(pointerBytes, pointer); err != nil {
+		return nil, nil, errs.New("error unmarshaling pointer: %v", err)
+	}
+	return pointerBytes, pointer, nil
+}
+
+// isRemote checks if the pointer references pieces on the storage nodes
+func isRemote(pointer *pb.Pointer) bool {
+	return pointer != nil && pointer.Type == pb.Pointer_REMOTE && pointer.Remote != nil
+}
+
+// sharesPieces checks if the pointer references the pieces of rootPieceID
+func sharesPieces(pointer *pb.Pointer, rootPieceID storj.PieceID) bool {
+	return isRemote(pointer) && pointer.Remote.RootPieceId == rootPieceID
+}
+
+// containsPath checks if paths contains path
+func containsPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// addPath adds path to paths, if it isn't there yet
+func addPath(paths []string, path string) []string {
+	if containsPath(paths, path) {
+		return paths
+	}
+	return append(paths, path)
+}
+
+// removePath removes path from paths
+func removePath(paths []string, path string) []string {
+	kept := paths[:0:0]
+	for _, p := range paths {
+		if p != path {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
+
+// Get gets pointer from db
+func (s *Service) Get(path string) (pointer *pb.Pointer, err error) {
+	_, pointer, err = s.get(path)
+	return pointer, err
 }
 
 // List returns all Path keys in the pointers bucket
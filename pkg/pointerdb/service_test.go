@@ -0,0 +1,160 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb_test
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestSharedPieces(t *testing.T) {
+	service := pointerdb.NewService(zaptest.NewLogger(t), teststore.New())
+
+	remote := func(nodes ...string) *pb.Pointer {
+		pointer := &pb.Pointer{
+			Type:   pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{RootPieceId: teststorj.PieceIDFromString("root")},
+		}
+		for i, node := range nodes {
+			pointer.Remote.RemotePieces = append(pointer.Remote.RemotePieces, &pb.RemotePiece{
+				PieceNum: int32(i),
+				NodeId:   teststorj.NodeIDFromString(node),
+			})
+		}
+		return pointer
+	}
+
+	nodes := func(pointer *pb.Pointer) (ids storj.NodeIDList) {
+		for _, piece := range pointer.Remote.RemotePieces {
+			ids = append(ids, piece.NodeId)
+		}
+		return ids
+	}
+
+	get := func(path string) *pb.Pointer {
+		t.Helper()
+		pointer, err := service.Get(path)
+		require.NoError(t, err)
+		return pointer
+	}
+
+	require.NoError(t, service.Put("a", remote("node1", "node2")))
+
+	copied, err := service.Copy("a", "b", []byte("metadata"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("metadata"), copied.Metadata)
+	assert.Equal(t, []string{"a"}, copied.SharedWith)
+
+	_, err = service.Copy("b", "c", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"b", "c"}, get("a").SharedWith)
+	assert.Equal(t, []string{"a", "c"}, get("b").SharedWith)
+	assert.Equal(t, []string{"a", "b"}, get("c").SharedWith)
+
+	// moving a copy updates the references of the others
+	moved, err := service.Move("c", "d", []byte("moved"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("moved"), moved.Metadata)
+	assert.Equal(t, []string{"b", "d"}, get("a").SharedWith)
+	assert.Equal(t, []string{"a", "d"}, get("b").SharedWith)
+	_, err = service.Get("c")
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	// repairing a copy repairs the others, keeping their other fields
+	repaired := remote("node1", "node3")
+	require.NoError(t, service.UpdatePieces("a", repaired.Remote.RootPieceId, repaired.Remote.RemotePieces))
+	assert.Equal(t, nodes(repaired), nodes(get("a")))
+	assert.Equal(t, nodes(repaired), nodes(get("b")))
+	assert.Equal(t, nodes(repaired), nodes(get("d")))
+	assert.Equal(t, []string{"b", "d"}, get("a").SharedWith)
+	assert.Equal(t, []byte("moved"), get("d").Metadata)
+
+	// the pieces are released only with the last copy
+	released, shared, err := service.Release("a")
+	require.NoError(t, err)
+	assert.True(t, shared)
+	assert.Equal(t, nodes(repaired), nodes(released))
+
+	_, shared, err = service.Release("d")
+	require.NoError(t, err)
+	assert.True(t, shared)
+	assert.Empty(t, get("b").SharedWith)
+
+	_, shared, err = service.Release("b")
+	require.NoError(t, err)
+	assert.False(t, shared)
+
+	_, err = service.Get("b")
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	_, _, err = service.Release("b")
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+}
+
+func TestSharedPiecesConcurrently(t *testing.T) {
+	service := pointerdb.NewService(zaptest.NewLogger(t), teststore.New())
+
+	rootPieceID := teststorj.PieceIDFromString("root")
+	require.NoError(t, service.Put("a", &pb.Pointer{
+		Type:   pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{RootPieceId: rootPieceID},
+	}))
+
+	const copies = 10
+	var group errgroup.Group
+	for i := 0; i < copies; i++ {
+		path := fmt.Sprintf("copy%d", i)
+		group.Go(func() error {
+			_, err := service.Copy("a", path, nil)
+			return err
+		})
+	}
+	// the repair of the copied pointer doesn't lose the concurrent copies
+	group.Go(func() error {
+		return service.UpdatePieces("a", rootPieceID, []*pb.RemotePiece{
+			{PieceNum: 1, NodeId: teststorj.NodeIDFromString("node1")},
+		})
+	})
+	require.NoError(t, group.Wait())
+
+	pointer, err := service.Get("a")
+	require.NoError(t, err)
+	assert.Len(t, pointer.SharedWith, copies)
+	assert.Len(t, pointer.Remote.RemotePieces, 1)
+
+	// releasing every pointer concurrently leaves exactly one of them
+	// deleting the pieces
+	paths := []string{"a"}
+	for i := 0; i < copies; i++ {
+		paths = append(paths, fmt.Sprintf("copy%d", i))
+	}
+
+	var unshared int32
+	for _, path := range paths {
+		path := path
+		group.Go(func() error {
+			_, shared, err := service.Release(path)
+			if !shared {
+				atomic.AddInt32(&unshared, 1)
+			}
+			return err
+		})
+	}
+	require.NoError(t, group.Wait())
+	assert.Equal(t, int32(1), unshared)
+}
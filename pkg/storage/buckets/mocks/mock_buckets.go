@@ -102,3 +102,16 @@ func (m *MockStore) Put(arg0 context.Context, arg1 string, arg2 storj.Cipher) (b
 func (mr *MockStoreMockRecorder) Put(arg0, arg1, arg2 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), arg0, arg1, arg2)
 }
+
+// SetVersioning mocks base method
+func (m *MockStore) SetVersioning(arg0 context.Context, arg1 string, arg2 bool) (buckets.Meta, error) {
+	ret := m.ctrl.Call(m, "SetVersioning", arg0, arg1, arg2)
+	ret0, _ := ret[0].(buckets.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetVersioning indicates an expected call of SetVersioning
+func (mr *MockStoreMockRecorder) SetVersioning(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVersioning", reflect.TypeOf((*MockStore)(nil).SetVersioning), arg0, arg1, arg2)
+}
@@ -26,6 +26,7 @@ var mon = monkit.Package()
 type Store interface {
 	Get(ctx context.Context, bucket string) (meta Meta, err error)
 	Put(ctx context.Context, bucket string, pathCipher storj.Cipher) (meta Meta, err error)
+	SetVersioning(ctx context.Context, bucket string, enabled bool) (meta Meta, err error)
 	Delete(ctx context.Context, bucket string) (err error)
 	List(ctx context.Context, startAfter, endBefore string, limit int) (items []ListItem, more bool, err error)
 	GetObjectStore(ctx context.Context, bucketName string) (store objects.Store, err error)
@@ -50,6 +51,7 @@ type Meta struct {
 	SegmentsSize       int64
 	RedundancyScheme   storj.RedundancyScheme
 	EncryptionScheme   storj.EncryptionScheme
+	Versioning         bool
 }
 
 // NewStore instantiates BucketStore
@@ -110,10 +112,29 @@ func (b *BucketStore) Put(ctx context.Context, bucket string, pathCipher storj.C
 		return Meta{}, encryption.ErrInvalidConfig.New("encryption type %d is not supported", pathCipher)
 	}
 
+	return b.put(ctx, bucket, pathCipher, false)
+}
+
+// SetVersioning enables or suspends versioning of the objects in the bucket
+func (b *BucketStore) SetVersioning(ctx context.Context, bucket string, enabled bool) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	m, err := b.Get(ctx, bucket)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	return b.put(ctx, bucket, m.PathEncryptionType, enabled)
+}
+
+func (b *BucketStore) put(ctx context.Context, bucket string, pathCipher storj.Cipher, versioning bool) (meta Meta, err error) {
 	r := bytes.NewReader(nil)
 	userMeta := map[string]string{
 		"path-enc-type": strconv.Itoa(int(pathCipher)),
 	}
+	if versioning {
+		userMeta["versioning"] = strconv.FormatBool(versioning)
+	}
 	var exp time.Time
 	m, err := b.store.Put(ctx, bucket, r, pb.SerializableMeta{UserDefined: userMeta}, exp)
 	if err != nil {
@@ -182,11 +203,21 @@ func convertMeta(m objects.Meta) (Meta, error) {
 		cipher = storj.Cipher(pet)
 	}
 
+	var versioning bool
+	if value := m.UserDefined["versioning"]; value != "" {
+		var err error
+		versioning, err = strconv.ParseBool(value)
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
 	return Meta{
 		Created:            m.Modified,
 		PathEncryptionType: cipher,
 		RedundancyScheme:   m.RedundancyScheme,
 		EncryptionScheme:   m.EncryptionScheme,
 		SegmentsSize:       m.SegmentsSize,
+		Versioning:         versioning,
 	}, nil
 }
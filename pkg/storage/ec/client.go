@@ -27,12 +27,20 @@ import (
 
 var mon = monkit.Package()
 
+// latencies are the node download latencies shared by all the clients
+var latencies = newNodeLatencies()
+
+func init() {
+	mon.Chain("node_download_latency_seconds", latencies)
+}
+
 // Client defines an interface for storing erasure coded data to piece store nodes
 type Client interface {
 	Put(ctx context.Context, limits []*pb.AddressedOrderLimit, rs eestream.RedundancyStrategy, data io.Reader, expiration time.Time) (successfulNodes []*pb.Node, successfulHashes []*pb.PieceHash, err error)
 	Repair(ctx context.Context, limits []*pb.AddressedOrderLimit, rs eestream.RedundancyStrategy, data io.Reader, expiration time.Time, timeout time.Duration) (successfulNodes []*pb.Node, successfulHashes []*pb.PieceHash, err error)
 	Get(ctx context.Context, limits []*pb.AddressedOrderLimit, es eestream.ErasureScheme, size int64) (ranger.Ranger, error)
 	Delete(ctx context.Context, limits []*pb.AddressedOrderLimit) error
+	WithLongTail(longTail eestream.LongTail) Client
 }
 
 type psClientHelper func(context.Context, *pb.Node) (*piecestore.Client, error)
@@ -40,6 +48,11 @@ type psClientHelper func(context.Context, *pb.Node) (*piecestore.Client, error)
 type ecClient struct {
 	transport   transport.Client
 	memoryLimit int
+
+	// longTail is set if Get downloads only the needed pieces
+	longTail *eestream.LongTail
+	// latencies are used for selecting the nodes to download from first
+	latencies *nodeLatencies
 }
 
 // NewClient from the given identity and max buffer memory
@@ -47,9 +60,19 @@ func NewClient(tc transport.Client, memoryLimit int) Client {
 	return &ecClient{
 		transport:   tc,
 		memoryLimit: memoryLimit,
+		latencies:   latencies,
 	}
 }
 
+// WithLongTail returns a client, which downloads only the required count of
+// pieces plus longTail.Extra, starting with the fastest nodes. The other
+// pieces are downloaded only instead of the failing and slow ones.
+func (ec *ecClient) WithLongTail(longTail eestream.LongTail) Client {
+	clone := *ec
+	clone.longTail = &longTail
+	return &clone
+}
+
 func (ec *ecClient) newPSClient(ctx context.Context, n *pb.Node) (*piecestore.Client, error) {
 	n.Type.DPanicOnInvalid("new ps client")
 	conn, err := ec.transport.DialNode(ctx, n)
@@ -355,10 +378,15 @@ func (ec *ecClient) Get(ctx context.Context, limits []*pb.AddressedOrderLimit, e
 			newPSClientHelper: ec.newPSClient,
 			limit:             addressedLimit,
 			size:              pieceSize,
+			latencies:         ec.latencies,
 		}
 	}
 
-	rr, err = eestream.Decode(rrs, es, ec.memoryLimit)
+	if ec.longTail != nil {
+		rr, err = eestream.DecodeLongTail(rrs, ec.latencies.order(limits), es, ec.memoryLimit, *ec.longTail)
+	} else {
+		rr, err = eestream.Decode(rrs, es, ec.memoryLimit)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -451,6 +479,7 @@ type lazyPieceRanger struct {
 	newPSClientHelper psClientHelper
 	limit             *pb.AddressedOrderLimit
 	size              int64
+	latencies         *nodeLatencies
 }
 
 // Size implements Ranger.Size
@@ -459,16 +488,36 @@ func (lr *lazyPieceRanger) Size() int64 {
 }
 
 // Range implements Ranger.Range to be lazily connected
-func (lr *lazyPieceRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+func (lr *lazyPieceRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
+	start := time.Now()
+	nodeID := lr.limit.GetLimit().StorageNodeId
+	report := func(latency time.Duration, err error) {
+		if lr.latencies != nil {
+			lr.latencies.observe(nodeID, latency, err)
+		}
+	}
+
 	ps, err := lr.newPSClientHelper(ctx, &pb.Node{
-		Id:      lr.limit.GetLimit().StorageNodeId,
+		Id:      nodeID,
 		Address: lr.limit.GetStorageNodeAddress(),
 		Type:    pb.NodeType_STORAGE,
 	})
 	if err != nil {
+		report(time.Since(start), err)
+		return nil, err
+	}
+
+	download, err := ps.Download(ctx, lr.limit.GetLimit(), offset, length)
+	if err != nil {
+		report(time.Since(start), err)
 		return nil, err
 	}
-	return ps.Download(ctx, lr.limit.GetLimit(), offset, length)
+
+	return &timedReader{
+		ReadCloser: download,
+		start:      start,
+		report:     report,
+	}, nil
 }
 
 func nonNilCount(limits []*pb.AddressedOrderLimit) int {
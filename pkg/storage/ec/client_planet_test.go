@@ -67,6 +67,9 @@ func TestECClient(t *testing.T) {
 	// Download the pieces and erasure decode the data
 	testGet(ctx, t, planet, ec, es, data, successfulNodes, successfulHashes)
 
+	// Download only the required pieces
+	testGet(ctx, t, planet, ec.WithLongTail(eestream.LongTail{}), es, data, successfulNodes, successfulHashes)
+
 	// Delete the pieces
 	testDelete(ctx, t, planet, ec, successfulNodes, successfulHashes)
 }
@@ -4,13 +4,17 @@
 package ecclient
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"storj.io/storj/internal/teststorj"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 )
 
 func TestUnique(t *testing.T) {
@@ -44,3 +48,59 @@ func TestUnique(t *testing.T) {
 		assert.Equal(t, tt.unique, unique(tt.limits), errTag)
 	}
 }
+
+func TestNodeLatenciesOrder(t *testing.T) {
+	limits := make([]*pb.AddressedOrderLimit, 6)
+	for i := 0; i < len(limits); i++ {
+		if i == 3 {
+			continue
+		}
+		limits[i] = &pb.AddressedOrderLimit{
+			Limit: &pb.OrderLimit2{
+				StorageNodeId: teststorj.NodeIDFromString(fmt.Sprintf("node-%d", i)),
+			},
+		}
+	}
+	nodeID := func(i int) storj.NodeID {
+		return limits[i].GetLimit().StorageNodeId
+	}
+
+	latencies := newNodeLatencies()
+
+	// without any latencies all the pieces are returned in random order
+	order := latencies.order(limits)
+	sort.Ints(order)
+	assert.Equal(t, []int{0, 1, 2, 4, 5}, order)
+
+	latencies.observe(nodeID(0), 300*time.Millisecond, nil)
+	latencies.observe(nodeID(1), 100*time.Millisecond, nil)
+	latencies.observe(nodeID(2), 200*time.Millisecond, errors.New("download failed"))
+	latencies.observe(nodeID(4), 500*time.Millisecond, nil)
+
+	latency, ok := latencies.latency(nodeID(2))
+	assert.True(t, ok)
+	assert.Equal(t, failedLatency, latency)
+
+	latency, ok = latencies.latency(nodeID(5))
+	assert.False(t, ok)
+	assert.Zero(t, latency)
+
+	// the node without latency is expected to be as fast as the average
+	assert.Equal(t, []int{1, 0, 4, 5, 2}, latencies.order(limits))
+
+	// the new observations move the average latency
+	for i := 0; i < 20; i++ {
+		latencies.observe(nodeID(4), 50*time.Millisecond, nil)
+	}
+	assert.Equal(t, []int{4, 1, 0, 5, 2}, latencies.order(limits))
+
+	// the averages are reported by node
+	reported := map[string]float64{}
+	latencies.Stats(func(name string, val float64) {
+		reported[name] = val
+	})
+	assert.Len(t, reported, 4)
+	assert.Equal(t, 0.1, reported[nodeID(1).String()])
+	assert.Equal(t, failedLatency.Seconds(), reported[nodeID(2).String()])
+	assert.NotContains(t, reported, nodeID(5).String())
+}
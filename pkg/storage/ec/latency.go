@@ -0,0 +1,160 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"io"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+const (
+	// failedLatency is the latency recorded for the failed downloads, so the
+	// failing nodes are selected last
+	failedLatency = 30 * time.Second
+	// latencyWeight is the weight of the new observations in the average latency
+	latencyWeight = 0.25
+)
+
+// errClosedBeforeRead is reported for the downloads canceled before any data arrived
+var errClosedBeforeRead = Error.New("piece download closed before the first read")
+
+// nodeLatencies keeps the average latencies of the piece downloads from the
+// storage nodes, the fastest nodes are selected first for the downloads.
+// The averages are reported to monkit as a stats source keyed by the node ids.
+type nodeLatencies struct {
+	mu        sync.Mutex
+	latencies map[storj.NodeID]time.Duration
+}
+
+func newNodeLatencies() *nodeLatencies {
+	return &nodeLatencies{
+		latencies: make(map[storj.NodeID]time.Duration),
+	}
+}
+
+// observe records the latency of the download from the node
+func (nl *nodeLatencies) observe(nodeID storj.NodeID, latency time.Duration, err error) {
+	if err != nil {
+		mon.Event("piece_download_failed")
+		if latency < failedLatency {
+			latency = failedLatency
+		}
+	} else {
+		mon.FloatVal("piece_download_latency").Observe(latency.Seconds())
+	}
+
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+
+	average, ok := nl.latencies[nodeID]
+	if !ok {
+		nl.latencies[nodeID] = latency
+		return
+	}
+	nl.latencies[nodeID] = average + time.Duration(latencyWeight*float64(latency-average))
+}
+
+// latency returns the average latency of the node, ok is false if it is unknown
+func (nl *nodeLatencies) latency(nodeID storj.NodeID) (latency time.Duration, ok bool) {
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+
+	latency, ok = nl.latencies[nodeID]
+	return latency, ok
+}
+
+// Stats implements monkit.StatSource and reports the average latency of every node in seconds
+func (nl *nodeLatencies) Stats(cb func(name string, val float64)) {
+	nl.mu.Lock()
+	averages := make(map[storj.NodeID]time.Duration, len(nl.latencies))
+	for nodeID, latency := range nl.latencies {
+		averages[nodeID] = latency
+	}
+	nl.mu.Unlock()
+
+	for nodeID, latency := range averages {
+		cb(nodeID.String(), latency.Seconds())
+	}
+}
+
+// order returns the piece numbers of the non-nil limits from the fastest node
+// to the slowest one. The nodes without known latency are expected to be as
+// fast as the average, the nodes with the same latency are shuffled.
+func (nl *nodeLatencies) order(limits []*pb.AddressedOrderLimit) []int {
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+
+	estimates := make(map[int]time.Duration, len(limits))
+	var unknown []int
+	var total time.Duration
+	for i, limit := range limits {
+		if limit == nil {
+			continue
+		}
+		latency, ok := nl.latencies[limit.GetLimit().StorageNodeId]
+		if !ok {
+			unknown = append(unknown, i)
+			continue
+		}
+		estimates[i] = latency
+		total += latency
+	}
+
+	var average time.Duration
+	if len(estimates) > 0 {
+		average = total / time.Duration(len(estimates))
+	}
+	for _, i := range unknown {
+		estimates[i] = average
+	}
+
+	order := make([]int, 0, len(estimates))
+	for _, i := range rand.Perm(len(limits)) {
+		if _, ok := estimates[i]; ok {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return estimates[order[a]] < estimates[order[b]]
+	})
+
+	return order
+}
+
+// timedReader reports the latency of the first read of the piece download
+type timedReader struct {
+	io.ReadCloser
+	start  time.Time
+	report func(latency time.Duration, err error)
+	once   sync.Once
+}
+
+// Read implements io.Reader and reports the latency of the first read
+func (r *timedReader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	if n > 0 || err == io.EOF {
+		r.done(nil)
+	} else if err != nil {
+		r.done(err)
+	}
+	return n, err
+}
+
+// Close implements io.Closer, closing before the first read counts as failure
+func (r *timedReader) Close() error {
+	r.done(errClosedBeforeRead)
+	return r.ReadCloser.Close()
+}
+
+func (r *timedReader) done(err error) {
+	r.once.Do(func() {
+		r.report(time.Since(r.start), err)
+	})
+}
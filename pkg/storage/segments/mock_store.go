@@ -103,6 +103,32 @@ func (mr *MockStoreMockRecorder) Delete(ctx, path interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), ctx, path)
 }
 
+// Move mocks base method
+func (m *MockStore) Move(ctx context.Context, path, newPath storj.Path, newMetadata []byte) (Meta, error) {
+	ret := m.ctrl.Call(m, "Move", ctx, path, newPath, newMetadata)
+	ret0, _ := ret[0].(Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move
+func (mr *MockStoreMockRecorder) Move(ctx, path, newPath, newMetadata interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockStore)(nil).Move), ctx, path, newPath, newMetadata)
+}
+
+// Copy mocks base method
+func (m *MockStore) Copy(ctx context.Context, path, newPath storj.Path, newMetadata []byte) (Meta, error) {
+	ret := m.ctrl.Call(m, "Copy", ctx, path, newPath, newMetadata)
+	ret0, _ := ret[0].(Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy
+func (mr *MockStoreMockRecorder) Copy(ctx, path, newPath, newMetadata interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockStore)(nil).Copy), ctx, path, newPath, newMetadata)
+}
+
 // List mocks base method
 func (m *MockStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) ([]ListItem, bool, error) {
 	ret := m.ctrl.Call(m, "List", ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags)
@@ -89,6 +89,7 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path, lostPiece
 		FreeBandwidth:  pieceSize,
 		FreeDisk:       pieceSize,
 		ExcludedNodes:  excludeNodeIDs,
+		BucketID:       bucketID,
 	}
 	newNodes, err := repairer.cache.FindStorageNodes(ctx, request)
 	if err != nil {
@@ -131,11 +132,10 @@ func (repairer *Repairer) Repair(ctx context.Context, path storj.Path, lostPiece
 		})
 	}
 
-	// Update the remote pieces in the pointer
-	pointer.GetRemote().RemotePieces = healthyPieces
-
-	// Update the segment pointer in the PointerDB
-	return repairer.pointerdb.Put(path, pointer)
+	// Update the remote pieces of the current segment pointer and of its
+	// copies referencing the same pieces, the pointer may have been changed
+	// during the repair
+	return Error.Wrap(repairer.pointerdb.UpdatePieces(path, pointer.GetRemote().RootPieceId, healthyPieces))
 }
 
 // sliceToSet converts the given slice to a set
@@ -147,10 +147,11 @@ func sliceToSet(slice []int32) map[int32]struct{} {
 	return set
 }
 
+// createBucketID returns the <project>/<bucket> id of the bucket of the segment at path <project>/<segment>/<bucket>/<path>
 func createBucketID(path storj.Path) []byte {
 	comps := storj.SplitPath(path)
-	if len(comps) < 2 {
+	if len(comps) < 3 {
 		return nil
 	}
-	return []byte(storj.JoinPaths(comps[0], comps[1]))
+	return []byte(storj.JoinPaths(comps[0], comps[2]))
 }
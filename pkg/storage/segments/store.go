@@ -50,6 +50,8 @@ type Store interface {
 	Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error)
 	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
+	Move(ctx context.Context, path, newPath storj.Path, newMetadata []byte) (meta Meta, err error)
+	Copy(ctx context.Context, path, newPath storj.Path, newMetadata []byte) (meta Meta, err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
@@ -307,6 +309,52 @@ func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error)
 	return nil
 }
 
+// Move requests the satellite to move a segment to a new path and replace
+// its metadata. The pieces on the storage nodes are not touched.
+func (s *segmentStore) Move(ctx context.Context, path, newPath storj.Path, newMetadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, objectPath, segmentIndex, err := splitPathFragments(path)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	newBucket, newObjectPath, newSegmentIndex, err := splitPathFragments(newPath)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	pointer, err := s.metainfo.MoveSegment(ctx, bucket, objectPath, segmentIndex, newBucket, newObjectPath, newSegmentIndex, newMetadata)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	return convertMeta(pointer), nil
+}
+
+// Copy requests the satellite to copy a segment to a new path with new
+// metadata. The copy references the same pieces on the storage nodes.
+func (s *segmentStore) Copy(ctx context.Context, path, newPath storj.Path, newMetadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, objectPath, segmentIndex, err := splitPathFragments(path)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	newBucket, newObjectPath, newSegmentIndex, err := splitPathFragments(newPath)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	pointer, err := s.metainfo.CopySegment(ctx, bucket, objectPath, segmentIndex, newBucket, newObjectPath, newSegmentIndex, newMetadata)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	return convertMeta(pointer), nil
+}
+
 // List retrieves paths to segments and their metadata stored in the pointerdb
 func (s *segmentStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
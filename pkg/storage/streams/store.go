@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -28,7 +30,17 @@ import (
 	"storj.io/storj/storage"
 )
 
-var mon = monkit.Package()
+// stagingPrefix is the path prefix inside a bucket, hidden from the listings,
+// where the streams replacing existing streams are assembled
+const stagingPrefix = ".storj/staging"
+
+var (
+	mon = monkit.Package()
+
+	// ErrPendingMismatch is the errs class of pending uploads that don't
+	// match the data of the resumed upload
+	ErrPendingMismatch = errs.Class("pending upload doesn't match the data")
+)
 
 // Meta info about a stream
 type Meta struct {
@@ -46,7 +58,7 @@ func convertMeta(lastSegmentMeta segments.Meta, stream pb.StreamInfo, streamMeta
 	return Meta{
 		Modified:         lastSegmentMeta.Modified,
 		Expiration:       lastSegmentMeta.Expiration,
-		Size:             ((stream.NumberOfSegments - 1) * stream.SegmentsSize) + stream.LastSegmentSize,
+		Size:             TotalSize(stream),
 		Data:             stream.Metadata,
 		SegmentsSize:     stream.SegmentsSize,
 		RedundancyScheme: lastSegmentMeta.RedundancyScheme,
@@ -62,7 +74,11 @@ type Store interface {
 	Meta(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (Meta, error)
 	Get(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (ranger.Ranger, Meta, error)
 	Put(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	Resume(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
 	Delete(ctx context.Context, path storj.Path, pathCipher storj.Cipher) error
+	Concat(ctx context.Context, path storj.Path, pathCipher storj.Cipher, sources []storj.Path, metadata []byte) (Meta, error)
+	Copy(ctx context.Context, path storj.Path, pathCipher storj.Cipher, source storj.Path, sourceCipher storj.Cipher, metadata []byte) (Meta, error)
+	Move(ctx context.Context, path storj.Path, pathCipher storj.Cipher, source storj.Path, sourceCipher storj.Cipher, metadata []byte) (Meta, error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
@@ -70,18 +86,18 @@ type Store interface {
 type streamStore struct {
 	segments     segments.Store
 	segmentSize  int64
-	rootKey      *storj.Key
+	keys         *encryption.Store
 	encBlockSize int
 	cipher       storj.Cipher
 }
 
 // NewStreamStore stuff
-func NewStreamStore(segments segments.Store, segmentSize int64, rootKey *storj.Key, encBlockSize int, cipher storj.Cipher) (Store, error) {
+func NewStreamStore(segments segments.Store, segmentSize int64, keys *encryption.Store, encBlockSize int, cipher storj.Cipher) (Store, error) {
 	if segmentSize <= 0 {
 		return nil, errs.New("segment size must be larger than 0")
 	}
-	if rootKey == nil {
-		return nil, errs.New("encryption key must not be empty")
+	if keys == nil {
+		return nil, errs.New("encryption keys must not be empty")
 	}
 	if encBlockSize <= 0 {
 		return nil, errs.New("encryption block size must be larger than 0")
@@ -90,7 +106,7 @@ func NewStreamStore(segments segments.Store, segmentSize int64, rootKey *storj.K
 	return &streamStore{
 		segments:     segments,
 		segmentSize:  segmentSize,
-		rootKey:      rootKey,
+		keys:         keys,
 		encBlockSize: encBlockSize,
 		cipher:       cipher,
 	}, nil
@@ -110,7 +126,7 @@ func (s *streamStore) Put(ctx context.Context, path storj.Path, pathCipher storj
 		return Meta{}, err
 	}
 
-	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration)
+	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration, nil)
 	if err != nil {
 		s.cancelHandler(context.Background(), lastSegment, path, pathCipher)
 	}
@@ -118,22 +134,131 @@ func (s *streamStore) Put(ctx context.Context, path storj.Path, pathCipher storj
 	return m, err
 }
 
-func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (m Meta, lastSegment int64, err error) {
+// pendingUpload is the part of a stream committed by an interrupted upload
+type pendingUpload struct {
+	segments int64
+	size     int64
+}
+
+// Resume uploads data like Put, but continues the pending upload left at
+// path by a previous call to Resume. The segments committed by the pending
+// upload are verified against the beginning of data instead of being
+// uploaded again. If the upload fails, the committed segments are kept so
+// that the upload can be resumed later.
+func (s *streamStore) Resume(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// a committed stream is overwritten like with Put
+	err = s.Delete(ctx, path, pathCipher)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, err
+	}
+
+	pending, err := s.verifyPending(ctx, path, pathCipher, data)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	m, _, err = s.upload(ctx, path, pathCipher, data, metadata, expiration, pending)
+	return m, err
+}
+
+// verifyPending reads the segments committed by the pending upload at path
+// from data, checking that they match the checksums of the committed
+// segments. A pending upload that doesn't match data is deleted.
+func (s *streamStore) verifyPending(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader) (pending *pendingUpload, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encPath, err := s.keys.EncryptPath(path, pathCipher)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := s.keys.DeriveContentKey(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var committed []segments.Meta
+	for {
+		meta, err := s.segments.Meta(ctx, getSegmentPath(encPath, int64(len(committed))))
+		if storage.ErrKeyNotFound.Has(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		committed = append(committed, meta)
+	}
+
+	pending = &pendingUpload{}
+	for _, meta := range committed {
+		// a checksum that can't be decrypted, e.g. because the pending upload
+		// used another key, doesn't match the data either
+		expected, _ := segmentChecksum(meta.Data, s.cipher, derivedKey)
+
+		hash := sha256.New()
+		n, err := io.Copy(hash, io.LimitReader(data, s.segmentSize))
+		if err != nil {
+			return nil, err
+		}
+
+		if n != s.segmentSize || !bytes.Equal(expected, hash.Sum(nil)) {
+			// the pending upload can't be reused, so the next attempt starts over
+			s.cancelHandler(ctx, int64(len(committed)), path, pathCipher)
+			return nil, ErrPendingMismatch.New("segment %d of %q", pending.segments, path)
+		}
+
+		pending.segments++
+		pending.size += n
+	}
+
+	return pending, nil
+}
+
+// segmentChecksum decrypts the checksum of the segment content stored in
+// the segment metadata
+func segmentChecksum(data []byte, cipher storj.Cipher, derivedKey *storj.Key) ([]byte, error) {
+	segmentMeta := pb.SegmentMeta{}
+	if err := proto.Unmarshal(data, &segmentMeta); err != nil {
+		return nil, err
+	}
+	if len(segmentMeta.EncryptedChecksum) == 0 {
+		return nil, nil
+	}
+
+	encryptedKey, keyNonce := getEncryptedKeyAndNonce(&segmentMeta)
+	contentKey, err := encryption.DecryptKey(encryptedKey, cipher, derivedKey, keyNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryption.Decrypt(segmentMeta.EncryptedChecksum, cipher, contentKey, &storj.Nonce{})
+}
+
+// upload uploads data as the segments of the stream at path, continuing after
+// the segments of pending when it isn't nil
+func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time, pending *pendingUpload) (m Meta, lastSegment int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	var currentSegment int64
 	var streamSize int64
 	var putMeta segments.Meta
 
-	defer func() {
-		select {
-		case <-ctx.Done():
-			s.cancelHandler(context.Background(), currentSegment, path, pathCipher)
-		default:
-		}
-	}()
+	if pending != nil {
+		currentSegment = pending.segments
+		streamSize = pending.size
+	} else {
+		defer func() {
+			select {
+			case <-ctx.Done():
+				s.cancelHandler(context.Background(), currentSegment, path, pathCipher)
+			default:
+			}
+		}()
+	}
 
-	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	derivedKey, err := s.keys.DeriveContentKey(path)
 	if err != nil {
 		return Meta{}, currentSegment, err
 	}
@@ -175,7 +300,8 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 		}
 
 		sizeReader := NewSizeReader(eofReader)
-		segmentReader := io.LimitReader(sizeReader, s.segmentSize)
+		hash := sha256.New()
+		segmentReader := io.LimitReader(io.TeeReader(sizeReader, hash), s.segmentSize)
 		peekReader := segments.NewPeekThresholdReader(segmentReader)
 		largeData, err := peekReader.IsLargerThan(encrypter.InBlockSize())
 		if err != nil {
@@ -198,7 +324,7 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 		}
 
 		putMeta, err = s.segments.Put(ctx, transformedReader, expiration, func() (storj.Path, []byte, error) {
-			encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+			encPath, err := s.keys.EncryptPath(path, pathCipher)
 			if err != nil {
 				return "", nil, err
 			}
@@ -206,14 +332,20 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 			if !eofReader.isEOF() {
 				segmentPath := getSegmentPath(encPath, currentSegment)
 
-				if s.cipher == storj.Unencrypted {
-					return segmentPath, nil, nil
+				// the checksum is encrypted with the zero nonce, which is
+				// only used by the stream info of the last segment
+				encryptedChecksum, err := encryption.Encrypt(hash.Sum(nil), s.cipher, &contentKey, &storj.Nonce{})
+				if err != nil {
+					return "", nil, err
 				}
 
-				segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				})
+				segmentInfo := &pb.SegmentMeta{EncryptedChecksum: encryptedChecksum}
+				if s.cipher != storj.Unencrypted {
+					segmentInfo.EncryptedKey = encryptedKey
+					segmentInfo.KeyNonce = keyNonce[:]
+				}
+
+				segmentMeta, err := proto.Marshal(segmentInfo)
 				if err != nil {
 					return "", nil, err
 				}
@@ -297,7 +429,7 @@ func getSegmentPath(path storj.Path, segNum int64) storj.Path {
 func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (rr ranger.Ranger, meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	encPath, err := s.keys.EncryptPath(path, pathCipher)
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -307,7 +439,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 		return nil, Meta{}, err
 	}
 
-	streamInfo, streamMeta, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, path, s.rootKey)
+	streamInfo, streamMeta, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, path, s.keys)
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -318,34 +450,29 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 		return nil, Meta{}, err
 	}
 
-	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	derivedKey, err := s.keys.DeriveContentKey(path)
 	if err != nil {
 		return nil, Meta{}, err
 	}
 
+	sizes := SegmentSizes(stream)
+
 	var rangers []ranger.Ranger
 	for i := int64(0); i < stream.NumberOfSegments-1; i++ {
 		currentPath := getSegmentPath(encPath, i)
-		size := stream.SegmentsSize
-		var contentNonce storj.Nonce
-		_, err := encryption.Increment(&contentNonce, i+1)
-		if err != nil {
-			return nil, Meta{}, err
-		}
 		rr := &lazySegmentRanger{
-			segments:      s.segments,
-			path:          currentPath,
-			size:          size,
-			derivedKey:    derivedKey,
-			startingNonce: &contentNonce,
-			encBlockSize:  int(streamMeta.EncryptionBlockSize),
-			cipher:        storj.Cipher(streamMeta.EncryptionType),
+			segments:     s.segments,
+			path:         currentPath,
+			index:        i,
+			size:         sizes[i],
+			derivedKey:   derivedKey,
+			encBlockSize: int(streamMeta.EncryptionBlockSize),
+			cipher:       storj.Cipher(streamMeta.EncryptionType),
 		}
 		rangers = append(rangers, rr)
 	}
 
-	var contentNonce storj.Nonce
-	_, err = encryption.Increment(&contentNonce, stream.NumberOfSegments)
+	contentNonce, err := getContentNonce(streamMeta.LastSegmentMeta, stream.NumberOfSegments-1)
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -358,7 +485,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 		derivedKey,
 		encryptedKey,
 		keyNonce,
-		&contentNonce,
+		contentNonce,
 		int(streamMeta.EncryptionBlockSize),
 	)
 	if err != nil {
@@ -375,7 +502,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 func (s *streamStore) Meta(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	encPath, err := s.keys.EncryptPath(path, pathCipher)
 	if err != nil {
 		return Meta{}, err
 	}
@@ -385,7 +512,7 @@ func (s *streamStore) Meta(ctx context.Context, path storj.Path, pathCipher stor
 		return Meta{}, err
 	}
 
-	streamInfo, streamMeta, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, path, s.rootKey)
+	streamInfo, streamMeta, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, path, s.keys)
 	if err != nil {
 		return Meta{}, err
 	}
@@ -401,7 +528,7 @@ func (s *streamStore) Meta(ctx context.Context, path storj.Path, pathCipher stor
 func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	encPath, err := s.keys.EncryptPath(path, pathCipher)
 	if err != nil {
 		return err
 	}
@@ -410,7 +537,7 @@ func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher st
 		return err
 	}
 
-	streamInfo, _, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, path, s.rootKey)
+	streamInfo, _, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, path, s.keys)
 	if err != nil {
 		return err
 	}
@@ -420,7 +547,7 @@ func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher st
 	}
 
 	for i := 0; i < int(stream.NumberOfSegments-1); i++ {
-		encPath, err = EncryptAfterBucket(path, pathCipher, s.rootKey)
+		encPath, err = s.keys.EncryptPath(path, pathCipher)
 		if err != nil {
 			return err
 		}
@@ -434,6 +561,324 @@ func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher st
 	return s.segments.Delete(ctx, storj.JoinPaths("l", encPath))
 }
 
+// streamSource is a source stream of Concat, Copy and Move
+type streamSource struct {
+	path       storj.Path
+	pathCipher storj.Cipher
+}
+
+// concatSegment is a segment of a source stream that is moved by Concat
+type concatSegment struct {
+	path         storj.Path
+	size         int64
+	contentKey   *storj.Key
+	contentNonce *storj.Nonce
+}
+
+// Concat moves the segments of the source streams, in the given order, into
+// a new stream at path. The segment keys are re-encrypted for the new path,
+// but the segment data is neither downloaded nor uploaded again. The source
+// streams no longer exist once Concat succeeds.
+func (s *streamStore) Concat(ctx context.Context, path storj.Path, pathCipher storj.Cipher, sources []storj.Path, metadata []byte) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(sources) == 0 {
+		return Meta{}, errs.New("no source streams to concatenate")
+	}
+
+	streamSources := make([]streamSource, 0, len(sources))
+	for _, source := range sources {
+		streamSources = append(streamSources, streamSource{path: source, pathCipher: pathCipher})
+	}
+
+	return s.relocate(ctx, path, pathCipher, streamSources, metadata, false)
+}
+
+// Copy creates a new stream at path that references the segments of the
+// source stream, which may be in another bucket. The segment keys are
+// re-encrypted for the new path, but the segment data is not copied.
+func (s *streamStore) Copy(ctx context.Context, path storj.Path, pathCipher storj.Cipher, source storj.Path, sourceCipher storj.Cipher, metadata []byte) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.relocate(ctx, path, pathCipher, []streamSource{{path: source, pathCipher: sourceCipher}}, metadata, true)
+}
+
+// Move moves the segments of the source stream, which may be in another
+// bucket, to a new stream at path. The segment keys are re-encrypted for
+// the new path, but the segment data is not transferred.
+func (s *streamStore) Move(ctx context.Context, path storj.Path, pathCipher storj.Cipher, source storj.Path, sourceCipher storj.Cipher, metadata []byte) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.relocate(ctx, path, pathCipher, []streamSource{{path: source, pathCipher: sourceCipher}}, metadata, false)
+}
+
+// relocate moves or copies the segments of the source streams, in the given
+// order, into a new stream at path with the given metadata. An existing stream
+// at path is replaced only after the new stream is complete, so a failure
+// leaves the existing stream in place.
+func (s *streamStore) relocate(ctx context.Context, path storj.Path, pathCipher storj.Cipher, sources []streamSource, metadata []byte, copy bool) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	replace := true
+	for _, source := range sources {
+		if source.path == path {
+			replace = false
+		}
+	}
+
+	if replace {
+		replace, err = s.exists(ctx, path, pathCipher)
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
+	if !replace {
+		return s.relocateTo(ctx, path, pathCipher, sources, metadata, copy)
+	}
+
+	staging, err := stagingPath(path)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	_, err = s.relocateTo(ctx, staging, pathCipher, sources, metadata, copy)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	err = s.Delete(ctx, path, pathCipher)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, err
+	}
+
+	return s.relocateTo(ctx, path, pathCipher, []streamSource{{path: staging, pathCipher: pathCipher}}, metadata, false)
+}
+
+// exists checks whether a stream exists at path
+func (s *streamStore) exists(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encPath, err := s.keys.EncryptPath(path, pathCipher)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = s.segments.Meta(ctx, storj.JoinPaths("l", encPath))
+	if storage.ErrKeyNotFound.Has(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// stagingPath returns a unique path hidden from the listings in the bucket
+// of path, where a stream replacing the stream at path is assembled
+func stagingPath(path storj.Path) (storj.Path, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+
+	bucket := storj.SplitPath(path)[0]
+	return storj.JoinPaths(bucket, stagingPrefix, hex.EncodeToString(id[:])), nil
+}
+
+// relocateTo moves or copies the segments of the source streams, in the given
+// order, into a new stream at path, which must not exist unless it is one of
+// the sources
+func (s *streamStore) relocateTo(ctx context.Context, path storj.Path, pathCipher storj.Cipher, sources []streamSource, metadata []byte, copy bool) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var cipher storj.Cipher
+	var encBlockSize int32
+	var concatSegments []concatSegment
+	overwrite := true
+
+	for i, source := range sources {
+		if source.path == path {
+			overwrite = false
+		}
+
+		encSource, err := s.keys.EncryptPath(source.path, source.pathCipher)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		lastSegmentMeta, err := s.segments.Meta(ctx, storj.JoinPaths("l", encSource))
+		if err != nil {
+			return Meta{}, err
+		}
+
+		streamInfo, streamMeta, err := DecryptStreamInfo(ctx, lastSegmentMeta.Data, source.path, s.keys)
+		if err != nil {
+			return Meta{}, err
+		}
+		var stream pb.StreamInfo
+		if err := proto.Unmarshal(streamInfo, &stream); err != nil {
+			return Meta{}, err
+		}
+
+		if i == 0 {
+			cipher = storj.Cipher(streamMeta.EncryptionType)
+			encBlockSize = streamMeta.EncryptionBlockSize
+		} else if cipher != storj.Cipher(streamMeta.EncryptionType) || encBlockSize != streamMeta.EncryptionBlockSize {
+			return Meta{}, errs.New("source stream %q has a different encryption scheme", source.path)
+		}
+
+		sourceKey, err := s.keys.DeriveContentKey(source.path)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		sizes := SegmentSizes(stream)
+		for index := int64(0); index < stream.NumberOfSegments; index++ {
+			segment := concatSegment{size: sizes[index]}
+
+			var segmentMeta *pb.SegmentMeta
+			if index == stream.NumberOfSegments-1 {
+				segment.path = storj.JoinPaths("l", encSource)
+				segmentMeta = streamMeta.LastSegmentMeta
+			} else {
+				segment.path = getSegmentPath(encSource, index)
+				meta, err := s.segments.Meta(ctx, segment.path)
+				if err != nil {
+					return Meta{}, err
+				}
+				segmentMeta = &pb.SegmentMeta{}
+				if err := proto.Unmarshal(meta.Data, segmentMeta); err != nil {
+					return Meta{}, err
+				}
+			}
+
+			segment.contentNonce, err = getContentNonce(segmentMeta, index)
+			if err != nil {
+				return Meta{}, err
+			}
+
+			encryptedKey, keyNonce := getEncryptedKeyAndNonce(segmentMeta)
+			segment.contentKey, err = encryption.DecryptKey(encryptedKey, cipher, sourceKey, keyNonce)
+			if err != nil {
+				return Meta{}, err
+			}
+
+			concatSegments = append(concatSegments, segment)
+		}
+	}
+
+	encPath, err := s.keys.EncryptPath(path, pathCipher)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	relocateSegment := s.segments.Move
+	if copy && overwrite {
+		relocateSegment = s.segments.Copy
+	}
+
+	derivedKey, err := s.keys.DeriveContentKey(path)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	// the last segment is moved last, so the stream becomes visible only when complete
+	lastIndex := int64(len(concatSegments) - 1)
+	sizes := make([]int64, 0, len(concatSegments))
+	var putMeta segments.Meta
+	for index, segment := range concatSegments {
+		sizes = append(sizes, segment.size)
+
+		var keyNonce storj.Nonce
+		_, err = rand.Read(keyNonce[:])
+		if err != nil {
+			return Meta{}, err
+		}
+
+		encryptedKey, err := encryption.EncryptKey(segment.contentKey, cipher, derivedKey, &keyNonce)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		segmentMeta := &pb.SegmentMeta{
+			EncryptedKey: encryptedKey,
+			KeyNonce:     keyNonce[:],
+			ContentNonce: segment.contentNonce[:],
+		}
+
+		if int64(index) < lastIndex {
+			var newMetadata []byte
+			if cipher != storj.Unencrypted {
+				newMetadata, err = proto.Marshal(segmentMeta)
+				if err != nil {
+					return Meta{}, err
+				}
+			}
+
+			_, err = relocateSegment(ctx, segment.path, getSegmentPath(encPath, int64(index)), newMetadata)
+			if err != nil {
+				return Meta{}, err
+			}
+			continue
+		}
+
+		streamInfo, err := proto.Marshal(&pb.StreamInfo{
+			NumberOfSegments: lastIndex + 1,
+			SegmentsSize:     s.segmentSize,
+			LastSegmentSize:  segment.size,
+			Metadata:         metadata,
+			SegmentSizes:     sizes,
+		})
+		if err != nil {
+			return Meta{}, err
+		}
+
+		// the zero nonce may have already been used with this content key
+		// for encrypting the stream info of the source stream
+		var streamInfoNonce storj.Nonce
+		_, err = rand.Read(streamInfoNonce[:])
+		if err != nil {
+			return Meta{}, err
+		}
+
+		encryptedStreamInfo, err := encryption.Encrypt(streamInfo, cipher, segment.contentKey, &streamInfoNonce)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		streamMeta := pb.StreamMeta{
+			EncryptedStreamInfo: encryptedStreamInfo,
+			EncryptionType:      int32(cipher),
+			EncryptionBlockSize: encBlockSize,
+			StreamInfoNonce:     streamInfoNonce[:],
+		}
+		if cipher != storj.Unencrypted {
+			streamMeta.LastSegmentMeta = segmentMeta
+		}
+
+		lastSegmentMeta, err := proto.Marshal(&streamMeta)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		putMeta, err = relocateSegment(ctx, segment.path, storj.JoinPaths("l", encPath), lastSegmentMeta)
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
+	return Meta{
+		Modified:     putMeta.Modified,
+		Expiration:   putMeta.Expiration,
+		Size:         TotalSize(pb.StreamInfo{SegmentSizes: sizes}),
+		Data:         metadata,
+		SegmentsSize: s.segmentSize,
+		EncryptionScheme: storj.EncryptionScheme{
+			Cipher:    cipher,
+			BlockSize: encBlockSize,
+		},
+		RedundancyScheme: putMeta.RedundancyScheme,
+	}, nil
+}
+
 // ListItem is a single item in a listing
 type ListItem struct {
 	Path     storj.Path
@@ -453,14 +898,17 @@ func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore st
 
 	prefix = strings.TrimSuffix(prefix, "/")
 
-	encPrefix, err := EncryptAfterBucket(prefix, pathCipher, s.rootKey)
+	encPrefix, err := s.keys.EncryptPath(prefix, pathCipher)
 	if err != nil {
 		return nil, false, err
 	}
 
-	prefixKey, err := encryption.DerivePathKey(prefix, s.rootKey, len(storj.SplitPath(prefix)))
-	if err != nil {
-		return nil, false, err
+	var prefixKey *storj.Key
+	if prefix != "" {
+		prefixKey, err = s.keys.DerivePathKey(prefix)
+		if err != nil {
+			return nil, false, err
+		}
 	}
 
 	encStartAfter, err := s.encryptMarker(startAfter, pathCipher, prefixKey)
@@ -485,7 +933,7 @@ func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore st
 			return nil, false, err
 		}
 
-		streamInfo, streamMeta, err := DecryptStreamInfo(ctx, item.Meta.Data, storj.JoinPaths(prefix, path), s.rootKey)
+		streamInfo, streamMeta, err := DecryptStreamInfo(ctx, item.Meta.Data, storj.JoinPaths(prefix, path), s.keys)
 		if err != nil {
 			return nil, false, err
 		}
@@ -503,29 +951,29 @@ func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore st
 
 // encryptMarker is a helper method for encrypting startAfter and endBefore markers
 func (s *streamStore) encryptMarker(marker storj.Path, pathCipher storj.Cipher, prefixKey *storj.Key) (storj.Path, error) {
-	if bytes.Equal(s.rootKey[:], prefixKey[:]) { // empty prefix
-		return EncryptAfterBucket(marker, pathCipher, s.rootKey)
+	if prefixKey == nil { // empty prefix
+		return s.keys.EncryptPath(marker, pathCipher)
 	}
 	return encryption.EncryptPath(marker, pathCipher, prefixKey)
 }
 
 // decryptMarker is a helper method for decrypting listed path markers
 func (s *streamStore) decryptMarker(marker storj.Path, pathCipher storj.Cipher, prefixKey *storj.Key) (storj.Path, error) {
-	if bytes.Equal(s.rootKey[:], prefixKey[:]) { // empty prefix
-		return DecryptAfterBucket(marker, pathCipher, s.rootKey)
+	if prefixKey == nil { // empty prefix
+		return s.keys.DecryptPath(marker, pathCipher)
 	}
 	return encryption.DecryptPath(marker, pathCipher, prefixKey)
 }
 
 type lazySegmentRanger struct {
-	ranger        ranger.Ranger
-	segments      segments.Store
-	path          storj.Path
-	size          int64
-	derivedKey    *storj.Key
-	startingNonce *storj.Nonce
-	encBlockSize  int
-	cipher        storj.Cipher
+	ranger       ranger.Ranger
+	segments     segments.Store
+	path         storj.Path
+	index        int64
+	size         int64
+	derivedKey   *storj.Key
+	encBlockSize int
+	cipher       storj.Cipher
 }
 
 // Size implements Ranger.Size
@@ -545,8 +993,12 @@ func (lr *lazySegmentRanger) Range(ctx context.Context, offset, length int64) (i
 		if err != nil {
 			return nil, err
 		}
+		contentNonce, err := getContentNonce(&segmentMeta, lr.index)
+		if err != nil {
+			return nil, err
+		}
 		encryptedKey, keyNonce := getEncryptedKeyAndNonce(&segmentMeta)
-		lr.ranger, err = decryptRanger(ctx, rr, lr.size, lr.cipher, lr.derivedKey, encryptedKey, keyNonce, lr.startingNonce, lr.encBlockSize)
+		lr.ranger, err = decryptRanger(ctx, rr, lr.size, lr.cipher, lr.derivedKey, encryptedKey, keyNonce, contentNonce, lr.encBlockSize)
 		if err != nil {
 			return nil, err
 		}
@@ -590,49 +1042,10 @@ func decryptRanger(ctx context.Context, rr ranger.Ranger, decryptedSize int64, c
 	return eestream.Unpad(rd, int(rd.Size()-decryptedSize))
 }
 
-// EncryptAfterBucket encrypts a path without encrypting its first element
-func EncryptAfterBucket(path storj.Path, cipher storj.Cipher, key *storj.Key) (encrypted storj.Path, err error) {
-	comps := storj.SplitPath(path)
-	if len(comps) <= 1 {
-		return path, nil
-	}
-
-	encrypted, err = encryption.EncryptPath(path, cipher, key)
-	if err != nil {
-		return "", err
-	}
-
-	// replace the first path component with the unencrypted bucket name
-	return storj.JoinPaths(comps[0], storj.JoinPaths(storj.SplitPath(encrypted)[1:]...)), nil
-}
-
-// DecryptAfterBucket decrypts a path without modifying its first element
-func DecryptAfterBucket(path storj.Path, cipher storj.Cipher, key *storj.Key) (decrypted storj.Path, err error) {
-	comps := storj.SplitPath(path)
-	if len(comps) <= 1 {
-		return path, nil
-	}
-
-	bucket := comps[0]
-	toDecrypt := storj.JoinPaths(comps[1:]...)
-
-	bucketKey, err := encryption.DerivePathKey(path, key, 1)
-	if err != nil {
-		return "", err
-	}
-
-	decPath, err := encryption.DecryptPath(toDecrypt, cipher, bucketKey)
-	if err != nil {
-		return "", err
-	}
-
-	return storj.JoinPaths(bucket, decPath), nil
-}
-
 // CancelHandler handles clean up of segments on receiving CTRL+C
 func (s *streamStore) cancelHandler(ctx context.Context, totalSegments int64, path storj.Path, pathCipher storj.Cipher) {
 	for i := int64(0); i < totalSegments; i++ {
-		encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+		encPath, err := s.keys.EncryptPath(path, pathCipher)
 		if err != nil {
 			zap.S().Warnf("Failed deleting a segment due to encryption path %v %v", i, err)
 		}
@@ -657,14 +1070,14 @@ func getEncryptedKeyAndNonce(m *pb.SegmentMeta) (storj.EncryptedPrivateKey, *sto
 }
 
 // DecryptStreamInfo decrypts stream info
-func DecryptStreamInfo(ctx context.Context, streamMetaBytes []byte, path storj.Path, rootKey *storj.Key) (
+func DecryptStreamInfo(ctx context.Context, streamMetaBytes []byte, path storj.Path, keys *encryption.Store) (
 	streamInfo []byte, streamMeta pb.StreamMeta, err error) {
 	err = proto.Unmarshal(streamMetaBytes, &streamMeta)
 	if err != nil {
 		return nil, pb.StreamMeta{}, err
 	}
 
-	derivedKey, err := encryption.DeriveContentKey(path, rootKey)
+	derivedKey, err := keys.DeriveContentKey(path)
 	if err != nil {
 		return nil, pb.StreamMeta{}, err
 	}
@@ -676,7 +1089,53 @@ func DecryptStreamInfo(ctx context.Context, streamMetaBytes []byte, path storj.P
 		return nil, pb.StreamMeta{}, err
 	}
 
-	// decrypt metadata with the content encryption key and zero nonce
-	streamInfo, err = encryption.Decrypt(streamMeta.EncryptedStreamInfo, cipher, contentKey, &storj.Nonce{})
+	// decrypt metadata with the content encryption key and zero nonce,
+	// unless the stream info was encrypted with a different one
+	var streamInfoNonce storj.Nonce
+	copy(streamInfoNonce[:], streamMeta.StreamInfoNonce)
+
+	streamInfo, err = encryption.Decrypt(streamMeta.EncryptedStreamInfo, cipher, contentKey, &streamInfoNonce)
 	return streamInfo, streamMeta, err
 }
+
+// getContentNonce returns the starting nonce of the segment content
+func getContentNonce(m *pb.SegmentMeta, index int64) (*storj.Nonce, error) {
+	var nonce storj.Nonce
+	if len(m.GetContentNonce()) > 0 {
+		copy(nonce[:], m.GetContentNonce())
+		return &nonce, nil
+	}
+
+	// The content nonce is initialized with the segment's index incremented by 1.
+	_, err := encryption.Increment(&nonce, index+1)
+	if err != nil {
+		return nil, err
+	}
+	return &nonce, nil
+}
+
+// SegmentSizes returns the size of each segment in the stream
+func SegmentSizes(stream pb.StreamInfo) []int64 {
+	if len(stream.SegmentSizes) > 0 {
+		return stream.SegmentSizes
+	}
+
+	sizes := make([]int64, 0, stream.NumberOfSegments)
+	for i := int64(0); i < stream.NumberOfSegments-1; i++ {
+		sizes = append(sizes, stream.SegmentsSize)
+	}
+	return append(sizes, stream.LastSegmentSize)
+}
+
+// TotalSize returns the total size of the stream
+func TotalSize(stream pb.StreamInfo) int64 {
+	if len(stream.SegmentSizes) == 0 {
+		return ((stream.NumberOfSegments - 1) * stream.SegmentsSize) + stream.LastSegmentSize
+	}
+
+	var size int64
+	for _, segmentSize := range stream.SegmentSizes {
+		size += segmentSize
+	}
+	return size
+}
@@ -22,6 +22,16 @@ type Metainfo interface {
 	GetBucket(ctx context.Context, bucket string) (Bucket, error)
 	// ListBuckets lists buckets starting from first
 	ListBuckets(ctx context.Context, options BucketListOptions) (BucketList, error)
+	// SetBucketVersioning enables or suspends keeping prior versions of objects in the bucket
+	SetBucketVersioning(ctx context.Context, bucket string, enabled bool) (Bucket, error)
+	// SetBucketLifecycle replaces the lifecycle rules of the bucket, no rules remove them
+	SetBucketLifecycle(ctx context.Context, bucket string, rules []LifecycleRule) error
+	// GetBucketLifecycle returns the lifecycle rules of the bucket
+	GetBucketLifecycle(ctx context.Context, bucket string) ([]LifecycleRule, error)
+	// SetBucketPlacement restricts the pieces of the bucket to nodes in the given countries, no countries remove the restriction
+	SetBucketPlacement(ctx context.Context, bucket string, countries []string) error
+	// GetBucketPlacement returns the countries the pieces of the bucket are restricted to
+	GetBucketPlacement(ctx context.Context, bucket string) ([]string, error)
 
 	// GetObject returns information about an object
 	GetObject(ctx context.Context, bucket string, path Path) (Object, error)
@@ -36,6 +46,20 @@ type Metainfo interface {
 	DeleteObject(ctx context.Context, bucket string, path Path) error
 	// ListObjects lists objects in bucket based on the ListOptions
 	ListObjects(ctx context.Context, bucket string, options ListOptions) (ObjectList, error)
+	// CopyObject copies an object to a new path, possibly in another bucket, without transferring its data
+	CopyObject(ctx context.Context, bucket string, path Path, newBucket string, newPath Path, info *CopyObject) (Object, error)
+	// MoveObject moves an object to a new path, possibly in another bucket, without transferring its data
+	MoveObject(ctx context.Context, bucket string, path Path, newBucket string, newPath Path) (Object, error)
+
+	// GetObjectVersion returns information about a specific version of an object
+	GetObjectVersion(ctx context.Context, bucket string, path Path, version uint32) (Object, error)
+	// GetObjectVersionStream returns interface for reading a specific version of the object stream,
+	// the path of the stream info is the path where the version is stored
+	GetObjectVersionStream(ctx context.Context, bucket string, path Path, version uint32) (ReadOnlyStream, error)
+	// DeleteObjectVersion permanently deletes a specific version of an object
+	DeleteObjectVersion(ctx context.Context, bucket string, path Path, version uint32) error
+	// ListObjectVersions lists all versions of the objects in bucket based on the ListOptions
+	ListObjectVersions(ctx context.Context, bucket string, options ListOptions) (ObjectList, error)
 
 	// ModifyPendingObject creates a mutable object for updating a partially uploaded object
 	ModifyPendingObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
@@ -73,6 +97,13 @@ func (create CreateObject) Object(bucket Bucket, path Path) Object {
 	}
 }
 
+// CopyObject has optional parameters that can be set when copying an object.
+// Unset values are taken from the source object.
+type CopyObject struct {
+	Metadata    map[string]string
+	ContentType string
+}
+
 // ListDirection specifies listing direction
 type ListDirection int8
 
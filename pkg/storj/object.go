@@ -31,14 +31,27 @@ type Bucket struct {
 	SegmentsSize     int64
 	RedundancyScheme RedundancyScheme
 	EncryptionScheme EncryptionScheme
+	Versioning       bool
+}
+
+// LifecycleRule describes when the objects in a bucket are deleted automatically
+type LifecycleRule struct {
+	ID string
+	// Prefix limits the rule to the objects within it, matching whole path components only
+	Prefix Path
+	// ExpirationDays is the age in days after which objects are deleted
+	ExpirationDays int
+	// AbortIncompleteUploadDays is the age in days after which pending uploads are deleted
+	AbortIncompleteUploadDays int
 }
 
 // Object contains information about a specific object
 type Object struct {
-	Version  uint32
-	Bucket   Bucket
-	Path     Path
-	IsPrefix bool
+	Version        uint32
+	Bucket         Bucket
+	Path           Path
+	IsPrefix       bool
+	IsDeleteMarker bool
 
 	Metadata map[string]string
 
@@ -6,6 +6,7 @@ package stream
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
@@ -26,8 +27,32 @@ type Upload struct {
 	errgroup errgroup.Group
 }
 
+// StagedStream is a mutable stream uploaded to a staging path, which replaces
+// its object only when committed, such as a new version of an object in a
+// versioned bucket
+type StagedStream interface {
+	storj.MutableStream
+	// StagingPath returns the path within the bucket where the stream is uploaded
+	StagingPath() storj.Path
+	// CommitStaged replaces the object with the stream uploaded to the staging path
+	CommitStaged(ctx context.Context) error
+}
+
+// putFunc is streams.Store.Put or streams.Store.Resume
+type putFunc func(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (streams.Meta, error)
+
 // NewUpload creates new stream upload.
 func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store) *Upload {
+	return newUpload(ctx, stream, streams, streams.Put)
+}
+
+// NewResumedUpload creates a stream upload continuing the pending upload of
+// the stream, if any. The upload is left pending if it fails.
+func NewResumedUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store) *Upload {
+	return newUpload(ctx, stream, streams, streams.Resume)
+}
+
+func newUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store, put putFunc) *Upload {
 	reader, writer := io.Pipe()
 
 	upload := Upload{
@@ -43,17 +68,27 @@ func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.
 		serMetaInfo := pb.SerializableMeta{
 			ContentType: obj.ContentType,
 			UserDefined: obj.Metadata,
+			Version:     obj.Version,
 		}
 		metadata, err := proto.Marshal(&serMetaInfo)
 		if err != nil {
 			return errs.Combine(err, reader.CloseWithError(err))
 		}
 
-		_, err = streams.Put(ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, reader, metadata, obj.Expires)
+		path := obj.Path
+		staged, isStaged := stream.(StagedStream)
+		if isStaged {
+			path = staged.StagingPath()
+		}
+
+		_, err = put(ctx, storj.JoinPaths(obj.Bucket.Name, path), obj.Bucket.PathCipher, reader, metadata, obj.Expires)
 		if err != nil {
 			return errs.Combine(err, reader.CloseWithError(err))
 		}
 
+		if isStaged {
+			return staged.CommitStaged(ctx)
+		}
 		return nil
 	})
 
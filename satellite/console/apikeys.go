@@ -28,6 +28,10 @@ type APIKeys interface {
 	Update(ctx context.Context, key APIKeyInfo) error
 	// Delete deletes APIKeyInfo from store
 	Delete(ctx context.Context, id uuid.UUID) error
+	// Revoke revokes the API keys restricted from the api key with given ID whose chain contains tail
+	Revoke(ctx context.Context, id uuid.UUID, tail []byte) error
+	// GetRevocations retrieves the revoked tails of the api key with given ID
+	GetRevocations(ctx context.Context, id uuid.UUID) ([][]byte, error)
 }
 
 // APIKeyInfo describing api key model in the database
@@ -39,6 +43,10 @@ type APIKeyInfo struct {
 
 	Name string `json:"name"`
 
+	// Secret is the root secret of the macaroons of the api key, empty for
+	// keys created before api keys could be restricted
+	Secret []byte `json:"-"`
+
 	CreatedAt time.Time `json:"createdAt"`
 }
 
@@ -108,5 +108,29 @@ func TestApiKeysRepository(t *testing.T) {
 			assert.Equal(t, len(keys), 9)
 			assert.NoError(t, err)
 		})
+
+		t.Run("Revoke success", func(t *testing.T) {
+			keys, err := apikeys.GetByProjectID(ctx, project.ID)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, keys)
+
+			revoked, err := apikeys.GetRevocations(ctx, keys[0].ID)
+			assert.NoError(t, err)
+			assert.Empty(t, revoked)
+
+			err = apikeys.Revoke(ctx, keys[0].ID, []byte("tail 1"))
+			assert.NoError(t, err)
+
+			err = apikeys.Revoke(ctx, keys[0].ID, []byte("tail 2"))
+			assert.NoError(t, err)
+
+			revoked, err = apikeys.GetRevocations(ctx, keys[0].ID)
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, [][]byte{[]byte("tail 1"), []byte("tail 2")}, revoked)
+
+			revoked, err = apikeys.GetRevocations(ctx, keys[1].ID)
+			assert.NoError(t, err)
+			assert.Empty(t, revoked)
+		})
 	})
 }
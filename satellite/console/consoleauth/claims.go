@@ -17,6 +17,7 @@ import (
 type Claims struct {
 	ID         uuid.UUID `json:"id"`
 	Email      string    `json:"email,omitempty"`
+	NewEmail   string    `json:"newEmail,omitempty"`
 	Expiration time.Time `json:"expires,omitempty"`
 }
 
@@ -0,0 +1,131 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleauth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// TOTP parameters (RFC 6238) supported by the common authenticator apps
+const (
+	// TOTPDigits is the length of TOTP passcodes
+	TOTPDigits = 6
+	// TOTPPeriod is how long a TOTP passcode is valid
+	TOTPPeriod = 30 * time.Second
+
+	// totpSkew is how many periods before and after the current one are accepted,
+	// to allow for the clock drift of the devices
+	totpSkew = 1
+	// totpSecretSize is the size of TOTP secrets, as recommended by RFC 4226
+	totpSecretSize = 20
+)
+
+// ErrTOTP is TOTP related error class
+var ErrTOTP = errs.Class("totp error")
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret generates new base32 encoded TOTP secret
+func NewTOTPSecret() (string, error) {
+	var secret [totpSecretSize]byte
+
+	_, err := rand.Read(secret[:])
+	if err != nil {
+		return "", ErrTOTP.Wrap(err)
+	}
+
+	return totpEncoding.EncodeToString(secret[:]), nil
+}
+
+// TOTPPasscode returns the passcode of the base32 encoded secret for the given time
+func TOTPPasscode(secret string, t time.Time) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return totpPasscode(key, uint64(t.Unix())/uint64(TOTPPeriod/time.Second)), nil
+}
+
+// ValidateTOTPPasscode checks whether passcode is the passcode of the secret for the given time
+func ValidateTOTPPasscode(secret, passcode string, t time.Time) (bool, error) {
+	_, valid, err := TOTPPasscodeStep(secret, passcode, t)
+	return valid, err
+}
+
+// TOTPPasscodeStep checks whether passcode is the passcode of the secret for the given time
+// and returns the time step it belongs to, so that the passcode of a step can be used only once
+func TOTPPasscodeStep(secret, passcode string, t time.Time) (step int64, valid bool, err error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(passcode) != TOTPDigits {
+		return 0, false, nil
+	}
+
+	counter := uint64(t.Unix()) / uint64(TOTPPeriod/time.Second)
+	for skew := -totpSkew; skew <= totpSkew; skew++ {
+		expected := totpPasscode(key, counter+uint64(skew))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(passcode)) == 1 {
+			return int64(counter + uint64(skew)), true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// TOTPKeyURI returns the otpauth uri of the secret, which the authenticator apps import from QR codes
+func TOTPKeyURI(issuer, account, secret string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("digits", fmt.Sprint(TOTPDigits))
+	params.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// decodeTOTPSecret decodes base32 encoded secret, the secret is not case sensitive
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, ErrTOTP.New("invalid secret: %v", err)
+	}
+
+	return key, nil
+}
+
+// totpPasscode computes HOTP value (RFC 4226) of the key for the counter
+func totpPasscode(key []byte, counter uint64) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	_, _ = mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%modulo)
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleauth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPPasscode(t *testing.T) {
+	// test vectors of RFC 6238, truncated to 6 digits
+	secret := totpEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	for _, tt := range []struct {
+		time     int64
+		passcode string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	} {
+		passcode, err := TOTPPasscode(secret, time.Unix(tt.time, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.passcode, passcode, tt.time)
+	}
+}
+
+func TestValidateTOTPPasscode(t *testing.T) {
+	secret, err := NewTOTPSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	passcode, err := TOTPPasscode(secret, now)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		time  time.Time
+		valid bool
+	}{
+		{now, true},
+		{now.Add(-TOTPPeriod), true},
+		{now.Add(TOTPPeriod), true},
+		{now.Add(-3 * TOTPPeriod), false},
+		{now.Add(3 * TOTPPeriod), false},
+	} {
+		valid, err := ValidateTOTPPasscode(secret, passcode, tt.time)
+		require.NoError(t, err)
+		assert.Equal(t, tt.valid, valid, tt.time.Sub(now))
+	}
+
+	valid, err := ValidateTOTPPasscode(secret, "", now)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = ValidateTOTPPasscode("not base32!", passcode, now)
+	assert.True(t, ErrTOTP.Has(err))
+}
+
+func TestTOTPPasscodeStep(t *testing.T) {
+	secret, err := NewTOTPSecret()
+	require.NoError(t, err)
+
+	now := time.Unix(1500000000, 0)
+	passcode, err := TOTPPasscode(secret, now)
+	require.NoError(t, err)
+
+	// the step of the passcode doesn't depend on the skew
+	for _, at := range []time.Time{now, now.Add(-TOTPPeriod), now.Add(TOTPPeriod)} {
+		step, valid, err := TOTPPasscodeStep(secret, passcode, at)
+		require.NoError(t, err)
+		assert.True(t, valid)
+		assert.Equal(t, int64(50000000), step)
+	}
+
+	next, err := TOTPPasscode(secret, now.Add(TOTPPeriod))
+	require.NoError(t, err)
+
+	step, valid, err := TOTPPasscodeStep(secret, next, now)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, int64(50000001), step)
+}
@@ -55,8 +55,8 @@ func graphqlCreateAPIKey(types *TypeCreator) *graphql.Object {
 	})
 }
 
-// createAPIKey holds satellite.APIKey and satellite.APIKeyInfo
+// createAPIKey holds the serialized api key and satellite.APIKeyInfo
 type createAPIKey struct {
-	Key     *console.APIKey
+	Key     string
 	KeyInfo *console.APIKeyInfo
 }
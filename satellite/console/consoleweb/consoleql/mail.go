@@ -8,6 +8,12 @@ const (
 	ActivationPath = "activationPath"
 	// SignInPath is key for sign in server route
 	SignInPath = "signInPath"
+	// PasswordRecoveryPath is key for path which handles password recovery
+	PasswordRecoveryPath = "passwordRecoveryPath"
+	// CancelPasswordRecoveryPath is key for path which cancels password recovery
+	CancelPasswordRecoveryPath = "cancelPasswordRecoveryPath"
+	// EmailChangePath is key for path which handles email change confirmation
+	EmailChangePath = "emailChangePath"
 )
 
 // AccountActivationEmail is mailservice template with activation data
@@ -34,7 +40,20 @@ type ForgotPasswordEmail struct {
 func (*ForgotPasswordEmail) Template() string { return "Forgot" }
 
 // Subject gets email subject
-func (*ForgotPasswordEmail) Subject() string { return "" }
+func (*ForgotPasswordEmail) Subject() string { return "Password recovery request" }
+
+// EmailChangeEmail is mailservice template with email change confirmation data
+type EmailChangeEmail struct {
+	Origin           string
+	UserName         string
+	ConfirmationLink string
+}
+
+// Template returns email template name
+func (*EmailChangeEmail) Template() string { return "ChangeEmail" }
+
+// Subject gets email subject
+func (*EmailChangeEmail) Subject() string { return "Confirm your new email" }
 
 // ProjectInvitationEmail is mailservice template for project invitation email
 type ProjectInvitationEmail struct {
@@ -0,0 +1,47 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	// MFASecretKeyType is graphql type name for the generated TOTP secret
+	MFASecretKeyType = "mfaSecretKey"
+	// FieldMFAEnabled is a field name for the flag of two-factor authentication
+	FieldMFAEnabled = "mfaEnabled"
+	// FieldSecretKey is a field name for TOTP secret
+	FieldSecretKey = "secretKey"
+	// FieldKeyURI is a field name for otpauth uri of TOTP secret
+	FieldKeyURI = "keyURI"
+	// FieldPasscode is a field name for TOTP passcode
+	FieldPasscode = "passcode"
+	// FieldRecoveryCode is a field name for MFA recovery code
+	FieldRecoveryCode = "recoveryCode"
+
+	// mfaIssuer is the name of the account issuer shown by the authenticator apps
+	mfaIssuer = "Storj Satellite"
+)
+
+// graphqlMFASecretKey creates mfaSecretKey graphql object
+func graphqlMFASecretKey() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: MFASecretKeyType,
+		Fields: graphql.Fields{
+			FieldSecretKey: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldKeyURI: &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+}
+
+// mfaSecretKey holds TOTP secret and its otpauth uri, which is shown as QR code
+type mfaSecretKey struct {
+	SecretKey string
+	KeyURI    string
+}
@@ -4,12 +4,15 @@
 package consoleql
 
 import (
+	"fmt"
+
 	"github.com/graphql-go/graphql"
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/post"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/mailservice"
 )
 
@@ -25,6 +28,20 @@ const (
 	DeleteAccountMutation = "deleteAccount"
 	// ChangePasswordMutation is a mutation name for password changing
 	ChangePasswordMutation = "changePassword"
+	// ForgotPasswordMutation is a mutation name for requesting password recovery
+	ForgotPasswordMutation = "forgotPassword"
+	// ResetPasswordMutation is a mutation name for password recovery
+	ResetPasswordMutation = "resetPassword"
+	// ChangeEmailMutation is a mutation name for requesting email change
+	ChangeEmailMutation = "changeEmail"
+	// ConfirmEmailChangeMutation is a mutation name for confirming email change
+	ConfirmEmailChangeMutation = "confirmEmailChange"
+	// GenerateMFASecretKeyMutation is a mutation name for generating TOTP secret before enabling MFA
+	GenerateMFASecretKeyMutation = "generateMFASecretKey"
+	// EnableMFAMutation is a mutation name for enabling MFA
+	EnableMFAMutation = "enableMFA"
+	// DisableMFAMutation is a mutation name for disabling MFA
+	DisableMFAMutation = "disableMFA"
 	// CreateProjectMutation is a mutation name for project creation
 	CreateProjectMutation = "createProject"
 	// DeleteProjectMutation is a mutation name for project deletion
@@ -36,6 +53,8 @@ const (
 	AddProjectMembersMutation = "addProjectMembers"
 	// DeleteProjectMembersMutation is a mutation name for deleting project members
 	DeleteProjectMembersMutation = "deleteProjectMembers"
+	// UpdateProjectMemberRoleMutation is a mutation name for changing the role of project member
+	UpdateProjectMemberRoleMutation = "updateProjectMemberRole"
 
 	// CreateAPIKeyMutation is a mutation name for api key creation
 	CreateAPIKeyMutation = "createAPIKey"
@@ -48,6 +67,8 @@ const (
 	FieldProjectID = "projectID"
 	// FieldNewPassword is a field name for new password
 	FieldNewPassword = "newPassword"
+	// FieldToken is a field name for the token confirming an action
+	FieldToken = "token"
 	// Secret is a field name for registration token for user creation during Vanguard release
 	Secret = "secret"
 )
@@ -168,6 +189,197 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return auth.User, nil
 				},
 			},
+			// sends password recovery email, doesn't reveal whether the account exists
+			ForgotPasswordMutation: &graphql.Field{
+				Type: graphql.Boolean,
+				Args: graphql.FieldConfigArgument{
+					FieldEmail: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					email, _ := p.Args[FieldEmail].(string)
+
+					user, err := service.GetUserByEmail(p.Context, email)
+					if err != nil {
+						log.Debug("forgot password: no user with given email", zap.Error(err))
+						return true, nil
+					}
+
+					token, err := service.GeneratePasswordRecoveryToken(p.Context, user.ID)
+					if err != nil {
+						return nil, err
+					}
+
+					rootObject := p.Info.RootValue.(map[string]interface{})
+					origin := rootObject["origin"].(string)
+					resetLink := origin + rootObject[PasswordRecoveryPath].(string) + token
+					cancelLink := origin + rootObject[CancelPasswordRecoveryPath].(string) + token
+					userName := user.ShortName
+					if user.ShortName == "" {
+						userName = user.FullName
+					}
+
+					// TODO: think of a better solution
+					go func() {
+						_ = mailService.SendRendered(
+							p.Context,
+							[]post.Address{{Address: user.Email, Name: userName}},
+							&ForgotPasswordEmail{
+								Origin:        origin,
+								UserName:      userName,
+								ResetLink:     resetLink,
+								LetUsKnowLink: cancelLink,
+							},
+						)
+					}()
+
+					return true, nil
+				},
+			},
+			ResetPasswordMutation: &graphql.Field{
+				Type: graphql.Boolean,
+				Args: graphql.FieldConfigArgument{
+					FieldToken: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldPassword: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, _ := p.Args[FieldToken].(string)
+					password, _ := p.Args[FieldPassword].(string)
+
+					err := service.ResetPassword(p.Context, token, password)
+					if err != nil {
+						return nil, err
+					}
+
+					return true, nil
+				},
+			},
+			// sends confirmation email to the new email, the email is changed after it is confirmed
+			ChangeEmailMutation: &graphql.Field{
+				Type: types.user,
+				Args: graphql.FieldConfigArgument{
+					FieldEmail: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					email, _ := p.Args[FieldEmail].(string)
+
+					auth, err := console.GetAuth(p.Context)
+					if err != nil {
+						return nil, err
+					}
+
+					token, err := service.GenerateEmailChangeToken(p.Context, email)
+					if err != nil {
+						return nil, err
+					}
+
+					rootObject := p.Info.RootValue.(map[string]interface{})
+					origin := rootObject["origin"].(string)
+					link := origin + rootObject[EmailChangePath].(string) + token
+					userName := auth.User.ShortName
+					if auth.User.ShortName == "" {
+						userName = auth.User.FullName
+					}
+
+					// TODO: think of a better solution
+					go func() {
+						_ = mailService.SendRendered(
+							p.Context,
+							[]post.Address{{Address: email, Name: userName}},
+							&EmailChangeEmail{
+								Origin:           origin,
+								UserName:         userName,
+								ConfirmationLink: link,
+							},
+						)
+					}()
+
+					return auth.User, nil
+				},
+			},
+			ConfirmEmailChangeMutation: &graphql.Field{
+				Type: graphql.Boolean,
+				Args: graphql.FieldConfigArgument{
+					FieldToken: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					token, _ := p.Args[FieldToken].(string)
+
+					err := service.ChangeEmail(p.Context, token)
+					if err != nil {
+						return nil, err
+					}
+
+					return true, nil
+				},
+			},
+			// generates TOTP secret, which is imported into the authenticator app
+			GenerateMFASecretKeyMutation: &graphql.Field{
+				Type: types.mfaSecretKey,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					auth, err := console.GetAuth(p.Context)
+					if err != nil {
+						return nil, err
+					}
+
+					key, err := service.GenerateMFASecretKey(p.Context)
+					if err != nil {
+						return nil, err
+					}
+
+					return mfaSecretKey{
+						SecretKey: key,
+						KeyURI:    consoleauth.TOTPKeyURI(mfaIssuer, auth.User.Email, key),
+					}, nil
+				},
+			},
+			// enables MFA, returns the recovery codes
+			EnableMFAMutation: &graphql.Field{
+				Type: graphql.NewList(graphql.String),
+				Args: graphql.FieldConfigArgument{
+					FieldPasscode: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					passcode, _ := p.Args[FieldPasscode].(string)
+
+					return service.EnableUserMFA(p.Context, passcode)
+				},
+			},
+			// disables MFA, either passcode or recovery code is required
+			DisableMFAMutation: &graphql.Field{
+				Type: graphql.Boolean,
+				Args: graphql.FieldConfigArgument{
+					FieldPasscode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+					FieldRecoveryCode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var mfa console.MFACredentials
+					mfa.Passcode, _ = p.Args[FieldPasscode].(string)
+					mfa.RecoveryCode, _ = p.Args[FieldRecoveryCode].(string)
+
+					err := service.DisableUserMFA(p.Context, mfa)
+					if err != nil {
+						return nil, err
+					}
+
+					return true, nil
+				},
+			},
 			DeleteAccountMutation: &graphql.Field{
 				Type: types.user,
 				Args: graphql.FieldConfigArgument{
@@ -351,6 +563,43 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return service.GetProject(p.Context, *projectID)
 				},
 			},
+			// change the role of project member
+			UpdateProjectMemberRoleMutation: &graphql.Field{
+				Type: types.project,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldEmail: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldRole: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pID, _ := p.Args[FieldProjectID].(string)
+					email, _ := p.Args[FieldEmail].(string)
+					roleName, _ := p.Args[FieldRole].(string)
+
+					projectID, err := uuid.Parse(pID)
+					if err != nil {
+						return nil, err
+					}
+
+					role, ok := console.ProjectMemberRoleFromString(roleName)
+					if !ok {
+						return nil, fmt.Errorf("unknown project member role %q", roleName)
+					}
+
+					err = service.UpdateProjectMemberRole(p.Context, *projectID, email, role)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.GetProject(p.Context, *projectID)
+				},
+			},
 			// creates new api key
 			CreateAPIKeyMutation: &graphql.Field{
 				Type: types.createAPIKey,
@@ -376,8 +625,13 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 						return nil, err
 					}
 
+					serialized, err := key.Serialize()
+					if err != nil {
+						return nil, err
+					}
+
 					return createAPIKey{
-						Key:     key,
+						Key:     serialized,
 						KeyInfo: info,
 					}, nil
 				},
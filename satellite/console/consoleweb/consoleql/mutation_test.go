@@ -5,15 +5,21 @@ package consoleql_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
 	"github.com/graphql-go/graphql"
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/post"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/auth"
@@ -22,6 +28,7 @@ import (
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
@@ -50,6 +57,8 @@ func TestGrapqhlMutation(t *testing.T) {
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			db.Console(),
 			console.TestPasswordCost,
+			25*memory.GB,
+			false,
 		)
 
 		if err != nil {
@@ -100,7 +109,7 @@ func TestGrapqhlMutation(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		token, err := service.Token(ctx, createUser.Email, createUser.Password)
+		token, err := service.Token(ctx, createUser.Email, createUser.Password, console.MFACredentials{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -184,22 +193,24 @@ func TestGrapqhlMutation(t *testing.T) {
 			return result.Data
 		}
 
-		t.Run("Update account mutation email only", func(t *testing.T) {
+		t.Run("Update account mutation doesn't change email", func(t *testing.T) {
 			email := "new@email.com"
 			query := fmt.Sprintf(
 				"mutation {updateAccount(input:{email:\"%s\"}){id,email,fullName,shortName,createdAt}}",
 				email,
 			)
 
-			result := testQuery(t, query)
-
-			data := result.(map[string]interface{})
-			user := data[consoleql.UpdateAccountMutation].(map[string]interface{})
+			result := graphql.Do(graphql.Params{
+				Schema:        schema,
+				Context:       authCtx,
+				RequestString: query,
+				RootObject:    rootObject,
+			})
+			assert.True(t, result.HasErrors())
 
-			assert.Equal(t, rootUser.ID.String(), user[consoleql.FieldID])
-			assert.Equal(t, email, user[consoleql.FieldEmail])
-			assert.Equal(t, rootUser.FullName, user[consoleql.FieldFullName])
-			assert.Equal(t, rootUser.ShortName, user[consoleql.FieldShortName])
+			user, err := service.GetUser(authCtx, rootUser.ID)
+			require.NoError(t, err)
+			assert.Equal(t, rootUser.Email, user.Email)
 		})
 
 		t.Run("Update account mutation fullName only", func(t *testing.T) {
@@ -239,13 +250,12 @@ func TestGrapqhlMutation(t *testing.T) {
 		})
 
 		t.Run("Update account mutation all info", func(t *testing.T) {
-			email := "test@newmail.com"
 			fullName := "Fill Goal"
 			shortName := "Goal"
 
 			query := fmt.Sprintf(
 				"mutation {updateAccount(input:{email:\"%s\",fullName:\"%s\",shortName:\"%s\"}){id,email,fullName,shortName,createdAt}}",
-				email,
+				rootUser.Email,
 				fullName,
 				shortName,
 			)
@@ -256,7 +266,7 @@ func TestGrapqhlMutation(t *testing.T) {
 			user := data[consoleql.UpdateAccountMutation].(map[string]interface{})
 
 			assert.Equal(t, rootUser.ID.String(), user[consoleql.FieldID])
-			assert.Equal(t, email, user[consoleql.FieldEmail])
+			assert.Equal(t, rootUser.Email, user[consoleql.FieldEmail])
 			assert.Equal(t, fullName, user[consoleql.FieldFullName])
 			assert.Equal(t, shortName, user[consoleql.FieldShortName])
 
@@ -304,7 +314,7 @@ func TestGrapqhlMutation(t *testing.T) {
 			createUser.Password = newPassword
 		})
 
-		token, err = service.Token(ctx, rootUser.Email, createUser.Password)
+		token, err = service.Token(ctx, rootUser.Email, createUser.Password, console.MFACredentials{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -450,6 +460,77 @@ func TestGrapqhlMutation(t *testing.T) {
 			assert.Equal(t, 3, len(proj[consoleql.FieldMembers].([]interface{})))
 		})
 
+		t.Run("Update project member role mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {updateProjectMemberRole(projectID:\"%s\",email:\"%s\",role:\"%s\"){id,members(limit:50,offset:0){user{id},role}}}",
+				project.ID.String(),
+				user1.Email,
+				console.RoleAdmin,
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			proj := data[consoleql.UpdateProjectMemberRoleMutation].(map[string]interface{})
+
+			roles := make(map[string]string)
+			for _, member := range proj[consoleql.FieldMembers].([]interface{}) {
+				member := member.(map[string]interface{})
+				user := member[consoleql.UserType].(map[string]interface{})
+				roles[user[consoleql.FieldID].(string)] = member[consoleql.FieldRole].(string)
+			}
+
+			assert.Equal(t, project.ID.String(), proj[consoleql.FieldID])
+			assert.Equal(t, "owner", roles[rootUser.ID.String()])
+			assert.Equal(t, "admin", roles[user1.ID.String()])
+			assert.Equal(t, "member", roles[user2.ID.String()])
+		})
+
+		t.Run("Project member role permissions", func(t *testing.T) {
+			userCtx := func(email string) context.Context {
+				token, err := service.Token(ctx, email, "123a123", console.MFACredentials{})
+				require.NoError(t, err)
+
+				sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+				require.NoError(t, err)
+
+				return console.WithAuth(ctx, sauth)
+			}
+			adminCtx, memberCtx := userCtx(user1.Email), userCtx(user2.Email)
+
+			// only the owner can delete the project or grant the admin role
+			err := service.DeleteProject(adminCtx, project.ID)
+			assert.True(t, console.ErrUnauthorized.Has(err))
+			err = service.UpdateProjectMemberRole(adminCtx, project.ID, user2.Email, console.RoleAdmin)
+			assert.True(t, console.ErrUnauthorized.Has(err))
+			err = service.UpdateProjectMemberRole(adminCtx, project.ID, rootUser.Email, console.RoleMember)
+			assert.True(t, console.ErrUnauthorized.Has(err))
+			err = service.DeleteProjectMembers(adminCtx, project.ID, []string{rootUser.Email})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+
+			// admins manage the members below them
+			err = service.UpdateProjectMemberRole(adminCtx, project.ID, user2.Email, console.RoleViewer)
+			assert.NoError(t, err)
+
+			// viewers can't create api keys or change the project
+			_, _, err = service.CreateAPIKey(memberCtx, project.ID, "viewer key")
+			assert.True(t, console.ErrUnauthorized.Has(err))
+			_, err = service.UpdateProject(memberCtx, project.ID, "viewer")
+			assert.True(t, console.ErrUnauthorized.Has(err))
+			_, err = service.AddProjectMembers(memberCtx, project.ID, []string{rootUser.Email})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+
+			err = service.UpdateProjectMemberRole(adminCtx, project.ID, user2.Email, console.RoleMember)
+			assert.NoError(t, err)
+
+			info, _, err := service.CreateAPIKey(memberCtx, project.ID, "member key")
+			require.NoError(t, err)
+			err = service.DeleteAPIKeys(memberCtx, []uuid.UUID{info.ID})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+			err = service.DeleteAPIKeys(adminCtx, []uuid.UUID{info.ID})
+			assert.NoError(t, err)
+		})
+
 		t.Run("Delete project members mutation", func(t *testing.T) {
 			query := fmt.Sprintf(
 				"mutation {deleteProjectMembers(projectID:\"%s\",email:[\"%s\",\"%s\"]){id,name,members(limit:50,offset:0){user{id}}}}",
@@ -562,3 +643,354 @@ func TestGrapqhlMutation(t *testing.T) {
 		})
 	})
 }
+
+func TestPasswordRecoveryAndEmailChange(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+
+		service, err := console.NewService(
+			log,
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			console.TestPasswordCost,
+			25*memory.GB,
+			false,
+		)
+		require.NoError(t, err)
+
+		// the link clicker follows the links of the emails, the clicks
+		// are collected by the server standing in for the console web server
+		clicks := make(chan *url.URL, 10)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			clicks <- req.URL
+		}))
+		defer server.Close()
+
+		mailService, err := mailservice.New(log, &simulate.LinkClicker{}, "testdata")
+		require.NoError(t, err)
+
+		rootObject := make(map[string]interface{})
+		rootObject["origin"] = server.URL + "/"
+		rootObject[consoleql.ActivationPath] = "activation/?token="
+		rootObject[consoleql.SignInPath] = "login"
+		rootObject[consoleql.PasswordRecoveryPath] = "password-recovery/?token="
+		rootObject[consoleql.CancelPasswordRecoveryPath] = "cancel-password-recovery/?token="
+		rootObject[consoleql.EmailChangePath] = "email-change/?token="
+
+		schema, err := consoleql.CreateSchema(log, service, mailService)
+		require.NoError(t, err)
+
+		// clickedToken waits for the link with the given path to be clicked and returns its token
+		clickedToken := func(t *testing.T, path string) string {
+			for {
+				select {
+				case link := <-clicks:
+					if link.Path == path {
+						return link.Query().Get("token")
+					}
+				case <-time.After(10 * time.Second):
+					t.Fatalf("link %s wasn't clicked", path)
+				}
+			}
+		}
+
+		doQuery := func(ctx context.Context, query string) *graphql.Result {
+			return graphql.Do(graphql.Params{
+				Schema:        schema,
+				Context:       ctx,
+				RequestString: query,
+				RootObject:    rootObject,
+			})
+		}
+
+		regToken, err := service.CreateRegToken(ctx, 1)
+		require.NoError(t, err)
+
+		user, err := service.CreateUser(ctx, console.CreateUser{
+			UserInfo: console.UserInfo{
+				FullName: "Recovery User",
+				Email:    "recovery@email.com",
+			},
+			Password: "123a123",
+		}, regToken.Secret)
+		require.NoError(t, err)
+
+		activationToken, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+		require.NoError(t, err)
+		require.NoError(t, service.ActivateAccount(ctx, activationToken))
+
+		t.Run("Password recovery", func(t *testing.T) {
+			result := doQuery(ctx, `mutation {forgotPassword(email:"unknown@email.com")}`)
+			require.False(t, result.HasErrors(), result.Errors)
+
+			result = doQuery(ctx, fmt.Sprintf(`mutation {forgotPassword(email:"%s")}`, user.Email))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			token := clickedToken(t, "/password-recovery/")
+			require.NotEmpty(t, token)
+
+			// issuing a new token revokes the previous one
+			newToken, err := service.GeneratePasswordRecoveryToken(ctx, user.ID)
+			require.NoError(t, err)
+			err = service.ResetPassword(ctx, token, "456b456")
+			assert.Error(t, err)
+			token = newToken
+
+			result = doQuery(ctx, fmt.Sprintf(`mutation {resetPassword(token:"%s",password:"%s")}`, token, "short"))
+			assert.True(t, result.HasErrors())
+
+			result = doQuery(ctx, fmt.Sprintf(`mutation {resetPassword(token:"%s",password:"%s")}`, token, "456b456"))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			_, err = service.Token(ctx, user.Email, "123a123", console.MFACredentials{})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+			_, err = service.Token(ctx, user.Email, "456b456", console.MFACredentials{})
+			assert.NoError(t, err)
+
+			// the token can be used only once
+			result = doQuery(ctx, fmt.Sprintf(`mutation {resetPassword(token:"%s",password:"%s")}`, token, "789c789"))
+			assert.True(t, result.HasErrors())
+		})
+
+		t.Run("Email change", func(t *testing.T) {
+			token, err := service.Token(ctx, user.Email, "456b456", console.MFACredentials{})
+			require.NoError(t, err)
+			sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+			require.NoError(t, err)
+			authCtx := console.WithAuth(ctx, sauth)
+
+			newEmail := "changed@email.com"
+
+			result := doQuery(authCtx, fmt.Sprintf(`mutation {changeEmail(email:"%s"){email}}`, user.Email))
+			assert.True(t, result.HasErrors())
+
+			result = doQuery(authCtx, fmt.Sprintf(`mutation {changeEmail(email:"%s"){email}}`, newEmail))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			// the email isn't changed before it is confirmed
+			changed := result.Data.(map[string]interface{})[consoleql.ChangeEmailMutation].(map[string]interface{})
+			assert.Equal(t, user.Email, changed[consoleql.FieldEmail])
+
+			confirmation := clickedToken(t, "/email-change/")
+			require.NotEmpty(t, confirmation)
+
+			current, err := service.GetUser(authCtx, user.ID)
+			require.NoError(t, err)
+			assert.Equal(t, user.Email, current.Email)
+
+			// the confirmation token is sent to the unconfirmed email, so it can't be used for authorization
+			_, err = service.Authorize(auth.WithAPIKey(ctx, []byte(confirmation)))
+			assert.True(t, console.ErrUnauthorized.Has(err))
+
+			result = doQuery(ctx, fmt.Sprintf(`mutation {confirmEmailChange(token:"%s")}`, confirmation))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			current, err = service.GetUser(authCtx, user.ID)
+			require.NoError(t, err)
+			assert.Equal(t, newEmail, current.Email)
+
+			// the confirmation token can be used only once
+			result = doQuery(ctx, fmt.Sprintf(`mutation {confirmEmailChange(token:"%s")}`, confirmation))
+			assert.True(t, result.HasErrors())
+		})
+	})
+}
+
+func TestMFA(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := zaptest.NewLogger(t)
+
+		service, err := console.NewService(
+			log,
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			db.Console(),
+			console.TestPasswordCost,
+			25*memory.GB,
+			true,
+		)
+		require.NoError(t, err)
+
+		mailService, err := mailservice.New(log, &discardSender{}, "testdata")
+		require.NoError(t, err)
+
+		schema, err := consoleql.CreateSchema(log, service, mailService)
+		require.NoError(t, err)
+
+		doQuery := func(ctx context.Context, query string) *graphql.Result {
+			return graphql.Do(graphql.Params{
+				Schema:        schema,
+				Context:       ctx,
+				RequestString: query,
+				RootObject:    make(map[string]interface{}),
+			})
+		}
+
+		regToken, err := service.CreateRegToken(ctx, 1)
+		require.NoError(t, err)
+
+		user, err := service.CreateUser(ctx, console.CreateUser{
+			UserInfo: console.UserInfo{
+				FullName: "MFA User",
+				Email:    "mfa@email.com",
+			},
+			Password: "123a123",
+		}, regToken.Secret)
+		require.NoError(t, err)
+
+		activationToken, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+		require.NoError(t, err)
+		require.NoError(t, service.ActivateAccount(ctx, activationToken))
+
+		authorize := func(t *testing.T, token string) context.Context {
+			sauth, err := service.Authorize(auth.WithAPIKey(ctx, []byte(token)))
+			require.NoError(t, err)
+			return console.WithAuth(ctx, sauth)
+		}
+
+		tokenQuery := func(credentials string) *graphql.Result {
+			return doQuery(ctx, fmt.Sprintf(`query {token(email:"%s",password:"123a123"%s){token}}`, user.Email, credentials))
+		}
+
+		token, err := service.Token(ctx, user.Email, "123a123", console.MFACredentials{})
+		require.NoError(t, err)
+		authCtx := authorize(t, token)
+
+		project, err := service.CreateProject(authCtx, console.ProjectInfo{Name: "MFA Project"})
+		require.NoError(t, err)
+
+		// the service requires MFA for creating api keys
+		_, _, err = service.CreateAPIKey(authCtx, project.ID, "key")
+		assert.True(t, console.ErrUnauthorized.Has(err))
+
+		var secretKey string
+		var recoveryCodes []string
+
+		t.Run("Enable", func(t *testing.T) {
+			result := doQuery(authCtx, `mutation {enableMFA(passcode:"123456")}`)
+			assert.True(t, result.HasErrors())
+
+			result = doQuery(authCtx, `mutation {generateMFASecretKey{secretKey,keyURI}}`)
+			require.False(t, result.HasErrors(), result.Errors)
+
+			generated := result.Data.(map[string]interface{})[consoleql.GenerateMFASecretKeyMutation].(map[string]interface{})
+			secretKey = generated[consoleql.FieldSecretKey].(string)
+			assert.NotEmpty(t, secretKey)
+			assert.Contains(t, generated[consoleql.FieldKeyURI], "otpauth://totp/")
+			assert.Contains(t, generated[consoleql.FieldKeyURI], secretKey)
+
+			passcode, err := consoleauth.TOTPPasscode(secretKey, time.Now())
+			require.NoError(t, err)
+
+			result = doQuery(authCtx, `mutation {enableMFA(passcode:"abcdef")}`)
+			assert.True(t, result.HasErrors())
+
+			result = doQuery(authCtx, fmt.Sprintf(`mutation {enableMFA(passcode:"%s")}`, passcode))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			for _, code := range result.Data.(map[string]interface{})[consoleql.EnableMFAMutation].([]interface{}) {
+				recoveryCodes = append(recoveryCodes, code.(string))
+			}
+			assert.Len(t, recoveryCodes, 10)
+
+			// the secret can't be regenerated while MFA is enabled
+			result = doQuery(authCtx, `mutation {generateMFASecretKey{secretKey}}`)
+			assert.True(t, result.HasErrors())
+
+			current, err := service.GetUser(authCtx, user.ID)
+			require.NoError(t, err)
+			assert.True(t, current.MFAEnabled)
+			assert.Len(t, current.MFARecoveryCodes, 10)
+			assert.NotContains(t, current.MFARecoveryCodes, recoveryCodes[0])
+		})
+
+		t.Run("Login", func(t *testing.T) {
+			result := tokenQuery("")
+			assert.True(t, result.HasErrors())
+
+			result = tokenQuery(`,passcode:"000000"`)
+			assert.True(t, result.HasErrors())
+
+			// the passcode of the current time step was used to enable MFA, the next one is accepted too
+			passcode, err := consoleauth.TOTPPasscode(secretKey, time.Now().Add(consoleauth.TOTPPeriod))
+			require.NoError(t, err)
+
+			result = tokenQuery(fmt.Sprintf(`,passcode:"%s"`, passcode))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			// passcodes are single use
+			_, err = service.Token(ctx, user.Email, "123a123", console.MFACredentials{Passcode: passcode})
+			assert.True(t, console.ErrMFAInvalid.Has(err))
+
+			token := result.Data.(map[string]interface{})[consoleql.TokenQuery].(map[string]interface{})[consoleql.TokenType].(string)
+			authCtx = authorize(t, token)
+
+			_, _, err = service.CreateAPIKey(authCtx, project.ID, "key")
+			assert.NoError(t, err)
+
+			// recovery codes are single use
+			result = tokenQuery(fmt.Sprintf(`,recoveryCode:"%s"`, recoveryCodes[0]))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			result = tokenQuery(fmt.Sprintf(`,recoveryCode:"%s"`, recoveryCodes[0]))
+			assert.True(t, result.HasErrors())
+
+			_, err = service.Token(ctx, user.Email, "123a123", console.MFACredentials{RecoveryCode: "unknown"})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+			assert.True(t, console.ErrMFAInvalid.Has(err))
+
+			_, err = service.Token(ctx, user.Email, "123a123", console.MFACredentials{})
+			assert.True(t, console.ErrMFAMissing.Has(err))
+		})
+
+		t.Run("Disable", func(t *testing.T) {
+			result := doQuery(authCtx, `mutation {disableMFA}`)
+			assert.True(t, result.HasErrors())
+
+			result = doQuery(authCtx, fmt.Sprintf(`mutation {disableMFA(recoveryCode:"%s")}`, recoveryCodes[0]))
+			assert.True(t, result.HasErrors())
+
+			result = doQuery(authCtx, fmt.Sprintf(`mutation {disableMFA(recoveryCode:"%s")}`, recoveryCodes[1]))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			current, err := service.GetUser(authCtx, user.ID)
+			require.NoError(t, err)
+			assert.False(t, current.MFAEnabled)
+			assert.Empty(t, current.MFASecretKey)
+			assert.Empty(t, current.MFARecoveryCodes)
+
+			result = tokenQuery("")
+			require.False(t, result.HasErrors(), result.Errors)
+		})
+
+		t.Run("Lockout", func(t *testing.T) {
+			result := doQuery(authCtx, `mutation {generateMFASecretKey{secretKey}}`)
+			require.False(t, result.HasErrors(), result.Errors)
+			secretKey = result.Data.(map[string]interface{})[consoleql.GenerateMFASecretKeyMutation].(map[string]interface{})[consoleql.FieldSecretKey].(string)
+
+			passcode, err := consoleauth.TOTPPasscode(secretKey, time.Now())
+			require.NoError(t, err)
+
+			result = doQuery(authCtx, fmt.Sprintf(`mutation {enableMFA(passcode:"%s")}`, passcode))
+			require.False(t, result.HasErrors(), result.Errors)
+
+			for i := 0; i < 5; i++ {
+				_, err = service.Token(ctx, user.Email, "123a123", console.MFACredentials{Passcode: "000000"})
+				assert.True(t, console.ErrMFAInvalid.Has(err))
+			}
+
+			// even the correct passcode is refused while MFA is locked
+			passcode, err = consoleauth.TOTPPasscode(secretKey, time.Now().Add(consoleauth.TOTPPeriod))
+			require.NoError(t, err)
+
+			_, err = service.Token(ctx, user.Email, "123a123", console.MFACredentials{Passcode: passcode})
+			assert.True(t, console.ErrUnauthorized.Has(err))
+			assert.True(t, console.ErrMFALocked.Has(err))
+		})
+	})
+}
@@ -18,6 +18,8 @@ const (
 	ProjectInputType = "projectInput"
 	// ProjectUsageType is a graphql type name for project usage
 	ProjectUsageType = "projectUsage"
+	// ProjectLimitsType is a graphql type name for project usage limits
+	ProjectLimitsType = "projectLimits"
 	// FieldName is a field name for "name"
 	FieldName = "name"
 	// FieldDescription is a field name for description
@@ -28,10 +30,14 @@ const (
 	FieldAPIKeys = "apiKeys"
 	// FieldUsage is a field name for usage rollup
 	FieldUsage = "usage"
+	// FieldLimits is a field name for usage limits
+	FieldLimits = "limits"
 	// FieldStorage is a field name for storage total
 	FieldStorage = "storage"
 	// FieldEgress is a field name for egress total
 	FieldEgress = "egress"
+	// FieldBandwidth is a field name for bandwidth limit
+	FieldBandwidth = "bandwidth"
 	// FieldObjectsCount is a field name for objects count
 	FieldObjectsCount = "objectsCount"
 	// LimitArg is argument name for limit
@@ -111,6 +117,7 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 						users = append(users, projectMember{
 							User:     user,
 							JoinedAt: member.CreatedAt,
+							Role:     member.Role,
 						})
 					}
 
@@ -144,6 +151,14 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 					return service.GetProjectUsage(p.Context, project.ID, since, before)
 				},
 			},
+			FieldLimits: &graphql.Field{
+				Type: types.projectLimits,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					return service.GetProjectLimits(p.Context, project.ID)
+				},
+			},
 		},
 	})
 }
@@ -187,6 +202,29 @@ func graphqlProjectUsage() *graphql.Object {
 	})
 }
 
+// graphqlProjectLimits creates project usage limits graphql type, the limits are in bytes
+func graphqlProjectLimits() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ProjectLimitsType,
+		Fields: graphql.Fields{
+			FieldStorage: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*console.ProjectLimits)
+					return limits.Storage.Int64(), nil
+				},
+			},
+			FieldBandwidth: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					limits, _ := p.Source.(*console.ProjectLimits)
+					return limits.Bandwidth.Int64(), nil
+				},
+			},
+		},
+	})
+}
+
 // fromMapProjectInfo creates satellite.ProjectInfo from input args
 func fromMapProjectInfo(args map[string]interface{}) (project console.ProjectInfo) {
 	project.Name, _ = args[FieldName].(string)
@@ -77,12 +77,23 @@ func rootQuery(service *console.Service, types *TypeCreator) *graphql.Object {
 					FieldPassword: &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.String),
 					},
+					// either passcode or recovery code is required for the users with MFA enabled
+					FieldPasscode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+					FieldRecoveryCode: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					email, _ := p.Args[FieldEmail].(string)
 					pass, _ := p.Args[FieldPassword].(string)
 
-					token, err := service.Token(p.Context, email, pass)
+					var mfa console.MFACredentials
+					mfa.Passcode, _ = p.Args[FieldPasscode].(string)
+					mfa.RecoveryCode, _ = p.Args[FieldRecoveryCode].(string)
+
+					token, err := service.Token(p.Context, email, pass, mfa)
 					if err != nil {
 						return nil, err
 					}
@@ -12,7 +12,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
@@ -34,6 +36,8 @@ func TestGraphqlQuery(t *testing.T) {
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			db.Console(),
 			console.TestPasswordCost,
+			25*memory.GB,
+			false,
 		)
 
 		if err != nil {
@@ -98,7 +102,7 @@ func TestGraphqlQuery(t *testing.T) {
 			rootUser.Email = "mtest@email.com"
 		})
 
-		token, err := service.Token(ctx, createUser.Email, createUser.Password)
+		token, err := service.Token(ctx, createUser.Email, createUser.Password, console.MFACredentials{})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -384,6 +388,35 @@ func TestGraphqlQuery(t *testing.T) {
 			assert.True(t, foundKey2)
 		})
 
+		t.Run("Project query limits", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"query {project(id:\"%s\"){limits{storage,bandwidth}}}",
+				createdProject.ID.String(),
+			)
+
+			limitsOf := func() map[string]interface{} {
+				result := testQuery(t, query)
+
+				data := result.(map[string]interface{})
+				project := data[consoleql.ProjectQuery].(map[string]interface{})
+				return project[consoleql.FieldLimits].(map[string]interface{})
+			}
+
+			// projects without their own limits have the default limits
+			limits := limitsOf()
+			assert.Equal(t, float64(25*memory.GB), limits[consoleql.FieldStorage])
+			assert.Equal(t, float64(25*memory.GB), limits[consoleql.FieldBandwidth])
+
+			err := db.Accounting().UpdateProjectLimits(ctx, createdProject.ID, accounting.ProjectLimits{
+				Storage: 10 * memory.GB,
+			})
+			assert.NoError(t, err)
+
+			limits = limitsOf()
+			assert.Equal(t, float64(10*memory.GB), limits[consoleql.FieldStorage])
+			assert.Equal(t, float64(25*memory.GB), limits[consoleql.FieldBandwidth])
+		})
+
 		project2, err := service.CreateProject(authCtx, console.ProjectInfo{
 			Name:        "Project2",
 			Description: "Test desc",
@@ -21,9 +21,11 @@ type TypeCreator struct {
 	user          *graphql.Object
 	project       *graphql.Object
 	projectUsage  *graphql.Object
+	projectLimits *graphql.Object
 	projectMember *graphql.Object
 	apiKeyInfo    *graphql.Object
 	createAPIKey  *graphql.Object
+	mfaSecretKey  *graphql.Object
 
 	userInput    *graphql.InputObject
 	projectInput *graphql.InputObject
@@ -53,6 +55,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.projectLimits = graphqlProjectLimits()
+	if err := c.projectLimits.Error(); err != nil {
+		return err
+	}
+
 	c.apiKeyInfo = graphqlAPIKeyInfo()
 	if err := c.apiKeyInfo.Error(); err != nil {
 		return err
@@ -63,6 +70,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.mfaSecretKey = graphqlMFASecretKey()
+	if err := c.mfaSecretKey.Error(); err != nil {
+		return err
+	}
+
 	c.projectMember = graphqlProjectMember(service, c)
 	if err := c.projectMember.Error(); err != nil {
 		return err
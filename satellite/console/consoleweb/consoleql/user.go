@@ -45,6 +45,9 @@ func baseUserConfig() graphql.ObjectConfig {
 			FieldShortName: &graphql.Field{
 				Type: graphql.String,
 			},
+			FieldMFAEnabled: &graphql.Field{
+				Type: graphql.Boolean,
+			},
 			FieldCreatedAt: &graphql.Field{
 				Type: graphql.DateTime,
 			},
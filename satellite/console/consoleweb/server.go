@@ -46,6 +46,8 @@ type Config struct {
 	AuthToken string `help:"auth token needed for access to registration token creation endpoint" default:""`
 
 	PasswordCost int `internal:"true" help:"password hashing cost (0=automatic)" default:"0"`
+
+	RequireMFAForAPIKeys bool `help:"allow only the users with two-factor authentication enabled to create api keys" default:"false"`
 }
 
 // Server represents console web server
@@ -89,6 +91,9 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, mail
 
 	if server.config.StaticDir != "" {
 		mux.Handle("/activation/", http.HandlerFunc(server.accountActivationHandler))
+		mux.Handle("/password-recovery/", http.HandlerFunc(server.passwordRecoveryHandler))
+		mux.Handle("/cancel-password-recovery/", http.HandlerFunc(server.cancelPasswordRecoveryHandler))
+		mux.Handle("/email-change/", http.HandlerFunc(server.emailChangeHandler))
 		mux.Handle("/registrationToken/", http.HandlerFunc(server.createRegistrationTokenHandler))
 		mux.Handle("/static/", http.StripPrefix("/static", fs))
 		mux.Handle("/", http.HandlerFunc(server.appHandler))
@@ -159,6 +164,35 @@ func (s *Server) accountActivationHandler(w http.ResponseWriter, req *http.Reque
 	http.ServeFile(w, req, filepath.Join(s.config.StaticDir, "static", "activation", "success.html"))
 }
 
+// passwordRecoveryHandler serves the page for setting the new password with the recovery token
+func (s *Server) passwordRecoveryHandler(w http.ResponseWriter, req *http.Request) {
+	http.ServeFile(w, req, filepath.Join(s.config.StaticDir, "static", "resetPassword", "resetPassword.html"))
+}
+
+// cancelPasswordRecoveryHandler revokes the recovery token of the password recovery the user didn't request
+func (s *Server) cancelPasswordRecoveryHandler(w http.ResponseWriter, req *http.Request) {
+	recoveryToken := req.URL.Query().Get("token")
+
+	// No need to check error as we anyway redirect user to the login page
+	_ = s.service.RevokeResetPasswordToken(context.Background(), recoveryToken)
+
+	http.Redirect(w, req, "/login", http.StatusSeeOther)
+}
+
+// emailChangeHandler confirms the change of the email of the user
+func (s *Server) emailChangeHandler(w http.ResponseWriter, req *http.Request) {
+	emailChangeToken := req.URL.Query().Get("token")
+
+	err := s.service.ChangeEmail(context.Background(), emailChangeToken)
+	if err != nil {
+		s.log.Debug("email change failed", zap.Error(err))
+		http.ServeFile(w, req, filepath.Join(s.config.StaticDir, "static", "errors", "404.html"))
+		return
+	}
+
+	http.Redirect(w, req, "/login", http.StatusSeeOther)
+}
+
 // grapqlHandler is graphql endpoint http handler function
 func (s *Server) grapqlHandler(w http.ResponseWriter, req *http.Request) {
 	w.Header().Set(contentType, applicationJSON)
@@ -183,6 +217,9 @@ func (s *Server) grapqlHandler(w http.ResponseWriter, req *http.Request) {
 	rootObject["origin"] = s.config.ExternalAddress
 	rootObject[consoleql.ActivationPath] = "activation/?token="
 	rootObject[consoleql.SignInPath] = "login"
+	rootObject[consoleql.PasswordRecoveryPath] = "password-recovery/?token="
+	rootObject[consoleql.CancelPasswordRecoveryPath] = "cancel-password-recovery/?token="
+	rootObject[consoleql.EmailChangePath] = "email-change/?token="
 
 	result := graphql.Do(graphql.Params{
 		Schema:         s.schema,
@@ -0,0 +1,90 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// mfaRecoveryCodeCount is how many recovery codes are issued when MFA is enabled
+	mfaRecoveryCodeCount = 10
+	// mfaRecoveryCodeSize is the size of the random part of recovery codes
+	mfaRecoveryCodeSize = 10
+	// mfaMaxFailedAttempts is how many MFA attempts may fail before MFA is locked
+	mfaMaxFailedAttempts = 5
+	// mfaLockoutDuration is how long MFA stays locked after too many failed attempts
+	mfaLockoutDuration = 15 * time.Minute
+)
+
+var (
+	// ErrMFAMissing is error type of the login of the user with MFA enabled missing the passcode or recovery code
+	ErrMFAMissing = errs.Class("mfa credentials missing")
+	// ErrMFAInvalid is error type of incorrect passcode or recovery code
+	ErrMFAInvalid = errs.Class("mfa credentials invalid")
+	// ErrMFALocked is error type of the MFA attempts of the user locked after too many failures
+	ErrMFALocked = errs.Class("mfa locked")
+)
+
+// MFACredentials holds the second factor of the users with MFA enabled,
+// either Passcode or RecoveryCode is required
+type MFACredentials struct {
+	// Passcode is the TOTP passcode of the authenticator app
+	Passcode string
+	// RecoveryCode is one of the single use recovery codes, for the case the authenticator is lost
+	RecoveryCode string
+}
+
+// IsEmpty checks whether credentials are missing
+func (credentials MFACredentials) IsEmpty() bool {
+	return credentials.Passcode == "" && credentials.RecoveryCode == ""
+}
+
+// newMFARecoveryCodes generates recovery codes, it returns the codes handed to the user and their hashes
+func newMFARecoveryCodes() (codes, hashes []string, err error) {
+	for i := 0; i < mfaRecoveryCodeCount; i++ {
+		var b [mfaRecoveryCodeSize]byte
+
+		_, err := rand.Read(b[:])
+		if err != nil {
+			return nil, nil, errs.New("error creating recovery code")
+		}
+
+		code := base32.StdEncoding.EncodeToString(b[:])
+		codes = append(codes, code[:8]+"-"+code[8:16])
+		hashes = append(hashes, hashMFARecoveryCode(code[:16]))
+	}
+
+	return codes, hashes, nil
+}
+
+// hashMFARecoveryCode hashes the normalized recovery code, the codes are
+// random enough for a plain hash and are stored only as hashes
+func hashMFARecoveryCode(code string) string {
+	code = strings.ToUpper(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// useMFARecoveryCode returns hashes without the hash of the code, ok is false if the code is unknown
+func useMFARecoveryCode(hashes []string, code string) (remaining []string, ok bool) {
+	hash := hashMFARecoveryCode(code)
+
+	for i, candidate := range hashes {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(hash)) == 1 {
+			remaining = append(remaining, hashes[:i]...)
+			return append(remaining, hashes[i+1:]...), true
+		}
+	}
+
+	return hashes, false
+}
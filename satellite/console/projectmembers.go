@@ -17,7 +17,9 @@ type ProjectMembers interface {
 	// GetByProjectID is a method for querying project members from the database by projectID, offset and limit.
 	GetByProjectID(ctx context.Context, projectID uuid.UUID, pagination Pagination) ([]ProjectMember, error)
 	// Insert is a method for inserting project member into the database.
-	Insert(ctx context.Context, memberID, projectID uuid.UUID) (*ProjectMember, error)
+	Insert(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) (*ProjectMember, error)
+	// UpdateRole is a method for changing the role of project member in the database.
+	UpdateRole(ctx context.Context, memberID, projectID uuid.UUID, role ProjectMemberRole) error
 	// Delete is a method for deleting project member by memberID and projectID from the database.
 	Delete(ctx context.Context, memberID, projectID uuid.UUID) error
 }
@@ -29,9 +31,61 @@ type ProjectMember struct {
 	// FK on Projects table.
 	ProjectID uuid.UUID
 
+	Role ProjectMemberRole
+
 	CreatedAt time.Time
 }
 
+// ProjectMemberRole defines what a project member is allowed to do in the project
+type ProjectMemberRole int
+
+const (
+	// RoleViewer indicates that member can only view the project
+	RoleViewer ProjectMemberRole = 1
+	// RoleMember indicates that member can use the project and create api keys
+	RoleMember ProjectMemberRole = 2
+	// RoleAdmin indicates that member can manage project, its members and api keys
+	RoleAdmin ProjectMemberRole = 3
+	// RoleOwner indicates that member owns the project and can delete it
+	RoleOwner ProjectMemberRole = 4
+)
+
+// ProjectMemberRoleFromString parses role from its name
+func ProjectMemberRoleFromString(name string) (ProjectMemberRole, bool) {
+	for _, role := range []ProjectMemberRole{RoleViewer, RoleMember, RoleAdmin, RoleOwner} {
+		if role.String() == name {
+			return role, true
+		}
+	}
+	return 0, false
+}
+
+// IsValid checks whether role is one of the known roles
+func (role ProjectMemberRole) IsValid() bool {
+	return role >= RoleViewer && role <= RoleOwner
+}
+
+// Includes checks whether role grants all permissions of the other role
+func (role ProjectMemberRole) Includes(other ProjectMemberRole) bool {
+	return role >= other
+}
+
+// String returns the name of the role
+func (role ProjectMemberRole) String() string {
+	switch role {
+	case RoleViewer:
+		return "viewer"
+	case RoleMember:
+		return "member"
+	case RoleAdmin:
+		return "admin"
+	case RoleOwner:
+		return "owner"
+	default:
+		return "unknown"
+	}
+}
+
 // Pagination defines pagination, filtering and sorting rules
 type Pagination struct {
 	Limit  int
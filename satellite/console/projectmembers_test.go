@@ -32,7 +32,7 @@ func TestProjectMembersRepository(t *testing.T) {
 			unexistingUserID, err := uuid.New()
 			assert.NoError(t, err)
 
-			projMember, err := projectMembers.Insert(ctx, *unexistingUserID, createdProjects[0].ID)
+			projMember, err := projectMembers.Insert(ctx, *unexistingUserID, createdProjects[0].ID, console.RoleMember)
 			assert.Nil(t, projMember)
 			assert.Error(t, err)
 		})
@@ -41,37 +41,37 @@ func TestProjectMembersRepository(t *testing.T) {
 			unexistingProjectID, err := uuid.New()
 			assert.NoError(t, err)
 
-			projMember, err := projectMembers.Insert(ctx, createdUsers[0].ID, *unexistingProjectID)
+			projMember, err := projectMembers.Insert(ctx, createdUsers[0].ID, *unexistingProjectID, console.RoleMember)
 			assert.Nil(t, projMember)
 			assert.Error(t, err)
 		})
 
 		t.Run("Insert  success", func(t *testing.T) {
-			projMember1, err := projectMembers.Insert(ctx, createdUsers[0].ID, createdProjects[0].ID)
+			projMember1, err := projectMembers.Insert(ctx, createdUsers[0].ID, createdProjects[0].ID, console.RoleOwner)
 			assert.NotNil(t, projMember1)
 			assert.NoError(t, err)
 
-			projMember2, err := projectMembers.Insert(ctx, createdUsers[1].ID, createdProjects[0].ID)
+			projMember2, err := projectMembers.Insert(ctx, createdUsers[1].ID, createdProjects[0].ID, console.RoleMember)
 			assert.NotNil(t, projMember2)
 			assert.NoError(t, err)
 
-			projMember3, err := projectMembers.Insert(ctx, createdUsers[3].ID, createdProjects[0].ID)
+			projMember3, err := projectMembers.Insert(ctx, createdUsers[3].ID, createdProjects[0].ID, console.RoleMember)
 			assert.NotNil(t, projMember3)
 			assert.NoError(t, err)
 
-			projMember4, err := projectMembers.Insert(ctx, createdUsers[4].ID, createdProjects[0].ID)
+			projMember4, err := projectMembers.Insert(ctx, createdUsers[4].ID, createdProjects[0].ID, console.RoleMember)
 			assert.NotNil(t, projMember4)
 			assert.NoError(t, err)
 
-			projMember5, err := projectMembers.Insert(ctx, createdUsers[5].ID, createdProjects[0].ID)
+			projMember5, err := projectMembers.Insert(ctx, createdUsers[5].ID, createdProjects[0].ID, console.RoleMember)
 			assert.NotNil(t, projMember5)
 			assert.NoError(t, err)
 
-			projMember6, err := projectMembers.Insert(ctx, createdUsers[2].ID, createdProjects[1].ID)
+			projMember6, err := projectMembers.Insert(ctx, createdUsers[2].ID, createdProjects[1].ID, console.RoleOwner)
 			assert.NotNil(t, projMember6)
 			assert.NoError(t, err)
 
-			projMember7, err := projectMembers.Insert(ctx, createdUsers[0].ID, createdProjects[1].ID)
+			projMember7, err := projectMembers.Insert(ctx, createdUsers[0].ID, createdProjects[1].ID, console.RoleMember)
 			assert.NotNil(t, projMember7)
 			assert.NoError(t, err)
 		})
@@ -137,6 +137,24 @@ func TestProjectMembersRepository(t *testing.T) {
 			assert.Equal(t, originalMember2.ID, selectedMembers2[0].MemberID)
 		})
 
+		t.Run("Update member role", func(t *testing.T) {
+			err := projectMembers.UpdateRole(ctx, createdUsers[1].ID, createdProjects[0].ID, console.RoleAdmin)
+			assert.NoError(t, err)
+
+			memberships, err := projectMembers.GetByMemberID(ctx, createdUsers[1].ID)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(memberships))
+			assert.Equal(t, console.RoleAdmin, memberships[0].Role)
+
+			members, err := projectMembers.GetByProjectID(ctx, createdProjects[0].ID, console.Pagination{Limit: 6, Offset: 0, Search: "Jameson", Order: 1})
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(members))
+			assert.Equal(t, console.RoleOwner, members[0].Role)
+
+			err = projectMembers.UpdateRole(ctx, createdUsers[1].ID, createdProjects[1].ID, console.RoleAdmin)
+			assert.Error(t, err)
+		})
+
 		t.Run("Delete member by memberID and projectID success", func(t *testing.T) {
 			err := projectMembers.Delete(ctx, createdUsers[0].ID, createdProjects[0].ID)
 			assert.NoError(t, err)
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/internal/memory"
 )
 
 // Projects exposes methods to manage Project table in database.
@@ -33,9 +35,19 @@ type Project struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 
+	// usage limits of the project, zero means the default limit of the satellite applies
+	StorageLimit   memory.Size `json:"storageLimit"`
+	BandwidthLimit memory.Size `json:"bandwidthLimit"`
+
 	CreatedAt time.Time `json:"createdAt"`
 }
 
+// ProjectLimits holds the storage and monthly bandwidth usage limits of a project
+type ProjectLimits struct {
+	Storage   memory.Size `json:"storage"`
+	Bandwidth memory.Size `json:"bandwidth"`
+}
+
 // ProjectInfo holds data needed to create/update Project
 type ProjectInfo struct {
 	Name        string `json:"name"`
@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/subtle"
 	"fmt"
+	"net/mail"
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
@@ -15,7 +16,9 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/satellite/console/consoleauth"
 )
 
@@ -28,6 +31,9 @@ const (
 	maxLimit            = 50
 	tokenExpirationTime = 24 * time.Hour
 
+	// passwordRecoveryTokenExpiration is how long a reset password token can be used
+	passwordRecoveryTokenExpiration = time.Hour
+
 	// DefaultPasswordCost is the hashing complexity
 	DefaultPasswordCost = bcrypt.DefaultCost
 	// TestPasswordCost is the hashing complexity to use for testing
@@ -42,10 +48,16 @@ type Service struct {
 	log   *zap.Logger
 
 	passwordCost int
+
+	// defaultUsageLimit applies to the projects without their own usage limits
+	defaultUsageLimit memory.Size
+
+	// requireMFAForAPIKeys allows only the users with MFA enabled to create api keys
+	requireMFAForAPIKeys bool
 }
 
 // NewService returns new instance of Service
-func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int) (*Service, error) {
+func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int, defaultUsageLimit memory.Size, requireMFAForAPIKeys bool) (*Service, error) {
 	if signer == nil {
 		return nil, errs.New("signer can't be nil")
 	}
@@ -63,10 +75,12 @@ func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int) (*Se
 	}
 
 	return &Service{
-		Signer:       signer,
-		store:        store,
-		log:          log,
-		passwordCost: passwordCost,
+		Signer:               signer,
+		store:                store,
+		log:                  log,
+		passwordCost:         passwordCost,
+		defaultUsageLimit:    defaultUsageLimit,
+		requireMFAForAPIKeys: requireMFAForAPIKeys,
 	}, nil
 }
 
@@ -163,8 +177,9 @@ func (s *Service) ActivateAccount(ctx context.Context, activationToken string) (
 	return s.store.Users().Update(ctx, user)
 }
 
-// Token authenticates User by credentials and returns auth token
-func (s *Service) Token(ctx context.Context, email, password string) (token string, err error) {
+// Token authenticates User by credentials and returns auth token,
+// the users with MFA enabled are required to provide the passcode or one of the recovery codes
+func (s *Service) Token(ctx context.Context, email, password string, mfa MFACredentials) (token string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	email = normalizeEmail(email)
@@ -179,6 +194,13 @@ func (s *Service) Token(ctx context.Context, email, password string) (token stri
 		return "", ErrUnauthorized.New("password is incorrect: %s", err.Error())
 	}
 
+	if user.MFAEnabled {
+		err = s.checkMFA(ctx, user, mfa)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	claims := consoleauth.Claims{
 		ID:         user.ID,
 		Expiration: time.Now().Add(tokenExpirationTime),
@@ -203,10 +225,15 @@ func (s *Service) GetUser(ctx context.Context, id uuid.UUID) (u *User, err error
 	return s.store.Users().Get(ctx, id)
 }
 
-// UpdateAccount updates User
+// GetUserByEmail returns User by email, it is used by the flows which don't require authorization
+func (s *Service) GetUserByEmail(ctx context.Context, email string) (u *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.store.Users().GetByEmail(ctx, normalizeEmail(email))
+}
+
+// UpdateAccount updates User, the email can only be changed with ChangeEmail
 func (s *Service) UpdateAccount(ctx context.Context, info UserInfo) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	_, err = GetAuth(ctx)
 	auth, err := GetAuth(ctx)
 	if err != nil {
 		return err
@@ -216,17 +243,198 @@ func (s *Service) UpdateAccount(ctx context.Context, info UserInfo) (err error)
 		return err
 	}
 
-	//TODO: store original email input in the db,
+	if normalizeEmail(info.Email) != normalizeEmail(auth.User.Email) {
+		return ErrValidation.New("email can't be changed without confirmation")
+	}
+
+	// update the stored user, so the fields not in info stay intact
+	user, err := s.store.Users().Get(ctx, auth.User.ID)
+	if err != nil {
+		return err
+	}
+
+	user.FullName = info.FullName
+	user.ShortName = info.ShortName
+
+	return s.store.Users().Update(ctx, user)
+}
+
+// GenerateEmailChangeToken - is a method for generating the token confirming the change
+// of the email of the authorized user to the new email
+func (s *Service) GenerateEmailChangeToken(ctx context.Context, newEmail string) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = mail.ParseAddress(newEmail); err != nil {
+		return "", ErrValidation.Wrap(err)
+	}
+
+	// TODO: store original email input in the db,
 	// add normalization
-	email := normalizeEmail(info.Email)
-
-	return s.store.Users().Update(ctx, &User{
-		ID:           auth.User.ID,
-		FullName:     info.FullName,
-		ShortName:    info.ShortName,
-		Email:        email,
-		PasswordHash: nil,
-	})
+	email := normalizeEmail(newEmail)
+
+	if email == normalizeEmail(auth.User.Email) {
+		return "", ErrValidation.New("%s is the current email", email)
+	}
+
+	_, err = s.store.Users().GetByEmail(ctx, email)
+	if err == nil {
+		return "", errs.New("%s is already in use", email)
+	}
+
+	// the token is bound to the current email, so it can't be used
+	// once the email has changed
+	claims := &consoleauth.Claims{
+		ID:         auth.User.ID,
+		Email:      auth.User.Email,
+		NewEmail:   email,
+		Expiration: time.Now().Add(tokenExpirationTime),
+	}
+
+	return s.createToken(claims)
+}
+
+// ChangeEmail - is a method for changing the email of the user after the new email is confirmed
+func (s *Service) ChangeEmail(ctx context.Context, emailChangeToken string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	token, err := consoleauth.FromBase64URLString(emailChangeToken)
+	if err != nil {
+		return
+	}
+
+	claims, err := s.authenticate(token)
+	if err != nil {
+		return
+	}
+
+	if claims.NewEmail == "" {
+		return errs.New("not an email change token")
+	}
+
+	if time.Now().After(claims.Expiration) {
+		return errs.New("email change token has expired")
+	}
+
+	user, err := s.store.Users().Get(ctx, claims.ID)
+	if err != nil {
+		return
+	}
+
+	if user.Email != claims.Email {
+		return errs.New("email change token is already used")
+	}
+
+	_, err = s.store.Users().GetByEmail(ctx, claims.NewEmail)
+	if err == nil {
+		return errs.New("%s is already in use", claims.NewEmail)
+	}
+
+	user.Email = claims.NewEmail
+
+	return s.store.Users().Update(ctx, user)
+}
+
+// GeneratePasswordRecoveryToken - is a method for generating a single use password recovery token,
+// the previous token of the user is revoked
+func (s *Service) GeneratePasswordRecoveryToken(ctx context.Context, id uuid.UUID) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resetPasswordToken, err := s.store.ResetPasswordTokens().GetByOwnerID(ctx, id)
+	if err == nil {
+		err = s.store.ResetPasswordTokens().Delete(ctx, resetPasswordToken.Secret)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	resetPasswordToken, err = s.store.ResetPasswordTokens().Create(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	return resetPasswordToken.Secret.String(), nil
+}
+
+// ResetPassword - is a method for resetting the password of the user the recovery token was issued for
+func (s *Service) ResetPassword(ctx context.Context, resetPasswordToken, password string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	token, err := s.getPasswordRecoveryToken(ctx, resetPasswordToken)
+	if err != nil {
+		return err
+	}
+
+	if err := validatePassword(password); err != nil {
+		return err
+	}
+
+	user, err := s.store.Users().Get(ctx, token.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), s.passwordCost)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, tx.Rollback())
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	user.PasswordHash = hash
+	err = tx.Users().Update(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	return tx.ResetPasswordTokens().Delete(ctx, token.Secret)
+}
+
+// RevokeResetPasswordToken - is a method to revoke the password recovery token,
+// when the user didn't request the password recovery
+func (s *Service) RevokeResetPasswordToken(ctx context.Context, resetPasswordToken string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	secret, err := ResetPasswordSecretFromBase64(resetPasswordToken)
+	if err != nil {
+		return err
+	}
+
+	return s.store.ResetPasswordTokens().Delete(ctx, secret)
+}
+
+// getPasswordRecoveryToken returns the password recovery token if it is valid and not expired
+func (s *Service) getPasswordRecoveryToken(ctx context.Context, resetPasswordToken string) (*ResetPasswordToken, error) {
+	secret, err := ResetPasswordSecretFromBase64(resetPasswordToken)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.store.ResetPasswordTokens().GetBySecret(ctx, secret)
+	if err != nil {
+		return nil, errs.New("password recovery token is not valid")
+	}
+
+	if time.Since(token.CreatedAt) > passwordRecoveryTokenExpiration {
+		return nil, errs.New("password recovery token has expired")
+	}
+
+	return token, nil
 }
 
 // ChangePassword updates password for a given user
@@ -271,6 +479,120 @@ func (s *Service) DeleteAccount(ctx context.Context, password string) (err error
 	return s.store.Users().Delete(ctx, auth.User.ID)
 }
 
+// GenerateMFASecretKey generates new TOTP secret of the authorized user, it is
+// imported into the authenticator app before MFA is enabled with EnableUserMFA
+func (s *Service) GenerateMFASecretKey(ctx context.Context) (key string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	user, err := s.store.Users().Get(ctx, auth.User.ID)
+	if err != nil {
+		return "", err
+	}
+
+	if user.MFAEnabled {
+		return "", ErrValidation.New("two-factor authentication is already enabled")
+	}
+
+	key, err = consoleauth.NewTOTPSecret()
+	if err != nil {
+		return "", err
+	}
+
+	user.MFASecretKey = key
+	err = s.store.Users().Update(ctx, user)
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+// EnableUserMFA enables MFA of the authorized user, the passcode confirms that the
+// authenticator app uses the generated secret. It returns the recovery codes,
+// which are shown to the user only once
+func (s *Service) EnableUserMFA(ctx context.Context, passcode string) (recoveryCodes []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.store.Users().Get(ctx, auth.User.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.MFAEnabled {
+		return nil, ErrValidation.New("two-factor authentication is already enabled")
+	}
+
+	if user.MFASecretKey == "" {
+		return nil, ErrValidation.New("two-factor authentication secret key is not generated")
+	}
+
+	step, valid, err := consoleauth.TOTPPasscodeStep(user.MFASecretKey, passcode, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, ErrUnauthorized.Wrap(ErrMFAInvalid.New("passcode is incorrect"))
+	}
+
+	recoveryCodes, hashes, err := newMFARecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	user.MFAEnabled = true
+	user.MFARecoveryCodes = hashes
+	err = s.store.Users().Update(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// the passcode confirming the secret can't be used to log in again
+	_, err = s.store.Users().AcceptMFAPasscode(ctx, user.ID, step)
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+// DisableUserMFA disables MFA of the authorized user, it requires either the
+// passcode or one of the recovery codes
+func (s *Service) DisableUserMFA(ctx context.Context, mfa MFACredentials) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.store.Users().Get(ctx, auth.User.ID)
+	if err != nil {
+		return err
+	}
+
+	if !user.MFAEnabled {
+		return ErrValidation.New("two-factor authentication is not enabled")
+	}
+
+	err = s.checkMFA(ctx, user, mfa)
+	if err != nil {
+		return err
+	}
+
+	user.MFAEnabled = false
+	user.MFASecretKey = ""
+	user.MFARecoveryCodes = nil
+
+	return s.store.Users().Update(ctx, user)
+}
+
 // GetProject is a method for querying project by id
 func (s *Service) GetProject(ctx context.Context, projectID uuid.UUID) (p *Project, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -331,7 +653,7 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 		return nil, err
 	}
 
-	_, err = transaction.ProjectMembers().Insert(ctx, auth.User.ID, prj.ID)
+	_, err = transaction.ProjectMembers().Insert(ctx, auth.User.ID, prj.ID, RoleOwner)
 	if err != nil {
 		return nil, err
 	}
@@ -347,7 +669,7 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 		return err
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.hasProjectRole(ctx, auth.User.ID, projectID, RoleOwner); err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
 
@@ -362,7 +684,7 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, descri
 		return nil, err
 	}
 
-	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	isMember, err := s.hasProjectRole(ctx, auth.User.ID, projectID, RoleAdmin)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
@@ -386,7 +708,7 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 		return nil, err
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	if _, err = s.hasProjectRole(ctx, auth.User.ID, projectID, RoleAdmin); err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
@@ -425,7 +747,7 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 	}()
 
 	for _, user := range users {
-		_, err = tx.ProjectMembers().Insert(ctx, user.ID, projectID)
+		_, err = tx.ProjectMembers().Insert(ctx, user.ID, projectID, RoleMember)
 
 		if err != nil {
 			return nil, err
@@ -443,7 +765,8 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		return err
 	}
 
-	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+	isMember, err := s.hasProjectRole(ctx, auth.User.ID, projectID, RoleAdmin)
+	if err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
 
@@ -459,6 +782,19 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 			continue
 		}
 
+		member, err := s.isProjectMember(ctx, user.ID, projectID)
+		if err != nil {
+			userErr.Add(err)
+			continue
+		}
+
+		// the owner can't be removed, and only the owner can remove admins
+		if member.membership.Role == RoleOwner ||
+			(member.membership.Role == RoleAdmin && isMember.membership.Role != RoleOwner) {
+			userErr.Add(ErrUnauthorized.Wrap(ErrNoPermission.New("%s can't remove %s %s", isMember.membership.Role, member.membership.Role, email)))
+			continue
+		}
+
 		userIDs = append(userIDs, user.ID)
 	}
 
@@ -492,6 +828,73 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 	return nil
 }
 
+// UpdateProjectMemberRole changes the role of the project member with given email.
+// Granting the owner role transfers the ownership, the previous owner becomes an admin.
+func (s *Service) UpdateProjectMemberRole(ctx context.Context, projectID uuid.UUID, email string, role ProjectMemberRole) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !role.IsValid() {
+		return errs.New("invalid project member role %d", role)
+	}
+
+	isMember, err := s.hasProjectRole(ctx, auth.User.ID, projectID, RoleAdmin)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+	ownRole := isMember.membership.Role
+
+	user, err := s.store.Users().GetByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	member, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return err
+	}
+	memberRole := member.membership.Role
+
+	if memberRole == role {
+		return nil
+	}
+
+	// the role of the owner can only be changed by transferring the ownership,
+	// and only the owner can grant or revoke the admin role
+	switch {
+	case memberRole == RoleOwner:
+		return ErrUnauthorized.Wrap(ErrNoPermission.New("the role of the project owner can't be changed"))
+	case ownRole != RoleOwner && (role.Includes(RoleAdmin) || memberRole == RoleAdmin):
+		return ErrUnauthorized.Wrap(ErrNoPermission.New("only the project owner can change the role from %s to %s", memberRole, role))
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, tx.Rollback())
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	if role == RoleOwner {
+		err = tx.ProjectMembers().UpdateRole(ctx, auth.User.ID, projectID, RoleAdmin)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.ProjectMembers().UpdateRole(ctx, user.ID, projectID, role)
+}
+
 // GetProjectMembers returns ProjectMembers for given Project
 func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, pagination Pagination) (pm []ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -507,8 +910,9 @@ func (s *Service) GetProjectMembers(ctx context.Context, projectID uuid.UUID, pa
 	return s.store.ProjectMembers().GetByProjectID(ctx, projectID, pagination)
 }
 
-// CreateAPIKey creates new api key
-func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string) (*APIKeyInfo, *APIKey, error) {
+// CreateAPIKey creates new api key, returning the unrestricted macaroon that
+// is handed to the user
+func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name string) (*APIKeyInfo, *macaroon.APIKey, error) {
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
@@ -517,21 +921,35 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, err
 	}
 
-	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	_, err = s.hasProjectRole(ctx, auth.User.ID, projectID, RoleMember)
 	if err != nil {
 		return nil, nil, ErrUnauthorized.Wrap(err)
 	}
 
+	if s.requireMFAForAPIKeys && !auth.User.MFAEnabled {
+		return nil, nil, ErrUnauthorized.New("two-factor authentication is required for creating api keys")
+	}
+
 	key, err := CreateAPIKey()
 	if err != nil {
 		return nil, nil, err
 	}
 
+	secret, err := macaroon.NewSecret()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	info, err := s.store.APIKeys().Create(ctx, *key, APIKeyInfo{
 		Name:      name,
 		ProjectID: projectID,
+		Secret:    secret,
 	})
-	return info, key, err
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return info, macaroon.NewAPIKey(key[:], secret), nil
 }
 
 // GetAPIKeyInfo retrieves api key by id
@@ -574,7 +992,7 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			continue
 		}
 
-		_, err = s.isProjectMember(ctx, auth.User.ID, key.ProjectID)
+		_, err = s.hasProjectRole(ctx, auth.User.ID, key.ProjectID, RoleAdmin)
 		if err != nil {
 			keysErr.Add(ErrUnauthorized.Wrap(err))
 			continue
@@ -643,6 +1061,35 @@ func (s *Service) GetProjectUsage(ctx context.Context, projectID uuid.UUID, sinc
 	return s.store.UsageRollups().GetProjectTotal(ctx, projectID, since, before)
 }
 
+// GetProjectLimits retrieves the usage limits of a project, the default limits
+// of the satellite are filled in for the projects without their own limits
+func (s *Service) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (_ *ProjectLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := &ProjectLimits{
+		Storage:   isMember.project.StorageLimit,
+		Bandwidth: isMember.project.BandwidthLimit,
+	}
+	if limits.Storage == 0 {
+		limits.Storage = s.defaultUsageLimit
+	}
+	if limits.Bandwidth == 0 {
+		limits.Bandwidth = s.defaultUsageLimit
+	}
+
+	return limits, nil
+}
+
 // Authorize validates token from context and returns authorized Authorization
 func (s *Service) Authorize(ctx context.Context) (a Authorization, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -661,6 +1108,11 @@ func (s *Service) Authorize(ctx context.Context) (a Authorization, err error) {
 		return Authorization{}, ErrUnauthorized.Wrap(err)
 	}
 
+	// email change tokens are sent to the unconfirmed email, so they can't be used for authorization
+	if claims.NewEmail != "" {
+		return Authorization{}, ErrUnauthorized.New("email change token can't be used for authorization")
+	}
+
 	user, err := s.authorize(ctx, claims)
 	if err != nil {
 		return Authorization{}, ErrUnauthorized.Wrap(err)
@@ -757,6 +1209,9 @@ type isProjectMember struct {
 // ErrNoMembership is error type of not belonging to a specific project
 var ErrNoMembership = errs.Class("no membership error")
 
+// ErrNoPermission is error type of project member not having the role required for an action
+var ErrNoPermission = errs.Class("no permission error")
+
 // isProjectMember checks if the user is a member of given project
 func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, projectID uuid.UUID) (result isProjectMember, err error) {
 	project, err := s.store.Projects().Get(ctx, projectID)
@@ -779,3 +1234,78 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 
 	return isProjectMember{}, ErrNoMembership.New("user %s is not a member of project %s", userID, project.ID)
 }
+
+// hasProjectRole checks if the user is a member of given project with at least the given role
+func (s *Service) hasProjectRole(ctx context.Context, userID uuid.UUID, projectID uuid.UUID, role ProjectMemberRole) (result isProjectMember, err error) {
+	result, err = s.isProjectMember(ctx, userID, projectID)
+	if err != nil {
+		return isProjectMember{}, err
+	}
+
+	if !result.membership.Role.Includes(role) {
+		return isProjectMember{}, ErrNoPermission.New("user %s is a %s of project %s, %s role is required", userID, result.membership.Role, projectID, role)
+	}
+
+	return result, nil
+}
+
+// checkMFA verifies the second factor of the user with MFA enabled,
+// the used recovery code is removed from the codes of the user.
+// Each passcode is accepted only once, and too many failed attempts lock MFA for a while.
+func (s *Service) checkMFA(ctx context.Context, user *User, mfa MFACredentials) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if mfa.IsEmpty() {
+		return ErrUnauthorized.Wrap(ErrMFAMissing.New("passcode or recovery code is required"))
+	}
+
+	now := time.Now()
+	if now.Before(user.MFALockedUntil) {
+		return ErrUnauthorized.Wrap(ErrMFALocked.New("too many failed attempts, try again later"))
+	}
+
+	if mfa.Passcode != "" {
+		step, valid, err := consoleauth.TOTPPasscodeStep(user.MFASecretKey, mfa.Passcode, now)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return s.failMFAAttempt(ctx, user, ErrMFAInvalid.New("passcode is incorrect"))
+		}
+
+		accepted, err := s.store.Users().AcceptMFAPasscode(ctx, user.ID, step)
+		if err != nil {
+			return err
+		}
+		if !accepted {
+			return s.failMFAAttempt(ctx, user, ErrMFAInvalid.New("passcode was used already"))
+		}
+
+		return nil
+	}
+
+	remaining, ok := useMFARecoveryCode(user.MFARecoveryCodes, mfa.RecoveryCode)
+	if !ok {
+		return s.failMFAAttempt(ctx, user, ErrMFAInvalid.New("recovery code is incorrect"))
+	}
+
+	user.MFARecoveryCodes = remaining
+	err = s.store.Users().Update(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Users().ResetMFAAttempts(ctx, user.ID)
+}
+
+// failMFAAttempt counts the failed MFA attempt of the user and returns the unauthorized error for it
+func (s *Service) failMFAAttempt(ctx context.Context, user *User, failure error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.store.Users().FailMFAAttempt(ctx, user.ID, mfaMaxFailedAttempts, time.Now().Add(mfaLockoutDuration))
+	if err != nil {
+		return errs.Combine(ErrUnauthorized.Wrap(failure), err)
+	}
+
+	return ErrUnauthorized.Wrap(failure)
+}
@@ -23,6 +23,13 @@ type Users interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	// Update is a method for updating user entity.
 	Update(ctx context.Context, user *User) error
+	// AcceptMFAPasscode records the time step of the accepted MFA passcode of the user and resets the failed attempts,
+	// it returns false when a passcode of the same or a later time step was accepted already.
+	AcceptMFAPasscode(ctx context.Context, id uuid.UUID, step int64) (bool, error)
+	// FailMFAAttempt counts a failed MFA attempt of the user, the maxAttempts-th one locks MFA until lockedUntil.
+	FailMFAAttempt(ctx context.Context, id uuid.UUID, maxAttempts int, lockedUntil time.Time) error
+	// ResetMFAAttempts resets the failed MFA attempts of the user and unlocks MFA.
+	ResetMFAAttempts(ctx context.Context, id uuid.UUID) error
 }
 
 // UserInfo holds User updatable data.
@@ -88,5 +95,18 @@ type User struct {
 
 	Status UserStatus `json:"status"`
 
+	// MFAEnabled indicates that the user logs in with a TOTP passcode in addition to the password
+	MFAEnabled bool `json:"mfaEnabled"`
+	// MFASecretKey is the TOTP secret of the user, it is generated before MFA is enabled
+	MFASecretKey string `json:"-"`
+	// MFARecoveryCodes holds the hashes of the unused recovery codes
+	MFARecoveryCodes []string `json:"-"`
+	// MFAFailedAttempts is how many MFA attempts failed since the last lock or accepted passcode
+	MFAFailedAttempts int `json:"-"`
+	// MFALockedUntil is when MFA is unlocked after too many failed attempts, zero when it isn't locked
+	MFALockedUntil time.Time `json:"-"`
+	// MFALastStep is the TOTP time step of the last accepted passcode, older passcodes are refused
+	MFALastStep int64 `json:"-"`
+
 	CreatedAt time.Time `json:"createdAt"`
 }
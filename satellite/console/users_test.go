@@ -9,6 +9,7 @@ import (
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/satellite"
@@ -114,3 +115,75 @@ func TestUserRepository(t *testing.T) {
 		})
 	})
 }
+
+func TestUserMFAAttempts(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		repository := db.Console().Users()
+
+		user, err := repository.Insert(ctx, &console.User{
+			FullName:     "name",
+			Email:        "mfa@mail.test",
+			PasswordHash: []byte("123456"),
+		})
+		require.NoError(t, err)
+
+		lockedUntil := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+		require.NoError(t, repository.FailMFAAttempt(ctx, user.ID, 2, lockedUntil))
+		current, err := repository.Get(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, current.MFAFailedAttempts)
+		assert.True(t, current.MFALockedUntil.IsZero())
+
+		// the last allowed attempt locks MFA
+		require.NoError(t, repository.FailMFAAttempt(ctx, user.ID, 2, lockedUntil))
+		current, err = repository.Get(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 0, current.MFAFailedAttempts)
+		assert.True(t, lockedUntil.Equal(current.MFALockedUntil), current.MFALockedUntil)
+
+		// an accepted passcode unlocks MFA
+		accepted, err := repository.AcceptMFAPasscode(ctx, user.ID, 100)
+		require.NoError(t, err)
+		assert.True(t, accepted)
+
+		current, err = repository.Get(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), current.MFALastStep)
+		assert.True(t, current.MFALockedUntil.IsZero())
+
+		// the passcodes of the same or an earlier time step are refused
+		accepted, err = repository.AcceptMFAPasscode(ctx, user.ID, 100)
+		require.NoError(t, err)
+		assert.False(t, accepted)
+
+		accepted, err = repository.AcceptMFAPasscode(ctx, user.ID, 99)
+		require.NoError(t, err)
+		assert.False(t, accepted)
+
+		accepted, err = repository.AcceptMFAPasscode(ctx, user.ID, 101)
+		require.NoError(t, err)
+		assert.True(t, accepted)
+
+		// updating the user keeps the attempts
+		require.NoError(t, repository.FailMFAAttempt(ctx, user.ID, 2, lockedUntil))
+		current.FullName = "new name"
+		require.NoError(t, repository.Update(ctx, current))
+
+		current, err = repository.Get(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, current.MFAFailedAttempts)
+		assert.Equal(t, int64(101), current.MFALastStep)
+
+		require.NoError(t, repository.FailMFAAttempt(ctx, user.ID, 2, lockedUntil))
+		require.NoError(t, repository.ResetMFAAttempts(ctx, user.ID))
+
+		current, err = repository.Get(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 0, current.MFAFailedAttempts)
+		assert.True(t, current.MFALockedUntil.IsZero())
+	})
+}
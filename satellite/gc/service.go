@@ -0,0 +1,196 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/uplink/piecestore"
+)
+
+var (
+	// Error is the default error class for the garbage collection service
+	Error = errs.Class("garbage collection error")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for garbage collection
+type Config struct {
+	Interval time.Duration `help:"how frequently garbage collection filters are sent to the storage nodes" default:"120h0m0s"`
+	Enabled  bool          `help:"set if garbage collection is enabled or not" default:"false" devDefault:"true"`
+
+	// value for InitialPieces currently based on average pieces per node
+	InitialPieces     int     `help:"the initial number of pieces expected for a storage node to have, used for creating a filter" default:"400000"`
+	FalsePositiveRate float64 `help:"the false positive rate used for creating a garbage collection bloom filter" default:"0.1"`
+}
+
+// Service sends to every storage node a bloom filter of the pieces it should
+// have, so that it can move the rest to its trash
+type Service struct {
+	log          *zap.Logger
+	config       Config
+	transport    transport.Client
+	metainfoLoop *metainfo.Loop
+	overlay      *overlay.Cache
+
+	Loop sync2.Cycle
+}
+
+// NewService creates a new garbage collection service
+func NewService(log *zap.Logger, config Config, transport transport.Client, metainfoLoop *metainfo.Loop, overlay *overlay.Cache) *Service {
+	return &Service{
+		log:          log,
+		config:       config,
+		transport:    transport,
+		metainfoLoop: metainfoLoop,
+		overlay:      overlay,
+		Loop:         *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the garbage collection service loop
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.Collect(ctx)
+		if err != nil {
+			service.log.Error("error collecting garbage", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close halts the garbage collection service loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// Collect builds the bloom filters of all the storage nodes holding pieces
+// and sends them to the nodes
+func (service *Service) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// pieces uploaded after the walk has started may be missing from the
+	// filters, so the nodes only consider the pieces created before it
+	creationDate := time.Now()
+
+	filters, err := service.buildFilters(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for nodeID, filter := range filters {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := service.sendRetainRequest(ctx, nodeID, creationDate, filter)
+		if err != nil {
+			service.log.Warn("unable to send retain request", zap.Stringer("node", nodeID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// buildFilters joins the metainfo loop and adds every remote piece to the
+// filter of the storage node it is stored on
+func (service *Service) buildFilters(ctx context.Context) (_ map[storj.NodeID]*bloomfilter.Filter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	observer := &filterObserver{
+		config:  service.config,
+		filters: map[storj.NodeID]*bloomfilter.Filter{},
+	}
+
+	err = service.metainfoLoop.Join(ctx, observer)
+	if err != nil {
+		return nil, err
+	}
+	return observer.filters, nil
+}
+
+// filterObserver builds the bloom filters from the pointers visited by the metainfo loop
+type filterObserver struct {
+	config  Config
+	filters map[storj.NodeID]*bloomfilter.Filter
+}
+
+// RemoteSegment adds the pieces of the segment to the filters of their storage nodes
+func (observer *filterObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	remote := pointer.GetRemote()
+	for _, piece := range remote.GetRemotePieces() {
+		filter, ok := observer.filters[piece.NodeId]
+		if !ok {
+			filter = bloomfilter.NewOptimal(observer.config.InitialPieces, observer.config.FalsePositiveRate)
+			observer.filters[piece.NodeId] = filter
+		}
+		filter.Add(remote.RootPieceId.Derive(piece.NodeId))
+	}
+	return nil
+}
+
+// RemoteObject is called for the last segment of every remote object
+func (observer *filterObserver) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	return nil
+}
+
+// InlineSegment is called for every inline segment, which has no pieces
+func (observer *filterObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	return nil
+}
+
+// sendRetainRequest sends the filter to the storage node
+func (service *Service) sendRetainRequest(ctx context.Context, nodeID storj.NodeID, creationDate time.Time, filter *bloomfilter.Filter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := service.transport.DialNode(ctx, &pb.Node{
+		Id:      nodeID,
+		Address: node.Address,
+		Type:    pb.NodeType_STORAGE,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	client := piecestore.NewClient(
+		service.log.Named(nodeID.String()),
+		signing.SignerFromFullIdentity(service.transport.Identity()),
+		conn,
+		piecestore.DefaultConfig,
+	)
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	timestamp, err := ptypes.TimestampProto(creationDate)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = client.Retain(ctx, &pb.RetainRequest{
+		CreationDate: timestamp,
+		Filter:       filter.Bytes(),
+	})
+	return Error.Wrap(err)
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc_test
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/gc"
+	"storj.io/storj/storage"
+)
+
+func TestGarbageCollection(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.GarbageCollection.Service.Loop.Pause()
+
+		for _, bucket := range []string{"keep", "garbage"} {
+			data := make([]byte, 10*memory.KiB)
+			_, err := rand.Read(data)
+			require.NoError(t, err)
+			require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, bucket, "object", data))
+		}
+
+		// forget the pointer of the garbage object without deleting its pieces
+		var kept, garbage *pb.Pointer
+		err := satellite.Metainfo.Service.Iterate("", "", true, false,
+			func(it storage.Iterator) error {
+				var item storage.ListItem
+				for it.Next(&item) {
+					pointer := &pb.Pointer{}
+					if err := proto.Unmarshal(item.Value, pointer); err != nil {
+						return err
+					}
+					if strings.Contains(item.Key.String(), "/garbage/") {
+						garbage = pointer
+						if err := satellite.Metainfo.Service.Delete(item.Key.String()); err != nil {
+							return err
+						}
+					} else {
+						kept = pointer
+					}
+				}
+				return nil
+			})
+		require.NoError(t, err)
+		require.NotNil(t, kept)
+		require.NotNil(t, garbage)
+
+		service := gc.NewService(satellite.Log.Named("gc"), gc.Config{
+			InitialPieces:     1000,
+			FalsePositiveRate: 0.000001,
+		}, satellite.Transport, satellite.Metainfo.Loop, satellite.Overlay.Service)
+		require.NoError(t, service.Collect(ctx))
+
+		pieceExists := func(pointer *pb.Pointer, piece *pb.RemotePiece) bool {
+			for _, node := range planet.StorageNodes {
+				if node.ID() != piece.NodeId {
+					continue
+				}
+				reader, err := node.Storage2.Store.Reader(ctx, satellite.ID(), pointer.Remote.RootPieceId.Derive(piece.NodeId))
+				if err != nil {
+					return false
+				}
+				require.NoError(t, reader.Close())
+				return true
+			}
+			return false
+		}
+
+		keptNodes := map[storj.NodeID]bool{}
+		for _, piece := range kept.Remote.RemotePieces {
+			require.True(t, pieceExists(kept, piece))
+			keptNodes[piece.NodeId] = true
+		}
+		// only the nodes holding pieces are sent filters
+		for _, piece := range garbage.Remote.RemotePieces {
+			require.Equal(t, !keptNodes[piece.NodeId], pieceExists(garbage, piece))
+		}
+
+		// the garbage pieces can be restored from the trash
+		for _, node := range planet.StorageNodes {
+			_, err := node.Storage2.Store.RestoreTrash(ctx, satellite.ID())
+			require.NoError(t, err)
+		}
+		for _, piece := range garbage.Remote.RemotePieces {
+			require.True(t, pieceExists(garbage, piece))
+		}
+	})
+}
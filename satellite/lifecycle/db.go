@@ -0,0 +1,31 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// DB stores the lifecycle rules of buckets
+type DB interface {
+	// Set replaces the lifecycle rules of a bucket
+	Set(ctx context.Context, projectID uuid.UUID, bucket []byte, lifecycle *pb.BucketLifecycle) error
+	// Get returns the lifecycle rules of a bucket, which are empty when none are set
+	Get(ctx context.Context, projectID uuid.UUID, bucket []byte) (*pb.BucketLifecycle, error)
+	// Delete removes the lifecycle rules of a bucket
+	Delete(ctx context.Context, projectID uuid.UUID, bucket []byte) error
+	// List returns the lifecycle rules of all buckets
+	List(ctx context.Context) ([]BucketLifecycle, error)
+}
+
+// BucketLifecycle are the lifecycle rules of a bucket
+type BucketLifecycle struct {
+	ProjectID uuid.UUID
+	Bucket    []byte
+	Lifecycle *pb.BucketLifecycle
+}
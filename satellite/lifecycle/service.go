@@ -0,0 +1,329 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	ecclient "storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/storage"
+)
+
+var (
+	// Error is the default error class for the lifecycle service
+	Error = errs.Class("lifecycle error")
+	mon   = monkit.Package()
+)
+
+// day is the unit of the lifecycle rule ages
+const day = 24 * time.Hour
+
+// Config contains configurable values for the lifecycle service
+type Config struct {
+	Interval  time.Duration `help:"how frequently the bucket lifecycle rules are applied" default:"1h0m0s"`
+	BatchSize int           `help:"how many objects and pending segments are deleted after each pass over the metainfo" default:"1000"`
+}
+
+// Service deletes the objects and pending uploads that have outlived
+// the lifecycle rules of their bucket
+type Service struct {
+	log          *zap.Logger
+	config       Config
+	db           DB
+	metainfoLoop *metainfo.Loop
+	pointerdb    *pointerdb.Service
+	orders       *orders.Service
+	ec           ecclient.Client
+	identity     *identity.PeerIdentity
+
+	Loop sync2.Cycle
+}
+
+// expiredObject is an object that is removed together with all its segments
+type expiredObject struct {
+	project string
+	bucket  string
+	path    storj.Path
+}
+
+// pendingSegment is a segment of an upload that was not committed
+type pendingSegment struct {
+	expiredObject
+	segment string
+}
+
+// NewService creates a new lifecycle service
+func NewService(log *zap.Logger, config Config, db DB, metainfoLoop *metainfo.Loop, pointerdb *pointerdb.Service, orders *orders.Service, transport transport.Client) *Service {
+	return &Service{
+		log:          log,
+		config:       config,
+		db:           db,
+		metainfoLoop: metainfoLoop,
+		pointerdb:    pointerdb,
+		orders:       orders,
+		// deleting pieces doesn't need any buffers
+		ec:       ecclient.NewClient(transport, 0),
+		identity: transport.Identity().PeerIdentity(),
+		Loop:     *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the lifecycle service loop
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.Collect(ctx, time.Now())
+		if err != nil {
+			service.log.Error("error applying bucket lifecycle rules", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close halts the lifecycle service loop
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// Collect deletes everything that is older than the lifecycle rules allow at time now
+func (service *Service) Collect(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	configs, err := service.db.List(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// rules by project and bucket
+	rules := map[string]map[string][]*pb.LifecycleRule{}
+	for _, config := range configs {
+		if len(config.Lifecycle.GetRules()) == 0 {
+			continue
+		}
+		project := config.ProjectID.String()
+		if rules[project] == nil {
+			rules[project] = map[string][]*pb.LifecycleRule{}
+		}
+		rules[project][string(config.Bucket)] = config.Lifecycle.Rules
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	// the pointers can't be deleted while the metainfo loop iterates over
+	// them, so every pass collects a batch which is deleted afterwards
+	for {
+		observer := &expiredObserver{
+			rules:     rules,
+			now:       now,
+			batchSize: service.config.BatchSize,
+		}
+		if err := service.metainfoLoop.Join(ctx, observer); err != nil {
+			return Error.Wrap(err)
+		}
+
+		if err := service.deleteBatch(ctx, observer); err != nil {
+			return err
+		}
+
+		if !observer.full {
+			return nil
+		}
+	}
+}
+
+// deleteBatch deletes the expired objects and then the pending segments found by the observer
+func (service *Service) deleteBatch(ctx context.Context, observer *expiredObserver) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, object := range observer.expired {
+		if err := service.deleteObject(ctx, object); err != nil {
+			return err
+		}
+	}
+
+	for _, segment := range observer.pending {
+		// segments of committed objects are only removed with the object
+		_, err := service.pointerdb.Get(segment.key("l"))
+		if err == nil {
+			continue
+		}
+		if !storage.ErrKeyNotFound.Has(err) {
+			return Error.Wrap(err)
+		}
+
+		// the segment may have been deleted together with its object
+		err = service.deleteSegment(ctx, segment.expiredObject, segment.key(segment.segment))
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expiredObserver collects the objects and pending segments visited by the
+// metainfo loop that have outlived the lifecycle rules, up to a batch size
+type expiredObserver struct {
+	// rules by project and bucket
+	rules     map[string]map[string][]*pb.LifecycleRule
+	now       time.Time
+	batchSize int
+
+	expired []expiredObject
+	pending []pendingSegment
+	// full is set when there were more items than the batch size
+	full bool
+}
+
+// RemoteSegment checks the remote segment against the lifecycle rules
+func (observer *expiredObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.check(path, pointer)
+	return nil
+}
+
+// RemoteObject is called for the last segment of every remote object, which RemoteSegment has checked
+func (observer *expiredObserver) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	return nil
+}
+
+// InlineSegment checks the inline segment against the lifecycle rules
+func (observer *expiredObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.check(path, pointer)
+	return nil
+}
+
+// check adds the segment at path to the batch when it has outlived a rule of its bucket
+func (observer *expiredObserver) check(path storj.Path, pointer *pb.Pointer) {
+	// the key is <project>/<segment>/<bucket>/<path>
+	parts := storj.SplitPath(path)
+	if len(parts) < 4 {
+		return
+	}
+	project, segment, bucket, objectPath := parts[0], parts[1], parts[2], storj.JoinPaths(parts[3:]...)
+
+	bucketRules := observer.rules[project][bucket]
+	if len(bucketRules) == 0 {
+		return
+	}
+
+	created, err := ptypes.Timestamp(pointer.GetCreationDate())
+	if err != nil {
+		return
+	}
+
+	object := expiredObject{project: project, bucket: bucket, path: objectPath}
+	for _, rule := range bucketRules {
+		if !matchesPrefix(objectPath, rule.Prefix) {
+			continue
+		}
+		if segment == "l" && olderThan(created, rule.ExpirationDays, observer.now) {
+			observer.add(func() { observer.expired = append(observer.expired, object) })
+			return
+		}
+		if segment != "l" && olderThan(created, rule.AbortIncompleteUploadDays, observer.now) {
+			observer.add(func() { observer.pending = append(observer.pending, pendingSegment{object, segment}) })
+			return
+		}
+	}
+}
+
+// add calls appendItem when the batch isn't full yet
+func (observer *expiredObserver) add(appendItem func()) {
+	if len(observer.expired)+len(observer.pending) >= observer.batchSize {
+		observer.full = true
+		return
+	}
+	appendItem()
+}
+
+// deleteObject deletes the last segment of an object and all the segments before it
+func (service *Service) deleteObject(ctx context.Context, object expiredObject) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.deleteSegment(ctx, object, object.key("l")); err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+
+	for i := 0; ; i++ {
+		err := service.deleteSegment(ctx, object, object.key("s"+strconv.Itoa(i)))
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// deleteSegment deletes the pointer at path and the pieces it doesn't share with other pointers
+func (service *Service) deleteSegment(ctx context.Context, object expiredObject, path string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, shared, err := service.pointerdb.Release(path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return err
+		}
+		return Error.Wrap(err)
+	}
+
+	if pointer.Type != pb.Pointer_REMOTE || pointer.Remote == nil || shared {
+		return nil
+	}
+
+	bucketID := []byte(storj.JoinPaths(object.project, object.bucket))
+	limits, err := service.orders.CreateDeleteOrderLimits(ctx, service.identity, bucketID, pointer)
+	if err != nil {
+		// the pieces on the nodes that are not reachable are left for garbage collection
+		service.log.Debug("failed creating delete order limits", zap.String("path", path), zap.Error(err))
+		return nil
+	}
+
+	if err := service.ec.Delete(ctx, limits); err != nil {
+		service.log.Debug("failed deleting pieces", zap.String("path", path), zap.Error(err))
+	}
+
+	return nil
+}
+
+// key returns the pointerdb key of the given segment of the object
+func (object expiredObject) key(segment string) string {
+	return storj.JoinPaths(object.project, segment, object.bucket, object.path)
+}
+
+// matchesPrefix checks whether the encrypted path is within the encrypted prefix
+func matchesPrefix(path storj.Path, prefix []byte) bool {
+	if len(prefix) == 0 {
+		return true
+	}
+	return path == string(prefix) || strings.HasPrefix(path, string(prefix)+"/")
+}
+
+// olderThan checks whether something created at created is older than the given
+// number of days at time now. A non-positive number of days never matches.
+func olderThan(created time.Time, days int32, now time.Time) bool {
+	return days > 0 && !created.Add(time.Duration(days)*day).After(now)
+}
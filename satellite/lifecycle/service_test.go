@@ -0,0 +1,106 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycle_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/lifecycle"
+	"storj.io/storj/storage"
+)
+
+func TestCollect(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.Lifecycle.Service
+		service.Loop.Pause()
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		project := projectID.String()
+
+		err = satellite.DB.Lifecycles().Set(ctx, *projectID, []byte("bucket"), &pb.BucketLifecycle{
+			Rules: []*pb.LifecycleRule{
+				{Prefix: []byte("expiring"), ExpirationDays: 30, AbortIncompleteUploadDays: 7},
+			},
+		})
+		require.NoError(t, err)
+
+		pointers := satellite.Metainfo.Service
+		put := func(segment, path string) {
+			key := storj.JoinPaths(project, segment, "bucket", path)
+			require.NoError(t, pointers.Put(key, &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("data")}))
+		}
+		exists := func(segment, path string) bool {
+			_, err := pointers.Get(storj.JoinPaths(project, segment, "bucket", path))
+			if storage.ErrKeyNotFound.Has(err) {
+				return false
+			}
+			require.NoError(t, err)
+			return true
+		}
+
+		// an expired object, whose segments are old enough to be pending too
+		put("s0", "expiring/object")
+		put("s1", "expiring/object")
+		put("l", "expiring/object")
+		// a pending upload
+		put("s0", "expiring/upload")
+		// the object outside of the rule prefix is kept
+		put("s0", "kept/object")
+		put("l", "kept/object")
+
+		// neither the objects nor the uploads are old enough
+		require.NoError(t, service.Collect(ctx, time.Now().Add(2*24*time.Hour)))
+		assert.True(t, exists("l", "expiring/object"))
+		assert.True(t, exists("s0", "expiring/upload"))
+
+		// only the pending upload is old enough
+		require.NoError(t, service.Collect(ctx, time.Now().Add(10*24*time.Hour)))
+		assert.True(t, exists("l", "expiring/object"))
+		assert.True(t, exists("s0", "expiring/object"))
+		assert.False(t, exists("s0", "expiring/upload"))
+
+		// the expiration and the pending upload cleanup happen in the same pass
+		put("s0", "expiring/upload")
+		require.NoError(t, service.Collect(ctx, time.Now().Add(40*24*time.Hour)))
+		assert.False(t, exists("l", "expiring/object"))
+		assert.False(t, exists("s0", "expiring/object"))
+		assert.False(t, exists("s1", "expiring/object"))
+		assert.False(t, exists("s0", "expiring/upload"))
+
+		assert.True(t, exists("l", "kept/object"))
+		assert.True(t, exists("s0", "kept/object"))
+
+		// the deletions are split in batches until nothing is left
+		batched := lifecycle.NewService(zaptest.NewLogger(t), lifecycle.Config{BatchSize: 2},
+			satellite.DB.Lifecycles(), satellite.Metainfo.Loop, satellite.Metainfo.Service,
+			satellite.Orders.Service, satellite.Transport)
+		for i := 0; i < 3; i++ {
+			put("s0", "expiring/batch"+strconv.Itoa(i))
+			put("l", "expiring/batch"+strconv.Itoa(i))
+			put("s0", "expiring/upload"+strconv.Itoa(i))
+		}
+		require.NoError(t, batched.Collect(ctx, time.Now().Add(40*24*time.Hour)))
+		for i := 0; i < 3; i++ {
+			assert.False(t, exists("l", "expiring/batch"+strconv.Itoa(i)))
+			assert.False(t, exists("s0", "expiring/batch"+strconv.Itoa(i)))
+			assert.False(t, exists("s0", "expiring/upload"+strconv.Itoa(i)))
+		}
+		assert.True(t, exists("l", "kept/object"))
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"bytes"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+)
+
+// RevokeAPIKey revokes an api key restricted from the api key of the request,
+// which also revokes all keys restricted from it
+func (endpoint *Endpoint) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (resp *pb.RevokeAPIKeyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, keyInfo, err := endpoint.getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "api key can't be restricted")
+	}
+
+	revoked, err := endpoint.apiKeys.GetRevocations(ctx, keyInfo.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	err = key.Verify(keyInfo.Secret, revoked)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	}
+
+	toRevoke, err := macaroon.ParseAPIKey(req.ApiKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if !toRevoke.RestrictedFrom(key) {
+		return nil, status.Errorf(codes.PermissionDenied, "api key isn't restricted from the api key of the request")
+	}
+
+	tail := toRevoke.Tail()
+	for _, revokedTail := range revoked {
+		if bytes.Equal(tail, revokedTail) {
+			return &pb.RevokeAPIKeyResponse{}, nil
+		}
+	}
+
+	err = endpoint.apiKeys.Revoke(ctx, keyInfo.ID, tail)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.RevokeAPIKeyResponse{}, nil
+}
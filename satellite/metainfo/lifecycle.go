@@ -0,0 +1,124 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+)
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket
+func (endpoint *Endpoint) SetBucketLifecycle(ctx context.Context, req *pb.SetBucketLifecycleRequest) (resp *pb.SetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.validateLifecycle(req.Lifecycle)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.checkBucketExists(keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Lifecycle.GetRules()) == 0 {
+		err = endpoint.lifecycles.Delete(ctx, keyInfo.ProjectID, req.Bucket)
+	} else {
+		err = endpoint.lifecycles.Set(ctx, keyInfo.ProjectID, req.Bucket, req.Lifecycle)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.SetBucketLifecycleResponse{}, nil
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket
+func (endpoint *Endpoint) GetBucketLifecycle(ctx context.Context, req *pb.GetBucketLifecycleRequest) (resp *pb.GetBucketLifecycleResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.checkBucketExists(keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle, err := endpoint.lifecycles.Get(ctx, keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.GetBucketLifecycleResponse{Lifecycle: lifecycle}, nil
+}
+
+// checkBucketExists returns a grpc status error when the bucket doesn't exist
+func (endpoint *Endpoint) checkBucketExists(projectID uuid.UUID, bucket []byte) error {
+	path, err := CreatePath(projectID, -1, bucket, nil)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = endpoint.pointerdb.Get(path)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return status.Errorf(codes.NotFound, err.Error())
+		}
+		return status.Errorf(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (endpoint *Endpoint) validateLifecycle(lifecycle *pb.BucketLifecycle) error {
+	ids := map[string]bool{}
+	for _, rule := range lifecycle.GetRules() {
+		if rule.ExpirationDays < 0 || rule.AbortIncompleteUploadDays < 0 {
+			return errs.New("lifecycle rule %q has negative days", rule.Id)
+		}
+		if rule.ExpirationDays == 0 && rule.AbortIncompleteUploadDays == 0 {
+			return errs.New("lifecycle rule %q has no action", rule.Id)
+		}
+		if ids[rule.Id] {
+			return errs.New("duplicate lifecycle rule %q", rule.Id)
+		}
+		ids[rule.Id] = true
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// LoopError is the error class for the metainfo loop
+var LoopError = errs.Class("metainfo loop error")
+
+// LoopClosedError is returned when joining a loop that has been closed
+var LoopClosedError = errs.Class("metainfo loop closed")
+
+// LoopConfig contains configurable values for the metainfo loop
+type LoopConfig struct {
+	CoalesceDuration time.Duration `help:"how long to wait for more observers to join before starting an iteration" default:"5s" devDefault:"1s"`
+}
+
+// Observer is notified about every pointer visited by the metainfo loop.
+//
+// An observer that returns an error is removed from the iteration and the
+// error is returned from its Join, without affecting the other observers.
+type Observer interface {
+	// RemoteSegment is called for every remote segment.
+	RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
+	// RemoteObject is called for the last segment of every object, when it is remote.
+	// It is called after RemoteSegment for the same pointer.
+	RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
+	// InlineSegment is called for every pointer without a remote segment, including bucket entries.
+	InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
+}
+
+// observerContext is an observer that has joined an iteration
+type observerContext struct {
+	Observer
+	ctx  context.Context
+	name string
+	done chan error
+
+	duration time.Duration
+}
+
+// handle calls the observer with the pointer and reports whether it should stay in the iteration
+func (observer *observerContext) handle(path storj.Path, pointer *pb.Pointer) bool {
+	if err := observer.ctx.Err(); err != nil {
+		observer.finish(err)
+		return false
+	}
+
+	start := time.Now()
+	var err error
+	if pointer.GetRemote() != nil {
+		err = observer.RemoteSegment(observer.ctx, path, pointer)
+		if err == nil && isLastSegment(path) {
+			err = observer.RemoteObject(observer.ctx, path, pointer)
+		}
+	} else {
+		err = observer.InlineSegment(observer.ctx, path, pointer)
+	}
+	observer.duration += time.Since(start)
+
+	if err != nil {
+		mon.Meter("metainfo_loop_observer_errors").Mark(1)
+		observer.finish(err)
+		return false
+	}
+	return true
+}
+
+// finish reports the observer metrics and releases Join with err
+func (observer *observerContext) finish(err error) {
+	mon.FloatValf("metainfo_loop_observer_%s_seconds", observer.name).Observe(observer.duration.Seconds())
+	observer.done <- err
+	close(observer.done)
+}
+
+// Loop iterates over the pointerdb once per iteration and notifies every
+// observer that joined it about each pointer, so that services which need
+// to see all of the metainfo can share a single pass.
+type Loop struct {
+	config    LoopConfig
+	pointerdb *pointerdb.Service
+
+	join chan *observerContext
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// NewLoop creates a new metainfo loop
+func NewLoop(config LoopConfig, pointerdb *pointerdb.Service) *Loop {
+	return &Loop{
+		config:    config,
+		pointerdb: pointerdb,
+		join:      make(chan *observerContext),
+		done:      make(chan struct{}),
+	}
+}
+
+// Join adds the observer to the next iteration of the loop and waits until
+// the iteration has completed, the observer failed or ctx is canceled.
+func (loop *Loop) Join(ctx context.Context, observer Observer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	observerCtx := &observerContext{
+		Observer: observer,
+		ctx:      ctx,
+		name:     observerName(observer),
+		// buffered so that the loop never blocks on an observer that stopped waiting
+		done: make(chan error, 1),
+	}
+
+	select {
+	case loop.join <- observerCtx:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-loop.done:
+		return LoopClosedError.New("")
+	}
+
+	select {
+	case err := <-observerCtx.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Run runs the loop until ctx is canceled or the loop is closed
+func (loop *Loop) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		if err := loop.runOnce(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-loop.done:
+			return nil
+		default:
+		}
+	}
+}
+
+// Close stops the loop and releases everyone waiting to join it
+func (loop *Loop) Close() error {
+	loop.closeOnce.Do(func() { close(loop.done) })
+	return nil
+}
+
+// runOnce waits for observers to join and iterates over the pointerdb once
+func (loop *Loop) runOnce(ctx context.Context) (err error) {
+	var observers []*observerContext
+
+	// wait for the first observer
+	select {
+	case observer := <-loop.join:
+		observers = append(observers, observer)
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-loop.done:
+		return nil
+	}
+
+	// only the iteration itself is measured, not the waiting for observers
+	defer mon.Task()(&ctx)(&err)
+
+	// give other observers a chance to join the same iteration
+	timer := time.NewTimer(loop.config.CoalesceDuration)
+	defer timer.Stop()
+waitForMore:
+	for {
+		select {
+		case observer := <-loop.join:
+			observers = append(observers, observer)
+		case <-timer.C:
+			break waitForMore
+		case <-ctx.Done():
+			finishAll(observers, ctx.Err())
+			return ctx.Err()
+		case <-loop.done:
+			finishAll(observers, LoopClosedError.New(""))
+			return nil
+		}
+	}
+
+	mon.IntVal("metainfo_loop_observers").Observe(int64(len(observers)))
+
+	var pointers int64
+	err = loop.pointerdb.Iterate("", "", true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return LoopError.New("error unmarshalling pointer %s", err)
+				}
+				pointers++
+
+				path := storj.Path(item.Key)
+				remaining := observers[:0]
+				for _, observer := range observers {
+					if observer.handle(path, pointer) {
+						remaining = append(remaining, observer)
+					}
+				}
+				observers = remaining
+
+				if len(observers) == 0 {
+					return nil
+				}
+			}
+			return nil
+		})
+
+	mon.IntVal("metainfo_loop_pointers").Observe(pointers)
+
+	if err != nil {
+		finishAll(observers, LoopError.Wrap(err))
+		return ctx.Err()
+	}
+	finishAll(observers, nil)
+	return nil
+}
+
+// finishAll releases all the observers with err
+func finishAll(observers []*observerContext, err error) {
+	for _, observer := range observers {
+		observer.finish(err)
+	}
+}
+
+// isLastSegment returns whether the path is the last segment of an object
+func isLastSegment(path storj.Path) bool {
+	elements := storj.SplitPath(path)
+	return len(elements) >= 4 && elements[1] == "l"
+}
+
+// observerName returns the name used for the metrics of the observer
+func observerName(observer Observer) string {
+	name := fmt.Sprintf("%T", observer)
+	name = strings.TrimPrefix(name, "*")
+	return strings.Replace(name, ".", "_", -1)
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// TestLoop checks that the observers joining the same iteration see every
+// pointer and that a failing observer doesn't affect the others
+func TestLoop(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		pointerdb := satellite.Metainfo.Service
+
+		const remoteCount, inlineCount = 10, 5
+		for i := 0; i < remoteCount; i++ {
+			path := storj.JoinPaths("project", "l", "bucket", "remote"+strconv.Itoa(i))
+			require.NoError(t, pointerdb.Put(path, &pb.Pointer{
+				Type: pb.Pointer_REMOTE,
+				Remote: &pb.RemoteSegment{
+					Redundancy: &pb.RedundancyScheme{
+						MinReq:          1,
+						RepairThreshold: 1,
+					},
+					RootPieceId: teststorj.PieceIDFromString("root"),
+					RemotePieces: []*pb.RemotePiece{
+						{PieceNum: 0, NodeId: teststorj.NodeIDFromString("node")},
+					},
+				},
+			}))
+		}
+		for i := 0; i < inlineCount; i++ {
+			path := storj.JoinPaths("project", "s0", "bucket", "inline"+strconv.Itoa(i))
+			require.NoError(t, pointerdb.Put(path, &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: []byte{1, 2, 3},
+			}))
+		}
+
+		counters := []*countObserver{{}, {}}
+		failing := &countObserver{fail: errors.New("expected failure")}
+
+		var group errgroup.Group
+		for _, observer := range counters {
+			observer := observer
+			group.Go(func() error {
+				return satellite.Metainfo.Loop.Join(ctx, observer)
+			})
+		}
+		group.Go(func() error {
+			err := satellite.Metainfo.Loop.Join(ctx, failing)
+			if err != failing.fail {
+				return errors.New("expected the failure of the observer")
+			}
+			return nil
+		})
+		require.NoError(t, group.Wait())
+
+		for _, observer := range counters {
+			require.Equal(t, remoteCount, observer.remoteSegments)
+			require.Equal(t, remoteCount, observer.remoteObjects)
+			require.Equal(t, inlineCount, observer.inlineSegments)
+		}
+		require.Equal(t, 1, failing.remoteSegments+failing.inlineSegments)
+	})
+}
+
+// TestLoopCanceled checks that a canceled observer is released
+func TestLoopCanceled(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := planet.Satellites[0].Metainfo.Loop.Join(canceled, &countObserver{})
+		require.Equal(t, context.Canceled, err)
+	})
+}
+
+// countObserver counts the pointers it has been notified about
+type countObserver struct {
+	fail error
+
+	remoteSegments int
+	remoteObjects  int
+	inlineSegments int
+}
+
+func (observer *countObserver) RemoteSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.remoteSegments++
+	return observer.fail
+}
+
+func (observer *countObserver) RemoteObject(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.remoteObjects++
+	return observer.fail
+}
+
+func (observer *countObserver) InlineSegment(ctx context.Context, path storj.Path, pointer *pb.Pointer) error {
+	observer.inlineSegments++
+	return observer.fail
+}
@@ -17,11 +17,11 @@ import (
 	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
-	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
@@ -40,44 +40,123 @@ var (
 // APIKeys is api keys store methods used by endpoint
 type APIKeys interface {
 	GetByKey(ctx context.Context, key console.APIKey) (*console.APIKeyInfo, error)
+	GetRevocations(ctx context.Context, id uuid.UUID) ([][]byte, error)
+	Revoke(ctx context.Context, id uuid.UUID, tail []byte) error
+}
+
+// Lifecycles is bucket lifecycle rules store methods used by endpoint
+type Lifecycles interface {
+	Set(ctx context.Context, projectID uuid.UUID, bucket []byte, lifecycle *pb.BucketLifecycle) error
+	Get(ctx context.Context, projectID uuid.UUID, bucket []byte) (*pb.BucketLifecycle, error)
+	Delete(ctx context.Context, projectID uuid.UUID, bucket []byte) error
 }
 
 // Endpoint metainfo endpoint
 type Endpoint struct {
-	log           *zap.Logger
-	pointerdb     *pointerdb.Service
-	orders        *orders.Service
-	cache         *overlay.Cache
-	apiKeys       APIKeys
-	accountingDB  accounting.DB
-	maxAlphaUsage memory.Size
+	log          *zap.Logger
+	pointerdb    *pointerdb.Service
+	orders       *orders.Service
+	cache        *overlay.Cache
+	apiKeys      APIKeys
+	projectUsage *accounting.ProjectUsage
+	lifecycles   Lifecycles
 }
 
 // NewEndpoint creates new metainfo endpoint instance
-func NewEndpoint(log *zap.Logger, pointerdb *pointerdb.Service, orders *orders.Service, cache *overlay.Cache, apiKeys APIKeys, acctDB accounting.DB, maxAlphaUsage memory.Size) *Endpoint {
+func NewEndpoint(log *zap.Logger, pointerdb *pointerdb.Service, orders *orders.Service, cache *overlay.Cache, apiKeys APIKeys, projectUsage *accounting.ProjectUsage, lifecycles Lifecycles) *Endpoint {
 	// TODO do something with too many params
 	return &Endpoint{
-		log:           log,
-		pointerdb:     pointerdb,
-		orders:        orders,
-		cache:         cache,
-		apiKeys:       apiKeys,
-		accountingDB:  acctDB,
-		maxAlphaUsage: maxAlphaUsage,
+		log:          log,
+		pointerdb:    pointerdb,
+		orders:       orders,
+		cache:        cache,
+		apiKeys:      apiKeys,
+		projectUsage: projectUsage,
+		lifecycles:   lifecycles,
 	}
 }
 
 // Close closes resources
 func (endpoint *Endpoint) Close() error { return nil }
 
-func (endpoint *Endpoint) validateAuth(ctx context.Context) (*console.APIKeyInfo, error) {
+// validateAuth checks that the api key of the request allows all actions and
+// returns the info of the root key it was derived from
+func (endpoint *Endpoint) validateAuth(ctx context.Context, actions ...macaroon.Action) (*console.APIKeyInfo, error) {
+	key, keyInfo, err := endpoint.getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// raw keys can't be restricted
+	if key == nil {
+		return keyInfo, nil
+	}
+
+	revoked, err := endpoint.apiKeys.GetRevocations(ctx, keyInfo.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	// the key is verified even when no action is checked
+	err = key.Verify(keyInfo.Secret, revoked)
+	if err != nil {
+		endpoint.log.Error("unauthorized request: ", zap.Error(err))
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	}
+
+	for _, action := range actions {
+		err = key.Allows(action)
+		if err != nil {
+			endpoint.log.Error("unauthorized request: ", zap.Error(err))
+			if macaroon.ErrUnauthorized.Has(err) {
+				return nil, status.Errorf(codes.PermissionDenied, "Unauthorized API credential")
+			}
+			return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+		}
+	}
+
+	return keyInfo, nil
+}
+
+// getAPIKey parses the api key of the request and looks up the root key it
+// was derived from. The returned key is nil for raw keys created before api
+// keys could be restricted.
+func (endpoint *Endpoint) getAPIKey(ctx context.Context) (*macaroon.APIKey, *console.APIKeyInfo, error) {
 	APIKey, ok := auth.GetAPIKey(ctx)
 	if !ok {
 		endpoint.log.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "Invalid API credential")))
-		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+		return nil, nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
 	}
 
-	key, err := console.APIKeyFromBase64(string(APIKey))
+	key, err := macaroon.ParseAPIKey(string(APIKey))
+	if err != nil {
+		keyInfo, err := endpoint.getRawAPIKey(ctx, string(APIKey))
+		return nil, keyInfo, err
+	}
+
+	head := key.Head()
+	if len(head) != len(console.APIKey{}) {
+		endpoint.log.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "invalid api key head")))
+		return nil, nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	}
+
+	keyInfo, err := endpoint.apiKeys.GetByKey(ctx, *console.APIKeyFromBytes(head))
+	if err != nil {
+		endpoint.log.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, err.Error())))
+		return nil, nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	}
+
+	if len(keyInfo.Secret) == 0 {
+		endpoint.log.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "api key can't be restricted")))
+		return nil, nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	}
+
+	return key, keyInfo, nil
+}
+
+// getRawAPIKey looks up a raw key created before api keys could be restricted
+func (endpoint *Endpoint) getRawAPIKey(ctx context.Context, APIKey string) (*console.APIKeyInfo, error) {
+	key, err := console.APIKeyFromBase64(APIKey)
 	if err != nil {
 		endpoint.log.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "Invalid API credential")))
 		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
@@ -89,6 +168,13 @@ func (endpoint *Endpoint) validateAuth(ctx context.Context) (*console.APIKeyInfo
 		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
 	}
 
+	// the key is the head of the macaroons of keys with a secret, which
+	// anyone holding a restricted key knows
+	if len(keyInfo.Secret) != 0 {
+		endpoint.log.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "raw key of a macaroon")))
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	}
+
 	return keyInfo, nil
 }
 
@@ -96,9 +182,14 @@ func (endpoint *Endpoint) validateAuth(ctx context.Context) (*console.APIKeyInfo
 func (endpoint *Endpoint) SegmentInfo(ctx context.Context, req *pb.SegmentInfoRequest) (resp *pb.SegmentInfoResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	keyInfo, err := endpoint.validateAuth(ctx)
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionRead,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.Path,
+		Time:          time.Now(),
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		return nil, err
 	}
 
 	err = endpoint.validateBucket(req.Bucket)
@@ -127,9 +218,14 @@ func (endpoint *Endpoint) SegmentInfo(ctx context.Context, req *pb.SegmentInfoRe
 func (endpoint *Endpoint) CreateSegment(ctx context.Context, req *pb.SegmentWriteRequest) (resp *pb.SegmentWriteResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	keyInfo, err := endpoint.validateAuth(ctx)
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.Path,
+		Time:          time.Now(),
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		return nil, err
 	}
 
 	err = endpoint.validateBucket(req.Bucket)
@@ -137,23 +233,19 @@ func (endpoint *Endpoint) CreateSegment(ctx context.Context, req *pb.SegmentWrit
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	// Check if this projectID has exceeded alpha usage limits, i.e. 25GB of bandwidth or storage used in the past month
-	// TODO: remove this code once we no longer need usage limiting for alpha release
-	// Ref: https://storjlabs.atlassian.net/browse/V3-1274
-	bucketID := createBucketID(keyInfo.ProjectID, req.Bucket)
-	inlineTotal, remoteTotal, err := endpoint.accountingDB.ProjectStorageTotals(ctx, keyInfo.ProjectID)
+	exceeded, limit, err := endpoint.projectUsage.ExceedsStorageUsage(ctx, keyInfo.ProjectID)
 	if err != nil {
-		endpoint.log.Error("retrieving ProjectStorageTotals", zap.Error(err))
+		endpoint.log.Error("retrieving project storage totals", zap.Error(err))
 	}
-	exceeded, resource := accounting.ExceedsAlphaUsage(0, inlineTotal, remoteTotal, endpoint.maxAlphaUsage)
 	if exceeded {
-		endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for %s for projectID %s.",
-			endpoint.maxAlphaUsage.String(),
-			resource, keyInfo.ProjectID,
+		endpoint.log.Sugar().Errorf("project limits are %s of storage. This limit has been exceeded for projectID %s.",
+			limit, keyInfo.ProjectID,
 		)
-		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Alpha Usage Limit")
+		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	bucketID := createBucketID(keyInfo.ProjectID, req.Bucket)
+
 	redundancy, err := eestream.NewRedundancyStrategyFromProto(req.GetRedundancy())
 	if err != nil {
 		return nil, err
@@ -165,6 +257,7 @@ func (endpoint *Endpoint) CreateSegment(ctx context.Context, req *pb.SegmentWrit
 		RequestedCount: int(req.Redundancy.Total),
 		FreeBandwidth:  maxPieceSize,
 		FreeDisk:       maxPieceSize,
+		BucketID:       bucketID,
 	}
 	nodes, err := endpoint.cache.FindStorageNodes(ctx, request)
 	if err != nil {
@@ -188,9 +281,14 @@ func (endpoint *Endpoint) CreateSegment(ctx context.Context, req *pb.SegmentWrit
 func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentCommitRequest) (resp *pb.SegmentCommitResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	keyInfo, err := endpoint.validateAuth(ctx)
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.Path,
+		Time:          time.Now(),
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		return nil, err
 	}
 
 	err = endpoint.validateBucket(req.Bucket)
@@ -213,6 +311,9 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	// only the satellite records which pointers share their pieces
+	req.Pointer.SharedWith = nil
+
 	err = endpoint.pointerdb.Put(path, req.Pointer)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
@@ -230,9 +331,14 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDownloadRequest) (resp *pb.SegmentDownloadResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	keyInfo, err := endpoint.validateAuth(ctx)
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionRead,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.Path,
+		Time:          time.Now(),
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		return nil, err
 	}
 
 	err = endpoint.validateBucket(req.Bucket)
@@ -240,22 +346,15 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	// Check if this projectID has exceeded alpha usage limits, i.e. 25GB of bandwidth or storage used in the past month
-	// TODO: remove this code once we no longer need usage limiting for alpha release
-	// Ref: https://storjlabs.atlassian.net/browse/V3-1274
-	bucketID := createBucketID(keyInfo.ProjectID, req.Bucket)
-	from := time.Now().AddDate(0, 0, -accounting.AverageDaysInMonth) // past 30 days
-	bandwidthTotal, err := endpoint.accountingDB.ProjectBandwidthTotal(ctx, bucketID, from)
+	exceeded, limit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfo.ProjectID)
 	if err != nil {
-		endpoint.log.Error("retrieving ProjectBandwidthTotal", zap.Error(err))
+		endpoint.log.Error("retrieving project bandwidth total", zap.Error(err))
 	}
-	exceeded, resource := accounting.ExceedsAlphaUsage(bandwidthTotal, 0, 0, endpoint.maxAlphaUsage)
 	if exceeded {
-		endpoint.log.Sugar().Errorf("monthly project limits are %s of storage and bandwidth usage. This limit has been exceeded for %s for projectID %s.",
-			endpoint.maxAlphaUsage.String(),
-			resource, keyInfo.ProjectID,
+		endpoint.log.Sugar().Errorf("monthly project limits are %s of bandwidth. This limit has been exceeded for projectID %s.",
+			limit, keyInfo.ProjectID,
 		)
-		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Alpha Usage Limit")
+		return nil, status.Errorf(codes.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
 	path, err := CreatePath(keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
@@ -296,9 +395,14 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 func (endpoint *Endpoint) DeleteSegment(ctx context.Context, req *pb.SegmentDeleteRequest) (resp *pb.SegmentDeleteResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	keyInfo, err := endpoint.validateAuth(ctx)
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionDelete,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.Path,
+		Time:          time.Now(),
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		return nil, err
 	}
 
 	err = endpoint.validateBucket(req.Bucket)
@@ -311,8 +415,7 @@ func (endpoint *Endpoint) DeleteSegment(ctx context.Context, req *pb.SegmentDele
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	// TODO refactor to use []byte directly
-	pointer, err := endpoint.pointerdb.Get(path)
+	pointer, shared, err := endpoint.pointerdb.Release(path)
 	if err != nil {
 		if storage.ErrKeyNotFound.Has(err) {
 			return nil, status.Errorf(codes.NotFound, err.Error())
@@ -320,12 +423,21 @@ func (endpoint *Endpoint) DeleteSegment(ctx context.Context, req *pb.SegmentDele
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	err = endpoint.pointerdb.Delete(path)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+	// deleting the bucket itself removes its lifecycle rules and placement
+	if len(req.Path) == 0 && req.Segment == -1 {
+		err = endpoint.lifecycles.Delete(ctx, keyInfo.ProjectID, req.Bucket)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+
+		err = endpoint.cache.SetBucketPlacement(ctx, createBucketID(keyInfo.ProjectID, req.Bucket), nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
 	}
 
-	if pointer.Type == pb.Pointer_REMOTE && pointer.Remote != nil {
+	// the pieces still referenced by copies of the segment are kept
+	if pointer.Type == pb.Pointer_REMOTE && pointer.Remote != nil && !shared {
 		uplinkIdentity, err := identity.PeerIdentityFromContext(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, err.Error())
@@ -347,9 +459,14 @@ func (endpoint *Endpoint) DeleteSegment(ctx context.Context, req *pb.SegmentDele
 func (endpoint *Endpoint) ListSegments(ctx context.Context, req *pb.ListSegmentsRequest) (resp *pb.ListSegmentsResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	keyInfo, err := endpoint.validateAuth(ctx)
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:            macaroon.ActionList,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.Prefix,
+		Time:          time.Now(),
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		return nil, err
 	}
 
 	prefix, err := CreatePath(keyInfo.ProjectID, -1, req.Bucket, req.Prefix)
@@ -374,6 +491,113 @@ func (endpoint *Endpoint) ListSegments(ctx context.Context, req *pb.ListSegments
 	return &pb.ListSegmentsResponse{Items: segmentItems, More: more}, nil
 }
 
+// MoveSegment moves a segment pointer to a new path without touching the pieces on storage nodes
+func (endpoint *Endpoint) MoveSegment(ctx context.Context, req *pb.SegmentMoveRequest) (resp *pb.SegmentMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	newBucket := req.NewBucket
+	if len(newBucket) == 0 {
+		newBucket = req.Bucket
+	}
+
+	now := time.Now()
+	keyInfo, err := endpoint.validateAuth(ctx,
+		macaroon.Action{Op: macaroon.ActionRead, Bucket: req.Bucket, EncryptedPath: req.Path, Time: now},
+		macaroon.Action{Op: macaroon.ActionDelete, Bucket: req.Bucket, EncryptedPath: req.Path, Time: now},
+		macaroon.Action{Op: macaroon.ActionWrite, Bucket: newBucket, EncryptedPath: req.NewPath, Time: now},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.NewPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "new path not specified")
+	}
+
+	err = endpoint.validateBucket(newBucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	path, err := CreatePath(keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	newPath, err := CreatePath(keyInfo.ProjectID, req.NewSegment, newBucket, req.NewPath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	pointer, err := endpoint.pointerdb.Move(path, newPath, req.NewMetadata)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.SegmentMoveResponse{Pointer: pointer}, nil
+}
+
+// CopySegment stores a copy of the segment pointer under a new path. The copy
+// references the same pieces as the original, which are then marked as shared.
+func (endpoint *Endpoint) CopySegment(ctx context.Context, req *pb.SegmentCopyRequest) (resp *pb.SegmentCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	keyInfo, err := endpoint.validateAuth(ctx,
+		macaroon.Action{Op: macaroon.ActionRead, Bucket: req.Bucket, EncryptedPath: req.Path, Time: now},
+		macaroon.Action{Op: macaroon.ActionWrite, Bucket: req.NewBucket, EncryptedPath: req.NewPath, Time: now},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.validateBucket(req.NewBucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.NewPath) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "new path not specified")
+	}
+
+	path, err := CreatePath(keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	newPath, err := CreatePath(keyInfo.ProjectID, req.NewSegment, req.NewBucket, req.NewPath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if newPath == path {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot copy segment onto itself")
+	}
+
+	pointer, err := endpoint.pointerdb.Copy(path, newPath, req.NewMetadata)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.SegmentCopyResponse{Pointer: pointer}, nil
+}
+
 func createBucketID(projectID uuid.UUID, bucket []byte) []byte {
 	entries := make([]string, 0)
 	entries = append(entries, projectID.String())
@@ -13,15 +13,19 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+	"storj.io/storj/uplink/metainfo"
 )
 
 // mockAPIKeys is mock for api keys store of pointerdb
@@ -69,6 +73,139 @@ func TestInvalidAPIKey(t *testing.T) {
 	}
 }
 
+func TestRestrictedAPIKey(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 1, 1)
+	require.NoError(t, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	satellite := planet.Satellites[0]
+	rootKey, err := macaroon.ParseAPIKey(planet.Uplinks[0].APIKey[satellite.ID()])
+	require.NoError(t, err)
+
+	dial := func(key *macaroon.APIKey) metainfo.Client {
+		serialized, err := key.Serialize()
+		require.NoError(t, err)
+		client, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, serialized)
+		require.NoError(t, err)
+		return client
+	}
+
+	restrict := func(key *macaroon.APIKey, caveat macaroon.Caveat) *macaroon.APIKey {
+		restricted, err := key.Restrict(caveat)
+		require.NoError(t, err)
+		return restricted
+	}
+
+	// listing an empty bucket is allowed
+	listTestBucket := func(client metainfo.Client) error {
+		_, _, err := client.ListSegments(ctx, "testbucket", "", "", "", true, 1, 0)
+		return err
+	}
+
+	t.Run("read only", func(t *testing.T) {
+		client := dial(restrict(rootKey, macaroon.Caveat{DisallowWrites: true, DisallowDeletes: true}))
+
+		require.NoError(t, listTestBucket(client))
+
+		_, err := client.SegmentInfo(ctx, "testbucket", "testpath", 0)
+		assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+		_, _, err = client.CreateSegment(ctx, "testbucket", "testpath", 0, &pb.RedundancyScheme{}, 123, time.Now())
+		assertPermissionDenied(t, err)
+
+		_, err = client.DeleteSegment(ctx, "testbucket", "testpath", 0)
+		assertPermissionDenied(t, err)
+	})
+
+	t.Run("paths", func(t *testing.T) {
+		client := dial(restrict(rootKey, macaroon.Caveat{
+			AllowedPaths: []*macaroon.CaveatPath{
+				{Bucket: []byte("testbucket"), EncryptedPathPrefix: []byte("prefix")},
+			},
+		}))
+
+		_, _, err := client.ListSegments(ctx, "testbucket", "prefix/", "", "", true, 1, 0)
+		require.NoError(t, err)
+
+		_, err = client.SegmentInfo(ctx, "testbucket", "prefix/path", 0)
+		assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+		_, err = client.SegmentInfo(ctx, "testbucket", "prefixed", 0)
+		assertPermissionDenied(t, err)
+
+		assertPermissionDenied(t, listTestBucket(client))
+
+		_, _, err = client.ListSegments(ctx, "otherbucket", "prefix/", "", "", true, 1, 0)
+		assertPermissionDenied(t, err)
+	})
+
+	t.Run("time window", func(t *testing.T) {
+		expired, err := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assertPermissionDenied(t, listTestBucket(dial(restrict(rootKey, macaroon.Caveat{NotAfter: expired}))))
+
+		notYet, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assertPermissionDenied(t, listTestBucket(dial(restrict(rootKey, macaroon.Caveat{NotBefore: notYet}))))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		// the raw key of an api key with a secret is the head of its macaroons
+		client, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, console.APIKeyFromBytes(rootKey.Head()).String())
+		require.NoError(t, err)
+		assertUnauthenticated(t, listTestBucket(client))
+
+		secret, err := macaroon.NewSecret()
+		require.NoError(t, err)
+		assertUnauthenticated(t, listTestBucket(dial(macaroon.NewAPIKey(rootKey.Head(), secret))))
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		caveat, err := macaroon.NewCaveat()
+		require.NoError(t, err)
+		revokedKey := restrict(rootKey, caveat)
+		derivedKey := restrict(revokedKey, macaroon.Caveat{DisallowDeletes: true})
+
+		caveat, err = macaroon.NewCaveat()
+		require.NoError(t, err)
+		siblingKey := restrict(rootKey, caveat)
+
+		serialized, err := revokedKey.Serialize()
+		require.NoError(t, err)
+
+		// a key can't revoke the keys it wasn't restricted from
+		err = dial(siblingKey).RevokeAPIKey(ctx, serialized)
+		assertPermissionDenied(t, err)
+
+		err = dial(rootKey).RevokeAPIKey(ctx, serialized)
+		require.NoError(t, err)
+
+		// revoking twice is fine
+		err = dial(rootKey).RevokeAPIKey(ctx, serialized)
+		require.NoError(t, err)
+
+		assertUnauthenticated(t, listTestBucket(dial(revokedKey)))
+		assertUnauthenticated(t, listTestBucket(dial(derivedKey)))
+		require.NoError(t, listTestBucket(dial(siblingKey)))
+		require.NoError(t, listTestBucket(dial(rootKey)))
+	})
+}
+
+func assertPermissionDenied(t *testing.T, err error) {
+	t.Helper()
+
+	if err, ok := status.FromError(errs.Unwrap(err)); ok {
+		assert.Equal(t, codes.PermissionDenied, err.Code())
+	} else {
+		assert.Fail(t, "got unexpected error", "%T", err)
+	}
+}
+
 func assertUnauthenticated(t *testing.T, err error) {
 	t.Helper()
 
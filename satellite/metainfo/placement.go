@@ -0,0 +1,81 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+)
+
+// SetBucketPlacement replaces the placement constraints of a bucket
+func (endpoint *Endpoint) SetBucketPlacement(ctx context.Context, req *pb.SetBucketPlacementRequest) (resp *pb.SetBucketPlacementResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionWrite,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.checkBucketExists(keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.cache.SetBucketPlacement(ctx, createBucketID(keyInfo.ProjectID, req.Bucket), req.Placement.GetCountries())
+	if err != nil {
+		if overlay.ErrInvalidPlacement.Has(err) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.SetBucketPlacementResponse{}, nil
+}
+
+// GetBucketPlacement returns the placement constraints of a bucket
+func (endpoint *Endpoint) GetBucketPlacement(ctx context.Context, req *pb.GetBucketPlacementRequest) (resp *pb.GetBucketPlacementResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Bucket,
+		Time:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.validateBucket(req.Bucket)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.checkBucketExists(keyInfo.ProjectID, req.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	countries, err := endpoint.cache.GetBucketPlacement(ctx, createBucketID(keyInfo.ProjectID, req.Bucket))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &pb.GetBucketPlacementResponse{Placement: &pb.BucketPlacement{Countries: countries}}, nil
+}
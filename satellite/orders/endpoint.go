@@ -54,6 +54,8 @@ var (
 	Error = errs.Class("orders error")
 	// ErrUsingSerialNumber error class for serial number
 	ErrUsingSerialNumber = errs.Class("serial number")
+	// ErrNodeOffline error class for when an order limit is requested for an offline node
+	ErrNodeOffline = errs.Class("node is offline")
 
 	mon = monkit.Package()
 )
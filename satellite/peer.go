@@ -47,7 +47,9 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/gc"
 	"storj.io/storj/satellite/inspector"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
 	"storj.io/storj/satellite/metainfo"
@@ -83,8 +85,12 @@ type DB interface {
 	Irreparable() irreparable.DB
 	// Console returns database for satellite console
 	Console() console.DB
+	// Containment returns database for storing pending audit info
+	Containment() audit.Containment
 	// Orders returns database for orders
 	Orders() orders.DB
+	// Lifecycles returns database for bucket lifecycle rules
+	Lifecycles() lifecycle.DB
 }
 
 // Config is the global config satellite
@@ -98,8 +104,9 @@ type Config struct {
 	Overlay   overlay.Config
 	Discovery discovery.Config
 
-	PointerDB   pointerdb.Config
-	BwAgreement bwagreement.Config // TODO: decide whether to keep empty configs for consistency
+	PointerDB    pointerdb.Config
+	MetainfoLoop metainfo.LoopConfig
+	BwAgreement  bwagreement.Config // TODO: decide whether to keep empty configs for consistency
 
 	Checker  checker.Config
 	Repairer repairer.Config
@@ -108,6 +115,10 @@ type Config struct {
 	Tally  tally.Config
 	Rollup rollup.Config
 
+	Lifecycle lifecycle.Config
+
+	GarbageCollection gc.Config
+
 	Mail    mailservice.Config
 	Console consoleweb.Config
 
@@ -150,6 +161,7 @@ type Peer struct {
 		Database  storage.KeyValueStore // TODO: move into pointerDB
 		Service   *pointerdb.Service
 		Endpoint2 *metainfo.Endpoint
+		Loop      *metainfo.Loop
 	}
 
 	Inspector struct {
@@ -175,8 +187,17 @@ type Peer struct {
 	}
 
 	Accounting struct {
-		Tally  *tally.Service
-		Rollup *rollup.Service
+		Tally        *tally.Service
+		Rollup       *rollup.Service
+		ProjectUsage *accounting.ProjectUsage
+	}
+
+	Lifecycle struct {
+		Service *lifecycle.Service
+	}
+
+	GarbageCollection struct {
+		Service *gc.Service
 	}
 
 	Mail struct {
@@ -206,7 +227,10 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			peer.Log.Sugar().Debugf("Binary Version: %s with CommitHash %s, built at %s as Release %v",
 				versionInfo.Version.String(), versionInfo.CommitHash, versionInfo.Timestamp.String(), versionInfo.Release)
 		}
-		peer.Version = version.NewService(config.Version, versionInfo, "Satellite")
+		peer.Version, err = version.NewService(config.Version, versionInfo, "Satellite")
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup listener and server
@@ -236,9 +260,16 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			AuditCount:            config.Node.AuditCount,
 			NewNodeAuditThreshold: config.Node.NewNodeAuditThreshold,
 			NewNodePercentage:     config.Node.NewNodePercentage,
+			DistinctIP:            config.Node.DistinctIP,
+			DistinctOperator:      config.Node.DistinctOperator,
+		}
+
+		geoip, err := overlay.LoadGeoIP(config.GeoIP)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Overlay.Service = overlay.NewCache(peer.Log.Named("overlay"), peer.DB.OverlayCache(), nodeSelectionConfig)
+		peer.Overlay.Service = overlay.NewCache(peer.Log.Named("overlay"), peer.DB.OverlayCache(), nodeSelectionConfig, geoip)
 		peer.Transport = peer.Transport.WithObservers(peer.Overlay.Service)
 
 		peer.Overlay.Inspector = overlay.NewInspector(peer.Overlay.Service)
@@ -336,17 +367,25 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		peer.Metainfo.Database = storelogger.New(peer.Log.Named("pdb"), db)
 		peer.Metainfo.Service = pointerdb.NewService(peer.Log.Named("pointerdb"), peer.Metainfo.Database)
 
+		peer.Accounting.ProjectUsage = accounting.NewProjectUsage(
+			peer.DB.Accounting(),
+			config.Rollup.MaxAlphaUsage,
+			config.Rollup.UsageCacheExpiration,
+		)
+
 		peer.Metainfo.Endpoint2 = metainfo.NewEndpoint(
 			peer.Log.Named("metainfo:endpoint"),
 			peer.Metainfo.Service,
 			peer.Orders.Service,
 			peer.Overlay.Service,
 			peer.DB.Console().APIKeys(),
-			peer.DB.Accounting(),
-			config.Rollup.MaxAlphaUsage,
+			peer.Accounting.ProjectUsage,
+			peer.DB.Lifecycles(),
 		)
 
 		pb.RegisterMetainfoServer(peer.Server.GRPC(), peer.Metainfo.Endpoint2)
+
+		peer.Metainfo.Loop = metainfo.NewLoop(config.MetainfoLoop, peer.Metainfo.Service)
 	}
 
 	{ // setup agreements
@@ -360,7 +399,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		log.Debug("Setting up datarepair")
 		// TODO: simplify argument list somehow
 		peer.Repair.Checker = checker.NewChecker(
-			peer.Metainfo.Service,
+			peer.Metainfo.Loop,
 			peer.DB.RepairQueue(),
 			peer.Overlay.Service, peer.DB.Irreparable(),
 			0, peer.Log.Named("checker"),
@@ -388,9 +427,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 		peer.Audit.Service, err = audit.NewService(peer.Log.Named("audit"),
 			config,
 			peer.Metainfo.Service,
+			peer.Metainfo.Loop,
 			peer.Orders.Service,
 			peer.Transport,
 			peer.Overlay.Service,
+			peer.DB.Containment(),
 			peer.Identity,
 		)
 		if err != nil {
@@ -400,10 +441,34 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 
 	{ // setup accounting
 		log.Debug("Setting up accounting")
-		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.Accounting(), peer.Metainfo.Service, peer.Overlay.Service, 0, config.Tally.Interval)
+		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.Accounting(), peer.Metainfo.Loop, peer.Overlay.Service, 0, config.Tally.Interval)
 		peer.Accounting.Rollup = rollup.New(peer.Log.Named("rollup"), peer.DB.Accounting(), config.Rollup.Interval)
 	}
 
+	{ // setup lifecycle
+		log.Debug("Setting up bucket lifecycle")
+		peer.Lifecycle.Service = lifecycle.NewService(peer.Log.Named("lifecycle"),
+			config.Lifecycle,
+			peer.DB.Lifecycles(),
+			peer.Metainfo.Loop,
+			peer.Metainfo.Service,
+			peer.Orders.Service,
+			peer.Transport,
+		)
+	}
+
+	{ // setup garbage collection
+		if config.GarbageCollection.Enabled {
+			log.Debug("Setting up garbage collection")
+			peer.GarbageCollection.Service = gc.NewService(peer.Log.Named("garbage collection"),
+				config.GarbageCollection,
+				peer.Transport,
+				peer.Metainfo.Loop,
+				peer.Overlay.Service,
+			)
+		}
+	}
+
 	{ // setup inspector
 		log.Debug("Setting up inspector")
 		peer.Inspector.Endpoint = inspector.NewEndpoint(
@@ -498,6 +563,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config, ve
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			peer.DB.Console(),
 			consoleConfig.PasswordCost,
+			config.Rollup.MaxAlphaUsage,
+			consoleConfig.RequireMFAForAPIKeys,
 		)
 
 		if err != nil {
@@ -539,6 +606,9 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return ignoreCancel(peer.Discovery.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Metainfo.Loop.Run(ctx))
+	})
 	group.Go(func() error {
 		return ignoreCancel(peer.Repair.Checker.Run(ctx))
 	})
@@ -551,6 +621,14 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return ignoreCancel(peer.Accounting.Rollup.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Lifecycle.Service.Run(ctx))
+	})
+	if peer.GarbageCollection.Service != nil {
+		group.Go(func() error {
+			return ignoreCancel(peer.GarbageCollection.Service.Run(ctx))
+		})
+	}
 	group.Go(func() error {
 		return ignoreCancel(peer.Audit.Service.Run(ctx))
 	})
@@ -588,7 +666,18 @@ func (peer *Peer) Close() error {
 		}
 	}
 
+	// close the metainfo loop first, to release the services waiting to join it
+	if peer.Metainfo.Loop != nil {
+		errlist.Add(peer.Metainfo.Loop.Close())
+	}
+
 	// close services in reverse initialization order
+	if peer.GarbageCollection.Service != nil {
+		errlist.Add(peer.GarbageCollection.Service.Close())
+	}
+	if peer.Lifecycle.Service != nil {
+		errlist.Add(peer.Lifecycle.Service.Close())
+	}
 	if peer.Repair.Repairer != nil {
 		errlist.Add(peer.Repair.Repairer.Close())
 	}
@@ -4,7 +4,6 @@
 package satellitedb
 
 import (
-	"bytes"
 	"context"
 	"database/sql"
 	"time"
@@ -12,6 +11,7 @@ import (
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
@@ -24,12 +24,11 @@ type accountingDB struct {
 }
 
 // ProjectBandwidthTotal returns the sum of GET bandwidth usage for a projectID for a time frame
-func (db *accountingDB) ProjectBandwidthTotal(ctx context.Context, bucketID []byte, from time.Time) (int64, error) {
-	pathEl := bytes.Split(bucketID, []byte("/"))
-	_, projectID := pathEl[1], pathEl[0]
+func (db *accountingDB) ProjectBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (int64, error) {
 	var sum *int64
+	// the rollups are keyed by the project id as it appears in the bucket id
 	query := `SELECT SUM(settled) FROM bucket_bandwidth_rollups WHERE project_id = ? AND action = ? AND interval_start > ?;`
-	err := db.db.QueryRow(db.db.Rebind(query), projectID, pb.PieceAction_GET, from).Scan(&sum)
+	err := db.db.QueryRow(db.db.Rebind(query), []byte(projectID.String()), pb.PieceAction_GET, from).Scan(&sum)
 	if err == sql.ErrNoRows || sum == nil {
 		return 0, nil
 	}
@@ -58,6 +57,41 @@ func (db *accountingDB) ProjectStorageTotals(ctx context.Context, projectID uuid
 	return inlineSum.Int64, remoteSum.Int64, err
 }
 
+// GetProjectLimits returns the usage limits of a project
+func (db *accountingDB) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (_ accounting.ProjectLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := db.db.Get_Project_By_Id(ctx, dbx.Project_Id(projectID[:]))
+	if err != nil {
+		return accounting.ProjectLimits{}, Error.Wrap(err)
+	}
+
+	return accounting.ProjectLimits{
+		Storage:   memory.Size(project.StorageLimit),
+		Bandwidth: memory.Size(project.BandwidthLimit),
+	}, nil
+}
+
+// UpdateProjectLimits sets the usage limits of a project
+func (db *accountingDB) UpdateProjectLimits(ctx context.Context, projectID uuid.UUID, limits accounting.ProjectLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := db.db.Update_Project_By_Id(ctx,
+		dbx.Project_Id(projectID[:]),
+		dbx.Project_Update_Fields{
+			StorageLimit:   dbx.Project_StorageLimit(limits.Storage.Int64()),
+			BandwidthLimit: dbx.Project_BandwidthLimit(limits.Bandwidth.Int64()),
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if project == nil {
+		return Error.New("project %s not found", projectID)
+	}
+	return nil
+}
+
 // CreateBucketStorageTally creates a record in the bucket_storage_tallies accounting table
 func (db *accountingDB) CreateBucketStorageTally(ctx context.Context, tally accounting.BucketStorageTally) error {
 	_, err := db.db.Create_BucketStorageTally(
@@ -72,11 +72,18 @@ func (keys *apikeys) Create(ctx context.Context, key console.APIKey, info consol
 		return nil, err
 	}
 
+	// keys without a secret can't be restricted
+	secret := info.Secret
+	if secret == nil {
+		secret = []byte{}
+	}
+
 	dbKey, err := keys.db.Create_ApiKey(
 		ctx,
 		dbx.ApiKey_Id(id[:]),
 		dbx.ApiKey_ProjectId(info.ProjectID[:]),
 		dbx.ApiKey_Key(key[:]),
+		dbx.ApiKey_Secret(secret),
 		dbx.ApiKey_Name(info.Name),
 	)
 
@@ -106,6 +113,29 @@ func (keys *apikeys) Delete(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+// Revoke implements satellite.APIKeys
+func (keys *apikeys) Revoke(ctx context.Context, id uuid.UUID, tail []byte) error {
+	_, err := keys.db.Create_ApiKeyRevocation(ctx,
+		dbx.ApiKeyRevocation_Tail(tail),
+		dbx.ApiKeyRevocation_ApiKeyId(id[:]),
+	)
+	return err
+}
+
+// GetRevocations implements satellite.APIKeys
+func (keys *apikeys) GetRevocations(ctx context.Context, id uuid.UUID) ([][]byte, error) {
+	dbRevocations, err := keys.db.All_ApiKeyRevocation_By_ApiKeyId(ctx, dbx.ApiKeyRevocation_ApiKeyId(id[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	var tails [][]byte
+	for _, revocation := range dbRevocations {
+		tails = append(tails, revocation.Tail)
+	}
+	return tails, nil
+}
+
 // fromDBXAPIKey converts dbx.ApiKey to satellite.APIKeyInfo
 func fromDBXAPIKey(key *dbx.ApiKey) (*console.APIKeyInfo, error) {
 	id, err := bytesToUUID(key.Id)
@@ -122,6 +152,7 @@ func fromDBXAPIKey(key *dbx.ApiKey) (*console.APIKeyInfo, error) {
 		ID:        id,
 		ProjectID: projectID,
 		Name:      key.Name,
+		Secret:    key.Secret,
 		CreatedAt: key.CreatedAt,
 	}, nil
 }
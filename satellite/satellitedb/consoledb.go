@@ -23,7 +23,7 @@ type ConsoleDB struct {
 
 // Users is getter a for Users repository
 func (db *ConsoleDB) Users() console.Users {
-	return &users{db.methods}
+	return &users{db.methods, db.db}
 }
 
 // Projects is a getter for Projects repository
@@ -51,6 +51,11 @@ func (db *ConsoleDB) RegistrationTokens() console.RegistrationTokens {
 	return &registrationTokens{db.methods}
 }
 
+// ResetPasswordTokens is a getter for ResetPasswordTokens repository
+func (db *ConsoleDB) ResetPasswordTokens() console.ResetPasswordTokens {
+	return &resetPasswordTokens{db.methods}
+}
+
 // UsageRollups is a getter for console.UsageRollups repository
 func (db *ConsoleDB) UsageRollups() console.UsageRollups {
 	return &usagerollups{db.db}
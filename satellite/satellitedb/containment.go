@@ -0,0 +1,113 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/storj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type containment struct {
+	db *dbx.DB
+}
+
+// Get gets the pending audit by node id
+func (containment *containment) Get(ctx context.Context, nodeID storj.NodeID) (_ *audit.PendingAudit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pending, err := containment.db.Get_PendingAudits_By_NodeId(ctx, dbx.PendingAudits_NodeId(nodeID.Bytes()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, audit.ErrContainedNotFound.New("%s", nodeID)
+		}
+		return nil, audit.ContainError.Wrap(err)
+	}
+
+	return convertDBPending(pending)
+}
+
+// IncrementPending creates a new pending audit entry, or increases its reverify count if it already exists
+func (containment *containment) IncrementPending(ctx context.Context, pendingAudit *audit.PendingAudit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := containment.db.Open(ctx)
+	if err != nil {
+		return audit.ContainError.Wrap(err)
+	}
+
+	existing, err := tx.Get_PendingAudits_By_NodeId(ctx, dbx.PendingAudits_NodeId(pendingAudit.NodeID.Bytes()))
+	switch {
+	case err == sql.ErrNoRows:
+		_, err = tx.Create_PendingAudits(ctx,
+			dbx.PendingAudits_NodeId(pendingAudit.NodeID.Bytes()),
+			dbx.PendingAudits_PieceId(pendingAudit.PieceID.Bytes()),
+			dbx.PendingAudits_StripeIndex(pendingAudit.StripeIndex),
+			dbx.PendingAudits_ShareSize(int64(pendingAudit.ShareSize)),
+			dbx.PendingAudits_ExpectedShareHash(pendingAudit.ExpectedShareHash),
+			dbx.PendingAudits_ReverifyCount(int64(pendingAudit.ReverifyCount)),
+			dbx.PendingAudits_Path(pendingAudit.Path),
+		)
+		if err != nil {
+			return audit.ContainError.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	case err != nil:
+		return audit.ContainError.Wrap(errs.Combine(err, tx.Rollback()))
+	default:
+		current, err := convertDBPending(existing)
+		if err != nil {
+			return errs.Combine(err, tx.Rollback())
+		}
+		if !current.Same(pendingAudit) {
+			return errs.Combine(audit.ErrAlreadyExists.New("%s", pendingAudit.NodeID), tx.Rollback())
+		}
+
+		_, err = tx.Update_PendingAudits_By_NodeId(ctx,
+			dbx.PendingAudits_NodeId(pendingAudit.NodeID.Bytes()),
+			dbx.PendingAudits_Update_Fields{
+				ReverifyCount: dbx.PendingAudits_ReverifyCount(existing.ReverifyCount + 1),
+			},
+		)
+		if err != nil {
+			return audit.ContainError.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	}
+
+	return audit.ContainError.Wrap(tx.Commit())
+}
+
+// Delete deletes the pending audit of the node
+func (containment *containment) Delete(ctx context.Context, nodeID storj.NodeID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := containment.db.Delete_PendingAudits_By_NodeId(ctx, dbx.PendingAudits_NodeId(nodeID.Bytes()))
+	return deleted, audit.ContainError.Wrap(err)
+}
+
+func convertDBPending(info *dbx.PendingAudits) (*audit.PendingAudit, error) {
+	nodeID, err := storj.NodeIDFromBytes(info.NodeId)
+	if err != nil {
+		return nil, audit.ContainError.Wrap(err)
+	}
+
+	pieceID, err := storj.PieceIDFromBytes(info.PieceId)
+	if err != nil {
+		return nil, audit.ContainError.Wrap(err)
+	}
+
+	return &audit.PendingAudit{
+		NodeID:            nodeID,
+		PieceID:           pieceID,
+		StripeIndex:       info.StripeIndex,
+		ShareSize:         int32(info.ShareSize),
+		ExpectedShareHash: info.ExpectedShareHash,
+		ReverifyCount:     int32(info.ReverifyCount),
+		Path:              info.Path,
+	}, nil
+}
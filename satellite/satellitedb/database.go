@@ -10,6 +10,7 @@ import (
 	"storj.io/storj/internal/dbutil"
 	"storj.io/storj/internal/dbutil/pgutil"
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/certdb"
 	"storj.io/storj/pkg/datarepair/irreparable"
@@ -17,6 +18,7 @@ import (
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/orders"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -124,6 +126,11 @@ func (db *DB) Irreparable() irreparable.DB {
 	return &irreparableDB{db: db.db}
 }
 
+// Containment returns database for storing pending audit info
+func (db *DB) Containment() audit.Containment {
+	return &containment{db: db.db}
+}
+
 // Console returns database for storing users, projects and api keys
 func (db *DB) Console() console.DB {
 	return &ConsoleDB{
@@ -136,3 +143,8 @@ func (db *DB) Console() console.DB {
 func (db *DB) Orders() orders.DB {
 	return &ordersDB{db: db.db}
 }
+
+// Lifecycles returns database for storing bucket lifecycle rules
+func (db *DB) Lifecycles() lifecycle.DB {
+	return &lifecycles{db: db.db}
+}
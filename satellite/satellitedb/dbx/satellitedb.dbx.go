@@ -309,6 +309,21 @@ CREATE TABLE bucket_bandwidth_rollups (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_lifecycles (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	rules bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_placements (
+	bucket_id bytea NOT NULL,
+	countries text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( bucket_id )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -353,9 +368,12 @@ CREATE TABLE certRecords (
 	PRIMARY KEY ( id )
 );
 CREATE TABLE injuredsegments (
-	id bigserial NOT NULL,
-	info bytea NOT NULL,
-	PRIMARY KEY ( id )
+	path text NOT NULL,
+	data bytea NOT NULL,
+	health double precision NOT NULL,
+	leased_until timestamp with time zone,
+	attempts integer NOT NULL,
+	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
 	segmentpath bytea NOT NULL,
@@ -385,12 +403,31 @@ CREATE TABLE nodes (
 	updated_at timestamp with time zone NOT NULL,
 	last_contact_success timestamp with time zone NOT NULL,
 	last_contact_failure timestamp with time zone NOT NULL,
+	last_net text NOT NULL,
+	country_code text NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
+	uptime_reputation_alpha double precision NOT NULL,
+	uptime_reputation_beta double precision NOT NULL,
+	disqualified timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE pending_audits (
+	node_id bytea NOT NULL,
+	piece_id bytea NOT NULL,
+	stripe_index bigint NOT NULL,
+	share_size bigint NOT NULL,
+	expected_share_hash bytea NOT NULL,
+	reverify_count bigint NOT NULL,
+	path text NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
 	description text NOT NULL,
+	storage_limit bigint NOT NULL,
+	bandwidth_limit bigint NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -402,6 +439,13 @@ CREATE TABLE registration_tokens (
 	PRIMARY KEY ( secret ),
 	UNIQUE ( owner_id )
 );
+CREATE TABLE reset_password_tokens (
+	secret bytea NOT NULL,
+	owner_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( secret ),
+	UNIQUE ( owner_id )
+);
 CREATE TABLE serial_numbers (
 	id serial NOT NULL,
 	serial_number bytea NOT NULL,
@@ -431,6 +475,12 @@ CREATE TABLE users (
 	email text NOT NULL,
 	password_hash bytea NOT NULL,
 	status integer NOT NULL,
+	mfa_enabled boolean NOT NULL,
+	mfa_secret_key text,
+	mfa_recovery_codes text,
+	mfa_failed_attempts integer NOT NULL,
+	mfa_locked_until timestamp with time zone,
+	mfa_last_step bigint NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -438,15 +488,23 @@ CREATE TABLE api_keys (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	key bytea NOT NULL,
+	secret bytea NOT NULL,
 	name text NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id ),
 	UNIQUE ( key ),
 	UNIQUE ( name, project_id )
 );
+CREATE TABLE api_key_revocations (
+	tail bytea NOT NULL,
+	api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( tail )
+);
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role integer NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
@@ -457,6 +515,7 @@ CREATE TABLE used_serials (
 );
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
+CREATE INDEX injuredsegments_health_index ON injuredsegments ( health );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
 CREATE INDEX storagenode_id_interval_start_interval_seconds ON storagenode_bandwidth_rollups ( storagenode_id, interval_start, interval_seconds );`
@@ -560,6 +619,21 @@ CREATE TABLE bucket_bandwidth_rollups (
 	settled INTEGER NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_lifecycles (
+	project_id BLOB NOT NULL,
+	bucket_name BLOB NOT NULL,
+	rules BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_placements (
+	bucket_id BLOB NOT NULL,
+	countries TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( bucket_id )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name BLOB NOT NULL,
 	project_id BLOB NOT NULL,
@@ -604,9 +678,12 @@ CREATE TABLE certRecords (
 	PRIMARY KEY ( id )
 );
 CREATE TABLE injuredsegments (
-	id INTEGER NOT NULL,
-	info BLOB NOT NULL,
-	PRIMARY KEY ( id )
+	path TEXT NOT NULL,
+	data BLOB NOT NULL,
+	health REAL NOT NULL,
+	leased_until TIMESTAMP,
+	attempts INTEGER NOT NULL,
+	PRIMARY KEY ( path )
 );
 CREATE TABLE irreparabledbs (
 	segmentpath BLOB NOT NULL,
@@ -636,12 +713,31 @@ CREATE TABLE nodes (
 	updated_at TIMESTAMP NOT NULL,
 	last_contact_success TIMESTAMP NOT NULL,
 	last_contact_failure TIMESTAMP NOT NULL,
+	last_net TEXT NOT NULL,
+	country_code TEXT NOT NULL,
+	audit_reputation_alpha REAL NOT NULL,
+	audit_reputation_beta REAL NOT NULL,
+	uptime_reputation_alpha REAL NOT NULL,
+	uptime_reputation_beta REAL NOT NULL,
+	disqualified TIMESTAMP,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE pending_audits (
+	node_id BLOB NOT NULL,
+	piece_id BLOB NOT NULL,
+	stripe_index INTEGER NOT NULL,
+	share_size INTEGER NOT NULL,
+	expected_share_hash BLOB NOT NULL,
+	reverify_count INTEGER NOT NULL,
+	path TEXT NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE projects (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
 	description TEXT NOT NULL,
+	storage_limit INTEGER NOT NULL,
+	bandwidth_limit INTEGER NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -653,6 +749,13 @@ CREATE TABLE registration_tokens (
 	PRIMARY KEY ( secret ),
 	UNIQUE ( owner_id )
 );
+CREATE TABLE reset_password_tokens (
+	secret BLOB NOT NULL,
+	owner_id BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( secret ),
+	UNIQUE ( owner_id )
+);
 CREATE TABLE serial_numbers (
 	id INTEGER NOT NULL,
 	serial_number BLOB NOT NULL,
@@ -682,6 +785,12 @@ CREATE TABLE users (
 	email TEXT NOT NULL,
 	password_hash BLOB NOT NULL,
 	status INTEGER NOT NULL,
+	mfa_enabled INTEGER NOT NULL,
+	mfa_secret_key TEXT,
+	mfa_recovery_codes TEXT,
+	mfa_failed_attempts INTEGER NOT NULL,
+	mfa_locked_until TIMESTAMP,
+	mfa_last_step INTEGER NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -689,15 +798,23 @@ CREATE TABLE api_keys (
 	id BLOB NOT NULL,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	key BLOB NOT NULL,
+	secret BLOB NOT NULL,
 	name TEXT NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id ),
 	UNIQUE ( key ),
 	UNIQUE ( name, project_id )
 );
+CREATE TABLE api_key_revocations (
+	tail BLOB NOT NULL,
+	api_key_id BLOB NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( tail )
+);
 CREATE TABLE project_members (
 	member_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role INTEGER NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 );
@@ -708,6 +825,7 @@ CREATE TABLE used_serials (
 );
 CREATE INDEX bucket_name_project_id_interval_start_interval_seconds ON bucket_bandwidth_rollups ( bucket_name, project_id, interval_start, interval_seconds );
 CREATE UNIQUE INDEX bucket_id_rollup ON bucket_usages ( bucket_id, rollup_end_time );
+CREATE INDEX injuredsegments_health_index ON injuredsegments ( health );
 CREATE UNIQUE INDEX serial_number ON serial_numbers ( serial_number );
 CREATE INDEX serial_numbers_expires_at_index ON serial_numbers ( expires_at );
 CREATE INDEX storagenode_id_interval_start_interval_seconds ON storagenode_bandwidth_rollups ( storagenode_id, interval_start, interval_seconds );`
@@ -1310,6 +1428,204 @@ func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
 
 func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
+type BucketLifecycle struct {
+	ProjectId  []byte
+	BucketName []byte
+	Rules      []byte
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (BucketLifecycle) _Table() string { return "bucket_lifecycles" }
+
+type BucketLifecycle_Update_Fields struct {
+	Rules BucketLifecycle_Rules_Field
+}
+
+type BucketLifecycle_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycle_ProjectId(v []byte) BucketLifecycle_ProjectId_Field {
+	return BucketLifecycle_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycle_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketLifecycle_ProjectId_Field) _Column() string { return "project_id" }
+
+type BucketLifecycle_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycle_BucketName(v []byte) BucketLifecycle_BucketName_Field {
+	return BucketLifecycle_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycle_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketLifecycle_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketLifecycle_Rules_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycle_Rules(v []byte) BucketLifecycle_Rules_Field {
+	return BucketLifecycle_Rules_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycle_Rules_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketLifecycle_Rules_Field) _Column() string { return "rules" }
+
+type BucketLifecycle_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketLifecycle_CreatedAt(v time.Time) BucketLifecycle_CreatedAt_Field {
+	return BucketLifecycle_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycle_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketLifecycle_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketLifecycle_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketLifecycle_UpdatedAt(v time.Time) BucketLifecycle_UpdatedAt_Field {
+	return BucketLifecycle_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycle_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketLifecycle_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type BucketPlacement struct {
+	BucketId  []byte
+	Countries string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (BucketPlacement) _Table() string { return "bucket_placements" }
+
+type BucketPlacement_Update_Fields struct {
+	Countries BucketPlacement_Countries_Field
+}
+
+type BucketPlacement_BucketId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketPlacement_BucketId(v []byte) BucketPlacement_BucketId_Field {
+	return BucketPlacement_BucketId_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacement_BucketId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketPlacement_BucketId_Field) _Column() string { return "bucket_id" }
+
+type BucketPlacement_Countries_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func BucketPlacement_Countries(v string) BucketPlacement_Countries_Field {
+	return BucketPlacement_Countries_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacement_Countries_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketPlacement_Countries_Field) _Column() string { return "countries" }
+
+type BucketPlacement_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketPlacement_CreatedAt(v time.Time) BucketPlacement_CreatedAt_Field {
+	return BucketPlacement_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacement_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketPlacement_CreatedAt_Field) _Column() string { return "created_at" }
+
+type BucketPlacement_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketPlacement_UpdatedAt(v time.Time) BucketPlacement_UpdatedAt_Field {
+	return BucketPlacement_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketPlacement_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketPlacement_UpdatedAt_Field) _Column() string { return "updated_at" }
+
 type BucketStorageTally struct {
 	BucketName          []byte
 	ProjectId           []byte
@@ -1964,52 +2280,133 @@ func (f CertRecord_UpdateAt_Field) value() interface{} {
 func (CertRecord_UpdateAt_Field) _Column() string { return "update_at" }
 
 type Injuredsegment struct {
-	Id   int64
-	Info []byte
+	Path        string
+	Data        []byte
+	Health      float64
+	LeasedUntil *time.Time
+	Attempts    int
 }
 
 func (Injuredsegment) _Table() string { return "injuredsegments" }
 
+type Injuredsegment_Create_Fields struct {
+	LeasedUntil Injuredsegment_LeasedUntil_Field
+}
+
 type Injuredsegment_Update_Fields struct {
+	Data        Injuredsegment_Data_Field
+	Health      Injuredsegment_Health_Field
+	LeasedUntil Injuredsegment_LeasedUntil_Field
+	Attempts    Injuredsegment_Attempts_Field
 }
 
-type Injuredsegment_Id_Field struct {
+type Injuredsegment_Path_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Injuredsegment_Id(v int64) Injuredsegment_Id_Field {
-	return Injuredsegment_Id_Field{_set: true, _value: v}
+func Injuredsegment_Path(v string) Injuredsegment_Path_Field {
+	return Injuredsegment_Path_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Id_Field) value() interface{} {
+func (f Injuredsegment_Path_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Id_Field) _Column() string { return "id" }
+func (Injuredsegment_Path_Field) _Column() string { return "path" }
 
-type Injuredsegment_Info_Field struct {
+type Injuredsegment_Data_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Injuredsegment_Info(v []byte) Injuredsegment_Info_Field {
-	return Injuredsegment_Info_Field{_set: true, _value: v}
+func Injuredsegment_Data(v []byte) Injuredsegment_Data_Field {
+	return Injuredsegment_Data_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Data_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Data_Field) _Column() string { return "data" }
+
+type Injuredsegment_Health_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Injuredsegment_Health(v float64) Injuredsegment_Health_Field {
+	return Injuredsegment_Health_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Health_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Health_Field) _Column() string { return "health" }
+
+type Injuredsegment_LeasedUntil_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Injuredsegment_LeasedUntil(v time.Time) Injuredsegment_LeasedUntil_Field {
+	return Injuredsegment_LeasedUntil_Field{_set: true, _value: &v}
+}
+
+func Injuredsegment_LeasedUntil_Raw(v *time.Time) Injuredsegment_LeasedUntil_Field {
+	if v == nil {
+		return Injuredsegment_LeasedUntil_Null()
+	}
+	return Injuredsegment_LeasedUntil(*v)
+}
+
+func Injuredsegment_LeasedUntil_Null() Injuredsegment_LeasedUntil_Field {
+	return Injuredsegment_LeasedUntil_Field{_set: true, _null: true}
+}
+
+func (f Injuredsegment_LeasedUntil_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Injuredsegment_LeasedUntil_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_LeasedUntil_Field) _Column() string { return "leased_until" }
+
+type Injuredsegment_Attempts_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Injuredsegment_Attempts(v int) Injuredsegment_Attempts_Field {
+	return Injuredsegment_Attempts_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Info_Field) value() interface{} {
+func (f Injuredsegment_Attempts_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Info_Field) _Column() string { return "info" }
+func (Injuredsegment_Attempts_Field) _Column() string { return "attempts" }
 
 type Irreparabledb struct {
 	Segmentpath        []byte
@@ -2124,46 +2521,64 @@ func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
 func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
 
 type Node struct {
-	Id                 []byte
-	Address            string
-	Protocol           int
-	Type               int
-	Email              string
-	Wallet             string
-	FreeBandwidth      int64
-	FreeDisk           int64
-	Latency90          int64
-	AuditSuccessCount  int64
-	TotalAuditCount    int64
-	AuditSuccessRatio  float64
-	UptimeSuccessCount int64
-	TotalUptimeCount   int64
-	UptimeRatio        float64
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
-	LastContactSuccess time.Time
-	LastContactFailure time.Time
+	Id                    []byte
+	Address               string
+	Protocol              int
+	Type                  int
+	Email                 string
+	Wallet                string
+	FreeBandwidth         int64
+	FreeDisk              int64
+	Latency90             int64
+	AuditSuccessCount     int64
+	TotalAuditCount       int64
+	AuditSuccessRatio     float64
+	UptimeSuccessCount    int64
+	TotalUptimeCount      int64
+	UptimeRatio           float64
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	LastContactSuccess    time.Time
+	LastContactFailure    time.Time
+	LastNet               string
+	CountryCode           string
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+	Disqualified          *time.Time
 }
 
 func (Node) _Table() string { return "nodes" }
 
+type Node_Create_Fields struct {
+	Disqualified Node_Disqualified_Field
+}
+
 type Node_Update_Fields struct {
-	Address            Node_Address_Field
-	Protocol           Node_Protocol_Field
-	Type               Node_Type_Field
-	Email              Node_Email_Field
-	Wallet             Node_Wallet_Field
-	FreeBandwidth      Node_FreeBandwidth_Field
-	FreeDisk           Node_FreeDisk_Field
-	Latency90          Node_Latency90_Field
-	AuditSuccessCount  Node_AuditSuccessCount_Field
-	TotalAuditCount    Node_TotalAuditCount_Field
-	AuditSuccessRatio  Node_AuditSuccessRatio_Field
-	UptimeSuccessCount Node_UptimeSuccessCount_Field
-	TotalUptimeCount   Node_TotalUptimeCount_Field
-	UptimeRatio        Node_UptimeRatio_Field
-	LastContactSuccess Node_LastContactSuccess_Field
-	LastContactFailure Node_LastContactFailure_Field
+	Address               Node_Address_Field
+	Protocol              Node_Protocol_Field
+	Type                  Node_Type_Field
+	Email                 Node_Email_Field
+	Wallet                Node_Wallet_Field
+	FreeBandwidth         Node_FreeBandwidth_Field
+	FreeDisk              Node_FreeDisk_Field
+	Latency90             Node_Latency90_Field
+	AuditSuccessCount     Node_AuditSuccessCount_Field
+	TotalAuditCount       Node_TotalAuditCount_Field
+	AuditSuccessRatio     Node_AuditSuccessRatio_Field
+	UptimeSuccessCount    Node_UptimeSuccessCount_Field
+	TotalUptimeCount      Node_TotalUptimeCount_Field
+	UptimeRatio           Node_UptimeRatio_Field
+	LastContactSuccess    Node_LastContactSuccess_Field
+	LastContactFailure    Node_LastContactFailure_Field
+	LastNet               Node_LastNet_Field
+	CountryCode           Node_CountryCode_Field
+	AuditReputationAlpha  Node_AuditReputationAlpha_Field
+	AuditReputationBeta   Node_AuditReputationBeta_Field
+	UptimeReputationAlpha Node_UptimeReputationAlpha_Field
+	UptimeReputationBeta  Node_UptimeReputationBeta_Field
+	Disqualified          Node_Disqualified_Field
 }
 
 type Node_Id_Field struct {
@@ -2527,61 +2942,360 @@ func (f Node_LastContactFailure_Field) value() interface{} {
 
 func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
 
-type Project struct {
-	Id          []byte
-	Name        string
-	Description string
-	CreatedAt   time.Time
-}
-
-func (Project) _Table() string { return "projects" }
-
-type Project_Update_Fields struct {
-	Description Project_Description_Field
-}
-
-type Project_Id_Field struct {
+type Node_LastNet_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func Project_Id(v []byte) Project_Id_Field {
-	return Project_Id_Field{_set: true, _value: v}
+func Node_LastNet(v string) Node_LastNet_Field {
+	return Node_LastNet_Field{_set: true, _value: v}
 }
 
-func (f Project_Id_Field) value() interface{} {
+func (f Node_LastNet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Id_Field) _Column() string { return "id" }
+func (Node_LastNet_Field) _Column() string { return "last_net" }
 
-type Project_Name_Field struct {
+type Node_CountryCode_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Project_Name(v string) Project_Name_Field {
-	return Project_Name_Field{_set: true, _value: v}
+func Node_CountryCode(v string) Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _value: v}
 }
 
-func (f Project_Name_Field) value() interface{} {
+func (f Node_CountryCode_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Name_Field) _Column() string { return "name" }
+func (Node_CountryCode_Field) _Column() string { return "country_code" }
 
-type Project_Description_Field struct {
+type Node_AuditReputationAlpha_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value float64
+}
+
+func Node_AuditReputationAlpha(v float64) Node_AuditReputationAlpha_Field {
+	return Node_AuditReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+
+type Node_AuditReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditReputationBeta(v float64) Node_AuditReputationBeta_Field {
+	return Node_AuditReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+
+type Node_UptimeReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UptimeReputationAlpha(v float64) Node_UptimeReputationAlpha_Field {
+	return Node_UptimeReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeReputationAlpha_Field) _Column() string { return "uptime_reputation_alpha" }
+
+type Node_UptimeReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UptimeReputationBeta(v float64) Node_UptimeReputationBeta_Field {
+	return Node_UptimeReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeReputationBeta_Field) _Column() string { return "uptime_reputation_beta" }
+
+type Node_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_Disqualified(v time.Time) Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _value: &v}
+}
+
+func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
+	if v == nil {
+		return Node_Disqualified_Null()
+	}
+	return Node_Disqualified(*v)
+}
+
+func Node_Disqualified_Null() Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _null: true}
+}
+
+func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Disqualified_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+
+type PendingAudits struct {
+	NodeId            []byte
+	PieceId           []byte
+	StripeIndex       int64
+	ShareSize         int64
+	ExpectedShareHash []byte
+	ReverifyCount     int64
+	Path              string
+}
+
+func (PendingAudits) _Table() string { return "pending_audits" }
+
+type PendingAudits_Update_Fields struct {
+	ReverifyCount PendingAudits_ReverifyCount_Field
+}
+
+type PendingAudits_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudits_NodeId(v []byte) PendingAudits_NodeId_Field {
+	return PendingAudits_NodeId_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_NodeId_Field) _Column() string { return "node_id" }
+
+type PendingAudits_PieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudits_PieceId(v []byte) PendingAudits_PieceId_Field {
+	return PendingAudits_PieceId_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_PieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_PieceId_Field) _Column() string { return "piece_id" }
+
+type PendingAudits_StripeIndex_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PendingAudits_StripeIndex(v int64) PendingAudits_StripeIndex_Field {
+	return PendingAudits_StripeIndex_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_StripeIndex_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_StripeIndex_Field) _Column() string { return "stripe_index" }
+
+type PendingAudits_ShareSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PendingAudits_ShareSize(v int64) PendingAudits_ShareSize_Field {
+	return PendingAudits_ShareSize_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_ShareSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_ShareSize_Field) _Column() string { return "share_size" }
+
+type PendingAudits_ExpectedShareHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudits_ExpectedShareHash(v []byte) PendingAudits_ExpectedShareHash_Field {
+	return PendingAudits_ExpectedShareHash_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_ExpectedShareHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
+
+type PendingAudits_ReverifyCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PendingAudits_ReverifyCount(v int64) PendingAudits_ReverifyCount_Field {
+	return PendingAudits_ReverifyCount_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_ReverifyCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
+
+type PendingAudits_Path_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PendingAudits_Path(v string) PendingAudits_Path_Field {
+	return PendingAudits_Path_Field{_set: true, _value: v}
+}
+
+func (f PendingAudits_Path_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudits_Path_Field) _Column() string { return "path" }
+
+type Project struct {
+	Id             []byte
+	Name           string
+	Description    string
+	StorageLimit   int64
+	BandwidthLimit int64
+	CreatedAt      time.Time
+}
+
+func (Project) _Table() string { return "projects" }
+
+type Project_Update_Fields struct {
+	Description    Project_Description_Field
+	StorageLimit   Project_StorageLimit_Field
+	BandwidthLimit Project_BandwidthLimit_Field
+}
+
+type Project_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Project_Id(v []byte) Project_Id_Field {
+	return Project_Id_Field{_set: true, _value: v}
+}
+
+func (f Project_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_Id_Field) _Column() string { return "id" }
+
+type Project_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Project_Name(v string) Project_Name_Field {
+	return Project_Name_Field{_set: true, _value: v}
+}
+
+func (f Project_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_Name_Field) _Column() string { return "name" }
+
+type Project_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
 func Project_Description(v string) Project_Description_Field {
@@ -2597,6 +3311,44 @@ func (f Project_Description_Field) value() interface{} {
 
 func (Project_Description_Field) _Column() string { return "description" }
 
+type Project_StorageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Project_StorageLimit(v int64) Project_StorageLimit_Field {
+	return Project_StorageLimit_Field{_set: true, _value: v}
+}
+
+func (f Project_StorageLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_StorageLimit_Field) _Column() string { return "storage_limit" }
+
+type Project_BandwidthLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _value: v}
+}
+
+func (f Project_BandwidthLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+
 type Project_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
@@ -2722,6 +3474,75 @@ func (f RegistrationToken_CreatedAt_Field) value() interface{} {
 
 func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
 
+type ResetPasswordToken struct {
+	Secret    []byte
+	OwnerId   []byte
+	CreatedAt time.Time
+}
+
+func (ResetPasswordToken) _Table() string { return "reset_password_tokens" }
+
+type ResetPasswordToken_Update_Fields struct {
+	OwnerId ResetPasswordToken_OwnerId_Field
+}
+
+type ResetPasswordToken_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ResetPasswordToken_Secret(v []byte) ResetPasswordToken_Secret_Field {
+	return ResetPasswordToken_Secret_Field{_set: true, _value: v}
+}
+
+func (f ResetPasswordToken_Secret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ResetPasswordToken_Secret_Field) _Column() string { return "secret" }
+
+type ResetPasswordToken_OwnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ResetPasswordToken_OwnerId(v []byte) ResetPasswordToken_OwnerId_Field {
+	return ResetPasswordToken_OwnerId_Field{_set: true, _value: v}
+}
+
+func (f ResetPasswordToken_OwnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ResetPasswordToken_OwnerId_Field) _Column() string { return "owner_id" }
+
+type ResetPasswordToken_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ResetPasswordToken_CreatedAt(v time.Time) ResetPasswordToken_CreatedAt_Field {
+	return ResetPasswordToken_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ResetPasswordToken_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ResetPasswordToken_CreatedAt_Field) _Column() string { return "created_at" }
+
 type SerialNumber struct {
 	Id           int
 	SerialNumber []byte
@@ -2998,169 +3819,334 @@ type StoragenodeStorageTally_Total_Field struct {
 	_value uint64
 }
 
-func StoragenodeStorageTally_Total(v uint64) StoragenodeStorageTally_Total_Field {
-	return StoragenodeStorageTally_Total_Field{_set: true, _value: v}
+func StoragenodeStorageTally_Total(v uint64) StoragenodeStorageTally_Total_Field {
+	return StoragenodeStorageTally_Total_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeStorageTally_Total_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeStorageTally_Total_Field) _Column() string { return "total" }
+
+type User struct {
+	Id                []byte
+	FullName          string
+	ShortName         *string
+	Email             string
+	PasswordHash      []byte
+	Status            int
+	MfaEnabled        bool
+	MfaSecretKey      *string
+	MfaRecoveryCodes  *string
+	MfaFailedAttempts int
+	MfaLockedUntil    *time.Time
+	MfaLastStep       int64
+	CreatedAt         time.Time
+}
+
+func (User) _Table() string { return "users" }
+
+type User_Create_Fields struct {
+	ShortName        User_ShortName_Field
+	MfaSecretKey     User_MfaSecretKey_Field
+	MfaRecoveryCodes User_MfaRecoveryCodes_Field
+	MfaLockedUntil   User_MfaLockedUntil_Field
+}
+
+type User_Update_Fields struct {
+	FullName         User_FullName_Field
+	ShortName        User_ShortName_Field
+	Email            User_Email_Field
+	PasswordHash     User_PasswordHash_Field
+	Status           User_Status_Field
+	MfaEnabled       User_MfaEnabled_Field
+	MfaSecretKey     User_MfaSecretKey_Field
+	MfaRecoveryCodes User_MfaRecoveryCodes_Field
+}
+
+type User_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_Id(v []byte) User_Id_Field {
+	return User_Id_Field{_set: true, _value: v}
+}
+
+func (f User_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Id_Field) _Column() string { return "id" }
+
+type User_FullName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func User_FullName(v string) User_FullName_Field {
+	return User_FullName_Field{_set: true, _value: v}
+}
+
+func (f User_FullName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_FullName_Field) _Column() string { return "full_name" }
+
+type User_ShortName_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func User_ShortName(v string) User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _value: &v}
+}
+
+func User_ShortName_Raw(v *string) User_ShortName_Field {
+	if v == nil {
+		return User_ShortName_Null()
+	}
+	return User_ShortName(*v)
+}
+
+func User_ShortName_Null() User_ShortName_Field {
+	return User_ShortName_Field{_set: true, _null: true}
+}
+
+func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_ShortName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_ShortName_Field) _Column() string { return "short_name" }
+
+type User_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func User_Email(v string) User_Email_Field {
+	return User_Email_Field{_set: true, _value: v}
+}
+
+func (f User_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_Email_Field) _Column() string { return "email" }
+
+type User_PasswordHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func User_PasswordHash(v []byte) User_PasswordHash_Field {
+	return User_PasswordHash_Field{_set: true, _value: v}
+}
+
+func (f User_PasswordHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+
+type User_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func User_Status(v int) User_Status_Field {
+	return User_Status_Field{_set: true, _value: v}
 }
 
-func (f StoragenodeStorageTally_Total_Field) value() interface{} {
+func (f User_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StoragenodeStorageTally_Total_Field) _Column() string { return "total" }
-
-type User struct {
-	Id           []byte
-	FullName     string
-	ShortName    *string
-	Email        string
-	PasswordHash []byte
-	Status       int
-	CreatedAt    time.Time
-}
-
-func (User) _Table() string { return "users" }
-
-type User_Create_Fields struct {
-	ShortName User_ShortName_Field
-}
-
-type User_Update_Fields struct {
-	FullName     User_FullName_Field
-	ShortName    User_ShortName_Field
-	Email        User_Email_Field
-	PasswordHash User_PasswordHash_Field
-	Status       User_Status_Field
-}
+func (User_Status_Field) _Column() string { return "status" }
 
-type User_Id_Field struct {
+type User_MfaEnabled_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func User_Id(v []byte) User_Id_Field {
-	return User_Id_Field{_set: true, _value: v}
+func User_MfaEnabled(v bool) User_MfaEnabled_Field {
+	return User_MfaEnabled_Field{_set: true, _value: v}
 }
 
-func (f User_Id_Field) value() interface{} {
+func (f User_MfaEnabled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Id_Field) _Column() string { return "id" }
+func (User_MfaEnabled_Field) _Column() string { return "mfa_enabled" }
 
-type User_FullName_Field struct {
+type User_MfaSecretKey_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *string
 }
 
-func User_FullName(v string) User_FullName_Field {
-	return User_FullName_Field{_set: true, _value: v}
+func User_MfaSecretKey(v string) User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _value: &v}
 }
 
-func (f User_FullName_Field) value() interface{} {
+func User_MfaSecretKey_Raw(v *string) User_MfaSecretKey_Field {
+	if v == nil {
+		return User_MfaSecretKey_Null()
+	}
+	return User_MfaSecretKey(*v)
+}
+
+func User_MfaSecretKey_Null() User_MfaSecretKey_Field {
+	return User_MfaSecretKey_Field{_set: true, _null: true}
+}
+
+func (f User_MfaSecretKey_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_MfaSecretKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_FullName_Field) _Column() string { return "full_name" }
+func (User_MfaSecretKey_Field) _Column() string { return "mfa_secret_key" }
 
-type User_ShortName_Field struct {
+type User_MfaRecoveryCodes_Field struct {
 	_set   bool
 	_null  bool
 	_value *string
 }
 
-func User_ShortName(v string) User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _value: &v}
+func User_MfaRecoveryCodes(v string) User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _value: &v}
 }
 
-func User_ShortName_Raw(v *string) User_ShortName_Field {
+func User_MfaRecoveryCodes_Raw(v *string) User_MfaRecoveryCodes_Field {
 	if v == nil {
-		return User_ShortName_Null()
+		return User_MfaRecoveryCodes_Null()
 	}
-	return User_ShortName(*v)
+	return User_MfaRecoveryCodes(*v)
 }
 
-func User_ShortName_Null() User_ShortName_Field {
-	return User_ShortName_Field{_set: true, _null: true}
+func User_MfaRecoveryCodes_Null() User_MfaRecoveryCodes_Field {
+	return User_MfaRecoveryCodes_Field{_set: true, _null: true}
 }
 
-func (f User_ShortName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f User_MfaRecoveryCodes_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f User_ShortName_Field) value() interface{} {
+func (f User_MfaRecoveryCodes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_ShortName_Field) _Column() string { return "short_name" }
+func (User_MfaRecoveryCodes_Field) _Column() string { return "mfa_recovery_codes" }
 
-type User_Email_Field struct {
+type User_MfaFailedAttempts_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int
 }
 
-func User_Email(v string) User_Email_Field {
-	return User_Email_Field{_set: true, _value: v}
+func User_MfaFailedAttempts(v int) User_MfaFailedAttempts_Field {
+	return User_MfaFailedAttempts_Field{_set: true, _value: v}
 }
 
-func (f User_Email_Field) value() interface{} {
+func (f User_MfaFailedAttempts_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Email_Field) _Column() string { return "email" }
+func (User_MfaFailedAttempts_Field) _Column() string { return "mfa_failed_attempts" }
 
-type User_PasswordHash_Field struct {
+type User_MfaLockedUntil_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func User_PasswordHash(v []byte) User_PasswordHash_Field {
-	return User_PasswordHash_Field{_set: true, _value: v}
+func User_MfaLockedUntil(v time.Time) User_MfaLockedUntil_Field {
+	return User_MfaLockedUntil_Field{_set: true, _value: &v}
 }
 
-func (f User_PasswordHash_Field) value() interface{} {
+func User_MfaLockedUntil_Raw(v *time.Time) User_MfaLockedUntil_Field {
+	if v == nil {
+		return User_MfaLockedUntil_Null()
+	}
+	return User_MfaLockedUntil(*v)
+}
+
+func User_MfaLockedUntil_Null() User_MfaLockedUntil_Field {
+	return User_MfaLockedUntil_Field{_set: true, _null: true}
+}
+
+func (f User_MfaLockedUntil_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f User_MfaLockedUntil_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_PasswordHash_Field) _Column() string { return "password_hash" }
+func (User_MfaLockedUntil_Field) _Column() string { return "mfa_locked_until" }
 
-type User_Status_Field struct {
+type User_MfaLastStep_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value int64
 }
 
-func User_Status(v int) User_Status_Field {
-	return User_Status_Field{_set: true, _value: v}
+func User_MfaLastStep(v int64) User_MfaLastStep_Field {
+	return User_MfaLastStep_Field{_set: true, _value: v}
 }
 
-func (f User_Status_Field) value() interface{} {
+func (f User_MfaLastStep_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (User_Status_Field) _Column() string { return "status" }
+func (User_MfaLastStep_Field) _Column() string { return "mfa_last_step" }
 
 type User_CreatedAt_Field struct {
 	_set   bool
@@ -3185,6 +4171,7 @@ type ApiKey struct {
 	Id        []byte
 	ProjectId []byte
 	Key       []byte
+	Secret    []byte
 	Name      string
 	CreatedAt time.Time
 }
@@ -3252,6 +4239,25 @@ func (f ApiKey_Key_Field) value() interface{} {
 
 func (ApiKey_Key_Field) _Column() string { return "key" }
 
+type ApiKey_Secret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKey_Secret(v []byte) ApiKey_Secret_Field {
+	return ApiKey_Secret_Field{_set: true, _value: v}
+}
+
+func (f ApiKey_Secret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKey_Secret_Field) _Column() string { return "secret" }
+
 type ApiKey_Name_Field struct {
 	_set   bool
 	_null  bool
@@ -3290,15 +4296,85 @@ func (f ApiKey_CreatedAt_Field) value() interface{} {
 
 func (ApiKey_CreatedAt_Field) _Column() string { return "created_at" }
 
+type ApiKeyRevocation struct {
+	Tail      []byte
+	ApiKeyId  []byte
+	CreatedAt time.Time
+}
+
+func (ApiKeyRevocation) _Table() string { return "api_key_revocations" }
+
+type ApiKeyRevocation_Update_Fields struct {
+}
+
+type ApiKeyRevocation_Tail_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKeyRevocation_Tail(v []byte) ApiKeyRevocation_Tail_Field {
+	return ApiKeyRevocation_Tail_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyRevocation_Tail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyRevocation_Tail_Field) _Column() string { return "tail" }
+
+type ApiKeyRevocation_ApiKeyId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKeyRevocation_ApiKeyId(v []byte) ApiKeyRevocation_ApiKeyId_Field {
+	return ApiKeyRevocation_ApiKeyId_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyRevocation_ApiKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyRevocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
+
+type ApiKeyRevocation_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ApiKeyRevocation_CreatedAt(v time.Time) ApiKeyRevocation_CreatedAt_Field {
+	return ApiKeyRevocation_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyRevocation_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyRevocation_CreatedAt_Field) _Column() string { return "created_at" }
+
 type ProjectMember struct {
 	MemberId  []byte
 	ProjectId []byte
+	Role      int
 	CreatedAt time.Time
 }
 
 func (ProjectMember) _Table() string { return "project_members" }
 
 type ProjectMember_Update_Fields struct {
+	Role ProjectMember_Role_Field
 }
 
 type ProjectMember_MemberId_Field struct {
@@ -3339,6 +4415,25 @@ func (f ProjectMember_ProjectId_Field) value() interface{} {
 
 func (ProjectMember_ProjectId_Field) _Column() string { return "project_id" }
 
+type ProjectMember_Role_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func ProjectMember_Role(v int) ProjectMember_Role_Field {
+	return ProjectMember_Role_Field{_set: true, _value: v}
+}
+
+func (f ProjectMember_Role_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectMember_Role_Field) _Column() string { return "role" }
+
 type ProjectMember_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
@@ -3753,7 +4848,14 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
 	node_uptime_ratio Node_UptimeRatio_Field,
 	node_last_contact_success Node_LastContactSuccess_Field,
-	node_last_contact_failure Node_LastContactFailure_Field) (
+	node_last_contact_failure Node_LastContactFailure_Field,
+	node_last_net Node_LastNet_Field,
+	node_country_code Node_CountryCode_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	optional Node_Create_Fields) (
 	node *Node, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -3776,14 +4878,21 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	__updated_at_val := __now
 	__last_contact_success_val := node_last_contact_success.value()
 	__last_contact_failure_val := node_last_contact_failure.value()
+	__last_net_val := node_last_net.value()
+	__country_code_val := node_country_code.value()
+	__audit_reputation_alpha_val := node_audit_reputation_alpha.value()
+	__audit_reputation_beta_val := node_audit_reputation_beta.value()
+	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
+	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
+	__disqualified_val := optional.Disqualified.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, protocol, type, email, wallet, free_bandwidth, free_disk, latency_90, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at, last_contact_success, last_contact_failure ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, protocol, type, email, wallet, free_bandwidth, free_disk, latency_90, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at, last_contact_success, last_contact_failure, last_net, country_code, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, disqualified ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.last_net, nodes.country_code, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.disqualified")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val)
+	obj.logStmt(__stmt, __id_val, __address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __last_net_val, __country_code_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __disqualified_val)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure)
+	err = obj.driver.QueryRow(__stmt, __id_val, __address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __last_net_val, __country_code_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __disqualified_val).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.LastNet, &node.CountryCode, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.Disqualified)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -3791,25 +4900,6 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 
 }
 
-func (obj *postgresImpl) Create_Injuredsegment(ctx context.Context,
-	injuredsegment_info Injuredsegment_Info_Field) (
-	injuredsegment *Injuredsegment, err error) {
-	__info_val := injuredsegment_info.value()
-
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( info ) VALUES ( ? ) RETURNING injuredsegments.id, injuredsegments.info")
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __info_val)
-
-	injuredsegment = &Injuredsegment{}
-	err = obj.driver.QueryRow(__stmt, __info_val).Scan(&injuredsegment.Id, &injuredsegment.Info)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return injuredsegment, nil
-
-}
-
 func (obj *postgresImpl) Create_User(ctx context.Context,
 	user_id User_Id_Field,
 	user_full_name User_FullName_Field,
@@ -3825,15 +4915,21 @@ func (obj *postgresImpl) Create_User(ctx context.Context,
 	__email_val := user_email.value()
 	__password_hash_val := user_password_hash.value()
 	__status_val := int(0)
+	__mfa_enabled_val := false
+	__mfa_secret_key_val := optional.MfaSecretKey.value()
+	__mfa_recovery_codes_val := optional.MfaRecoveryCodes.value()
+	__mfa_failed_attempts_val := int(0)
+	__mfa_locked_until_val := optional.MfaLockedUntil.value()
+	__mfa_last_step_val := int64(0)
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, full_name, short_name, email, password_hash, status, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, full_name, short_name, email, password_hash, status, mfa_enabled, mfa_secret_key, mfa_recovery_codes, mfa_failed_attempts, mfa_locked_until, mfa_last_step, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_failed_attempts, users.mfa_locked_until, users.mfa_last_step, users.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __mfa_failed_attempts_val, __mfa_locked_until_val, __mfa_last_step_val, __created_at_val)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __created_at_val).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __mfa_failed_attempts_val, __mfa_locked_until_val, __mfa_last_step_val, __created_at_val).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaFailedAttempts, &user.MfaLockedUntil, &user.MfaLastStep, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -3844,22 +4940,26 @@ func (obj *postgresImpl) Create_User(ctx context.Context,
 func (obj *postgresImpl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
-	project_description Project_Description_Field) (
+	project_description Project_Description_Field,
+	project_storage_limit Project_StorageLimit_Field,
+	project_bandwidth_limit Project_BandwidthLimit_Field) (
 	project *Project, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__id_val := project_id.value()
 	__name_val := project_name.value()
 	__description_val := project_description.value()
+	__storage_limit_val := project_storage_limit.value()
+	__bandwidth_limit_val := project_bandwidth_limit.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, storage_limit, bandwidth_limit, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.storage_limit, projects.bandwidth_limit, projects.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __storage_limit_val, __bandwidth_limit_val, __created_at_val)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __storage_limit_val, __bandwidth_limit_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.BandwidthLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -3869,21 +4969,23 @@ func (obj *postgresImpl) Create_Project(ctx context.Context,
 
 func (obj *postgresImpl) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	project_member_role ProjectMember_Role_Field) (
 	project_member *ProjectMember, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__member_id_val := project_member_member_id.value()
 	__project_id_val := project_member_project_id.value()
+	__role_val := project_member_role.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, role, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.role, project_members.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	obj.logStmt(__stmt, __member_id_val, __project_id_val, __role_val, __created_at_val)
 
 	project_member = &ProjectMember{}
-	err = obj.driver.QueryRow(__stmt, __member_id_val, __project_id_val, __created_at_val).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __member_id_val, __project_id_val, __role_val, __created_at_val).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -3895,6 +4997,7 @@ func (obj *postgresImpl) Create_ApiKey(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	api_key_project_id ApiKey_ProjectId_Field,
 	api_key_key ApiKey_Key_Field,
+	api_key_secret ApiKey_Secret_Field,
 	api_key_name ApiKey_Name_Field) (
 	api_key *ApiKey, err error) {
 
@@ -3902,16 +5005,17 @@ func (obj *postgresImpl) Create_ApiKey(ctx context.Context,
 	__id_val := api_key_id.value()
 	__project_id_val := api_key_project_id.value()
 	__key_val := api_key_key.value()
+	__secret_val := api_key_secret.value()
 	__name_val := api_key_name.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, name, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, secret, name, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.secret, api_keys.name, api_keys.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __secret_val, __name_val, __created_at_val)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __key_val, __secret_val, __name_val, __created_at_val).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Secret, &api_key.Name, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4025,67 +5129,198 @@ func (obj *postgresImpl) Create_BucketStorageTally(ctx context.Context,
 	__object_count_val := bucket_storage_tally_object_count.value()
 	__metadata_size_val := bucket_storage_tally_metadata_size.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_storage_tallies ( bucket_name, project_id, interval_start, inline, remote, remote_segments_count, inline_segments_count, object_count, metadata_size ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_storage_tallies ( bucket_name, project_id, interval_start, inline, remote, remote_segments_count, inline_segments_count, object_count, metadata_size ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __bucket_name_val, __project_id_val, __interval_start_val, __inline_val, __remote_val, __remote_segments_count_val, __inline_segments_count_val, __object_count_val, __metadata_size_val)
+
+	bucket_storage_tally = &BucketStorageTally{}
+	err = obj.driver.QueryRow(__stmt, __bucket_name_val, __project_id_val, __interval_start_val, __inline_val, __remote_val, __remote_segments_count_val, __inline_segments_count_val, __object_count_val, __metadata_size_val).Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_storage_tally, nil
+
+}
+
+func (obj *postgresImpl) Create_CertRecord(ctx context.Context,
+	certRecord_publickey CertRecord_Publickey_Field,
+	certRecord_id CertRecord_Id_Field) (
+	certRecord *CertRecord, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__publickey_val := certRecord_publickey.value()
+	__id_val := certRecord_id.value()
+	__update_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO certRecords ( publickey, id, update_at ) VALUES ( ?, ?, ? ) RETURNING certRecords.publickey, certRecords.id, certRecords.update_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __publickey_val, __id_val, __update_at_val)
+
+	certRecord = &CertRecord{}
+	err = obj.driver.QueryRow(__stmt, __publickey_val, __id_val, __update_at_val).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return certRecord, nil
+
+}
+
+func (obj *postgresImpl) Create_RegistrationToken(ctx context.Context,
+	registration_token_secret RegistrationToken_Secret_Field,
+	registration_token_project_limit RegistrationToken_ProjectLimit_Field,
+	optional RegistrationToken_Create_Fields) (
+	registration_token *RegistrationToken, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__secret_val := registration_token_secret.value()
+	__owner_id_val := optional.OwnerId.value()
+	__project_limit_val := registration_token_project_limit.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO registration_tokens ( secret, owner_id, project_limit, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+
+	registration_token = &RegistrationToken{}
+	err = obj.driver.QueryRow(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return registration_token, nil
+
+}
+
+func (obj *postgresImpl) Create_BucketLifecycle(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	bucket_lifecycle_rules BucketLifecycle_Rules_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_lifecycle_project_id.value()
+	__bucket_name_val := bucket_lifecycle_bucket_name.value()
+	__rules_val := bucket_lifecycle_rules.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_lifecycles ( project_id, bucket_name, rules, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.created_at, bucket_lifecycles.updated_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __rules_val, __created_at_val, __updated_at_val)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __bucket_name_val, __rules_val, __created_at_val, __updated_at_val).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.CreatedAt, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *postgresImpl) Create_BucketPlacement(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field,
+	bucket_placement_countries BucketPlacement_Countries_Field) (
+	bucket_placement *BucketPlacement, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__bucket_id_val := bucket_placement_bucket_id.value()
+	__countries_val := bucket_placement_countries.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_placements ( bucket_id, countries, created_at, updated_at ) VALUES ( ?, ?, ?, ? ) RETURNING bucket_placements.bucket_id, bucket_placements.countries, bucket_placements.created_at, bucket_placements.updated_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __bucket_id_val, __countries_val, __created_at_val, __updated_at_val)
+
+	bucket_placement = &BucketPlacement{}
+	err = obj.driver.QueryRow(__stmt, __bucket_id_val, __countries_val, __created_at_val, __updated_at_val).Scan(&bucket_placement.BucketId, &bucket_placement.Countries, &bucket_placement.CreatedAt, &bucket_placement.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_placement, nil
+
+}
+
+func (obj *postgresImpl) Create_ApiKeyRevocation(ctx context.Context,
+	api_key_revocation_tail ApiKeyRevocation_Tail_Field,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+	api_key_revocation *ApiKeyRevocation, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__tail_val := api_key_revocation_tail.value()
+	__api_key_id_val := api_key_revocation_api_key_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_key_revocations ( tail, api_key_id, created_at ) VALUES ( ?, ?, ? ) RETURNING api_key_revocations.tail, api_key_revocations.api_key_id, api_key_revocations.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __bucket_name_val, __project_id_val, __interval_start_val, __inline_val, __remote_val, __remote_segments_count_val, __inline_segments_count_val, __object_count_val, __metadata_size_val)
+	obj.logStmt(__stmt, __tail_val, __api_key_id_val, __created_at_val)
 
-	bucket_storage_tally = &BucketStorageTally{}
-	err = obj.driver.QueryRow(__stmt, __bucket_name_val, __project_id_val, __interval_start_val, __inline_val, __remote_val, __remote_segments_count_val, __inline_segments_count_val, __object_count_val, __metadata_size_val).Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize)
+	api_key_revocation = &ApiKeyRevocation{}
+	err = obj.driver.QueryRow(__stmt, __tail_val, __api_key_id_val, __created_at_val).Scan(&api_key_revocation.Tail, &api_key_revocation.ApiKeyId, &api_key_revocation.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return bucket_storage_tally, nil
+	return api_key_revocation, nil
 
 }
 
-func (obj *postgresImpl) Create_CertRecord(ctx context.Context,
-	certRecord_publickey CertRecord_Publickey_Field,
-	certRecord_id CertRecord_Id_Field) (
-	certRecord *CertRecord, err error) {
-
-	__now := obj.db.Hooks.Now().UTC()
-	__publickey_val := certRecord_publickey.value()
-	__id_val := certRecord_id.value()
-	__update_at_val := __now
+func (obj *postgresImpl) Create_PendingAudits(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field,
+	pending_audits_piece_id PendingAudits_PieceId_Field,
+	pending_audits_stripe_index PendingAudits_StripeIndex_Field,
+	pending_audits_share_size PendingAudits_ShareSize_Field,
+	pending_audits_expected_share_hash PendingAudits_ExpectedShareHash_Field,
+	pending_audits_reverify_count PendingAudits_ReverifyCount_Field,
+	pending_audits_path PendingAudits_Path_Field) (
+	pending_audits *PendingAudits, err error) {
+	__node_id_val := pending_audits_node_id.value()
+	__piece_id_val := pending_audits_piece_id.value()
+	__stripe_index_val := pending_audits_stripe_index.value()
+	__share_size_val := pending_audits_share_size.value()
+	__expected_share_hash_val := pending_audits_expected_share_hash.value()
+	__reverify_count_val := pending_audits_reverify_count.value()
+	__path_val := pending_audits_path.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO certRecords ( publickey, id, update_at ) VALUES ( ?, ?, ? ) RETURNING certRecords.publickey, certRecords.id, certRecords.update_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO pending_audits ( node_id, piece_id, stripe_index, share_size, expected_share_hash, reverify_count, path ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.path")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __publickey_val, __id_val, __update_at_val)
+	obj.logStmt(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __path_val)
 
-	certRecord = &CertRecord{}
-	err = obj.driver.QueryRow(__stmt, __publickey_val, __id_val, __update_at_val).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	pending_audits = &PendingAudits{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __path_val).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.Path)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return certRecord, nil
+	return pending_audits, nil
 
 }
 
-func (obj *postgresImpl) Create_RegistrationToken(ctx context.Context,
-	registration_token_secret RegistrationToken_Secret_Field,
-	registration_token_project_limit RegistrationToken_ProjectLimit_Field,
-	optional RegistrationToken_Create_Fields) (
-	registration_token *RegistrationToken, err error) {
+func (obj *postgresImpl) Create_ResetPasswordToken(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field,
+	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__secret_val := registration_token_secret.value()
-	__owner_id_val := optional.OwnerId.value()
-	__project_limit_val := registration_token_project_limit.value()
+	__secret_val := reset_password_token_secret.value()
+	__owner_id_val := reset_password_token_owner_id.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO registration_tokens ( secret, owner_id, project_limit, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO reset_password_tokens ( secret, owner_id, created_at ) VALUES ( ?, ?, ? ) RETURNING reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+	obj.logStmt(__stmt, __secret_val, __owner_id_val, __created_at_val)
 
-	registration_token = &RegistrationToken{}
-	err = obj.driver.QueryRow(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	reset_password_token = &ResetPasswordToken{}
+	err = obj.driver.QueryRow(__stmt, __secret_val, __owner_id_val, __created_at_val).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return registration_token, nil
+	return reset_password_token, nil
 
 }
 
@@ -4313,7 +5548,7 @@ func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.last_net, nodes.country_code, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.disqualified FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -4322,7 +5557,7 @@ func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.LastNet, &node.CountryCode, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.Disqualified)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4367,7 +5602,7 @@ func (obj *postgresImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx co
 	limit int, offset int64) (
 	rows []*Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.last_net, nodes.country_code, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.disqualified FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id_greater_or_equal.value())
@@ -4385,7 +5620,7 @@ func (obj *postgresImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx co
 
 	for __rows.Next() {
 		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure)
+		err = __rows.Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.LastNet, &node.CountryCode, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.Disqualified)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -4398,80 +5633,11 @@ func (obj *postgresImpl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx co
 
 }
 
-func (obj *postgresImpl) First_Injuredsegment(ctx context.Context) (
-	injuredsegment *Injuredsegment, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT 1 OFFSET 0")
-
-	var __values []interface{}
-	__values = append(__values)
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
-
-	if !__rows.Next() {
-		if err := __rows.Err(); err != nil {
-			return nil, obj.makeErr(err)
-		}
-		return nil, nil
-	}
-
-	injuredsegment = &Injuredsegment{}
-	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-
-	return injuredsegment, nil
-
-}
-
-func (obj *postgresImpl) Limited_Injuredsegment(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Injuredsegment, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT ? OFFSET ?")
-
-	var __values []interface{}
-	__values = append(__values)
-
-	__values = append(__values, limit, offset)
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
-
-	for __rows.Next() {
-		injuredsegment := &Injuredsegment{}
-		err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, injuredsegment)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
-
-}
-
 func (obj *postgresImpl) Get_User_By_Email_And_Status_Not_Number(ctx context.Context,
 	user_email User_Email_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_failed_attempts, users.mfa_locked_until, users.mfa_last_step, users.created_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_email.value())
@@ -4493,7 +5659,7 @@ func (obj *postgresImpl) Get_User_By_Email_And_Status_Not_Number(ctx context.Con
 	}
 
 	user = &User{}
-	err = __rows.Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = __rows.Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaFailedAttempts, &user.MfaLockedUntil, &user.MfaLastStep, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4514,7 +5680,7 @@ func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
 	user_id User_Id_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_failed_attempts, users.mfa_locked_until, users.mfa_last_step, users.created_at FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -4523,7 +5689,7 @@ func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaFailedAttempts, &user.MfaLockedUntil, &user.MfaLastStep, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4534,7 +5700,7 @@ func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
 func (obj *postgresImpl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.bandwidth_limit, projects.created_at FROM projects")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -4550,7 +5716,7 @@ func (obj *postgresImpl) All_Project(ctx context.Context) (
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.BandwidthLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -4567,7 +5733,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.bandwidth_limit, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -4576,7 +5742,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.BandwidthLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4588,7 +5754,7 @@ func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Proje
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.bandwidth_limit, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -4604,7 +5770,7 @@ func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Proje
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.BandwidthLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -4621,7 +5787,7 @@ func (obj *postgresImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -4637,7 +5803,7 @@ func (obj *postgresImpl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -4655,7 +5821,7 @@ func (obj *postgresImpl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 	limit int, offset int64) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_project_id.value())
@@ -4673,7 +5839,7 @@ func (obj *postgresImpl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -4690,7 +5856,7 @@ func (obj *postgresImpl) Get_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field) (
 	api_key *ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.secret, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_id.value())
@@ -4699,7 +5865,7 @@ func (obj *postgresImpl) Get_ApiKey_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Secret, &api_key.Name, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4711,7 +5877,7 @@ func (obj *postgresImpl) Get_ApiKey_By_Key(ctx context.Context,
 	api_key_key ApiKey_Key_Field) (
 	api_key *ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.secret, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_key.value())
@@ -4720,7 +5886,7 @@ func (obj *postgresImpl) Get_ApiKey_By_Key(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Secret, &api_key.Name, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4732,7 +5898,7 @@ func (obj *postgresImpl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Co
 	api_key_project_id ApiKey_ProjectId_Field) (
 	rows []*ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.secret, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
 
 	var __values []interface{}
 	__values = append(__values, api_key_project_id.value())
@@ -4748,7 +5914,7 @@ func (obj *postgresImpl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Co
 
 	for __rows.Next() {
 		api_key := &ApiKey{}
-		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Secret, &api_key.Name, &api_key.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5104,27 +6270,233 @@ func (obj *postgresImpl) Get_RegistrationToken_By_OwnerId(ctx context.Context,
 	registration_token_owner_id RegistrationToken_OwnerId_Field) (
 	registration_token *RegistrationToken, err error) {
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "registration_tokens.owner_id", Equal: true, Right: "?", Null: true}
+	var __cond_0 = &__sqlbundle_Condition{Left: "registration_tokens.owner_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE "), __cond_0}}
+
+	var __values []interface{}
+	__values = append(__values)
+
+	if !registration_token_owner_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, registration_token_owner_id.value())
+	}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	registration_token = &RegistrationToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return registration_token, nil
+
+}
+
+func (obj *postgresImpl) Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.created_at, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.CreatedAt, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *postgresImpl) All_BucketLifecycle(ctx context.Context) (
+	rows []*BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.created_at, bucket_lifecycles.updated_at FROM bucket_lifecycles")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_lifecycle := &BucketLifecycle{}
+		err = __rows.Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.CreatedAt, &bucket_lifecycle.UpdatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_lifecycle)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_BucketPlacement_By_BucketId(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field) (
+	bucket_placement *BucketPlacement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placements.bucket_id, bucket_placements.countries, bucket_placements.created_at, bucket_placements.updated_at FROM bucket_placements WHERE bucket_placements.bucket_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_placement_bucket_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_placement = &BucketPlacement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_placement.BucketId, &bucket_placement.Countries, &bucket_placement.CreatedAt, &bucket_placement.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_placement, nil
+
+}
+
+func (obj *postgresImpl) All_ApiKeyRevocation_By_ApiKeyId(ctx context.Context,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+	rows []*ApiKeyRevocation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_key_revocations.tail, api_key_revocations.api_key_id, api_key_revocations.created_at FROM api_key_revocations WHERE api_key_revocations.api_key_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_revocation_api_key_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		api_key_revocation := &ApiKeyRevocation{}
+		err = __rows.Scan(&api_key_revocation.Tail, &api_key_revocation.ApiKeyId, &api_key_revocation.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, api_key_revocation)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Limited_Injuredsegment_OrderBy_Asc_Health(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Injuredsegment, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.path, injuredsegments.data, injuredsegments.health, injuredsegments.leased_until, injuredsegments.attempts FROM injuredsegments ORDER BY injuredsegments.health LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		injuredsegment := &Injuredsegment{}
+		err = __rows.Scan(&injuredsegment.Path, &injuredsegment.Data, &injuredsegment.Health, &injuredsegment.LeasedUntil, &injuredsegment.Attempts)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, injuredsegment)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field) (
+	pending_audits *PendingAudits, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.path FROM pending_audits WHERE pending_audits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_audits_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_audits = &PendingAudits{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.Path)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_audits, nil
+
+}
+
+func (obj *postgresImpl) Get_ResetPasswordToken_By_Secret(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
+
+	var __values []interface{}
+	__values = append(__values, reset_password_token_secret.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	reset_password_token = &ResetPasswordToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return reset_password_token, nil
+
+}
+
+func (obj *postgresImpl) Get_ResetPasswordToken_By_OwnerId(ctx context.Context,
+	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE "), __cond_0}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at FROM reset_password_tokens WHERE reset_password_tokens.owner_id = ?")
 
 	var __values []interface{}
-	__values = append(__values)
-
-	if !registration_token_owner_id.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, registration_token_owner_id.value())
-	}
+	__values = append(__values, reset_password_token_owner_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	registration_token = &RegistrationToken{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	reset_password_token = &ResetPasswordToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return registration_token, nil
+	return reset_password_token, nil
 
 }
 
@@ -5229,7 +6601,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.last_net, nodes.country_code, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.disqualified")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -5315,6 +6687,41 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
 	}
 
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -5329,7 +6736,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.LastNet, &node.CountryCode, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.Disqualified)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -5345,7 +6752,7 @@ func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
 	user *User, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE users SET "), __sets, __sqlbundle_Literal(" WHERE users.id = ? RETURNING users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_failed_attempts, users.mfa_locked_until, users.mfa_last_step, users.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -5376,6 +6783,21 @@ func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
 
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
+
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
+	}
+
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -5389,7 +6811,7 @@ func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaFailedAttempts, &user.MfaLockedUntil, &user.MfaLastStep, &user.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -5405,7 +6827,7 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	project *Project, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.storage_limit, projects.bandwidth_limit, projects.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -5416,6 +6838,16 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("description = ?"))
 	}
 
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -5429,7 +6861,7 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.BandwidthLimit, &project.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -5445,7 +6877,7 @@ func (obj *postgresImpl) Update_ApiKey_By_Id(ctx context.Context,
 	api_key *ApiKey, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE api_keys SET "), __sets, __sqlbundle_Literal(" WHERE api_keys.id = ? RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE api_keys SET "), __sets, __sqlbundle_Literal(" WHERE api_keys.id = ? RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.secret, api_keys.name, api_keys.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -5469,7 +6901,7 @@ func (obj *postgresImpl) Update_ApiKey_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Secret, &api_key.Name, &api_key.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -5555,14 +6987,282 @@ func (obj *postgresImpl) Update_RegistrationToken_By_Secret(ctx context.Context,
 	return registration_token, nil
 }
 
+func (obj *postgresImpl) Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	update BucketLifecycle_Update_Fields) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_lifecycles SET "), __sets, __sqlbundle_Literal(" WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ? RETURNING bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.created_at, bucket_lifecycles.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Rules._set {
+		__values = append(__values, update.Rules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("rules = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.CreatedAt, &bucket_lifecycle.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+}
+
+func (obj *postgresImpl) Update_BucketPlacement_By_BucketId(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field,
+	update BucketPlacement_Update_Fields) (
+	bucket_placement *BucketPlacement, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_placements SET "), __sets, __sqlbundle_Literal(" WHERE bucket_placements.bucket_id = ? RETURNING bucket_placements.bucket_id, bucket_placements.countries, bucket_placements.created_at, bucket_placements.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Countries._set {
+		__values = append(__values, update.Countries.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("countries = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, bucket_placement_bucket_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_placement = &BucketPlacement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_placement.BucketId, &bucket_placement.Countries, &bucket_placement.CreatedAt, &bucket_placement.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_placement, nil
+}
+
+func (obj *postgresImpl) Update_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field,
+	update PendingAudits_Update_Fields) (
+	pending_audits *PendingAudits, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE pending_audits SET "), __sets, __sqlbundle_Literal(" WHERE pending_audits.node_id = ? RETURNING pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.path")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.ReverifyCount._set {
+		__values = append(__values, update.ReverifyCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("reverify_count = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, pending_audits_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_audits = &PendingAudits{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.Path)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_audits, nil
+}
+
+func (obj *postgresImpl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_members SET "), __sets, __sqlbundle_Literal(" WHERE project_members.member_id = ? AND project_members.project_id = ? RETURNING project_members.member_id, project_members.project_id, project_members.role, project_members.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_member_member_id.value(), project_member_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member = &ProjectMember{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+}
+
 func (obj *postgresImpl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM irreparabledbs WHERE irreparabledbs.segmentpath = ?")
+
+	var __values []interface{}
+	__values = append(__values, irreparabledb_segmentpath.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_AccountingRollup_By_Id(ctx context.Context,
+	accounting_rollup_id AccountingRollup_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_rollups WHERE accounting_rollups.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_rollup_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_AccountingRaw_By_Id(ctx context.Context,
+	accounting_raw_id AccountingRaw_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_raws WHERE accounting_raws.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_raw_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_User_By_Id(ctx context.Context,
+	user_id User_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, irreparabledb_segmentpath.value())
+	__values = append(__values, user_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5581,14 +7281,14 @@ func (obj *postgresImpl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context
 
 }
 
-func (obj *postgresImpl) Delete_AccountingRollup_By_Id(ctx context.Context,
-	accounting_rollup_id AccountingRollup_Id_Field) (
+func (obj *postgresImpl) Delete_Project_By_Id(ctx context.Context,
+	project_id Project_Id_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_rollups WHERE accounting_rollups.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_rollup_id.value())
+	__values = append(__values, project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5607,14 +7307,15 @@ func (obj *postgresImpl) Delete_AccountingRollup_By_Id(ctx context.Context,
 
 }
 
-func (obj *postgresImpl) Delete_AccountingRaw_By_Id(ctx context.Context,
-	accounting_raw_id AccountingRaw_Id_Field) (
+func (obj *postgresImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM accounting_raws WHERE accounting_raws.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, accounting_raw_id.value())
+	__values = append(__values, project_member_member_id.value(), project_member_project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5633,14 +7334,14 @@ func (obj *postgresImpl) Delete_AccountingRaw_By_Id(ctx context.Context,
 
 }
 
-func (obj *postgresImpl) Delete_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
+func (obj *postgresImpl) Delete_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, node_id.value())
+	__values = append(__values, api_key_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5659,14 +7360,14 @@ func (obj *postgresImpl) Delete_Node_By_Id(ctx context.Context,
 
 }
 
-func (obj *postgresImpl) Delete_Injuredsegment_By_Id(ctx context.Context,
-	injuredsegment_id Injuredsegment_Id_Field) (
+func (obj *postgresImpl) Delete_BucketUsage_By_Id(ctx context.Context,
+	bucket_usage_id BucketUsage_Id_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_usages WHERE bucket_usages.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, injuredsegment_id.value())
+	__values = append(__values, bucket_usage_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5685,40 +7386,40 @@ func (obj *postgresImpl) Delete_Injuredsegment_By_Id(ctx context.Context,
 
 }
 
-func (obj *postgresImpl) Delete_User_By_Id(ctx context.Context,
-	user_id User_Id_Field) (
-	deleted bool, err error) {
+func (obj *postgresImpl) Delete_SerialNumber_By_ExpiresAt_LessOrEqual(ctx context.Context,
+	serial_number_expires_at_less_or_equal SerialNumber_ExpiresAt_Field) (
+	count int64, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM serial_numbers WHERE serial_numbers.expires_at <= ?")
 
 	var __values []interface{}
-	__values = append(__values, user_id.value())
+	__values = append(__values, serial_number_expires_at_less_or_equal.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	return count, nil
 
 }
 
-func (obj *postgresImpl) Delete_Project_By_Id(ctx context.Context,
-	project_id Project_Id_Field) (
+func (obj *postgresImpl) Delete_CertRecord_By_Id(ctx context.Context,
+	certRecord_id CertRecord_Id_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM certRecords WHERE certRecords.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_id.value())
+	__values = append(__values, certRecord_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5737,15 +7438,15 @@ func (obj *postgresImpl) Delete_Project_By_Id(ctx context.Context,
 
 }
 
-func (obj *postgresImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+func (obj *postgresImpl) Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value(), project_member_project_id.value())
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5764,14 +7465,14 @@ func (obj *postgresImpl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx cont
 
 }
 
-func (obj *postgresImpl) Delete_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
+func (obj *postgresImpl) Delete_BucketPlacement_By_BucketId(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_placements WHERE bucket_placements.bucket_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, api_key_id.value())
+	__values = append(__values, bucket_placement_bucket_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5790,14 +7491,14 @@ func (obj *postgresImpl) Delete_ApiKey_By_Id(ctx context.Context,
 
 }
 
-func (obj *postgresImpl) Delete_BucketUsage_By_Id(ctx context.Context,
-	bucket_usage_id BucketUsage_Id_Field) (
+func (obj *postgresImpl) Delete_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_usages WHERE bucket_usages.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.path = ?")
 
 	var __values []interface{}
-	__values = append(__values, bucket_usage_id.value())
+	__values = append(__values, injuredsegment_path.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5816,40 +7517,40 @@ func (obj *postgresImpl) Delete_BucketUsage_By_Id(ctx context.Context,
 
 }
 
-func (obj *postgresImpl) Delete_SerialNumber_By_ExpiresAt_LessOrEqual(ctx context.Context,
-	serial_number_expires_at_less_or_equal SerialNumber_ExpiresAt_Field) (
-	count int64, err error) {
+func (obj *postgresImpl) Delete_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field) (
+	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM serial_numbers WHERE serial_numbers.expires_at <= ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM pending_audits WHERE pending_audits.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, serial_number_expires_at_less_or_equal.value())
+	__values = append(__values, pending_audits_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	__res, err := obj.driver.Exec(__stmt, __values...)
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
 
-	count, err = __res.RowsAffected()
+	__count, err := __res.RowsAffected()
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return false, obj.makeErr(err)
 	}
 
-	return count, nil
+	return __count > 0, nil
 
 }
 
-func (obj *postgresImpl) Delete_CertRecord_By_Id(ctx context.Context,
-	certRecord_id CertRecord_Id_Field) (
+func (obj *postgresImpl) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM certRecords WHERE certRecords.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
 
 	var __values []interface{}
-	__values = append(__values, certRecord_id.value())
+	__values = append(__values, reset_password_token_secret.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5896,6 +7597,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_key_revocations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -5946,6 +7657,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM reset_password_tokens;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -5966,6 +7687,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM pending_audits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -6036,6 +7767,26 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_placements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_lifecycles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -6223,7 +7974,14 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
 	node_uptime_ratio Node_UptimeRatio_Field,
 	node_last_contact_success Node_LastContactSuccess_Field,
-	node_last_contact_failure Node_LastContactFailure_Field) (
+	node_last_contact_failure Node_LastContactFailure_Field,
+	node_last_net Node_LastNet_Field,
+	node_country_code Node_CountryCode_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	optional Node_Create_Fields) (
 	node *Node, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -6246,13 +8004,20 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	__updated_at_val := __now
 	__last_contact_success_val := node_last_contact_success.value()
 	__last_contact_failure_val := node_last_contact_failure.value()
+	__last_net_val := node_last_net.value()
+	__country_code_val := node_country_code.value()
+	__audit_reputation_alpha_val := node_audit_reputation_alpha.value()
+	__audit_reputation_beta_val := node_audit_reputation_beta.value()
+	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
+	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
+	__disqualified_val := optional.Disqualified.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, protocol, type, email, wallet, free_bandwidth, free_disk, latency_90, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at, last_contact_success, last_contact_failure ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, address, protocol, type, email, wallet, free_bandwidth, free_disk, latency_90, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at, last_contact_success, last_contact_failure, last_net, country_code, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, disqualified ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val)
+	obj.logStmt(__stmt, __id_val, __address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __last_net_val, __country_code_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __disqualified_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __address_val, __protocol_val, __type_val, __email_val, __wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val, __last_contact_success_val, __last_contact_failure_val, __last_net_val, __country_code_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __disqualified_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6264,28 +8029,6 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Create_Injuredsegment(ctx context.Context,
-	injuredsegment_info Injuredsegment_Info_Field) (
-	injuredsegment *Injuredsegment, err error) {
-	__info_val := injuredsegment_info.value()
-
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( info ) VALUES ( ? )")
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __info_val)
-
-	__res, err := obj.driver.Exec(__stmt, __info_val)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	__pk, err := __res.LastInsertId()
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return obj.getLastInjuredsegment(ctx, __pk)
-
-}
-
 func (obj *sqlite3Impl) Create_User(ctx context.Context,
 	user_id User_Id_Field,
 	user_full_name User_FullName_Field,
@@ -6301,14 +8044,20 @@ func (obj *sqlite3Impl) Create_User(ctx context.Context,
 	__email_val := user_email.value()
 	__password_hash_val := user_password_hash.value()
 	__status_val := int(0)
+	__mfa_enabled_val := false
+	__mfa_secret_key_val := optional.MfaSecretKey.value()
+	__mfa_recovery_codes_val := optional.MfaRecoveryCodes.value()
+	__mfa_failed_attempts_val := int(0)
+	__mfa_locked_until_val := optional.MfaLockedUntil.value()
+	__mfa_last_step_val := int64(0)
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, full_name, short_name, email, password_hash, status, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO users ( id, full_name, short_name, email, password_hash, status, mfa_enabled, mfa_secret_key, mfa_recovery_codes, mfa_failed_attempts, mfa_locked_until, mfa_last_step, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __mfa_failed_attempts_val, __mfa_locked_until_val, __mfa_last_step_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __full_name_val, __short_name_val, __email_val, __password_hash_val, __status_val, __mfa_enabled_val, __mfa_secret_key_val, __mfa_recovery_codes_val, __mfa_failed_attempts_val, __mfa_locked_until_val, __mfa_last_step_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6323,21 +8072,25 @@ func (obj *sqlite3Impl) Create_User(ctx context.Context,
 func (obj *sqlite3Impl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
-	project_description Project_Description_Field) (
+	project_description Project_Description_Field,
+	project_storage_limit Project_StorageLimit_Field,
+	project_bandwidth_limit Project_BandwidthLimit_Field) (
 	project *Project, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__id_val := project_id.value()
 	__name_val := project_name.value()
 	__description_val := project_description.value()
+	__storage_limit_val := project_storage_limit.value()
+	__bandwidth_limit_val := project_bandwidth_limit.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, created_at ) VALUES ( ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, storage_limit, bandwidth_limit, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __storage_limit_val, __bandwidth_limit_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __storage_limit_val, __bandwidth_limit_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6351,20 +8104,22 @@ func (obj *sqlite3Impl) Create_Project(ctx context.Context,
 
 func (obj *sqlite3Impl) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	project_member_role ProjectMember_Role_Field) (
 	project_member *ProjectMember, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
 	__member_id_val := project_member_member_id.value()
 	__project_id_val := project_member_project_id.value()
+	__role_val := project_member_role.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, role, created_at ) VALUES ( ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	obj.logStmt(__stmt, __member_id_val, __project_id_val, __role_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __member_id_val, __project_id_val, __role_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6380,6 +8135,7 @@ func (obj *sqlite3Impl) Create_ApiKey(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	api_key_project_id ApiKey_ProjectId_Field,
 	api_key_key ApiKey_Key_Field,
+	api_key_secret ApiKey_Secret_Field,
 	api_key_name ApiKey_Name_Field) (
 	api_key *ApiKey, err error) {
 
@@ -6387,15 +8143,16 @@ func (obj *sqlite3Impl) Create_ApiKey(ctx context.Context,
 	__id_val := api_key_id.value()
 	__project_id_val := api_key_project_id.value()
 	__key_val := api_key_key.value()
+	__secret_val := api_key_secret.value()
 	__name_val := api_key_name.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, name, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, secret, name, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __secret_val, __name_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __project_id_val, __key_val, __secret_val, __name_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6544,17 +8301,165 @@ func (obj *sqlite3Impl) Create_CertRecord(ctx context.Context,
 	certRecord_id CertRecord_Id_Field) (
 	certRecord *CertRecord, err error) {
 
-	__now := obj.db.Hooks.Now().UTC()
-	__publickey_val := certRecord_publickey.value()
-	__id_val := certRecord_id.value()
-	__update_at_val := __now
+	__now := obj.db.Hooks.Now().UTC()
+	__publickey_val := certRecord_publickey.value()
+	__id_val := certRecord_id.value()
+	__update_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO certRecords ( publickey, id, update_at ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __publickey_val, __id_val, __update_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __publickey_val, __id_val, __update_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastCertRecord(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_RegistrationToken(ctx context.Context,
+	registration_token_secret RegistrationToken_Secret_Field,
+	registration_token_project_limit RegistrationToken_ProjectLimit_Field,
+	optional RegistrationToken_Create_Fields) (
+	registration_token *RegistrationToken, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__secret_val := registration_token_secret.value()
+	__owner_id_val := optional.OwnerId.value()
+	__project_limit_val := registration_token_project_limit.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO registration_tokens ( secret, owner_id, project_limit, created_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastRegistrationToken(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_BucketLifecycle(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	bucket_lifecycle_rules BucketLifecycle_Rules_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_lifecycle_project_id.value()
+	__bucket_name_val := bucket_lifecycle_bucket_name.value()
+	__rules_val := bucket_lifecycle_rules.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_lifecycles ( project_id, bucket_name, rules, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __rules_val, __created_at_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __bucket_name_val, __rules_val, __created_at_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBucketLifecycle(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_BucketPlacement(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field,
+	bucket_placement_countries BucketPlacement_Countries_Field) (
+	bucket_placement *BucketPlacement, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__bucket_id_val := bucket_placement_bucket_id.value()
+	__countries_val := bucket_placement_countries.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_placements ( bucket_id, countries, created_at, updated_at ) VALUES ( ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __bucket_id_val, __countries_val, __created_at_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __bucket_id_val, __countries_val, __created_at_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastBucketPlacement(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ApiKeyRevocation(ctx context.Context,
+	api_key_revocation_tail ApiKeyRevocation_Tail_Field,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+	api_key_revocation *ApiKeyRevocation, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__tail_val := api_key_revocation_tail.value()
+	__api_key_id_val := api_key_revocation_api_key_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_key_revocations ( tail, api_key_id, created_at ) VALUES ( ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __tail_val, __api_key_id_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __tail_val, __api_key_id_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastApiKeyRevocation(ctx, __pk)
+
+}
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO certRecords ( publickey, id, update_at ) VALUES ( ?, ?, ? )")
+func (obj *sqlite3Impl) Create_PendingAudits(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field,
+	pending_audits_piece_id PendingAudits_PieceId_Field,
+	pending_audits_stripe_index PendingAudits_StripeIndex_Field,
+	pending_audits_share_size PendingAudits_ShareSize_Field,
+	pending_audits_expected_share_hash PendingAudits_ExpectedShareHash_Field,
+	pending_audits_reverify_count PendingAudits_ReverifyCount_Field,
+	pending_audits_path PendingAudits_Path_Field) (
+	pending_audits *PendingAudits, err error) {
+	__node_id_val := pending_audits_node_id.value()
+	__piece_id_val := pending_audits_piece_id.value()
+	__stripe_index_val := pending_audits_stripe_index.value()
+	__share_size_val := pending_audits_share_size.value()
+	__expected_share_hash_val := pending_audits_expected_share_hash.value()
+	__reverify_count_val := pending_audits_reverify_count.value()
+	__path_val := pending_audits_path.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO pending_audits ( node_id, piece_id, stripe_index, share_size, expected_share_hash, reverify_count, path ) VALUES ( ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __publickey_val, __id_val, __update_at_val)
+	obj.logStmt(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __path_val)
 
-	__res, err := obj.driver.Exec(__stmt, __publickey_val, __id_val, __update_at_val)
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __piece_id_val, __stripe_index_val, __share_size_val, __expected_share_hash_val, __reverify_count_val, __path_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6562,28 +8467,26 @@ func (obj *sqlite3Impl) Create_CertRecord(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastCertRecord(ctx, __pk)
+	return obj.getLastPendingAudits(ctx, __pk)
 
 }
 
-func (obj *sqlite3Impl) Create_RegistrationToken(ctx context.Context,
-	registration_token_secret RegistrationToken_Secret_Field,
-	registration_token_project_limit RegistrationToken_ProjectLimit_Field,
-	optional RegistrationToken_Create_Fields) (
-	registration_token *RegistrationToken, err error) {
+func (obj *sqlite3Impl) Create_ResetPasswordToken(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field,
+	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__secret_val := registration_token_secret.value()
-	__owner_id_val := optional.OwnerId.value()
-	__project_limit_val := registration_token_project_limit.value()
+	__secret_val := reset_password_token_secret.value()
+	__owner_id_val := reset_password_token_owner_id.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO registration_tokens ( secret, owner_id, project_limit, created_at ) VALUES ( ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO reset_password_tokens ( secret, owner_id, created_at ) VALUES ( ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+	obj.logStmt(__stmt, __secret_val, __owner_id_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __secret_val, __owner_id_val, __project_limit_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __secret_val, __owner_id_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6591,7 +8494,7 @@ func (obj *sqlite3Impl) Create_RegistrationToken(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastRegistrationToken(ctx, __pk)
+	return obj.getLastResetPasswordToken(ctx, __pk)
 
 }
 
@@ -6819,7 +8722,7 @@ func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.last_net, nodes.country_code, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.disqualified FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -6828,7 +8731,7 @@ func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.LastNet, &node.CountryCode, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.Disqualified)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6873,7 +8776,7 @@ func (obj *sqlite3Impl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx con
 	limit int, offset int64) (
 	rows []*Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.last_net, nodes.country_code, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.disqualified FROM nodes WHERE nodes.id >= ? ORDER BY nodes.id LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id_greater_or_equal.value())
@@ -6891,7 +8794,7 @@ func (obj *sqlite3Impl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx con
 
 	for __rows.Next() {
 		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure)
+		err = __rows.Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.LastNet, &node.CountryCode, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.Disqualified)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -6904,80 +8807,11 @@ func (obj *sqlite3Impl) Limited_Node_By_Id_GreaterOrEqual_OrderBy_Asc_Id(ctx con
 
 }
 
-func (obj *sqlite3Impl) First_Injuredsegment(ctx context.Context) (
-	injuredsegment *Injuredsegment, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT 1 OFFSET 0")
-
-	var __values []interface{}
-	__values = append(__values)
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
-
-	if !__rows.Next() {
-		if err := __rows.Err(); err != nil {
-			return nil, obj.makeErr(err)
-		}
-		return nil, nil
-	}
-
-	injuredsegment = &Injuredsegment{}
-	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-
-	return injuredsegment, nil
-
-}
-
-func (obj *sqlite3Impl) Limited_Injuredsegment(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Injuredsegment, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT ? OFFSET ?")
-
-	var __values []interface{}
-	__values = append(__values)
-
-	__values = append(__values, limit, offset)
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__rows, err := obj.driver.Query(__stmt, __values...)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	defer __rows.Close()
-
-	for __rows.Next() {
-		injuredsegment := &Injuredsegment{}
-		err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, injuredsegment)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
-
-}
-
 func (obj *sqlite3Impl) Get_User_By_Email_And_Status_Not_Number(ctx context.Context,
 	user_email User_Email_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_failed_attempts, users.mfa_locked_until, users.mfa_last_step, users.created_at FROM users WHERE users.email = ? AND users.status != 0 LIMIT 2")
 
 	var __values []interface{}
 	__values = append(__values, user_email.value())
@@ -6999,7 +8833,7 @@ func (obj *sqlite3Impl) Get_User_By_Email_And_Status_Not_Number(ctx context.Cont
 	}
 
 	user = &User{}
-	err = __rows.Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = __rows.Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaFailedAttempts, &user.MfaLockedUntil, &user.MfaLastStep, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7020,7 +8854,7 @@ func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
 	user_id User_Id_Field) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_failed_attempts, users.mfa_locked_until, users.mfa_last_step, users.created_at FROM users WHERE users.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, user_id.value())
@@ -7029,7 +8863,7 @@ func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaFailedAttempts, &user.MfaLockedUntil, &user.MfaLastStep, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7040,7 +8874,7 @@ func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
 func (obj *sqlite3Impl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.bandwidth_limit, projects.created_at FROM projects")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -7056,7 +8890,7 @@ func (obj *sqlite3Impl) All_Project(ctx context.Context) (
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.BandwidthLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -7073,7 +8907,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.bandwidth_limit, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -7082,7 +8916,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.BandwidthLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7094,7 +8928,7 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.bandwidth_limit, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -7110,7 +8944,7 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.BandwidthLimit, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -7127,7 +8961,7 @@ func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -7143,7 +8977,7 @@ func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -7161,7 +8995,7 @@ func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 	limit int, offset int64) (
 	rows []*ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, project_member_project_id.value())
@@ -7179,7 +9013,7 @@ func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 
 	for __rows.Next() {
 		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -7196,7 +9030,7 @@ func (obj *sqlite3Impl) Get_ApiKey_By_Id(ctx context.Context,
 	api_key_id ApiKey_Id_Field) (
 	api_key *ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.secret, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_id.value())
@@ -7205,7 +9039,7 @@ func (obj *sqlite3Impl) Get_ApiKey_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Secret, &api_key.Name, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7217,7 +9051,7 @@ func (obj *sqlite3Impl) Get_ApiKey_By_Key(ctx context.Context,
 	api_key_key ApiKey_Key_Field) (
 	api_key *ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.secret, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
 
 	var __values []interface{}
 	__values = append(__values, api_key_key.value())
@@ -7226,7 +9060,7 @@ func (obj *sqlite3Impl) Get_ApiKey_By_Key(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Secret, &api_key.Name, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -7238,7 +9072,7 @@ func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Con
 	api_key_project_id ApiKey_ProjectId_Field) (
 	rows []*ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.secret, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
 
 	var __values []interface{}
 	__values = append(__values, api_key_project_id.value())
@@ -7254,7 +9088,7 @@ func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Con
 
 	for __rows.Next() {
 		api_key := &ApiKey{}
-		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Secret, &api_key.Name, &api_key.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -7511,34 +9345,247 @@ func (obj *sqlite3Impl) Find_StoragenodeBandwidthRollup_By_StoragenodeId_And_Int
 	storagenode_bandwidth_rollup_action StoragenodeBandwidthRollup_Action_Field) (
 	storagenode_bandwidth_rollup *StoragenodeBandwidthRollup, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_bandwidth_rollups.storagenode_id, storagenode_bandwidth_rollups.interval_start, storagenode_bandwidth_rollups.interval_seconds, storagenode_bandwidth_rollups.action, storagenode_bandwidth_rollups.allocated, storagenode_bandwidth_rollups.settled FROM storagenode_bandwidth_rollups WHERE storagenode_bandwidth_rollups.storagenode_id = ? AND storagenode_bandwidth_rollups.interval_start = ? AND storagenode_bandwidth_rollups.action = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_bandwidth_rollups.storagenode_id, storagenode_bandwidth_rollups.interval_start, storagenode_bandwidth_rollups.interval_seconds, storagenode_bandwidth_rollups.action, storagenode_bandwidth_rollups.allocated, storagenode_bandwidth_rollups.settled FROM storagenode_bandwidth_rollups WHERE storagenode_bandwidth_rollups.storagenode_id = ? AND storagenode_bandwidth_rollups.interval_start = ? AND storagenode_bandwidth_rollups.action = ?")
+
+	var __values []interface{}
+	__values = append(__values, storagenode_bandwidth_rollup_storagenode_id.value(), storagenode_bandwidth_rollup_interval_start.value(), storagenode_bandwidth_rollup_action.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	storagenode_bandwidth_rollup = &StoragenodeBandwidthRollup{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&storagenode_bandwidth_rollup.StoragenodeId, &storagenode_bandwidth_rollup.IntervalStart, &storagenode_bandwidth_rollup.IntervalSeconds, &storagenode_bandwidth_rollup.Action, &storagenode_bandwidth_rollup.Allocated, &storagenode_bandwidth_rollup.Settled)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return storagenode_bandwidth_rollup, nil
+
+}
+
+func (obj *sqlite3Impl) All_StoragenodeBandwidthRollup_By_IntervalStart_GreaterOrEqual(ctx context.Context,
+	storagenode_bandwidth_rollup_interval_start_greater_or_equal StoragenodeBandwidthRollup_IntervalStart_Field) (
+	rows []*StoragenodeBandwidthRollup, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_bandwidth_rollups.storagenode_id, storagenode_bandwidth_rollups.interval_start, storagenode_bandwidth_rollups.interval_seconds, storagenode_bandwidth_rollups.action, storagenode_bandwidth_rollups.allocated, storagenode_bandwidth_rollups.settled FROM storagenode_bandwidth_rollups WHERE storagenode_bandwidth_rollups.interval_start >= ?")
+
+	var __values []interface{}
+	__values = append(__values, storagenode_bandwidth_rollup_interval_start_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		storagenode_bandwidth_rollup := &StoragenodeBandwidthRollup{}
+		err = __rows.Scan(&storagenode_bandwidth_rollup.StoragenodeId, &storagenode_bandwidth_rollup.IntervalStart, &storagenode_bandwidth_rollup.IntervalSeconds, &storagenode_bandwidth_rollup.Action, &storagenode_bandwidth_rollup.Allocated, &storagenode_bandwidth_rollup.Settled)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, storagenode_bandwidth_rollup)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_CertRecord_By_Id(ctx context.Context,
+	certRecord_id CertRecord_Id_Field) (
+	certRecord *CertRecord, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT certRecords.publickey, certRecords.id, certRecords.update_at FROM certRecords WHERE certRecords.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, certRecord_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	certRecord = &CertRecord{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return certRecord, nil
+
+}
+
+func (obj *sqlite3Impl) Get_RegistrationToken_By_Secret(ctx context.Context,
+	registration_token_secret RegistrationToken_Secret_Field) (
+	registration_token *RegistrationToken, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE registration_tokens.secret = ?")
+
+	var __values []interface{}
+	__values = append(__values, registration_token_secret.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	registration_token = &RegistrationToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return registration_token, nil
+
+}
+
+func (obj *sqlite3Impl) Get_RegistrationToken_By_OwnerId(ctx context.Context,
+	registration_token_owner_id RegistrationToken_OwnerId_Field) (
+	registration_token *RegistrationToken, err error) {
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "registration_tokens.owner_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE "), __cond_0}}
+
+	var __values []interface{}
+	__values = append(__values)
+
+	if !registration_token_owner_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, registration_token_owner_id.value())
+	}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	registration_token = &RegistrationToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return registration_token, nil
+
+}
+
+func (obj *sqlite3Impl) Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.created_at, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.CreatedAt, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *sqlite3Impl) All_BucketLifecycle(ctx context.Context) (
+	rows []*BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.created_at, bucket_lifecycles.updated_at FROM bucket_lifecycles")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_lifecycle := &BucketLifecycle{}
+		err = __rows.Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.CreatedAt, &bucket_lifecycle.UpdatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_lifecycle)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_BucketPlacement_By_BucketId(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field) (
+	bucket_placement *BucketPlacement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placements.bucket_id, bucket_placements.countries, bucket_placements.created_at, bucket_placements.updated_at FROM bucket_placements WHERE bucket_placements.bucket_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_placement_bucket_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_placement = &BucketPlacement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bucket_placement.BucketId, &bucket_placement.Countries, &bucket_placement.CreatedAt, &bucket_placement.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_placement, nil
+
+}
+
+func (obj *sqlite3Impl) All_ApiKeyRevocation_By_ApiKeyId(ctx context.Context,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+	rows []*ApiKeyRevocation, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_key_revocations.tail, api_key_revocations.api_key_id, api_key_revocations.created_at FROM api_key_revocations WHERE api_key_revocations.api_key_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, storagenode_bandwidth_rollup_storagenode_id.value(), storagenode_bandwidth_rollup_interval_start.value(), storagenode_bandwidth_rollup_action.value())
+	__values = append(__values, api_key_revocation_api_key_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	storagenode_bandwidth_rollup = &StoragenodeBandwidthRollup{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&storagenode_bandwidth_rollup.StoragenodeId, &storagenode_bandwidth_rollup.IntervalStart, &storagenode_bandwidth_rollup.IntervalSeconds, &storagenode_bandwidth_rollup.Action, &storagenode_bandwidth_rollup.Allocated, &storagenode_bandwidth_rollup.Settled)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return storagenode_bandwidth_rollup, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		api_key_revocation := &ApiKeyRevocation{}
+		err = __rows.Scan(&api_key_revocation.Tail, &api_key_revocation.ApiKeyId, &api_key_revocation.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, api_key_revocation)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *sqlite3Impl) All_StoragenodeBandwidthRollup_By_IntervalStart_GreaterOrEqual(ctx context.Context,
-	storagenode_bandwidth_rollup_interval_start_greater_or_equal StoragenodeBandwidthRollup_IntervalStart_Field) (
-	rows []*StoragenodeBandwidthRollup, err error) {
+func (obj *sqlite3Impl) Limited_Injuredsegment_OrderBy_Asc_Health(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Injuredsegment, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT storagenode_bandwidth_rollups.storagenode_id, storagenode_bandwidth_rollups.interval_start, storagenode_bandwidth_rollups.interval_seconds, storagenode_bandwidth_rollups.action, storagenode_bandwidth_rollups.allocated, storagenode_bandwidth_rollups.settled FROM storagenode_bandwidth_rollups WHERE storagenode_bandwidth_rollups.interval_start >= ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.path, injuredsegments.data, injuredsegments.health, injuredsegments.leased_until, injuredsegments.attempts FROM injuredsegments ORDER BY injuredsegments.health LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values, storagenode_bandwidth_rollup_interval_start_greater_or_equal.value())
+	__values = append(__values)
+
+	__values = append(__values, limit, offset)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -7550,12 +9597,12 @@ func (obj *sqlite3Impl) All_StoragenodeBandwidthRollup_By_IntervalStart_GreaterO
 	defer __rows.Close()
 
 	for __rows.Next() {
-		storagenode_bandwidth_rollup := &StoragenodeBandwidthRollup{}
-		err = __rows.Scan(&storagenode_bandwidth_rollup.StoragenodeId, &storagenode_bandwidth_rollup.IntervalStart, &storagenode_bandwidth_rollup.IntervalSeconds, &storagenode_bandwidth_rollup.Action, &storagenode_bandwidth_rollup.Allocated, &storagenode_bandwidth_rollup.Settled)
+		injuredsegment := &Injuredsegment{}
+		err = __rows.Scan(&injuredsegment.Path, &injuredsegment.Data, &injuredsegment.Health, &injuredsegment.LeasedUntil, &injuredsegment.Attempts)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, storagenode_bandwidth_rollup)
+		rows = append(rows, injuredsegment)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -7564,73 +9611,66 @@ func (obj *sqlite3Impl) All_StoragenodeBandwidthRollup_By_IntervalStart_GreaterO
 
 }
 
-func (obj *sqlite3Impl) Get_CertRecord_By_Id(ctx context.Context,
-	certRecord_id CertRecord_Id_Field) (
-	certRecord *CertRecord, err error) {
+func (obj *sqlite3Impl) Get_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field) (
+	pending_audits *PendingAudits, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT certRecords.publickey, certRecords.id, certRecords.update_at FROM certRecords WHERE certRecords.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.path FROM pending_audits WHERE pending_audits.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, certRecord_id.value())
+	__values = append(__values, pending_audits_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	certRecord = &CertRecord{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	pending_audits = &PendingAudits{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.Path)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return certRecord, nil
+	return pending_audits, nil
 
 }
 
-func (obj *sqlite3Impl) Get_RegistrationToken_By_Secret(ctx context.Context,
-	registration_token_secret RegistrationToken_Secret_Field) (
-	registration_token *RegistrationToken, err error) {
+func (obj *sqlite3Impl) Get_ResetPasswordToken_By_Secret(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE registration_tokens.secret = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
 
 	var __values []interface{}
-	__values = append(__values, registration_token_secret.value())
+	__values = append(__values, reset_password_token_secret.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	registration_token = &RegistrationToken{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	reset_password_token = &ResetPasswordToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return registration_token, nil
+	return reset_password_token, nil
 
 }
 
-func (obj *sqlite3Impl) Get_RegistrationToken_By_OwnerId(ctx context.Context,
-	registration_token_owner_id RegistrationToken_OwnerId_Field) (
-	registration_token *RegistrationToken, err error) {
-
-	var __cond_0 = &__sqlbundle_Condition{Left: "registration_tokens.owner_id", Equal: true, Right: "?", Null: true}
+func (obj *sqlite3Impl) Get_ResetPasswordToken_By_OwnerId(ctx context.Context,
+	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE "), __cond_0}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at FROM reset_password_tokens WHERE reset_password_tokens.owner_id = ?")
 
 	var __values []interface{}
-	__values = append(__values)
-
-	if !registration_token_owner_id.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, registration_token_owner_id.value())
-	}
+	__values = append(__values, reset_password_token_owner_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	registration_token = &RegistrationToken{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	reset_password_token = &ResetPasswordToken{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return registration_token, nil
+	return reset_password_token, nil
 
 }
 
@@ -7841,6 +9881,41 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
 	}
 
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -7860,12 +9935,12 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.last_net, nodes.country_code, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.disqualified FROM nodes WHERE nodes.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.LastNet, &node.CountryCode, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.Disqualified)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -7912,6 +9987,21 @@ func (obj *sqlite3Impl) Update_User_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("status = ?"))
 	}
 
+	if update.MfaEnabled._set {
+		__values = append(__values, update.MfaEnabled.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_enabled = ?"))
+	}
+
+	if update.MfaSecretKey._set {
+		__values = append(__values, update.MfaSecretKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_secret_key = ?"))
+	}
+
+	if update.MfaRecoveryCodes._set {
+		__values = append(__values, update.MfaRecoveryCodes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("mfa_recovery_codes = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -7930,12 +10020,12 @@ func (obj *sqlite3Impl) Update_User_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE users.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_failed_attempts, users.mfa_locked_until, users.mfa_last_step, users.created_at FROM users WHERE users.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaFailedAttempts, &user.MfaLockedUntil, &user.MfaLastStep, &user.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -7962,6 +10052,16 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("description = ?"))
 	}
 
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -7980,12 +10080,12 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.bandwidth_limit, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.BandwidthLimit, &project.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -8030,12 +10130,12 @@ func (obj *sqlite3Impl) Update_ApiKey_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.secret, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Secret, &api_key.Name, &api_key.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -8088,31 +10188,235 @@ func (obj *sqlite3Impl) Update_CertRecord_By_Id(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return certRecord, nil
+	return certRecord, nil
+}
+
+func (obj *sqlite3Impl) Update_RegistrationToken_By_Secret(ctx context.Context,
+	registration_token_secret RegistrationToken_Secret_Field,
+	update RegistrationToken_Update_Fields) (
+	registration_token *RegistrationToken, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE registration_tokens SET "), __sets, __sqlbundle_Literal(" WHERE registration_tokens.secret = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.OwnerId._set {
+		__values = append(__values, update.OwnerId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("owner_id = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, registration_token_secret.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	registration_token = &RegistrationToken{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE registration_tokens.secret = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return registration_token, nil
+}
+
+func (obj *sqlite3Impl) Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	update BucketLifecycle_Update_Fields) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_lifecycles SET "), __sets, __sqlbundle_Literal(" WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Rules._set {
+		__values = append(__values, update.Rules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("rules = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.created_at, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.CreatedAt, &bucket_lifecycle.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+}
+
+func (obj *sqlite3Impl) Update_BucketPlacement_By_BucketId(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field,
+	update BucketPlacement_Update_Fields) (
+	bucket_placement *BucketPlacement, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_placements SET "), __sets, __sqlbundle_Literal(" WHERE bucket_placements.bucket_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Countries._set {
+		__values = append(__values, update.Countries.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("countries = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, bucket_placement_bucket_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_placement = &BucketPlacement{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT bucket_placements.bucket_id, bucket_placements.countries, bucket_placements.created_at, bucket_placements.updated_at FROM bucket_placements WHERE bucket_placements.bucket_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&bucket_placement.BucketId, &bucket_placement.Countries, &bucket_placement.CreatedAt, &bucket_placement.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_placement, nil
+}
+
+func (obj *sqlite3Impl) Update_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field,
+	update PendingAudits_Update_Fields) (
+	pending_audits *PendingAudits, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE pending_audits SET "), __sets, __sqlbundle_Literal(" WHERE pending_audits.node_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.ReverifyCount._set {
+		__values = append(__values, update.ReverifyCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("reverify_count = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, pending_audits_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	pending_audits = &PendingAudits{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.path FROM pending_audits WHERE pending_audits.node_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.Path)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return pending_audits, nil
 }
 
-func (obj *sqlite3Impl) Update_RegistrationToken_By_Secret(ctx context.Context,
-	registration_token_secret RegistrationToken_Secret_Field,
-	update RegistrationToken_Update_Fields) (
-	registration_token *RegistrationToken, err error) {
+func (obj *sqlite3Impl) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE registration_tokens SET "), __sets, __sqlbundle_Literal(" WHERE registration_tokens.secret = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_members SET "), __sets, __sqlbundle_Literal(" WHERE project_members.member_id = ? AND project_members.project_id = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
 	var __args []interface{}
 
-	if update.OwnerId._set {
-		__values = append(__values, update.OwnerId.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("owner_id = ?"))
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, registration_token_secret.value())
+	__args = append(__args, project_member_member_id.value(), project_member_project_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -8120,25 +10424,25 @@ func (obj *sqlite3Impl) Update_RegistrationToken_By_Secret(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	registration_token = &RegistrationToken{}
+	project_member = &ProjectMember{}
 	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE registration_tokens.secret = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return registration_token, nil
+	return project_member, nil
 }
 
 func (obj *sqlite3Impl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
@@ -8245,32 +10549,6 @@ func (obj *sqlite3Impl) Delete_Node_By_Id(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Delete_Injuredsegment_By_Id(ctx context.Context,
-	injuredsegment_id Injuredsegment_Id_Field) (
-	deleted bool, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.id = ?")
-
-	var __values []interface{}
-	__values = append(__values, injuredsegment_id.value())
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	__res, err := obj.driver.Exec(__stmt, __values...)
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
-
-	__count, err := __res.RowsAffected()
-	if err != nil {
-		return false, obj.makeErr(err)
-	}
-
-	return __count > 0, nil
-
-}
-
 func (obj *sqlite3Impl) Delete_User_By_Id(ctx context.Context,
 	user_id User_Id_Field) (
 	deleted bool, err error) {
@@ -8454,6 +10732,137 @@ func (obj *sqlite3Impl) Delete_CertRecord_By_Id(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycles WHERE bucket_lifecycles.project_id = ? AND bucket_lifecycles.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_lifecycle_project_id.value(), bucket_lifecycle_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_BucketPlacement_By_BucketId(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_placements WHERE bucket_placements.bucket_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, bucket_placement_bucket_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.path = ?")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_path.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM pending_audits WHERE pending_audits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, pending_audits_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reset_password_tokens WHERE reset_password_tokens.secret = ?")
+
+	var __values []interface{}
+	__values = append(__values, reset_password_token_secret.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *sqlite3Impl) getLastIrreparabledb(ctx context.Context,
 	pk int64) (
 	irreparabledb *Irreparabledb, err error) {
@@ -8530,13 +10939,13 @@ func (obj *sqlite3Impl) getLastNode(ctx context.Context,
 	pk int64) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure FROM nodes WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.free_bandwidth, nodes.free_disk, nodes.latency_90, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.last_net, nodes.country_code, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.disqualified FROM nodes WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.Address, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.FreeBandwidth, &node.FreeDisk, &node.Latency90, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.LastNet, &node.CountryCode, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.Disqualified)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8544,35 +10953,17 @@ func (obj *sqlite3Impl) getLastNode(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) getLastInjuredsegment(ctx context.Context,
-	pk int64) (
-	injuredsegment *Injuredsegment, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments WHERE _rowid_ = ?")
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
-
-	injuredsegment = &Injuredsegment{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&injuredsegment.Id, &injuredsegment.Info)
-	if err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return injuredsegment, nil
-
-}
-
 func (obj *sqlite3Impl) getLastUser(ctx context.Context,
 	pk int64) (
 	user *User, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.created_at FROM users WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT users.id, users.full_name, users.short_name, users.email, users.password_hash, users.status, users.mfa_enabled, users.mfa_secret_key, users.mfa_recovery_codes, users.mfa_failed_attempts, users.mfa_locked_until, users.mfa_last_step, users.created_at FROM users WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	user = &User{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&user.Id, &user.FullName, &user.ShortName, &user.Email, &user.PasswordHash, &user.Status, &user.MfaEnabled, &user.MfaSecretKey, &user.MfaRecoveryCodes, &user.MfaFailedAttempts, &user.MfaLockedUntil, &user.MfaLastStep, &user.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8584,13 +10975,13 @@ func (obj *sqlite3Impl) getLastProject(ctx context.Context,
 	pk int64) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.storage_limit, projects.bandwidth_limit, projects.created_at FROM projects WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.StorageLimit, &project.BandwidthLimit, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8602,13 +10993,13 @@ func (obj *sqlite3Impl) getLastProjectMember(ctx context.Context,
 	pk int64) (
 	project_member *ProjectMember, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.role, project_members.created_at FROM project_members WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	project_member = &ProjectMember{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.Role, &project_member.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8620,13 +11011,13 @@ func (obj *sqlite3Impl) getLastApiKey(ctx context.Context,
 	pk int64) (
 	api_key *ApiKey, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.secret, api_keys.name, api_keys.created_at FROM api_keys WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Secret, &api_key.Name, &api_key.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -8688,73 +11079,163 @@ func (obj *sqlite3Impl) getLastUsedSerial(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) getLastBucketStorageTally(ctx context.Context,
+func (obj *sqlite3Impl) getLastBucketStorageTally(ctx context.Context,
+	pk int64) (
+	bucket_storage_tally *BucketStorageTally, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size FROM bucket_storage_tallies WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bucket_storage_tally = &BucketStorageTally{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_storage_tally, nil
+
+}
+
+func (obj *sqlite3Impl) getLastCertRecord(ctx context.Context,
+	pk int64) (
+	certRecord *CertRecord, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT certRecords.publickey, certRecords.id, certRecords.update_at FROM certRecords WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	certRecord = &CertRecord{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return certRecord, nil
+
+}
+
+func (obj *sqlite3Impl) getLastRegistrationToken(ctx context.Context,
+	pk int64) (
+	registration_token *RegistrationToken, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	registration_token = &RegistrationToken{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return registration_token, nil
+
+}
+
+func (impl sqlite3Impl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(sqlite3.Error); ok {
+		if e.Code == sqlite3.ErrConstraint {
+			msg := err.Error()
+			colon := strings.LastIndex(msg, ":")
+			if colon != -1 {
+				return strings.TrimSpace(msg[colon:]), true
+			}
+			return "", true
+		}
+	}
+	return "", false
+}
+
+func (obj *sqlite3Impl) getLastBucketLifecycle(ctx context.Context,
+	pk int64) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycles.project_id, bucket_lifecycles.bucket_name, bucket_lifecycles.rules, bucket_lifecycles.created_at, bucket_lifecycles.updated_at FROM bucket_lifecycles WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bucket_lifecycle = &BucketLifecycle{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_lifecycle.ProjectId, &bucket_lifecycle.BucketName, &bucket_lifecycle.Rules, &bucket_lifecycle.CreatedAt, &bucket_lifecycle.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle, nil
+
+}
+
+func (obj *sqlite3Impl) getLastBucketPlacement(ctx context.Context,
+	pk int64) (
+	bucket_placement *BucketPlacement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_placements.bucket_id, bucket_placements.countries, bucket_placements.created_at, bucket_placements.updated_at FROM bucket_placements WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bucket_placement = &BucketPlacement{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_placement.BucketId, &bucket_placement.Countries, &bucket_placement.CreatedAt, &bucket_placement.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_placement, nil
+
+}
+
+func (obj *sqlite3Impl) getLastApiKeyRevocation(ctx context.Context,
 	pk int64) (
-	bucket_storage_tally *BucketStorageTally, err error) {
+	api_key_revocation *ApiKeyRevocation, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.bucket_name, bucket_storage_tallies.project_id, bucket_storage_tallies.interval_start, bucket_storage_tallies.inline, bucket_storage_tallies.remote, bucket_storage_tallies.remote_segments_count, bucket_storage_tallies.inline_segments_count, bucket_storage_tallies.object_count, bucket_storage_tallies.metadata_size FROM bucket_storage_tallies WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_key_revocations.tail, api_key_revocations.api_key_id, api_key_revocations.created_at FROM api_key_revocations WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
-	bucket_storage_tally = &BucketStorageTally{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_storage_tally.BucketName, &bucket_storage_tally.ProjectId, &bucket_storage_tally.IntervalStart, &bucket_storage_tally.Inline, &bucket_storage_tally.Remote, &bucket_storage_tally.RemoteSegmentsCount, &bucket_storage_tally.InlineSegmentsCount, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.MetadataSize)
+	api_key_revocation = &ApiKeyRevocation{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key_revocation.Tail, &api_key_revocation.ApiKeyId, &api_key_revocation.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return bucket_storage_tally, nil
+	return api_key_revocation, nil
 
 }
 
-func (obj *sqlite3Impl) getLastCertRecord(ctx context.Context,
+func (obj *sqlite3Impl) getLastPendingAudits(ctx context.Context,
 	pk int64) (
-	certRecord *CertRecord, err error) {
+	pending_audits *PendingAudits, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT certRecords.publickey, certRecords.id, certRecords.update_at FROM certRecords WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT pending_audits.node_id, pending_audits.piece_id, pending_audits.stripe_index, pending_audits.share_size, pending_audits.expected_share_hash, pending_audits.reverify_count, pending_audits.path FROM pending_audits WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
-	certRecord = &CertRecord{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	pending_audits = &PendingAudits{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&pending_audits.NodeId, &pending_audits.PieceId, &pending_audits.StripeIndex, &pending_audits.ShareSize, &pending_audits.ExpectedShareHash, &pending_audits.ReverifyCount, &pending_audits.Path)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return certRecord, nil
+	return pending_audits, nil
 
 }
 
-func (obj *sqlite3Impl) getLastRegistrationToken(ctx context.Context,
+func (obj *sqlite3Impl) getLastResetPasswordToken(ctx context.Context,
 	pk int64) (
-	registration_token *RegistrationToken, err error) {
+	reset_password_token *ResetPasswordToken, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT registration_tokens.secret, registration_tokens.owner_id, registration_tokens.project_limit, registration_tokens.created_at FROM registration_tokens WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT reset_password_tokens.secret, reset_password_tokens.owner_id, reset_password_tokens.created_at FROM reset_password_tokens WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
-	registration_token = &RegistrationToken{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&registration_token.Secret, &registration_token.OwnerId, &registration_token.ProjectLimit, &registration_token.CreatedAt)
+	reset_password_token = &ResetPasswordToken{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&reset_password_token.Secret, &reset_password_token.OwnerId, &reset_password_token.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return registration_token, nil
-
-}
+	return reset_password_token, nil
 
-func (impl sqlite3Impl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(sqlite3.Error); ok {
-		if e.Code == sqlite3.ErrConstraint {
-			msg := err.Error()
-			colon := strings.LastIndex(msg, ":")
-			if colon != -1 {
-				return strings.TrimSpace(msg[colon:]), true
-			}
-			return "", true
-		}
-	}
-	return "", false
 }
 
 func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error) {
@@ -8775,6 +11256,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_key_revocations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -8825,6 +11316,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM reset_password_tokens;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -8845,6 +11346,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM pending_audits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -8915,6 +11426,26 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_placements;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_lifecycles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -9036,6 +11567,16 @@ func (rx *Rx) All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx context.Conte
 	return tx.All_AccountingRollup_By_StartTime_GreaterOrEqual(ctx, accounting_rollup_start_time_greater_or_equal)
 }
 
+func (rx *Rx) All_ApiKeyRevocation_By_ApiKeyId(ctx context.Context,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+	rows []*ApiKeyRevocation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ApiKeyRevocation_By_ApiKeyId(ctx, api_key_revocation_api_key_id)
+}
+
 func (rx *Rx) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 	api_key_project_id ApiKey_ProjectId_Field) (
 	rows []*ApiKey, err error) {
@@ -9046,6 +11587,15 @@ func (rx *Rx) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 	return tx.All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx, api_key_project_id)
 }
 
+func (rx *Rx) All_BucketLifecycle(ctx context.Context) (
+	rows []*BucketLifecycle, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_BucketLifecycle(ctx)
+}
+
 func (rx *Rx) All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart(ctx context.Context,
 	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
 	bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
@@ -9156,13 +11706,51 @@ func (rx *Rx) Create_ApiKey(ctx context.Context,
 	api_key_id ApiKey_Id_Field,
 	api_key_project_id ApiKey_ProjectId_Field,
 	api_key_key ApiKey_Key_Field,
+	api_key_secret ApiKey_Secret_Field,
 	api_key_name ApiKey_Name_Field) (
 	api_key *ApiKey, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_ApiKey(ctx, api_key_id, api_key_project_id, api_key_key, api_key_name)
+	return tx.Create_ApiKey(ctx, api_key_id, api_key_project_id, api_key_key, api_key_secret, api_key_name)
+
+}
+
+func (rx *Rx) Create_ApiKeyRevocation(ctx context.Context,
+	api_key_revocation_tail ApiKeyRevocation_Tail_Field,
+	api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+	api_key_revocation *ApiKeyRevocation, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_ApiKeyRevocation(ctx, api_key_revocation_tail, api_key_revocation_api_key_id)
+
+}
+
+func (rx *Rx) Create_BucketLifecycle(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	bucket_lifecycle_rules BucketLifecycle_Rules_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_BucketLifecycle(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name, bucket_lifecycle_rules)
+
+}
+
+func (rx *Rx) Create_BucketPlacement(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field,
+	bucket_placement_countries BucketPlacement_Countries_Field) (
+	bucket_placement *BucketPlacement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_BucketPlacement(ctx, bucket_placement_bucket_id, bucket_placement_countries)
 
 }
 
@@ -9219,17 +11807,6 @@ func (rx *Rx) Create_CertRecord(ctx context.Context,
 
 }
 
-func (rx *Rx) Create_Injuredsegment(ctx context.Context,
-	injuredsegment_info Injuredsegment_Info_Field) (
-	injuredsegment *Injuredsegment, err error) {
-	var tx *Tx
-	if tx, err = rx.getTx(ctx); err != nil {
-		return
-	}
-	return tx.Create_Injuredsegment(ctx, injuredsegment_info)
-
-}
-
 func (rx *Rx) Create_Irreparabledb(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 	irreparabledb_segmentdetail Irreparabledb_Segmentdetail_Field,
@@ -9262,38 +11839,65 @@ func (rx *Rx) Create_Node(ctx context.Context,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
 	node_uptime_ratio Node_UptimeRatio_Field,
 	node_last_contact_success Node_LastContactSuccess_Field,
-	node_last_contact_failure Node_LastContactFailure_Field) (
+	node_last_contact_failure Node_LastContactFailure_Field,
+	node_last_net Node_LastNet_Field,
+	node_country_code Node_CountryCode_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	optional Node_Create_Fields) (
 	node *Node, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Node(ctx, node_id, node_address, node_protocol, node_type, node_email, node_wallet, node_free_bandwidth, node_free_disk, node_latency_90, node_audit_success_count, node_total_audit_count, node_audit_success_ratio, node_uptime_success_count, node_total_uptime_count, node_uptime_ratio, node_last_contact_success, node_last_contact_failure)
+	return tx.Create_Node(ctx, node_id, node_address, node_protocol, node_type, node_email, node_wallet, node_free_bandwidth, node_free_disk, node_latency_90, node_audit_success_count, node_total_audit_count, node_audit_success_ratio, node_uptime_success_count, node_total_uptime_count, node_uptime_ratio, node_last_contact_success, node_last_contact_failure, node_last_net, node_country_code, node_audit_reputation_alpha, node_audit_reputation_beta, node_uptime_reputation_alpha, node_uptime_reputation_beta, optional)
+
+}
+
+func (rx *Rx) Create_PendingAudits(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field,
+	pending_audits_piece_id PendingAudits_PieceId_Field,
+	pending_audits_stripe_index PendingAudits_StripeIndex_Field,
+	pending_audits_share_size PendingAudits_ShareSize_Field,
+	pending_audits_expected_share_hash PendingAudits_ExpectedShareHash_Field,
+	pending_audits_reverify_count PendingAudits_ReverifyCount_Field,
+	pending_audits_path PendingAudits_Path_Field) (
+	pending_audits *PendingAudits, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_PendingAudits(ctx, pending_audits_node_id, pending_audits_piece_id, pending_audits_stripe_index, pending_audits_share_size, pending_audits_expected_share_hash, pending_audits_reverify_count, pending_audits_path)
 
 }
 
 func (rx *Rx) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
-	project_description Project_Description_Field) (
+	project_description Project_Description_Field,
+	project_storage_limit Project_StorageLimit_Field,
+	project_bandwidth_limit Project_BandwidthLimit_Field) (
 	project *Project, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Project(ctx, project_id, project_name, project_description)
+	return tx.Create_Project(ctx, project_id, project_name, project_description, project_storage_limit, project_bandwidth_limit)
 
 }
 
 func (rx *Rx) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+	project_member_project_id ProjectMember_ProjectId_Field,
+	project_member_role ProjectMember_Role_Field) (
 	project_member *ProjectMember, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id)
+	return tx.Create_ProjectMember(ctx, project_member_member_id, project_member_project_id, project_member_role)
 
 }
 
@@ -9310,6 +11914,18 @@ func (rx *Rx) Create_RegistrationToken(ctx context.Context,
 
 }
 
+func (rx *Rx) Create_ResetPasswordToken(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field,
+	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_ResetPasswordToken(ctx, reset_password_token_secret, reset_password_token_owner_id)
+
+}
+
 func (rx *Rx) Create_SerialNumber(ctx context.Context,
 	serial_number_serial_number SerialNumber_SerialNumber_Field,
 	serial_number_bucket_id SerialNumber_BucketId_Field,
@@ -9380,6 +11996,27 @@ func (rx *Rx) Delete_ApiKey_By_Id(ctx context.Context,
 	return tx.Delete_ApiKey_By_Id(ctx, api_key_id)
 }
 
+func (rx *Rx) Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name)
+}
+
+func (rx *Rx) Delete_BucketPlacement_By_BucketId(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_BucketPlacement_By_BucketId(ctx, bucket_placement_bucket_id)
+}
+
 func (rx *Rx) Delete_BucketUsage_By_Id(ctx context.Context,
 	bucket_usage_id BucketUsage_Id_Field) (
 	deleted bool, err error) {
@@ -9400,14 +12037,14 @@ func (rx *Rx) Delete_CertRecord_By_Id(ctx context.Context,
 	return tx.Delete_CertRecord_By_Id(ctx, certRecord_id)
 }
 
-func (rx *Rx) Delete_Injuredsegment_By_Id(ctx context.Context,
-	injuredsegment_id Injuredsegment_Id_Field) (
+func (rx *Rx) Delete_Injuredsegment_By_Path(ctx context.Context,
+	injuredsegment_path Injuredsegment_Path_Field) (
 	deleted bool, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Delete_Injuredsegment_By_Id(ctx, injuredsegment_id)
+	return tx.Delete_Injuredsegment_By_Path(ctx, injuredsegment_path)
 }
 
 func (rx *Rx) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
@@ -9430,6 +12067,16 @@ func (rx *Rx) Delete_Node_By_Id(ctx context.Context,
 	return tx.Delete_Node_By_Id(ctx, node_id)
 }
 
+func (rx *Rx) Delete_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_PendingAudits_By_NodeId(ctx, pending_audits_node_id)
+}
+
 func (rx *Rx) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
 	project_member_project_id ProjectMember_ProjectId_Field) (
@@ -9451,6 +12098,16 @@ func (rx *Rx) Delete_Project_By_Id(ctx context.Context,
 	return tx.Delete_Project_By_Id(ctx, project_id)
 }
 
+func (rx *Rx) Delete_ResetPasswordToken_By_Secret(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_ResetPasswordToken_By_Secret(ctx, reset_password_token_secret)
+}
+
 func (rx *Rx) Delete_SerialNumber_By_ExpiresAt_LessOrEqual(ctx context.Context,
 	serial_number_expires_at_less_or_equal SerialNumber_ExpiresAt_Field) (
 	count int64, err error) {
@@ -9527,15 +12184,6 @@ func (rx *Rx) First_BucketStorageTally_By_ProjectId_OrderBy_Desc_IntervalStart(c
 	return tx.First_BucketStorageTally_By_ProjectId_OrderBy_Desc_IntervalStart(ctx, bucket_storage_tally_project_id)
 }
 
-func (rx *Rx) First_Injuredsegment(ctx context.Context) (
-	injuredsegment *Injuredsegment, err error) {
-	var tx *Tx
-	if tx, err = rx.getTx(ctx); err != nil {
-		return
-	}
-	return tx.First_Injuredsegment(ctx)
-}
-
 func (rx *Rx) Get_AccountingRaw_By_Id(ctx context.Context,
 	accounting_raw_id AccountingRaw_Id_Field) (
 	accounting_raw *AccountingRaw, err error) {
@@ -9576,6 +12224,27 @@ func (rx *Rx) Get_ApiKey_By_Key(ctx context.Context,
 	return tx.Get_ApiKey_By_Key(ctx, api_key_key)
 }
 
+func (rx *Rx) Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name)
+}
+
+func (rx *Rx) Get_BucketPlacement_By_BucketId(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field) (
+	bucket_placement *BucketPlacement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_BucketPlacement_By_BucketId(ctx, bucket_placement_bucket_id)
+}
+
 func (rx *Rx) Get_BucketUsage_By_Id(ctx context.Context,
 	bucket_usage_id BucketUsage_Id_Field) (
 	bucket_usage *BucketUsage, err error) {
@@ -9616,6 +12285,16 @@ func (rx *Rx) Get_Node_By_Id(ctx context.Context,
 	return tx.Get_Node_By_Id(ctx, node_id)
 }
 
+func (rx *Rx) Get_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field) (
+	pending_audits *PendingAudits, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_PendingAudits_By_NodeId(ctx, pending_audits_node_id)
+}
+
 func (rx *Rx) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
@@ -9646,6 +12325,26 @@ func (rx *Rx) Get_RegistrationToken_By_Secret(ctx context.Context,
 	return tx.Get_RegistrationToken_By_Secret(ctx, registration_token_secret)
 }
 
+func (rx *Rx) Get_ResetPasswordToken_By_OwnerId(ctx context.Context,
+	reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_ResetPasswordToken_By_OwnerId(ctx, reset_password_token_owner_id)
+}
+
+func (rx *Rx) Get_ResetPasswordToken_By_Secret(ctx context.Context,
+	reset_password_token_secret ResetPasswordToken_Secret_Field) (
+	reset_password_token *ResetPasswordToken, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_ResetPasswordToken_By_Secret(ctx, reset_password_token_secret)
+}
+
 func (rx *Rx) Get_User_By_Email_And_Status_Not_Number(ctx context.Context,
 	user_email User_Email_Field) (
 	user *User, err error) {
@@ -9692,14 +12391,14 @@ func (rx *Rx) Limited_BucketUsage_By_BucketId_And_RollupEndTime_Greater_And_Roll
 	return tx.Limited_BucketUsage_By_BucketId_And_RollupEndTime_Greater_And_RollupEndTime_LessOrEqual_OrderBy_Desc_RollupEndTime(ctx, bucket_usage_bucket_id, bucket_usage_rollup_end_time_greater, bucket_usage_rollup_end_time_less_or_equal, limit, offset)
 }
 
-func (rx *Rx) Limited_Injuredsegment(ctx context.Context,
+func (rx *Rx) Limited_Injuredsegment_OrderBy_Asc_Health(ctx context.Context,
 	limit int, offset int64) (
 	rows []*Injuredsegment, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Limited_Injuredsegment(ctx, limit, offset)
+	return tx.Limited_Injuredsegment_OrderBy_Asc_Health(ctx, limit, offset)
 }
 
 func (rx *Rx) Limited_Irreparabledb_OrderBy_Asc_Segmentpath(ctx context.Context,
@@ -9756,6 +12455,29 @@ func (rx *Rx) Update_ApiKey_By_Id(ctx context.Context,
 	return tx.Update_ApiKey_By_Id(ctx, api_key_id, update)
 }
 
+func (rx *Rx) Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+	bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+	update BucketLifecycle_Update_Fields) (
+	bucket_lifecycle *BucketLifecycle, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx, bucket_lifecycle_project_id, bucket_lifecycle_bucket_name, update)
+}
+
+func (rx *Rx) Update_BucketPlacement_By_BucketId(ctx context.Context,
+	bucket_placement_bucket_id BucketPlacement_BucketId_Field,
+	update BucketPlacement_Update_Fields) (
+	bucket_placement *BucketPlacement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_BucketPlacement_By_BucketId(ctx, bucket_placement_bucket_id, update)
+}
+
 func (rx *Rx) Update_CertRecord_By_Id(ctx context.Context,
 	certRecord_id CertRecord_Id_Field,
 	update CertRecord_Update_Fields) (
@@ -9789,6 +12511,29 @@ func (rx *Rx) Update_Node_By_Id(ctx context.Context,
 	return tx.Update_Node_By_Id(ctx, node_id, update)
 }
 
+func (rx *Rx) Update_PendingAudits_By_NodeId(ctx context.Context,
+	pending_audits_node_id PendingAudits_NodeId_Field,
+	update PendingAudits_Update_Fields) (
+	pending_audits *PendingAudits, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_PendingAudits_By_NodeId(ctx, pending_audits_node_id, update)
+}
+
+func (rx *Rx) Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	update ProjectMember_Update_Fields) (
+	project_member *ProjectMember, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectMember_By_MemberId_And_ProjectId(ctx, project_member_member_id, project_member_project_id, update)
+}
+
 func (rx *Rx) Update_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field,
 	update Project_Update_Fields) (
@@ -9834,10 +12579,17 @@ type Methods interface {
 		accounting_rollup_start_time_greater_or_equal AccountingRollup_StartTime_Field) (
 		rows []*AccountingRollup, err error)
 
+	All_ApiKeyRevocation_By_ApiKeyId(ctx context.Context,
+		api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+		rows []*ApiKeyRevocation, err error)
+
 	All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 		api_key_project_id ApiKey_ProjectId_Field) (
 		rows []*ApiKey, err error)
 
+	All_BucketLifecycle(ctx context.Context) (
+		rows []*BucketLifecycle, err error)
+
 	All_BucketStorageTally_By_ProjectId_And_BucketName_And_IntervalStart_GreaterOrEqual_And_IntervalStart_LessOrEqual_OrderBy_Desc_IntervalStart(ctx context.Context,
 		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
 		bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
@@ -9891,9 +12643,26 @@ type Methods interface {
 		api_key_id ApiKey_Id_Field,
 		api_key_project_id ApiKey_ProjectId_Field,
 		api_key_key ApiKey_Key_Field,
+		api_key_secret ApiKey_Secret_Field,
 		api_key_name ApiKey_Name_Field) (
 		api_key *ApiKey, err error)
 
+	Create_ApiKeyRevocation(ctx context.Context,
+		api_key_revocation_tail ApiKeyRevocation_Tail_Field,
+		api_key_revocation_api_key_id ApiKeyRevocation_ApiKeyId_Field) (
+		api_key_revocation *ApiKeyRevocation, err error)
+
+	Create_BucketLifecycle(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+		bucket_lifecycle_rules BucketLifecycle_Rules_Field) (
+		bucket_lifecycle *BucketLifecycle, err error)
+
+	Create_BucketPlacement(ctx context.Context,
+		bucket_placement_bucket_id BucketPlacement_BucketId_Field,
+		bucket_placement_countries BucketPlacement_Countries_Field) (
+		bucket_placement *BucketPlacement, err error)
+
 	Create_BucketStorageTally(ctx context.Context,
 		bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
 		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
@@ -9926,10 +12695,6 @@ type Methods interface {
 		certRecord_id CertRecord_Id_Field) (
 		certRecord *CertRecord, err error)
 
-	Create_Injuredsegment(ctx context.Context,
-		injuredsegment_info Injuredsegment_Info_Field) (
-		injuredsegment *Injuredsegment, err error)
-
 	Create_Irreparabledb(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 		irreparabledb_segmentdetail Irreparabledb_Segmentdetail_Field,
@@ -9955,18 +12720,38 @@ type Methods interface {
 		node_total_uptime_count Node_TotalUptimeCount_Field,
 		node_uptime_ratio Node_UptimeRatio_Field,
 		node_last_contact_success Node_LastContactSuccess_Field,
-		node_last_contact_failure Node_LastContactFailure_Field) (
+		node_last_contact_failure Node_LastContactFailure_Field,
+		node_last_net Node_LastNet_Field,
+		node_country_code Node_CountryCode_Field,
+		node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+		node_audit_reputation_beta Node_AuditReputationBeta_Field,
+		node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+		node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+		optional Node_Create_Fields) (
 		node *Node, err error)
 
+	Create_PendingAudits(ctx context.Context,
+		pending_audits_node_id PendingAudits_NodeId_Field,
+		pending_audits_piece_id PendingAudits_PieceId_Field,
+		pending_audits_stripe_index PendingAudits_StripeIndex_Field,
+		pending_audits_share_size PendingAudits_ShareSize_Field,
+		pending_audits_expected_share_hash PendingAudits_ExpectedShareHash_Field,
+		pending_audits_reverify_count PendingAudits_ReverifyCount_Field,
+		pending_audits_path PendingAudits_Path_Field) (
+		pending_audits *PendingAudits, err error)
+
 	Create_Project(ctx context.Context,
 		project_id Project_Id_Field,
 		project_name Project_Name_Field,
-		project_description Project_Description_Field) (
+		project_description Project_Description_Field,
+		project_storage_limit Project_StorageLimit_Field,
+		project_bandwidth_limit Project_BandwidthLimit_Field) (
 		project *Project, err error)
 
 	Create_ProjectMember(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
-		project_member_project_id ProjectMember_ProjectId_Field) (
+		project_member_project_id ProjectMember_ProjectId_Field,
+		project_member_role ProjectMember_Role_Field) (
 		project_member *ProjectMember, err error)
 
 	Create_RegistrationToken(ctx context.Context,
@@ -9975,6 +12760,11 @@ type Methods interface {
 		optional RegistrationToken_Create_Fields) (
 		registration_token *RegistrationToken, err error)
 
+	Create_ResetPasswordToken(ctx context.Context,
+		reset_password_token_secret ResetPasswordToken_Secret_Field,
+		reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+		reset_password_token *ResetPasswordToken, err error)
+
 	Create_SerialNumber(ctx context.Context,
 		serial_number_serial_number SerialNumber_SerialNumber_Field,
 		serial_number_bucket_id SerialNumber_BucketId_Field,
@@ -10006,6 +12796,15 @@ type Methods interface {
 		api_key_id ApiKey_Id_Field) (
 		deleted bool, err error)
 
+	Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+		deleted bool, err error)
+
+	Delete_BucketPlacement_By_BucketId(ctx context.Context,
+		bucket_placement_bucket_id BucketPlacement_BucketId_Field) (
+		deleted bool, err error)
+
 	Delete_BucketUsage_By_Id(ctx context.Context,
 		bucket_usage_id BucketUsage_Id_Field) (
 		deleted bool, err error)
@@ -10014,8 +12813,8 @@ type Methods interface {
 		certRecord_id CertRecord_Id_Field) (
 		deleted bool, err error)
 
-	Delete_Injuredsegment_By_Id(ctx context.Context,
-		injuredsegment_id Injuredsegment_Id_Field) (
+	Delete_Injuredsegment_By_Path(ctx context.Context,
+		injuredsegment_path Injuredsegment_Path_Field) (
 		deleted bool, err error)
 
 	Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
@@ -10026,6 +12825,10 @@ type Methods interface {
 		node_id Node_Id_Field) (
 		deleted bool, err error)
 
+	Delete_PendingAudits_By_NodeId(ctx context.Context,
+		pending_audits_node_id PendingAudits_NodeId_Field) (
+		deleted bool, err error)
+
 	Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
@@ -10035,6 +12838,10 @@ type Methods interface {
 		project_id Project_Id_Field) (
 		deleted bool, err error)
 
+	Delete_ResetPasswordToken_By_Secret(ctx context.Context,
+		reset_password_token_secret ResetPasswordToken_Secret_Field) (
+		deleted bool, err error)
+
 	Delete_SerialNumber_By_ExpiresAt_LessOrEqual(ctx context.Context,
 		serial_number_expires_at_less_or_equal SerialNumber_ExpiresAt_Field) (
 		count int64, err error)
@@ -10068,9 +12875,6 @@ type Methods interface {
 		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field) (
 		bucket_storage_tally *BucketStorageTally, err error)
 
-	First_Injuredsegment(ctx context.Context) (
-		injuredsegment *Injuredsegment, err error)
-
 	Get_AccountingRaw_By_Id(ctx context.Context,
 		accounting_raw_id AccountingRaw_Id_Field) (
 		accounting_raw *AccountingRaw, err error)
@@ -10087,6 +12891,15 @@ type Methods interface {
 		api_key_key ApiKey_Key_Field) (
 		api_key *ApiKey, err error)
 
+	Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field) (
+		bucket_lifecycle *BucketLifecycle, err error)
+
+	Get_BucketPlacement_By_BucketId(ctx context.Context,
+		bucket_placement_bucket_id BucketPlacement_BucketId_Field) (
+		bucket_placement *BucketPlacement, err error)
+
 	Get_BucketUsage_By_Id(ctx context.Context,
 		bucket_usage_id BucketUsage_Id_Field) (
 		bucket_usage *BucketUsage, err error)
@@ -10103,6 +12916,10 @@ type Methods interface {
 		node_id Node_Id_Field) (
 		node *Node, err error)
 
+	Get_PendingAudits_By_NodeId(ctx context.Context,
+		pending_audits_node_id PendingAudits_NodeId_Field) (
+		pending_audits *PendingAudits, err error)
+
 	Get_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field) (
 		project *Project, err error)
@@ -10115,6 +12932,14 @@ type Methods interface {
 		registration_token_secret RegistrationToken_Secret_Field) (
 		registration_token *RegistrationToken, err error)
 
+	Get_ResetPasswordToken_By_OwnerId(ctx context.Context,
+		reset_password_token_owner_id ResetPasswordToken_OwnerId_Field) (
+		reset_password_token *ResetPasswordToken, err error)
+
+	Get_ResetPasswordToken_By_Secret(ctx context.Context,
+		reset_password_token_secret ResetPasswordToken_Secret_Field) (
+		reset_password_token *ResetPasswordToken, err error)
+
 	Get_User_By_Email_And_Status_Not_Number(ctx context.Context,
 		user_email User_Email_Field) (
 		user *User, err error)
@@ -10137,7 +12962,7 @@ type Methods interface {
 		limit int, offset int64) (
 		rows []*BucketUsage, err error)
 
-	Limited_Injuredsegment(ctx context.Context,
+	Limited_Injuredsegment_OrderBy_Asc_Health(ctx context.Context,
 		limit int, offset int64) (
 		rows []*Injuredsegment, err error)
 
@@ -10165,6 +12990,17 @@ type Methods interface {
 		update ApiKey_Update_Fields) (
 		api_key *ApiKey, err error)
 
+	Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_project_id BucketLifecycle_ProjectId_Field,
+		bucket_lifecycle_bucket_name BucketLifecycle_BucketName_Field,
+		update BucketLifecycle_Update_Fields) (
+		bucket_lifecycle *BucketLifecycle, err error)
+
+	Update_BucketPlacement_By_BucketId(ctx context.Context,
+		bucket_placement_bucket_id BucketPlacement_BucketId_Field,
+		update BucketPlacement_Update_Fields) (
+		bucket_placement *BucketPlacement, err error)
+
 	Update_CertRecord_By_Id(ctx context.Context,
 		certRecord_id CertRecord_Id_Field,
 		update CertRecord_Update_Fields) (
@@ -10180,6 +13016,17 @@ type Methods interface {
 		update Node_Update_Fields) (
 		node *Node, err error)
 
+	Update_PendingAudits_By_NodeId(ctx context.Context,
+		pending_audits_node_id PendingAudits_NodeId_Field,
+		update PendingAudits_Update_Fields) (
+		pending_audits *PendingAudits, err error)
+
+	Update_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+		project_member_member_id ProjectMember_MemberId_Field,
+		project_member_project_id ProjectMember_ProjectId_Field,
+		update ProjectMember_Update_Fields) (
+		project_member *ProjectMember, err error)
+
 	Update_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field,
 		update Project_Update_Fields) (
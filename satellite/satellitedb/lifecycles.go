@@ -0,0 +1,121 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/lifecycle"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// lifecycles is an implementation of lifecycle.DB using spacemonkeygo/dbx orm
+type lifecycles struct {
+	db *dbx.DB
+}
+
+// Set replaces the lifecycle rules of a bucket
+func (db *lifecycles) Set(ctx context.Context, projectID uuid.UUID, bucket []byte, lifecycle *pb.BucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rules, err := proto.Marshal(lifecycle)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	updated, err := db.db.Update_BucketLifecycle_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycle_ProjectId(projectID[:]),
+		dbx.BucketLifecycle_BucketName(bucket),
+		dbx.BucketLifecycle_Update_Fields{
+			Rules: dbx.BucketLifecycle_Rules(rules),
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated != nil {
+		return nil
+	}
+
+	_, err = db.db.Create_BucketLifecycle(ctx,
+		dbx.BucketLifecycle_ProjectId(projectID[:]),
+		dbx.BucketLifecycle_BucketName(bucket),
+		dbx.BucketLifecycle_Rules(rules),
+	)
+	return Error.Wrap(err)
+}
+
+// Get returns the lifecycle rules of a bucket, which are empty when none are set
+func (db *lifecycles) Get(ctx context.Context, projectID uuid.UUID, bucket []byte) (_ *pb.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row, err := db.db.Get_BucketLifecycle_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycle_ProjectId(projectID[:]),
+		dbx.BucketLifecycle_BucketName(bucket),
+	)
+	if err == sql.ErrNoRows {
+		return &pb.BucketLifecycle{}, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return lifecycleFromDBX(row.Rules)
+}
+
+// Delete removes the lifecycle rules of a bucket
+func (db *lifecycles) Delete(ctx context.Context, projectID uuid.UUID, bucket []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Delete_BucketLifecycle_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycle_ProjectId(projectID[:]),
+		dbx.BucketLifecycle_BucketName(bucket),
+	)
+	return Error.Wrap(err)
+}
+
+// List returns the lifecycle rules of all buckets
+func (db *lifecycles) List(ctx context.Context) (_ []lifecycle.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.All_BucketLifecycle(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	configs := make([]lifecycle.BucketLifecycle, 0, len(rows))
+	for _, row := range rows {
+		projectID, err := bytesToUUID(row.ProjectId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		rules, err := lifecycleFromDBX(row.Rules)
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, lifecycle.BucketLifecycle{
+			ProjectID: projectID,
+			Bucket:    row.BucketName,
+			Lifecycle: rules,
+		})
+	}
+
+	return configs, nil
+}
+
+// lifecycleFromDBX unmarshals the stored lifecycle rules
+func lifecycleFromDBX(rules []byte) (*pb.BucketLifecycle, error) {
+	lifecycle := &pb.BucketLifecycle{}
+	if err := proto.Unmarshal(rules, lifecycle); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return lifecycle, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/skyrings/skyring-common/tools/uuid"
 
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/certdb"
 	"storj.io/storj/pkg/datarepair/irreparable"
@@ -23,6 +24,7 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/lifecycle"
 	"storj.io/storj/satellite/orders"
 )
 
@@ -64,6 +66,13 @@ func (m *lockedAccounting) DeleteRawBefore(ctx context.Context, latestRollup tim
 	return m.db.DeleteRawBefore(ctx, latestRollup)
 }
 
+// GetProjectLimits returns the usage limits of a project
+func (m *lockedAccounting) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (accounting.ProjectLimits, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetProjectLimits(ctx, projectID)
+}
+
 // GetRaw retrieves all raw tallies
 func (m *lockedAccounting) GetRaw(ctx context.Context) ([]*accounting.Raw, error) {
 	m.Lock()
@@ -93,10 +102,10 @@ func (m *lockedAccounting) LastTimestamp(ctx context.Context, timestampType stri
 }
 
 // ProjectBandwidthTotal returns the sum of GET bandwidth usage for a projectID in the past time frame
-func (m *lockedAccounting) ProjectBandwidthTotal(ctx context.Context, bucketID []byte, from time.Time) (int64, error) {
+func (m *lockedAccounting) ProjectBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (int64, error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.ProjectBandwidthTotal(ctx, bucketID, from)
+	return m.db.ProjectBandwidthTotal(ctx, projectID, from)
 }
 
 // ProjectStorageTotals returns the current inline and remote storage usage for a projectID
@@ -134,6 +143,13 @@ func (m *lockedAccounting) SaveRollup(ctx context.Context, latestTally time.Time
 	return m.db.SaveRollup(ctx, latestTally, stats)
 }
 
+// UpdateProjectLimits sets the usage limits of a project
+func (m *lockedAccounting) UpdateProjectLimits(ctx context.Context, projectID uuid.UUID, limits accounting.ProjectLimits) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateProjectLimits(ctx, projectID, limits)
+}
+
 // BandwidthAgreement returns database for storing bandwidth agreements
 func (m *locked) BandwidthAgreement() bwagreement.DB {
 	m.Lock()
@@ -277,6 +293,20 @@ func (m *lockedAPIKeys) GetByProjectID(ctx context.Context, projectID uuid.UUID)
 	return m.db.GetByProjectID(ctx, projectID)
 }
 
+// GetRevocations retrieves the revoked tails of the api key with given ID
+func (m *lockedAPIKeys) GetRevocations(ctx context.Context, id uuid.UUID) ([][]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetRevocations(ctx, id)
+}
+
+// Revoke revokes the API keys restricted from the api key with given ID whose chain contains tail
+func (m *lockedAPIKeys) Revoke(ctx context.Context, id uuid.UUID, tail []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Revoke(ctx, id, tail)
+}
+
 // Update updates APIKeyInfo in store
 func (m *lockedAPIKeys) Update(ctx context.Context, key console.APIKeyInfo) error {
 	m.Lock()
@@ -356,10 +386,17 @@ func (m *lockedProjectMembers) GetByProjectID(ctx context.Context, projectID uui
 }
 
 // Insert is a method for inserting project member into the database.
-func (m *lockedProjectMembers) Insert(ctx context.Context, memberID uuid.UUID, projectID uuid.UUID) (*console.ProjectMember, error) {
+func (m *lockedProjectMembers) Insert(ctx context.Context, memberID uuid.UUID, projectID uuid.UUID, role console.ProjectMemberRole) (*console.ProjectMember, error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.Insert(ctx, memberID, projectID)
+	return m.db.Insert(ctx, memberID, projectID, role)
+}
+
+// UpdateRole is a method for changing the role of project member in the database.
+func (m *lockedProjectMembers) UpdateRole(ctx context.Context, memberID uuid.UUID, projectID uuid.UUID, role console.ProjectMemberRole) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateRole(ctx, memberID, projectID, role)
 }
 
 // Projects is a getter for Projects repository
@@ -458,6 +495,47 @@ func (m *lockedRegistrationTokens) UpdateOwner(ctx context.Context, secret conso
 	return m.db.UpdateOwner(ctx, secret, ownerID)
 }
 
+// ResetPasswordTokens is a getter for ResetPasswordTokens repository
+func (m *lockedConsole) ResetPasswordTokens() console.ResetPasswordTokens {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedResetPasswordTokens{m.Locker, m.db.ResetPasswordTokens()}
+}
+
+// lockedResetPasswordTokens implements locking wrapper for console.ResetPasswordTokens
+type lockedResetPasswordTokens struct {
+	sync.Locker
+	db console.ResetPasswordTokens
+}
+
+// Create creates new reset password token
+func (m *lockedResetPasswordTokens) Create(ctx context.Context, ownerID uuid.UUID) (*console.ResetPasswordToken, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Create(ctx, ownerID)
+}
+
+// Delete deletes ResetPasswordToken by ResetPasswordSecret
+func (m *lockedResetPasswordTokens) Delete(ctx context.Context, secret console.ResetPasswordSecret) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, secret)
+}
+
+// GetByOwnerID retrieves ResetPasswordToken by ownerID
+func (m *lockedResetPasswordTokens) GetByOwnerID(ctx context.Context, ownerID uuid.UUID) (*console.ResetPasswordToken, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByOwnerID(ctx, ownerID)
+}
+
+// GetBySecret retrieves ResetPasswordToken with given secret
+func (m *lockedResetPasswordTokens) GetBySecret(ctx context.Context, secret console.ResetPasswordSecret) (*console.ResetPasswordToken, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetBySecret(ctx, secret)
+}
+
 // UsageRollups is a getter for UsageRollups repository
 func (m *lockedConsole) UsageRollups() console.UsageRollups {
 	m.Lock()
@@ -490,6 +568,14 @@ type lockedUsers struct {
 	db console.Users
 }
 
+// AcceptMFAPasscode records the time step of the accepted MFA passcode of the user and resets the failed attempts,
+// it returns false when a passcode of the same or a later time step was accepted already.
+func (m *lockedUsers) AcceptMFAPasscode(ctx context.Context, id uuid.UUID, step int64) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.AcceptMFAPasscode(ctx, id, step)
+}
+
 // Delete is a method for deleting user by Id from the database.
 func (m *lockedUsers) Delete(ctx context.Context, id uuid.UUID) error {
 	m.Lock()
@@ -497,6 +583,13 @@ func (m *lockedUsers) Delete(ctx context.Context, id uuid.UUID) error {
 	return m.db.Delete(ctx, id)
 }
 
+// FailMFAAttempt counts a failed MFA attempt of the user, the maxAttempts-th one locks MFA until lockedUntil.
+func (m *lockedUsers) FailMFAAttempt(ctx context.Context, id uuid.UUID, maxAttempts int, lockedUntil time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.FailMFAAttempt(ctx, id, maxAttempts, lockedUntil)
+}
+
 // Get is a method for querying user from the database by id.
 func (m *lockedUsers) Get(ctx context.Context, id uuid.UUID) (*console.User, error) {
 	m.Lock()
@@ -518,6 +611,13 @@ func (m *lockedUsers) Insert(ctx context.Context, user *console.User) (*console.
 	return m.db.Insert(ctx, user)
 }
 
+// ResetMFAAttempts resets the failed MFA attempts of the user and unlocks MFA.
+func (m *lockedUsers) ResetMFAAttempts(ctx context.Context, id uuid.UUID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ResetMFAAttempts(ctx, id)
+}
+
 // Update is a method for updating user entity.
 func (m *lockedUsers) Update(ctx context.Context, user *console.User) error {
 	m.Lock()
@@ -525,6 +625,41 @@ func (m *lockedUsers) Update(ctx context.Context, user *console.User) error {
 	return m.db.Update(ctx, user)
 }
 
+// Containment returns database for storing pending audit info
+func (m *locked) Containment() audit.Containment {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedContainment{m.Locker, m.db.Containment()}
+}
+
+// lockedContainment implements locking wrapper for audit.Containment
+type lockedContainment struct {
+	sync.Locker
+	db audit.Containment
+}
+
+// Delete releases the node from containment mode
+func (m *lockedContainment) Delete(ctx context.Context, nodeID storj.NodeID) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, nodeID)
+}
+
+// Get returns the pending audit of the node, or ErrContainedNotFound
+func (m *lockedContainment) Get(ctx context.Context, nodeID storj.NodeID) (*audit.PendingAudit, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, nodeID)
+}
+
+// IncrementPending contains the node with the pending audit, or increments
+// the reverify count when the node is already contained with the same audit
+func (m *lockedContainment) IncrementPending(ctx context.Context, pendingAudit *audit.PendingAudit) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.IncrementPending(ctx, pendingAudit)
+}
+
 // CreateSchema sets the schema
 func (m *locked) CreateSchema(schema string) error {
 	m.Lock()
@@ -587,6 +722,47 @@ func (m *lockedIrreparable) IncrementRepairAttempts(ctx context.Context, segment
 	return m.db.IncrementRepairAttempts(ctx, segmentInfo)
 }
 
+// Lifecycles returns database for bucket lifecycle rules
+func (m *locked) Lifecycles() lifecycle.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedLifecycles{m.Locker, m.db.Lifecycles()}
+}
+
+// lockedLifecycles implements locking wrapper for lifecycle.DB
+type lockedLifecycles struct {
+	sync.Locker
+	db lifecycle.DB
+}
+
+// Delete removes the lifecycle rules of a bucket
+func (m *lockedLifecycles) Delete(ctx context.Context, projectID uuid.UUID, bucket []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, projectID, bucket)
+}
+
+// Get returns the lifecycle rules of a bucket, which are empty when none are set
+func (m *lockedLifecycles) Get(ctx context.Context, projectID uuid.UUID, bucket []byte) (*pb.BucketLifecycle, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, bucket)
+}
+
+// List returns the lifecycle rules of all buckets
+func (m *lockedLifecycles) List(ctx context.Context) ([]lifecycle.BucketLifecycle, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.List(ctx)
+}
+
+// Set replaces the lifecycle rules of a bucket
+func (m *lockedLifecycles) Set(ctx context.Context, projectID uuid.UUID, bucket []byte, lifecycle *pb.BucketLifecycle) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Set(ctx, projectID, bucket, lifecycle)
+}
+
 // Orders returns database for orders
 func (m *locked) Orders() orders.DB {
 	m.Lock()
@@ -690,7 +866,7 @@ func (m *lockedOverlayCache) CreateStats(ctx context.Context, nodeID storj.NodeI
 	return m.db.CreateStats(ctx, nodeID, initial)
 }
 
-// FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements.
+// FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements or are disqualified.
 func (m *lockedOverlayCache) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *overlay.NodeStats) (invalid storj.NodeIDList, err error) {
 	m.Lock()
 	defer m.Unlock()
@@ -711,6 +887,13 @@ func (m *lockedOverlayCache) GetAll(ctx context.Context, nodeIDs storj.NodeIDLis
 	return m.db.GetAll(ctx, nodeIDs)
 }
 
+// GetBucketPlacement returns the countries the pieces of a bucket must be placed in, nil when unconstrained
+func (m *lockedOverlayCache) GetBucketPlacement(ctx context.Context, bucketID []byte) ([]string, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetBucketPlacement(ctx, bucketID)
+}
+
 // List lists nodes starting from cursor
 func (m *lockedOverlayCache) List(ctx context.Context, cursor storj.NodeID, limit int) ([]*overlay.NodeDossier, error) {
 	m.Lock()
@@ -739,11 +922,19 @@ func (m *lockedOverlayCache) SelectStorageNodes(ctx context.Context, count int,
 	return m.db.SelectStorageNodes(ctx, count, criteria)
 }
 
-// Update updates node information
-func (m *lockedOverlayCache) Update(ctx context.Context, value *pb.Node) error {
+// SetBucketPlacement sets the countries the pieces of a bucket must be placed in, no countries remove the constraint
+func (m *lockedOverlayCache) SetBucketPlacement(ctx context.Context, bucketID []byte, countries []string) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SetBucketPlacement(ctx, bucketID, countries)
+}
+
+// Update updates node information and the network it was reached at,
+// new nodes start with the initial reputation of defaults
+func (m *lockedOverlayCache) Update(ctx context.Context, value *pb.Node, network overlay.NodeNetwork, defaults overlay.NodeSelectionConfig) error {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.Update(ctx, value)
+	return m.db.Update(ctx, value, network, defaults)
 }
 
 // UpdateOperator updates the email and wallet for a given node ID for satellite payments.
@@ -761,10 +952,10 @@ func (m *lockedOverlayCache) UpdateStats(ctx context.Context, request *overlay.U
 }
 
 // UpdateUptime updates a single storagenode's uptime stats.
-func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *overlay.NodeStats, err error) {
+func (m *lockedOverlayCache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda float64, weight float64, uptimeDQ float64) (stats *overlay.NodeStats, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateUptime(ctx, nodeID, isUp)
+	return m.db.UpdateUptime(ctx, nodeID, isUp, lambda, weight, uptimeDQ)
 }
 
 // RepairQueue returns queue for segments that need repairing
@@ -780,21 +971,28 @@ type lockedRepairQueue struct {
 	db queue.RepairQueue
 }
 
-// Dequeue removes an injured segment.
-func (m *lockedRepairQueue) Dequeue(ctx context.Context) (pb.InjuredSegment, error) {
+// Delete removes a repaired injured segment.
+func (m *lockedRepairQueue) Delete(ctx context.Context, qi *pb.InjuredSegment) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, qi)
+}
+
+// Dequeue leases the least healthy injured segment that isn't leased yet.
+func (m *lockedRepairQueue) Dequeue(ctx context.Context, lease time.Duration) (pb.InjuredSegment, error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.Dequeue(ctx)
+	return m.db.Dequeue(ctx, lease)
 }
 
-// Enqueue adds an injured segment.
+// Enqueue adds an injured segment or updates the queued segment with the same path.
 func (m *lockedRepairQueue) Enqueue(ctx context.Context, qi *pb.InjuredSegment) error {
 	m.Lock()
 	defer m.Unlock()
 	return m.db.Enqueue(ctx, qi)
 }
 
-// Peekqueue lists limit amount of injured segments.
+// Peekqueue lists limit amount of injured segments, least healthy first.
 func (m *lockedRepairQueue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSegment, error) {
 	m.Lock()
 	defer m.Unlock()
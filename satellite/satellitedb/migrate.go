@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/migrate"
+	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/satellite/console"
 )
@@ -530,10 +531,211 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`ALTER TABLE bucket_bandwidth_rollups ADD CONSTRAINT bucket_bandwidth_rollups_pk PRIMARY KEY (bucket_name, project_id, interval_start, action);`,
 				},
 			},
+			{
+				Description: "Add bucket_lifecycles table",
+				Version:     14,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_lifecycles (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						rules bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				Description: "Add network and country of nodes and bucket_placements table",
+				Version:     15,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD last_net TEXT NOT NULL DEFAULT '';
+					 ALTER TABLE nodes ADD country_code TEXT NOT NULL DEFAULT '';`,
+					`CREATE TABLE bucket_placements (
+						bucket_id bytea NOT NULL,
+						countries text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( bucket_id )
+					);`,
+				},
+			},
+			{
+				Description: "Add secret of api keys and api_key_revocations table",
+				Version:     16,
+				Action: migrate.SQL{
+					`ALTER TABLE api_keys ADD secret bytea NOT NULL DEFAULT ''::bytea;`,
+					`CREATE TABLE api_key_revocations (
+						tail bytea NOT NULL,
+						api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( tail )
+					);`,
+				},
+			},
+			{
+				Description: "Key the repair queue by path and order it by segment health",
+				Version:     17,
+				Action: migrate.Func(func(log *zap.Logger, db migrate.DB, tx *sql.Tx) error {
+					_, err := tx.Exec(`
+						ALTER TABLE injuredsegments
+							ADD COLUMN path text,
+							ADD COLUMN data bytea,
+							ADD COLUMN health double precision,
+							ADD COLUMN leased_until timestamp with time zone;
+					`)
+					if err != nil {
+						return ErrMigrate.Wrap(err)
+					}
+
+					if err := migrateInjuredSegments(tx); err != nil {
+						return err
+					}
+
+					// the queue may contain the same segment more than once
+					_, err = tx.Exec(`
+						DELETE FROM injuredsegments later USING injuredsegments earlier
+							WHERE later.path = earlier.path AND later.id > earlier.id;
+						ALTER TABLE injuredsegments DROP COLUMN id, DROP COLUMN info;
+						ALTER TABLE injuredsegments
+							ALTER COLUMN path SET NOT NULL,
+							ALTER COLUMN data SET NOT NULL,
+							ALTER COLUMN health SET NOT NULL,
+							ADD PRIMARY KEY ( path );
+						CREATE INDEX injuredsegments_health_index ON injuredsegments ( health );
+					`)
+					return ErrMigrate.Wrap(err)
+				}),
+			},
+			{
+				Description: "Add pending audits for nodes in containment mode",
+				Version:     18,
+				Action: migrate.SQL{
+					`CREATE TABLE pending_audits (
+						node_id bytea NOT NULL,
+						piece_id bytea NOT NULL,
+						stripe_index bigint NOT NULL,
+						share_size bigint NOT NULL,
+						expected_share_hash bytea NOT NULL,
+						reverify_count bigint NOT NULL,
+						path text NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				Description: "Add reputation and disqualification to nodes",
+				Version:     19,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD audit_reputation_alpha double precision NOT NULL DEFAULT 1;`,
+					`ALTER TABLE nodes ADD audit_reputation_beta double precision NOT NULL DEFAULT 0;`,
+					`ALTER TABLE nodes ADD uptime_reputation_alpha double precision NOT NULL DEFAULT 1;`,
+					`ALTER TABLE nodes ADD uptime_reputation_beta double precision NOT NULL DEFAULT 0;`,
+					`ALTER TABLE nodes ADD disqualified timestamp with time zone;`,
+				},
+			},
+			{
+				Description: "Add usage limits to projects",
+				Version:     20,
+				Action: migrate.SQL{
+					`ALTER TABLE projects ADD storage_limit bigint NOT NULL DEFAULT 0;`,
+					`ALTER TABLE projects ADD bandwidth_limit bigint NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				Description: "Add roles to project members, the earliest member of a project becomes its owner",
+				Version:     21,
+				Action: migrate.SQL{
+					`ALTER TABLE project_members ADD role integer NOT NULL DEFAULT 2;`,
+					// members added at the same time are ordered by their id, so that every project has a single owner
+					`UPDATE project_members SET role = 4
+						WHERE (project_id, member_id) IN (
+							SELECT DISTINCT ON (project_id) project_id, member_id FROM project_members
+								ORDER BY project_id, created_at, member_id
+						);`,
+				},
+			},
+			{
+				Description: "Add reset password tokens table",
+				Version:     22,
+				Action: migrate.SQL{
+					`CREATE TABLE reset_password_tokens (
+						secret bytea NOT NULL,
+						owner_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( secret ),
+						UNIQUE ( owner_id )
+					);`,
+				},
+			},
+			{
+				Description: "Add two-factor authentication to users",
+				Version:     23,
+				Action: migrate.SQL{
+					`ALTER TABLE users ADD mfa_enabled boolean NOT NULL DEFAULT false;`,
+					`ALTER TABLE users ADD mfa_secret_key text;`,
+					`ALTER TABLE users ADD mfa_recovery_codes text;`,
+				},
+			},
+			{
+				Description: "Count the repair attempts of injured segments",
+				Version:     24,
+				Action: migrate.SQL{
+					`ALTER TABLE injuredsegments ADD attempts integer NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				Description: "Limit the two-factor authentication attempts of users",
+				Version:     25,
+				Action: migrate.SQL{
+					`ALTER TABLE users ADD mfa_failed_attempts integer NOT NULL DEFAULT 0;`,
+					`ALTER TABLE users ADD mfa_locked_until timestamp with time zone;`,
+					`ALTER TABLE users ADD mfa_last_step bigint NOT NULL DEFAULT 0;`,
+				},
+			},
 		},
 	}
 }
 
+// migrateInjuredSegments fills the path, data and health of the injured
+// segments from their info
+func migrateInjuredSegments(tx *sql.Tx) (err error) {
+	_, err = tx.Exec(`
+		DECLARE injuredsegments_cursor CURSOR FOR
+		SELECT info FROM injuredsegments
+		FOR UPDATE`)
+	if err != nil {
+		return ErrMigrate.Wrap(err)
+	}
+	defer func() {
+		_, closeErr := tx.Exec(`CLOSE injuredsegments_cursor`)
+		err = errs.Combine(err, ErrMigrate.Wrap(closeErr))
+	}()
+
+	for {
+		var info []byte
+		err := tx.QueryRow(`FETCH NEXT FROM injuredsegments_cursor`).Scan(&info)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil
+			}
+			return ErrMigrate.Wrap(err)
+		}
+
+		var seg pb.InjuredSegment
+		if err := proto.Unmarshal(info, &seg); err != nil {
+			return ErrMigrate.Wrap(err)
+		}
+
+		_, err = tx.Exec(`
+			UPDATE injuredsegments SET path = $1, data = $2, health = $3
+			WHERE CURRENT OF injuredsegments_cursor`, seg.Path, info, queue.Health(&seg))
+		if err != nil {
+			return ErrMigrate.Wrap(err)
+		}
+	}
+}
+
 func postgresHasColumn(tx *sql.Tx, table, column string) (bool, error) {
 	var columnName string
 	err := tx.QueryRow(`
@@ -33,7 +33,12 @@ type overlaycache struct {
 
 func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
 	nodeType := int(pb.NodeType_STORAGE)
-	return cache.queryFilteredNodes(ctx, criteria.Excluded, count, `
+	return cache.queryFilteredNodes(ctx, nodeFilter{
+		excluded:         criteria.Excluded,
+		countries:        criteria.Countries,
+		distinctIP:       criteria.DistinctIP,
+		distinctOperator: criteria.DistinctOperator,
+	}, count, `
 		WHERE type = ? AND free_bandwidth >= ? AND free_disk >= ?
 		  AND total_audit_count >= ?
 		  AND audit_success_ratio >= ?
@@ -41,6 +46,7 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 		  AND uptime_ratio >= ?
 		  AND last_contact_success > ?
 		  AND last_contact_success > last_contact_failure
+		  AND disqualified IS NULL
 		`, nodeType, criteria.FreeBandwidth, criteria.FreeDisk,
 		criteria.AuditCount, criteria.AuditSuccessRatio, criteria.UptimeCount, criteria.UptimeSuccessRatio,
 		time.Now().Add(-1*time.Hour),
@@ -49,56 +55,116 @@ func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, cr
 
 func (cache *overlaycache) SelectNewStorageNodes(ctx context.Context, count int, criteria *overlay.NewNodeCriteria) ([]*pb.Node, error) {
 	nodeType := int(pb.NodeType_STORAGE)
-	return cache.queryFilteredNodes(ctx, criteria.Excluded, count, `
+	return cache.queryFilteredNodes(ctx, nodeFilter{
+		excluded:         criteria.Excluded,
+		countries:        criteria.Countries,
+		distinctIP:       criteria.DistinctIP,
+		distinctOperator: criteria.DistinctOperator,
+	}, count, `
 		WHERE type = ? AND free_bandwidth >= ? AND free_disk >= ?
 		  AND total_audit_count < ?
 		  AND last_contact_success > ?
 		  AND last_contact_success > last_contact_failure
+		  AND disqualified IS NULL
 	`, nodeType, criteria.FreeBandwidth, criteria.FreeDisk,
 		criteria.AuditThreshold,
 		time.Now().Add(-1*time.Hour),
 	)
 }
 
-func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []storj.NodeID, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
+// nodeFilter are the restrictions of a node selection that don't fit in a single WHERE clause
+type nodeFilter struct {
+	excluded  []storj.NodeID
+	countries []string
+
+	// distinctIP and distinctOperator allow at most one node per network and
+	// per operator wallet, including the networks and operators of the excluded nodes
+	distinctIP       bool
+	distinctOperator bool
+}
+
+// distinctSelectionFactor is how many more nodes than requested are read
+// to select nodes on distinct networks or operators
+const distinctSelectionFactor = 10
+
+func (cache *overlaycache) queryFilteredNodes(ctx context.Context, filter nodeFilter, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
 	if count == 0 {
 		return nil, nil
 	}
 
 	safeExcludeNodes := ""
-	if len(excluded) > 0 {
-		safeExcludeNodes = ` AND id NOT IN (?` + strings.Repeat(", ?", len(excluded)-1) + `)`
+	if len(filter.excluded) > 0 {
+		safeExcludeNodes = ` AND id NOT IN (?` + strings.Repeat(", ?", len(filter.excluded)-1) + `)`
 	}
-	for _, id := range excluded {
+	for _, id := range filter.excluded {
 		args = append(args, id.Bytes())
 	}
-	args = append(args, count)
+
+	safeCountries := ""
+	if len(filter.countries) > 0 {
+		safeCountries = ` AND country_code IN (?` + strings.Repeat(", ?", len(filter.countries)-1) + `)`
+	}
+	for _, country := range filter.countries {
+		args = append(args, country)
+	}
+
+	distinct := filter.distinctIP || filter.distinctOperator
+
+	// the duplicates are skipped while reading, so a distinct selection reads
+	// a bounded multiple of the nodes it needs
+	limit := count
+	if distinct {
+		limit = count * distinctSelectionFactor
+	}
+	args = append(args, limit)
+
+	usedNets, usedWallets := map[string]bool{}, map[string]bool{}
+	if distinct && len(filter.excluded) > 0 {
+		usedNets, usedWallets, err = cache.usedNetworks(ctx, filter.excluded)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	rows, err := cache.db.Query(cache.db.Rebind(`SELECT id,
 		type, address, free_bandwidth, free_disk, audit_success_ratio,
 		uptime_ratio, total_audit_count, audit_success_count, total_uptime_count,
-		uptime_success_count
+		uptime_success_count, wallet, last_net
 		FROM nodes
-		`+safeQuery+safeExcludeNodes+`
-		ORDER BY RANDOM()
-		LIMIT ?`), args...)
+		`+safeQuery+safeExcludeNodes+safeCountries+`
+		ORDER BY RANDOM() LIMIT ?`), args...)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { err = errs.Combine(err, rows.Close()) }()
 
 	var nodes []*pb.Node
-	for rows.Next() {
+	for len(nodes) < count && rows.Next() {
 		dbNode := &dbx.Node{}
 		err = rows.Scan(&dbNode.Id, &dbNode.Type,
 			&dbNode.Address, &dbNode.FreeBandwidth, &dbNode.FreeDisk,
 			&dbNode.AuditSuccessRatio, &dbNode.UptimeRatio,
 			&dbNode.TotalAuditCount, &dbNode.AuditSuccessCount,
-			&dbNode.TotalUptimeCount, &dbNode.UptimeSuccessCount)
+			&dbNode.TotalUptimeCount, &dbNode.UptimeSuccessCount,
+			&dbNode.Wallet, &dbNode.LastNet)
 		if err != nil {
 			return nil, err
 		}
 
+		// nodes with an unknown network or operator can't be told apart
+		if filter.distinctIP && dbNode.LastNet != "" {
+			if usedNets[dbNode.LastNet] {
+				continue
+			}
+			usedNets[dbNode.LastNet] = true
+		}
+		if filter.distinctOperator && dbNode.Wallet != "" {
+			if usedWallets[dbNode.Wallet] {
+				continue
+			}
+			usedWallets[dbNode.Wallet] = true
+		}
+
 		dossier, err := convertDBNode(dbNode)
 		if err != nil {
 			return nil, err
@@ -109,6 +175,37 @@ func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []st
 	return nodes, rows.Err()
 }
 
+// usedNetworks returns the networks and the operator wallets of the given nodes
+func (cache *overlaycache) usedNetworks(ctx context.Context, ids []storj.NodeID) (nets, wallets map[string]bool, err error) {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id.Bytes()
+	}
+
+	rows, err := cache.db.Query(cache.db.Rebind(`SELECT last_net, wallet FROM nodes
+		WHERE id IN (?`+strings.Repeat(", ?", len(ids)-1)+`)`), args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	nets, wallets = map[string]bool{}, map[string]bool{}
+	for rows.Next() {
+		var lastNet, wallet string
+		if err := rows.Scan(&lastNet, &wallet); err != nil {
+			return nil, nil, err
+		}
+		if lastNet != "" {
+			nets[lastNet] = true
+		}
+		if wallet != "" {
+			wallets[wallet] = true
+		}
+	}
+
+	return nets, wallets, rows.Err()
+}
+
 // Get looks up the node by nodeID
 func (cache *overlaycache) Get(ctx context.Context, id storj.NodeID) (*overlay.NodeDossier, error) {
 	if id.IsZero() {
@@ -192,8 +289,8 @@ func (cache *overlaycache) Paginate(ctx context.Context, offset int64, limit int
 	return infos, more, nil
 }
 
-// Update updates node information
-func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error) {
+// Update updates node information and the network it was reached at
+func (cache *overlaycache) Update(ctx context.Context, info *pb.Node, network overlay.NodeNetwork, defaults overlay.NodeSelectionConfig) (err error) {
 	if info == nil || info.Id.IsZero() {
 		return overlay.ErrEmptyNode
 	}
@@ -250,6 +347,13 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 			dbx.Node_UptimeRatio(reputation.UptimeRatio),
 			dbx.Node_LastContactSuccess(time.Now()),
 			dbx.Node_LastContactFailure(time.Time{}),
+			dbx.Node_LastNet(network.LastNet),
+			dbx.Node_CountryCode(network.CountryCode),
+			dbx.Node_AuditReputationAlpha(defaults.AuditReputationAlpha0),
+			dbx.Node_AuditReputationBeta(defaults.AuditReputationBeta0),
+			dbx.Node_UptimeReputationAlpha(defaults.UptimeReputationAlpha0),
+			dbx.Node_UptimeReputationBeta(defaults.UptimeReputationBeta0),
+			dbx.Node_Create_Fields{},
 		)
 		if err != nil {
 			return Error.Wrap(errs.Combine(err, tx.Rollback()))
@@ -257,8 +361,10 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 	} else {
 		update := dbx.Node_Update_Fields{
 			// TODO: should we be able to update node type?
-			Address:  dbx.Node_Address(address.Address),
-			Protocol: dbx.Node_Protocol(int(address.Transport)),
+			Address:     dbx.Node_Address(address.Address),
+			Protocol:    dbx.Node_Protocol(int(address.Transport)),
+			LastNet:     dbx.Node_LastNet(network.LastNet),
+			CountryCode: dbx.Node_CountryCode(network.CountryCode),
 		}
 
 		if info.Reputation != nil {
@@ -378,11 +484,16 @@ func (cache *overlaycache) findInvalidNodesQuery(nodeIds storj.NodeIDList, audit
 		nodes.uptime_ratio
 		FROM nodes
 		WHERE nodes.id IN (?`+strings.Repeat(", ?", len(nodeIds)-1)+`)
-		AND nodes.total_audit_count > 0
-		AND nodes.total_uptime_count > 0
 		AND (
-			nodes.audit_success_ratio < ?
-			OR nodes.uptime_ratio < ?
+			nodes.disqualified IS NOT NULL
+			OR (
+				nodes.total_audit_count > 0
+				AND nodes.total_uptime_count > 0
+				AND (
+					nodes.audit_success_ratio < ?
+					OR nodes.uptime_ratio < ?
+				)
+			)
 		)`), args...)
 
 	return rows, err
@@ -422,13 +533,46 @@ func (cache *overlaycache) UpdateStats(ctx context.Context, updateReq *overlay.U
 		totalUptimeCount,
 	)
 
+	auditAlpha, auditBeta := updateReputation(
+		updateReq.AuditSuccess,
+		dbNode.AuditReputationAlpha,
+		dbNode.AuditReputationBeta,
+		updateReq.AuditLambda,
+		updateReq.AuditWeight,
+	)
+
+	uptimeAlpha, uptimeBeta := updateReputation(
+		updateReq.IsUp,
+		dbNode.UptimeReputationAlpha,
+		dbNode.UptimeReputationBeta,
+		updateReq.UptimeLambda,
+		updateReq.UptimeWeight,
+	)
+
 	updateFields := dbx.Node_Update_Fields{
-		AuditSuccessCount:  dbx.Node_AuditSuccessCount(auditSuccessCount),
-		TotalAuditCount:    dbx.Node_TotalAuditCount(totalAuditCount),
-		AuditSuccessRatio:  dbx.Node_AuditSuccessRatio(auditSuccessRatio),
-		UptimeSuccessCount: dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
-		TotalUptimeCount:   dbx.Node_TotalUptimeCount(totalUptimeCount),
-		UptimeRatio:        dbx.Node_UptimeRatio(uptimeRatio),
+		AuditSuccessCount:     dbx.Node_AuditSuccessCount(auditSuccessCount),
+		TotalAuditCount:       dbx.Node_TotalAuditCount(totalAuditCount),
+		AuditSuccessRatio:     dbx.Node_AuditSuccessRatio(auditSuccessRatio),
+		UptimeSuccessCount:    dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
+		TotalUptimeCount:      dbx.Node_TotalUptimeCount(totalUptimeCount),
+		UptimeRatio:           dbx.Node_UptimeRatio(uptimeRatio),
+		AuditReputationAlpha:  dbx.Node_AuditReputationAlpha(auditAlpha),
+		AuditReputationBeta:   dbx.Node_AuditReputationBeta(auditBeta),
+		UptimeReputationAlpha: dbx.Node_UptimeReputationAlpha(uptimeAlpha),
+		UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(uptimeBeta),
+	}
+
+	reputation := &overlay.NodeStats{
+		AuditReputationAlpha:  auditAlpha,
+		AuditReputationBeta:   auditBeta,
+		UptimeReputationAlpha: uptimeAlpha,
+		UptimeReputationBeta:  uptimeBeta,
+	}
+
+	// disqualification is permanent, a recovered reputation doesn't undo it
+	if dbNode.Disqualified == nil &&
+		(reputation.AuditReputation() < updateReq.AuditDQ || reputation.UptimeReputation() < updateReq.UptimeDQ) {
+		updateFields.Disqualified = dbx.Node_Disqualified(time.Now().UTC())
 	}
 
 	if updateReq.IsUp {
@@ -464,7 +608,7 @@ func (cache *overlaycache) UpdateOperator(ctx context.Context, nodeID storj.Node
 }
 
 // UpdateUptime updates a single storagenode's uptime stats in the db
-func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *overlay.NodeStats, err error) {
+func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, lambda, weight, uptimeDQ float64) (stats *overlay.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	tx, err := cache.db.Open(ctx)
@@ -492,6 +636,27 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
 	updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
 
+	uptimeAlpha, uptimeBeta := updateReputation(
+		isUp,
+		dbNode.UptimeReputationAlpha,
+		dbNode.UptimeReputationBeta,
+		lambda,
+		weight,
+	)
+
+	updateFields.UptimeReputationAlpha = dbx.Node_UptimeReputationAlpha(uptimeAlpha)
+	updateFields.UptimeReputationBeta = dbx.Node_UptimeReputationBeta(uptimeBeta)
+
+	reputation := &overlay.NodeStats{
+		UptimeReputationAlpha: uptimeAlpha,
+		UptimeReputationBeta:  uptimeBeta,
+	}
+
+	// disqualification is permanent, a recovered reputation doesn't undo it
+	if dbNode.Disqualified == nil && reputation.UptimeReputation() < uptimeDQ {
+		updateFields.Disqualified = dbx.Node_Disqualified(time.Now().UTC())
+	}
+
 	if isUp {
 		updateFields.LastContactSuccess = dbx.Node_LastContactSuccess(time.Now())
 	} else {
@@ -507,6 +672,51 @@ func (cache *overlaycache) UpdateUptime(ctx context.Context, nodeID storj.NodeID
 	return nodeStats, Error.Wrap(tx.Commit())
 }
 
+// SetBucketPlacement sets the countries the pieces of a bucket must be placed in, no countries remove the constraint
+func (cache *overlaycache) SetBucketPlacement(ctx context.Context, bucketID []byte, countries []string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(countries) == 0 {
+		_, err = cache.db.Delete_BucketPlacement_By_BucketId(ctx, dbx.BucketPlacement_BucketId(bucketID))
+		return Error.Wrap(err)
+	}
+
+	joined := strings.Join(countries, ",")
+	updated, err := cache.db.Update_BucketPlacement_By_BucketId(ctx,
+		dbx.BucketPlacement_BucketId(bucketID),
+		dbx.BucketPlacement_Update_Fields{
+			Countries: dbx.BucketPlacement_Countries(joined),
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated != nil {
+		return nil
+	}
+
+	_, err = cache.db.Create_BucketPlacement(ctx,
+		dbx.BucketPlacement_BucketId(bucketID),
+		dbx.BucketPlacement_Countries(joined),
+	)
+	return Error.Wrap(err)
+}
+
+// GetBucketPlacement returns the countries the pieces of a bucket must be placed in, nil when unconstrained
+func (cache *overlaycache) GetBucketPlacement(ctx context.Context, bucketID []byte) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	placement, err := cache.db.Get_BucketPlacement_By_BucketId(ctx, dbx.BucketPlacement_BucketId(bucketID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return strings.Split(placement.Countries, ","), nil
+}
+
 func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 	if info == nil {
 		return nil, Error.New("missing info")
@@ -545,6 +755,16 @@ func convertDBNode(info *dbx.Node) (*overlay.NodeDossier, error) {
 			UptimeSuccessCount: info.UptimeSuccessCount,
 			LastContactSuccess: info.LastContactSuccess,
 			LastContactFailure: info.LastContactFailure,
+
+			AuditReputationAlpha:  info.AuditReputationAlpha,
+			AuditReputationBeta:   info.AuditReputationBeta,
+			UptimeReputationAlpha: info.UptimeReputationAlpha,
+			UptimeReputationBeta:  info.UptimeReputationBeta,
+			Disqualified:          info.Disqualified,
+		},
+		Network: overlay.NodeNetwork{
+			LastNet:     info.LastNet,
+			CountryCode: info.CountryCode,
 		},
 	}
 
@@ -566,6 +786,12 @@ func getNodeStats(nodeID storj.NodeID, dbNode *dbx.Node) *overlay.NodeStats {
 		UptimeCount:        dbNode.TotalUptimeCount,
 		LastContactSuccess: dbNode.LastContactSuccess,
 		LastContactFailure: dbNode.LastContactFailure,
+
+		AuditReputationAlpha:  dbNode.AuditReputationAlpha,
+		AuditReputationBeta:   dbNode.AuditReputationBeta,
+		UptimeReputationAlpha: dbNode.UptimeReputationAlpha,
+		UptimeReputationBeta:  dbNode.UptimeReputationBeta,
+		Disqualified:          dbNode.Disqualified,
 	}
 	return nodeStats
 }
@@ -579,6 +805,19 @@ func updateRatioVars(newStatus bool, successCount, totalCount int64) (int64, int
 	return successCount, totalCount, newRatio
 }
 
+// updateReputation decays the shapes of the reputation and adds the outcome of the new event
+// with the given weight, so that recent events count more than the older ones
+func updateReputation(isSuccess bool, alpha, beta, lambda, weight float64) (newAlpha, newBeta float64) {
+	// v is +1 for a success and -1 for a failure, so a single event updates only one of the shapes
+	v := -1.0
+	if isSuccess {
+		v = 1.0
+	}
+	newAlpha = lambda*alpha + weight*(1+v)/2
+	newBeta = lambda*beta + weight*(1-v)/2
+	return newAlpha, newBeta
+}
+
 func checkRatioVars(successCount, totalCount int64) (ratio float64, err error) {
 	if successCount < 0 {
 		return 0, errs.New("success count less than 0")
@@ -9,6 +9,7 @@ import (
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/satellite/console"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -58,7 +59,9 @@ func (projects *projects) Insert(ctx context.Context, project *console.Project)
 	createdProject, err := projects.db.Create_Project(ctx,
 		dbx.Project_Id(projectID[:]),
 		dbx.Project_Name(project.Name),
-		dbx.Project_Description(project.Description))
+		dbx.Project_Description(project.Description),
+		dbx.Project_StorageLimit(project.StorageLimit.Int64()),
+		dbx.Project_BandwidthLimit(project.BandwidthLimit.Int64()))
 
 	if err != nil {
 		return nil, err
@@ -99,10 +102,12 @@ func projectFromDBX(project *dbx.Project) (*console.Project, error) {
 	}
 
 	u := &console.Project{
-		ID:          id,
-		Name:        project.Name,
-		Description: project.Description,
-		CreatedAt:   project.CreatedAt,
+		ID:             id,
+		Name:           project.Name,
+		Description:    project.Description,
+		StorageLimit:   memory.Size(project.StorageLimit),
+		BandwidthLimit: memory.Size(project.BandwidthLimit),
+		CreatedAt:      project.CreatedAt,
 	}
 
 	return u, nil
@@ -7,11 +7,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/lib/pq"
 	sqlite3 "github.com/mattn/go-sqlite3"
 
+	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/pb"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 	"storj.io/storj/storage"
@@ -21,39 +23,63 @@ type repairQueue struct {
 	db *dbx.DB
 }
 
+// Enqueue adds the segment, or updates the queued segment with the same path
+// without changing its lease and its attempts
 func (r *repairQueue) Enqueue(ctx context.Context, seg *pb.InjuredSegment) error {
-	val, err := proto.Marshal(seg)
+	val, err := marshalInjuredSegment(seg)
 	if err != nil {
 		return err
 	}
 
-	_, err = r.db.Create_Injuredsegment(
-		ctx,
-		dbx.Injuredsegment_Info(val),
-	)
+	_, err = r.db.ExecContext(ctx, r.db.Rebind(`
+		INSERT INTO injuredsegments ( path, data, health, attempts ) VALUES ( ?, ?, ?, 0 )
+		ON CONFLICT ( path ) DO UPDATE SET data = excluded.data, health = excluded.health
+	`), seg.Path, val, queue.Health(seg))
 	return err
 }
 
-func (r *repairQueue) postgresDequeue(ctx context.Context) (seg pb.InjuredSegment, err error) {
+// marshalInjuredSegment marshals the segment without its attempts, which are
+// kept in their own column
+func marshalInjuredSegment(seg *pb.InjuredSegment) ([]byte, error) {
+	stored := *seg
+	stored.Attempts = 0
+	return proto.Marshal(&stored)
+}
+
+func (r *repairQueue) postgresDequeue(ctx context.Context, now time.Time, lease time.Duration) (seg pb.InjuredSegment, err error) {
+	var attempts int32
 	err = r.db.DB.QueryRowContext(ctx, `
-	DELETE FROM injuredsegments
-		WHERE id = ( SELECT id FROM injuredsegments ORDER BY id FOR UPDATE SKIP LOCKED LIMIT 1 )
-		RETURNING info
-	`).Scan(&seg)
+	UPDATE injuredsegments SET attempts = attempts + 1,
+		leased_until = $1::timestamptz + INTERVAL '1 microsecond' * $2::float8 * power(2, LEAST(attempts, $3::integer))
+		WHERE path = (
+			SELECT path FROM injuredsegments
+				WHERE leased_until IS NULL OR leased_until < $1
+				ORDER BY health, path FOR UPDATE SKIP LOCKED LIMIT 1
+		)
+		RETURNING data, attempts
+	`, now, int64(lease/time.Microsecond), queue.MaxLeaseDoublings).Scan(&seg, &attempts)
 	if err == sql.ErrNoRows {
 		err = storage.ErrEmptyQueue.New("")
 	}
+	seg.Attempts = attempts
 	return seg, err
 }
 
-func (r *repairQueue) sqliteDequeue(ctx context.Context) (seg pb.InjuredSegment, err error) {
+func (r *repairQueue) sqliteDequeue(ctx context.Context, now time.Time, lease time.Duration) (seg pb.InjuredSegment, err error) {
 	err = r.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
-		var id int64
-		err = tx.Tx.QueryRowContext(ctx, `SELECT id, info FROM injuredsegments ORDER BY id LIMIT 1`).Scan(&id, &seg)
+		var path string
+		var attempts int32
+		err = tx.Tx.QueryRowContext(ctx, r.db.Rebind(`
+			SELECT path, data, attempts FROM injuredsegments
+				WHERE leased_until IS NULL OR leased_until < ?
+				ORDER BY health, path LIMIT 1
+		`), now).Scan(&path, &seg, &attempts)
 		if err != nil {
 			return err
 		}
-		res, err := tx.Tx.ExecContext(ctx, r.db.Rebind(`DELETE FROM injuredsegments WHERE id = ?`), id)
+		seg.Attempts = attempts + 1
+		leasedUntil := now.Add(queue.LeaseDuration(lease, seg.Attempts))
+		res, err := tx.Tx.ExecContext(ctx, r.db.Rebind(`UPDATE injuredsegments SET leased_until = ?, attempts = ? WHERE path = ?`), leasedUntil, seg.Attempts, path)
 		if err != nil {
 			return err
 		}
@@ -62,7 +88,7 @@ func (r *repairQueue) sqliteDequeue(ctx context.Context) (seg pb.InjuredSegment,
 			return err
 		}
 		if count != 1 {
-			return fmt.Errorf("Expected 1, got %d segments deleted", count)
+			return fmt.Errorf("Expected 1, got %d segments leased", count)
 		}
 		return nil
 	})
@@ -72,22 +98,34 @@ func (r *repairQueue) sqliteDequeue(ctx context.Context) (seg pb.InjuredSegment,
 	return seg, err
 }
 
-func (r *repairQueue) Dequeue(ctx context.Context) (seg pb.InjuredSegment, err error) {
+func (r *repairQueue) Dequeue(ctx context.Context, lease time.Duration) (seg pb.InjuredSegment, err error) {
+	now := time.Now().UTC()
 	switch t := r.db.DB.Driver().(type) {
 	case *sqlite3.SQLiteDriver:
-		return r.sqliteDequeue(ctx)
+		return r.sqliteDequeue(ctx, now, lease)
 	case *pq.Driver:
-		return r.postgresDequeue(ctx)
+		return r.postgresDequeue(ctx, now, lease)
 	default:
 		return seg, fmt.Errorf("Unsupported database %t", t)
 	}
 }
 
+// Delete removes the segment unless it was enqueued again with other data since it was dequeued
+func (r *repairQueue) Delete(ctx context.Context, seg *pb.InjuredSegment) error {
+	val, err := marshalInjuredSegment(seg)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, r.db.Rebind(`DELETE FROM injuredsegments WHERE path = ? AND data = ?`), seg.Path, val)
+	return err
+}
+
 func (r *repairQueue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSegment, error) {
 	if limit <= 0 || limit > storage.LookupLimit {
 		limit = storage.LookupLimit
 	}
-	rows, err := r.db.Limited_Injuredsegment(ctx, limit, 0)
+	rows, err := r.db.Limited_Injuredsegment_OrderBy_Asc_Health(ctx, limit, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -95,9 +133,10 @@ func (r *repairQueue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSeg
 	segments := make([]pb.InjuredSegment, 0)
 	for _, entry := range rows {
 		seg := &pb.InjuredSegment{}
-		if err = proto.Unmarshal(entry.Info, seg); err != nil {
+		if err = proto.Unmarshal(entry.Data, seg); err != nil {
 			return nil, err
 		}
+		seg.Attempts = int32(entry.Attempts)
 		segments = append(segments, *seg)
 	}
 	return segments, nil
@@ -5,6 +5,8 @@ package satellitedb
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
@@ -15,12 +17,13 @@ import (
 
 // implementation of Users interface repository using spacemonkeygo/dbx orm
 type users struct {
-	db dbx.Methods
+	methods dbx.Methods
+	db      *dbx.DB
 }
 
 // Get is a method for querying user from the database by id
 func (users *users) Get(ctx context.Context, id uuid.UUID) (*console.User, error) {
-	user, err := users.db.Get_User_By_Id(ctx, dbx.User_Id(id[:]))
+	user, err := users.methods.Get_User_By_Id(ctx, dbx.User_Id(id[:]))
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +33,7 @@ func (users *users) Get(ctx context.Context, id uuid.UUID) (*console.User, error
 
 // GetByEmail is a method for querying user by email from the database.
 func (users *users) GetByEmail(ctx context.Context, email string) (*console.User, error) {
-	user, err := users.db.Get_User_By_Email_And_Status_Not_Number(ctx, dbx.User_Email(email))
+	user, err := users.methods.Get_User_By_Email_And_Status_Not_Number(ctx, dbx.User_Email(email))
 
 	if err != nil {
 		return nil, err
@@ -46,7 +49,7 @@ func (users *users) Insert(ctx context.Context, user *console.User) (*console.Us
 		return nil, err
 	}
 
-	createdUser, err := users.db.Create_User(ctx,
+	createdUser, err := users.methods.Create_User(ctx,
 		dbx.User_Id(userID[:]),
 		dbx.User_FullName(user.FullName),
 		dbx.User_Email(user.Email),
@@ -65,14 +68,14 @@ func (users *users) Insert(ctx context.Context, user *console.User) (*console.Us
 
 // Delete is a method for deleting user by Id from the database.
 func (users *users) Delete(ctx context.Context, id uuid.UUID) error {
-	_, err := users.db.Delete_User_By_Id(ctx, dbx.User_Id(id[:]))
+	_, err := users.methods.Delete_User_By_Id(ctx, dbx.User_Id(id[:]))
 
 	return err
 }
 
 // Update is a method for updating user entity
 func (users *users) Update(ctx context.Context, user *console.User) error {
-	_, err := users.db.Update_User_By_Id(
+	_, err := users.methods.Update_User_By_Id(
 		ctx,
 		dbx.User_Id(user.ID[:]),
 		toUpdateUser(user),
@@ -81,6 +84,46 @@ func (users *users) Update(ctx context.Context, user *console.User) error {
 	return err
 }
 
+// AcceptMFAPasscode records the time step of the accepted MFA passcode of the user and resets the failed attempts,
+// it returns false when a passcode of the same or a later time step was accepted already.
+func (users *users) AcceptMFAPasscode(ctx context.Context, id uuid.UUID, step int64) (bool, error) {
+	result, err := users.db.ExecContext(ctx, users.db.Rebind(`
+		UPDATE users SET mfa_last_step = ?, mfa_failed_attempts = 0, mfa_locked_until = NULL
+			WHERE id = ? AND mfa_last_step < ?
+	`), step, id[:], step)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+// FailMFAAttempt counts a failed MFA attempt of the user, the maxAttempts-th one locks MFA until lockedUntil.
+func (users *users) FailMFAAttempt(ctx context.Context, id uuid.UUID, maxAttempts int, lockedUntil time.Time) error {
+	_, err := users.db.ExecContext(ctx, users.db.Rebind(`
+		UPDATE users SET
+			mfa_failed_attempts = CASE WHEN mfa_failed_attempts + 1 >= ? THEN 0 ELSE mfa_failed_attempts + 1 END,
+			mfa_locked_until = CASE WHEN mfa_failed_attempts + 1 >= ? THEN ? ELSE mfa_locked_until END
+			WHERE id = ?
+	`), maxAttempts, maxAttempts, lockedUntil.UTC(), id[:])
+
+	return err
+}
+
+// ResetMFAAttempts resets the failed MFA attempts of the user and unlocks MFA.
+func (users *users) ResetMFAAttempts(ctx context.Context, id uuid.UUID) error {
+	_, err := users.db.ExecContext(ctx, users.db.Rebind(`
+		UPDATE users SET mfa_failed_attempts = 0, mfa_locked_until = NULL WHERE id = ?
+	`), id[:])
+
+	return err
+}
+
 // toUpdateUser creates dbx.User_Update_Fields with only non-empty fields as updatable
 func toUpdateUser(user *console.User) dbx.User_Update_Fields {
 	update := dbx.User_Update_Fields{
@@ -88,6 +131,18 @@ func toUpdateUser(user *console.User) dbx.User_Update_Fields {
 		ShortName: dbx.User_ShortName(user.ShortName),
 		Email:     dbx.User_Email(user.Email),
 		Status:    dbx.User_Status(int(user.Status)),
+
+		MfaEnabled:       dbx.User_MfaEnabled(user.MFAEnabled),
+		MfaSecretKey:     dbx.User_MfaSecretKey_Null(),
+		MfaRecoveryCodes: dbx.User_MfaRecoveryCodes_Null(),
+	}
+
+	if user.MFASecretKey != "" {
+		update.MfaSecretKey = dbx.User_MfaSecretKey(user.MFASecretKey)
+	}
+
+	if len(user.MFARecoveryCodes) != 0 {
+		update.MfaRecoveryCodes = dbx.User_MfaRecoveryCodes(strings.Join(user.MFARecoveryCodes, ","))
 	}
 
 	// extra password check to update only calculated hash from service
@@ -115,12 +170,28 @@ func userFromDBX(user *dbx.User) (*console.User, error) {
 		Email:        user.Email,
 		PasswordHash: user.PasswordHash,
 		Status:       console.UserStatus(user.Status),
+		MFAEnabled:   user.MfaEnabled,
 		CreatedAt:    user.CreatedAt,
+
+		MFAFailedAttempts: user.MfaFailedAttempts,
+		MFALastStep:       user.MfaLastStep,
+	}
+
+	if user.MfaLockedUntil != nil {
+		result.MFALockedUntil = *user.MfaLockedUntil
 	}
 
 	if user.ShortName != nil {
 		result.ShortName = *user.ShortName
 	}
 
+	if user.MfaSecretKey != nil {
+		result.MFASecretKey = *user.MfaSecretKey
+	}
+
+	if user.MfaRecoveryCodes != nil && *user.MfaRecoveryCodes != "" {
+		result.MFARecoveryCodes = strings.Split(*user.MfaRecoveryCodes, ",")
+	}
+
 	return &result, nil
 }
@@ -6,6 +6,7 @@ package storage
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/zeebo/errs"
 )
@@ -54,6 +55,14 @@ type Blobs interface {
 	Open(ctx context.Context, ref BlobRef) (BlobReader, error)
 	// Delete deletes the blob with the namespace and key
 	Delete(ctx context.Context, ref BlobRef) error
+	// Trash moves the blob with the namespace and key to the trash, from
+	// where it can be restored until the trash is emptied
+	Trash(ctx context.Context, ref BlobRef) error
+	// RestoreTrash moves all the blobs of the namespace from the trash back
+	// and returns their keys
+	RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error)
+	// EmptyTrash deletes the blobs that were moved to the trash before trashedBefore
+	EmptyTrash(ctx context.Context, trashedBefore time.Time) error
 	// FreeSpace return how much free space left for writing
 	FreeSpace() (int64, error)
 }
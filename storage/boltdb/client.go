@@ -152,6 +152,24 @@ func (client *Client) Delete(key storage.Key) error {
 	})
 }
 
+// CompareAndSwap atomically replaces the value of the key with newValue, if its current value is oldValue.
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	return client.update(func(bucket *bolt.Bucket) error {
+		data := bucket.Get([]byte(key))
+		if (data == nil) != (oldValue == nil) || !bytes.Equal(data, oldValue) {
+			return storage.ErrValueChanged.New("%s", key)
+		}
+		if newValue == nil {
+			return bucket.Delete(key)
+		}
+		return bucket.Put(key, newValue)
+	})
+}
+
 // List returns either a list of keys for which boltdb has values or an error.
 func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	rv, err := storage.ListKeys(client, first, limit)
@@ -19,6 +19,9 @@ var ErrKeyNotFound = errs.Class("key not found")
 // ErrEmptyKey is returned when an empty key is used in Put
 var ErrEmptyKey = errs.Class("empty key")
 
+// ErrValueChanged is returned when the current value of the key doesn't match the old value in CompareAndSwap
+var ErrValueChanged = errs.Class("value changed")
+
 // ErrEmptyQueue is returned when attempting to Dequeue from an empty queue
 var ErrEmptyQueue = errs.Class("empty queue")
 
@@ -60,6 +63,11 @@ type KeyValueStore interface {
 	GetAll(Keys) (Values, error)
 	// Delete deletes key and the value
 	Delete(Key) error
+	// CompareAndSwap atomically replaces the value of the key with newValue,
+	// if its current value is oldValue. A nil oldValue requires that the key
+	// doesn't exist and a nil newValue deletes the key. ErrValueChanged is
+	// returned if the current value isn't oldValue.
+	CompareAndSwap(key Key, oldValue, newValue Value) error
 	// List lists all keys starting from start and upto limit items
 	List(start Key, limit int) (Keys, error)
 	// Iterate iterates over items based on opts
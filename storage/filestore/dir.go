@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -42,6 +43,7 @@ func NewDir(path string) (*Dir, error) {
 		os.MkdirAll(dir.blobdir(), dirPermission),
 		os.MkdirAll(dir.tempdir(), dirPermission),
 		os.MkdirAll(dir.trashdir(), dirPermission),
+		os.MkdirAll(dir.garbagedir(), dirPermission),
 	)
 }
 
@@ -52,6 +54,10 @@ func (dir *Dir) blobdir() string  { return filepath.Join(dir.path, "blob") }
 func (dir *Dir) tempdir() string  { return filepath.Join(dir.path, "tmp") }
 func (dir *Dir) trashdir() string { return filepath.Join(dir.path, "trash") }
 
+// garbagedir keeps the blobs found by garbage collection until they expire,
+// unlike trashdir whose content is deleted right away
+func (dir *Dir) garbagedir() string { return filepath.Join(dir.path, "garbage") }
+
 // CreateTemporaryFile creates a preallocated temporary file in the temp directory
 // prealloc preallocates file to make writing faster
 func (dir *Dir) CreateTemporaryFile(prealloc int64) (*os.File, error) {
@@ -103,6 +109,17 @@ func (dir *Dir) blobToTrashPath(ref storage.BlobRef) string {
 	return filepath.Join(dir.trashdir(), pathEncoding.EncodeToString(name))
 }
 
+// blobToGarbagePath converts blob reference to a filepath in the garbage storage
+func (dir *Dir) blobToGarbagePath(ref storage.BlobRef) (string, error) {
+	if !ref.IsValid() {
+		return "", storage.ErrInvalidBlobRef.New("")
+	}
+
+	namespace := pathEncoding.EncodeToString(ref.Namespace)
+	key := pathEncoding.EncodeToString(ref.Key)
+	return filepath.Join(dir.garbagedir(), namespace, key), nil
+}
+
 // Commit commits temporary file to the permanent storage
 func (dir *Dir) Commit(file *os.File, ref storage.BlobRef) error {
 	position, seekErr := file.Seek(0, io.SeekCurrent)
@@ -198,6 +215,130 @@ func (dir *Dir) Delete(ref storage.BlobRef) error {
 	return err
 }
 
+// MoveToGarbage moves the file with the specified ref to the garbage storage,
+// from where it can be restored until it is emptied
+func (dir *Dir) MoveToGarbage(ref storage.BlobRef) error {
+	path, err := dir.blobToPath(ref)
+	if err != nil {
+		return err
+	}
+	garbagePath, err := dir.blobToGarbagePath(ref)
+	if err != nil {
+		return err
+	}
+
+	mkdirErr := os.MkdirAll(filepath.Dir(garbagePath), dirPermission)
+	if mkdirErr != nil && !os.IsExist(mkdirErr) {
+		return mkdirErr
+	}
+
+	err = rename(path, garbagePath)
+	// ignore concurrent delete
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the modification time tells when the file was moved to the garbage
+	now := time.Now()
+	return os.Chtimes(garbagePath, now, now)
+}
+
+// RestoreGarbage moves all the files of the namespace from the garbage
+// storage back to the permanent storage
+func (dir *Dir) RestoreGarbage(namespace []byte) (keys [][]byte, err error) {
+	namespacedir := filepath.Join(dir.garbagedir(), pathEncoding.EncodeToString(namespace))
+
+	names, err := readAllDirNames(namespacedir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var errlist errs.Group
+	for _, name := range names {
+		key, err := pathEncoding.DecodeString(name)
+		if err != nil {
+			continue
+		}
+
+		ref := storage.BlobRef{Namespace: namespace, Key: key}
+		path, err := dir.blobToPath(ref)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+
+		mkdirErr := os.MkdirAll(filepath.Dir(path), dirPermission)
+		if mkdirErr != nil && !os.IsExist(mkdirErr) {
+			errlist.Add(mkdirErr)
+			continue
+		}
+
+		if err := rename(filepath.Join(namespacedir, name), path); err != nil {
+			errlist.Add(err)
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, errlist.Err()
+}
+
+// EmptyGarbage deletes the files that were moved to the garbage storage
+// before movedBefore
+func (dir *Dir) EmptyGarbage(movedBefore time.Time) error {
+	namespaces, err := readAllDirNames(dir.garbagedir())
+	if err != nil {
+		return err
+	}
+
+	var errlist errs.Group
+	for _, namespace := range namespaces {
+		namespacedir := filepath.Join(dir.garbagedir(), namespace)
+		names, err := readAllDirNames(namespacedir)
+		if err != nil {
+			errlist.Add(err)
+			continue
+		}
+
+		for _, name := range names {
+			path := filepath.Join(namespacedir, name)
+			info, err := os.Stat(path)
+			if err != nil {
+				if !os.IsNotExist(err) {
+					errlist.Add(err)
+				}
+				continue
+			}
+			if !info.ModTime().Before(movedBefore) {
+				continue
+			}
+
+			err = os.Remove(path)
+			if err != nil && !os.IsNotExist(err) && !isBusy(err) {
+				errlist.Add(err)
+			}
+		}
+	}
+
+	return errlist.Err()
+}
+
+// readAllDirNames returns the names of all the entries in the directory
+func readAllDirNames(path string) ([]string, error) {
+	dir, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	names, err := dir.Readdirnames(-1)
+	return names, errs.Combine(err, dir.Close())
+}
+
 // GarbageCollect collects files that are pending deletion
 func (dir *Dir) GarbageCollect() error {
 	offset := int(math.MaxInt32)
@@ -6,6 +6,7 @@ package filestore
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -57,6 +58,25 @@ func (store *Store) Delete(ctx context.Context, ref storage.BlobRef) error {
 	return Error.Wrap(err)
 }
 
+// Trash moves the blob with the specified ref to the trash
+func (store *Store) Trash(ctx context.Context, ref storage.BlobRef) error {
+	err := store.dir.MoveToGarbage(ref)
+	return Error.Wrap(err)
+}
+
+// RestoreTrash moves all the blobs of the namespace from the trash back to
+// the store and returns their keys
+func (store *Store) RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error) {
+	keys, err := store.dir.RestoreGarbage(namespace)
+	return keys, Error.Wrap(err)
+}
+
+// EmptyTrash deletes the blobs that were moved to the trash before trashedBefore
+func (store *Store) EmptyTrash(ctx context.Context, trashedBefore time.Time) error {
+	err := store.dir.EmptyGarbage(trashedBefore)
+	return Error.Wrap(err)
+}
+
 // GarbageCollect tries to delete any files that haven't yet been deleted
 func (store *Store) GarbageCollect(ctx context.Context) error {
 	err := store.dir.GarbageCollect()
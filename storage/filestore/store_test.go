@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -226,3 +227,52 @@ func TestDeleteWhileReading(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestStoreTrash(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(ctx.Dir("store"))
+	require.NoError(t, err)
+
+	namespace := randomValue()
+	refs := []storage.BlobRef{}
+	for i := 0; i < 4; i++ {
+		ref := storage.BlobRef{Namespace: namespace, Key: randomValue()}
+		refs = append(refs, ref)
+
+		writer, err := store.Create(ctx, ref, -1)
+		require.NoError(t, err)
+		_, err = writer.Write(ref.Key)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit())
+
+		require.NoError(t, store.Trash(ctx, ref))
+		_, err = store.Open(ctx, ref)
+		require.Error(t, err)
+	}
+
+	// blobs trashed after the given time are kept
+	require.NoError(t, store.EmptyTrash(ctx, time.Now().Add(-time.Hour)))
+
+	keys, err := store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Len(t, keys, len(refs))
+
+	for _, ref := range refs {
+		reader, err := store.Open(ctx, ref)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, ref.Key, data)
+		require.NoError(t, reader.Close())
+
+		require.NoError(t, store.Trash(ctx, ref))
+	}
+
+	require.NoError(t, store.EmptyTrash(ctx, time.Now().Add(time.Hour)))
+
+	keys, err = store.RestoreTrash(ctx, namespace)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
@@ -110,6 +110,58 @@ func (client *Client) DeletePath(bucket, key storage.Key) error {
 	return nil
 }
 
+// CompareAndSwap atomically replaces the value of the key with newValue, if its current value is oldValue.
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	return client.CompareAndSwapPath(storage.Key(defaultBucket), key, oldValue, newValue)
+}
+
+// CompareAndSwapPath atomically replaces the value of the key (in the given bucket) with newValue, if its current value is oldValue.
+func (client *Client) CompareAndSwapPath(bucket, key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	var result sql.Result
+	var err error
+	switch {
+	case oldValue == nil && newValue == nil:
+		q := "SELECT EXISTS(SELECT 1 FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA)"
+		var exists bool
+		if err := client.pgConn.QueryRow(q, []byte(bucket), []byte(key)).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return storage.ErrValueChanged.New("%s", key)
+		}
+		return nil
+	case oldValue == nil:
+		q := `
+			INSERT INTO pathdata (bucket, fullpath, metadata)
+				VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA)
+				ON CONFLICT (bucket, fullpath) DO NOTHING
+		`
+		result, err = client.pgConn.Exec(q, []byte(bucket), []byte(key), []byte(newValue))
+	case newValue == nil:
+		q := "DELETE FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA AND metadata = $3::BYTEA"
+		result, err = client.pgConn.Exec(q, []byte(bucket), []byte(key), []byte(oldValue))
+	default:
+		q := "UPDATE pathdata SET metadata = $4::BYTEA WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA AND metadata = $3::BYTEA"
+		result, err = client.pgConn.Exec(q, []byte(bucket), []byte(key), []byte(oldValue), []byte(newValue))
+	}
+	if err != nil {
+		return err
+	}
+
+	numRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if numRows == 0 {
+		return storage.ErrValueChanged.New("%s", key)
+	}
+	return nil
+}
+
 // List returns either a list of known keys, in order, or an error.
 func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	return storage.ListKeys(client, first, limit)
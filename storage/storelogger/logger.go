@@ -51,6 +51,14 @@ func (store *Logger) Delete(key storage.Key) error {
 	return store.store.Delete(key)
 }
 
+// CompareAndSwap atomically replaces the value of the key with newValue, if its current value is oldValue
+func (store *Logger) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	store.log.Debug("CompareAndSwap", zap.String("key", string(key)),
+		zap.Int("old value length", len(oldValue)), zap.Int("new value length", len(newValue)),
+		zap.Binary("truncated old value", truncate(oldValue)), zap.Binary("truncated new value", truncate(newValue)))
+	return store.store.CompareAndSwap(key, oldValue, newValue)
+}
+
 // List lists all keys starting from first and upto limit items
 func (store *Logger) List(first storage.Key, limit int) (storage.Keys, error) {
 	keys, err := store.store.List(first, limit)
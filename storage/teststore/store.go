@@ -28,6 +28,7 @@ type Client struct {
 		GetAll      int
 		ReverseList int
 		Delete      int
+		CAS         int
 		Close       int
 		Iterate     int
 	}
@@ -166,6 +167,46 @@ func (store *Client) Delete(key storage.Key) error {
 	return nil
 }
 
+// CompareAndSwap atomically replaces the value of the key with newValue, if its current value is oldValue
+func (store *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	defer store.locked()()
+
+	store.version++
+	store.CallCount.CAS++
+	if store.forcedError() {
+		return errInternal
+	}
+
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	keyIndex, found := store.indexOf(key)
+	switch {
+	case !found && oldValue != nil:
+		return storage.ErrValueChanged.New("%s", key)
+	case found && (oldValue == nil || !bytes.Equal(store.Items[keyIndex].Value, oldValue)):
+		return storage.ErrValueChanged.New("%s", key)
+	}
+
+	switch {
+	case newValue == nil && found:
+		copy(store.Items[keyIndex:], store.Items[keyIndex+1:])
+		store.Items = store.Items[:len(store.Items)-1]
+	case newValue == nil:
+	case found:
+		store.Items[keyIndex].Value = storage.CloneValue(newValue)
+	default:
+		store.Items = append(store.Items, storage.ListItem{})
+		copy(store.Items[keyIndex+1:], store.Items[keyIndex:])
+		store.Items[keyIndex] = storage.ListItem{
+			Key:   storage.CloneKey(key),
+			Value: storage.CloneValue(newValue),
+		}
+	}
+	return nil
+}
+
 // List lists all keys starting from start and upto limit items
 func (store *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	store.mu.Lock()
@@ -0,0 +1,65 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"testing"
+
+	"storj.io/storj/storage"
+)
+
+func testCompareAndSwap(t *testing.T, store storage.KeyValueStore) {
+	key := storage.Key("compare-and-swap")
+	defer func() { _ = store.Delete(key) }()
+
+	expectValue := func(expected storage.Value) {
+		t.Helper()
+		value, err := store.Get(key)
+		if expected == nil {
+			if !storage.ErrKeyNotFound.Has(err) {
+				t.Fatalf("expected %q to be missing: got %v, %v", key, value, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("failed to get %q: %v", key, err)
+		}
+		if !bytes.Equal(value, expected) {
+			t.Fatalf("invalid value for %q = %v: got %v", key, expected, value)
+		}
+	}
+	expectChanged := func(err error) {
+		t.Helper()
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("expected the value of %q to be changed: got %v", key, err)
+		}
+	}
+
+	if err := store.CompareAndSwap(key, nil, nil); err != nil {
+		t.Fatalf("failed to compare the missing %q: %v", key, err)
+	}
+	expectChanged(store.CompareAndSwap(key, storage.Value("a"), storage.Value("b")))
+	expectValue(nil)
+
+	if err := store.CompareAndSwap(key, nil, storage.Value("a")); err != nil {
+		t.Fatalf("failed to create %q: %v", key, err)
+	}
+	expectValue(storage.Value("a"))
+	expectChanged(store.CompareAndSwap(key, nil, storage.Value("b")))
+	expectChanged(store.CompareAndSwap(key, nil, nil))
+
+	if err := store.CompareAndSwap(key, storage.Value("a"), storage.Value("b")); err != nil {
+		t.Fatalf("failed to swap %q: %v", key, err)
+	}
+	expectValue(storage.Value("b"))
+	expectChanged(store.CompareAndSwap(key, storage.Value("a"), storage.Value("c")))
+	expectChanged(store.CompareAndSwap(key, storage.Value("a"), nil))
+	expectValue(storage.Value("b"))
+
+	if err := store.CompareAndSwap(key, storage.Value("b"), nil); err != nil {
+		t.Fatalf("failed to delete %q: %v", key, err)
+	}
+	expectValue(nil)
+}
@@ -4,30 +4,123 @@
 package collector
 
 import (
+	"context"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/storagenode/pieces"
 )
 
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for collector errors
+	Error = errs.Class("piece collector")
+)
+
 // Config defines parameters for storage node Collector.
 type Config struct {
-	Interval time.Duration
+	Interval        time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	TrashExpiration time.Duration `help:"how long pieces found by garbage collection are kept in the trash" default:"168h0m0s"`
 }
 
+// batchSize is the number of expired pieces fetched from the database at once
+const batchSize = 1000
+
 // Service implements collecting expired pieces on the storage node.
 type Service struct {
 	log        *zap.Logger
 	pieces     *pieces.Store
 	pieceinfos pieces.DB
+
+	trashExpiration time.Duration
+
+	Loop sync2.Cycle
 }
 
 // NewService creates a new collector service.
-func NewService(log *zap.Logger, pieces *pieces.Store, pieceinfos pieces.DB) *Service {
+func NewService(log *zap.Logger, pieces *pieces.Store, pieceinfos pieces.DB, config Config) *Service {
 	return &Service{
 		log:        log,
 		pieces:     pieces,
 		pieceinfos: pieceinfos,
+
+		trashExpiration: config.TrashExpiration,
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs collector service until the context is canceled.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+
+		err := service.Collect(ctx, now)
+		if err != nil {
+			service.log.Error("error during collecting pieces: ", zap.Error(err))
+		}
+
+		err = service.pieces.EmptyTrash(ctx, now.Add(-service.trashExpiration))
+		if err != nil {
+			service.log.Error("error during emptying trash: ", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Collect deletes the pieces that have expired before now, in batches. Pieces
+// whose deletion fails are retried on the next call with another now.
+func (service *Service) Collect(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var count, reclaimed int64
+	defer func() {
+		if count > 0 {
+			service.log.Info("collected expired pieces", zap.Int64("count", count), zap.Int64("bytes", reclaimed))
+		}
+		mon.IntVal("pieces_collected").Observe(count)
+		mon.IntVal("bytes_reclaimed").Observe(reclaimed)
+	}()
+
+	for {
+		expired, err := service.pieceinfos.GetExpired(ctx, now, batchSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(expired) == 0 {
+			return nil
+		}
+
+		for _, info := range expired {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			err := service.pieces.Delete(ctx, info.SatelliteID, info.PieceID)
+			if err != nil {
+				service.log.Warn("unable to delete piece", zap.Stringer("satellite", info.SatelliteID), zap.Stringer("piece", info.PieceID), zap.Error(err))
+
+				err = service.pieceinfos.DeleteFailed(ctx, info.SatelliteID, info.PieceID, now)
+				if err != nil {
+					return Error.Wrap(err)
+				}
+				continue
+			}
+
+			err = service.pieceinfos.Delete(ctx, info.SatelliteID, info.PieceID)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			count++
+			reclaimed += info.PieceSize
+		}
 	}
 }
@@ -0,0 +1,101 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package collector_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+func TestCollector(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		for _, storageNode := range planet.StorageNodes {
+			// stop the collector loops so they don't interfere with the test
+			storageNode.Storage2.Collector.Loop.Pause()
+		}
+
+		config := planet.Uplinks[0].GetConfig(planet.Satellites[0])
+		metainfo, streams, err := config.GetMetainfo(ctx, planet.Uplinks[0].Identity)
+		require.NoError(t, err)
+
+		_, err = metainfo.CreateBucket(ctx, "testbucket", &storj.Bucket{PathCipher: storj.AESGCM})
+		require.NoError(t, err)
+
+		now := time.Now()
+
+		obj, err := metainfo.CreateObject(ctx, "testbucket", "expiring", &storj.CreateObject{
+			Expires: now.Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		mutableStream, err := obj.CreateStream(ctx)
+		require.NoError(t, err)
+
+		data := make([]byte, 50*memory.KiB)
+		_, err = rand.Read(data)
+		require.NoError(t, err)
+
+		upload := stream.NewUpload(ctx, mutableStream, streams)
+		_, err = io.Copy(upload, bytes.NewReader(data))
+		require.NoError(t, err)
+		require.NoError(t, upload.Close())
+
+		var stored int64
+		for _, storageNode := range planet.StorageNodes {
+			used, err := storageNode.DB.PieceInfo().SpaceUsed(ctx)
+			require.NoError(t, err)
+			stored += used
+		}
+		require.NotZero(t, stored)
+
+		// nothing has expired yet
+		for _, storageNode := range planet.StorageNodes {
+			before, err := storageNode.DB.PieceInfo().SpaceUsed(ctx)
+			require.NoError(t, err)
+
+			err = storageNode.Storage2.Collector.Collect(ctx, now)
+			require.NoError(t, err)
+
+			after, err := storageNode.DB.PieceInfo().SpaceUsed(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, before, after)
+		}
+
+		// all the pieces have expired
+		for _, storageNode := range planet.StorageNodes {
+			collected, err := storageNode.DB.PieceInfo().GetExpired(ctx, now.Add(2*time.Hour), 10)
+			require.NoError(t, err)
+
+			err = storageNode.Storage2.Collector.Collect(ctx, now.Add(2*time.Hour))
+			require.NoError(t, err)
+
+			for _, info := range collected {
+				_, err := storageNode.Storage2.Store.Reader(ctx, info.SatelliteID, info.PieceID)
+				assert.Error(t, err)
+			}
+
+			used, err := storageNode.DB.PieceInfo().SpaceUsed(ctx)
+			require.NoError(t, err)
+			assert.Zero(t, used)
+
+			expired, err := storageNode.DB.PieceInfo().GetExpired(ctx, now.Add(2*time.Hour), 10)
+			require.NoError(t, err)
+			assert.Empty(t, expired)
+		}
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/storage"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
@@ -61,7 +62,8 @@ type Config struct {
 	Kademlia kademlia.Config
 	Storage  psserver.Config
 
-	Storage2 piecestore.Config
+	Storage2  piecestore.Config
+	Collector collector.Config
 
 	Version version.Config
 }
@@ -104,6 +106,7 @@ type Peer struct {
 		Inspector *inspector.Endpoint
 		Monitor   *monitor.Service
 		Sender    *orders.Sender
+		Collector *collector.Service
 	}
 }
 
@@ -123,7 +126,10 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.Log.Sugar().Debugf("Binary Version: %s with CommitHash %s, built at %s as Release %v",
 				versionInfo.Version.String(), versionInfo.CommitHash, versionInfo.Timestamp.String(), versionInfo.Release)
 		}
-		peer.Version = version.NewService(config.Version, versionInfo, "Storagenode")
+		peer.Version, err = version.NewService(config.Version, versionInfo, "Storagenode")
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup listener and server
@@ -243,6 +249,13 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config, ver
 			peer.DB.Orders(),
 			config.Storage2.Sender,
 		)
+
+		peer.Storage2.Collector = collector.NewService(
+			log.Named("piecestore:collector"),
+			peer.Storage2.Store,
+			peer.DB.PieceInfo(),
+			config.Collector,
+		)
 	}
 
 	return peer, nil
@@ -270,6 +283,9 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return ignoreCancel(peer.Storage2.Monitor.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Storage2.Collector.Run(ctx))
+	})
 	group.Go(func() error {
 		// TODO: move the message into Server instead
 		// Don't change the format of this comment, it is used to figure out the node id.
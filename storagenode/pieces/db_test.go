@@ -51,6 +51,7 @@ func TestPieceInfo(t *testing.T) {
 			PieceID:         pieceid0,
 			PieceSize:       123,
 			PieceExpiration: &now,
+			PieceCreation:   now,
 
 			UplinkPieceHash: piecehash0,
 			Uplink:          uplink0.PeerIdentity(),
@@ -97,6 +98,42 @@ func TestPieceInfo(t *testing.T) {
 		require.NoError(t, err)
 		require.Empty(t, cmp.Diff(info1, info1loaded, cmp.Comparer(pb.Equal)))
 
+		// getting the pieces created before a date
+		pieceIDs, err := pieceinfos.GetPieceIDs(ctx, satellite0.ID, now.Add(time.Second), 10, storj.PieceID{})
+		require.NoError(t, err)
+		require.Equal(t, []storj.PieceID{pieceid0}, pieceIDs)
+
+		pieceIDs, err = pieceinfos.GetPieceIDs(ctx, satellite0.ID, now, 10, storj.PieceID{})
+		require.NoError(t, err)
+		require.Empty(t, pieceIDs)
+
+		pieceIDs, err = pieceinfos.GetPieceIDs(ctx, satellite0.ID, now.Add(time.Second), 10, pieceid0)
+		require.NoError(t, err)
+		require.Empty(t, pieceIDs)
+
+		// getting expired pieces
+		expiredAt := now.Add(time.Second)
+		expired, err := pieceinfos.GetExpired(ctx, expiredAt, 10)
+		require.NoError(t, err)
+		require.Len(t, expired, 2)
+
+		expired, err = pieceinfos.GetExpired(ctx, now.Add(-time.Second), 10)
+		require.NoError(t, err)
+		require.Empty(t, expired)
+
+		// pieces that failed to be deleted are skipped until the next attempt
+		err = pieceinfos.DeleteFailed(ctx, info0.SatelliteID, info0.PieceID, expiredAt)
+		require.NoError(t, err)
+
+		expired, err = pieceinfos.GetExpired(ctx, expiredAt, 10)
+		require.NoError(t, err)
+		require.Len(t, expired, 1)
+		require.Equal(t, info1.SatelliteID, expired[0].SatelliteID)
+
+		expired, err = pieceinfos.GetExpired(ctx, expiredAt.Add(time.Second), 10)
+		require.NoError(t, err)
+		require.Len(t, expired, 2)
+
 		// deleting
 		err = pieceinfos.Delete(ctx, info0.SatelliteID, info0.PieceID)
 		require.NoError(t, err)
@@ -33,11 +33,19 @@ type Info struct {
 	PieceID         storj.PieceID
 	PieceSize       int64
 	PieceExpiration *time.Time
+	PieceCreation   time.Time
 
 	UplinkPieceHash *pb.PieceHash
 	Uplink          *identity.PeerIdentity
 }
 
+// ExpiredInfo is a fully namespaced piece id
+type ExpiredInfo struct {
+	SatelliteID storj.NodeID
+	PieceID     storj.PieceID
+	PieceSize   int64
+}
+
 // DB stores meta information about a piece, the actual piece is stored in storage.Blobs
 type DB interface {
 	// Add inserts Info to the database.
@@ -46,8 +54,16 @@ type DB interface {
 	Get(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (*Info, error)
 	// Delete deletes Info about a piece.
 	Delete(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) error
+	// DeleteFailed marks piece as a failed deletion.
+	DeleteFailed(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, failedAt time.Time) error
 	// SpaceUsed calculates disk space used by all pieces
 	SpaceUsed(ctx context.Context) (int64, error)
+	// GetExpired gets at most limit pieces that have expired before expiredAt
+	// and whose deletion hasn't failed at expiredAt.
+	GetExpired(ctx context.Context, expiredAt time.Time, limit int64) ([]ExpiredInfo, error)
+	// GetPieceIDs gets at most limit IDs of the pieces of the satellite that
+	// were created before createdBefore, ordered by ID and starting after cursor.
+	GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, limit int, cursor storj.PieceID) ([]storj.PieceID, error)
 }
 
 // Store implements storing pieces onto a blob storage implementation.
@@ -101,6 +117,37 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 	return Error.Wrap(err)
 }
 
+// Trash moves the specified piece to the trash.
+func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) error {
+	err := store.blobs.Trash(ctx, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	})
+	return Error.Wrap(err)
+}
+
+// RestoreTrash moves all the trashed pieces of the satellite back and
+// returns their IDs.
+func (store *Store) RestoreTrash(ctx context.Context, satellite storj.NodeID) ([]storj.PieceID, error) {
+	keys, err := store.blobs.RestoreTrash(ctx, satellite.Bytes())
+	pieceIDs := make([]storj.PieceID, 0, len(keys))
+	for _, key := range keys {
+		pieceID, idErr := storj.PieceIDFromBytes(key)
+		if idErr != nil {
+			err = errs.Combine(err, idErr)
+			continue
+		}
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+	return pieceIDs, Error.Wrap(err)
+}
+
+// EmptyTrash deletes the pieces that were trashed before trashedBefore.
+func (store *Store) EmptyTrash(ctx context.Context, trashedBefore time.Time) error {
+	err := store.blobs.EmptyTrash(ctx, trashedBefore)
+	return Error.Wrap(err)
+}
+
 // StorageStatus contains information about the disk store is using.
 type StorageStatus struct {
 	DiskUsed int64
@@ -17,8 +17,10 @@ import (
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/auth/signing"
+	"storj.io/storj/pkg/bloomfilter"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
@@ -41,6 +43,7 @@ var _ pb.PiecestoreServer = (*Endpoint)(nil)
 // Config defines parameters for piecestore endpoint.
 type Config struct {
 	ExpirationGracePeriod time.Duration `help:"how soon before expiration date should things be considered expired" default:"48h0m0s"`
+	RetainTimeBuffer      time.Duration `help:"allows the satellite and the storage node clocks to differ when collecting garbage" default:"1h0m0s"`
 
 	Monitor monitor.Config
 	Sender  orders.SenderConfig
@@ -108,6 +111,71 @@ func (endpoint *Endpoint) Delete(ctx context.Context, delete *pb.PieceDeleteRequ
 	return &pb.PieceDeleteResponse{}, nil
 }
 
+// retainBatchSize is the number of piece IDs checked against the bloom filter at once
+const retainBatchSize = 1000
+
+// Retain keeps only the pieces of the calling satellite that are in the bloom
+// filter. The pieces created before the filter that are not in it are moved
+// to the trash.
+func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainRequest) (_ *pb.RetainResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := endpoint.trust.VerifySatelliteID(ctx, peer.ID); err != nil {
+		return nil, Error.New("retain called with untrusted ID")
+	}
+
+	filter, err := bloomfilter.NewFromBytes(retainReq.GetFilter())
+	if err != nil {
+		return nil, ErrProtocol.Wrap(err)
+	}
+
+	creationDate, err := ptypes.Timestamp(retainReq.GetCreationDate())
+	if err != nil {
+		return nil, ErrProtocol.Wrap(err)
+	}
+	createdBefore := creationDate.Add(-endpoint.config.RetainTimeBuffer)
+
+	var count int64
+	var cursor storj.PieceID
+	for {
+		pieceIDs, err := endpoint.pieceinfo.GetPieceIDs(ctx, peer.ID, createdBefore, retainBatchSize, cursor)
+		if err != nil {
+			return nil, ErrInternal.Wrap(err)
+		}
+
+		for _, pieceID := range pieceIDs {
+			if filter.Contains(pieceID) {
+				continue
+			}
+
+			if err := endpoint.store.Trash(ctx, peer.ID, pieceID); err != nil {
+				endpoint.log.Error("failed to move piece to trash", zap.Stringer("Piece ID", pieceID), zap.Error(err))
+				continue
+			}
+
+			if err := endpoint.pieceinfo.Delete(ctx, peer.ID, pieceID); err != nil {
+				return nil, ErrInternal.Wrap(err)
+			}
+			count++
+		}
+
+		if len(pieceIDs) < retainBatchSize {
+			break
+		}
+		cursor = pieceIDs[len(pieceIDs)-1]
+	}
+
+	endpoint.log.Info("moved garbage pieces to trash", zap.Stringer("Satellite ID", peer.ID), zap.Int64("count", count))
+	mon.IntVal("garbage_pieces").Observe(count)
+
+	return &pb.RetainResponse{}, nil
+}
+
 // Upload handles uploading a piece on piece store.
 func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error) {
 	ctx := stream.Context()
@@ -229,6 +297,7 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 					PieceID:         limit.PieceId,
 					PieceSize:       pieceWriter.Size(),
 					PieceExpiration: expiration,
+					PieceCreation:   time.Now(),
 
 					UplinkPieceHash: message.Done,
 					Uplink:          peer,
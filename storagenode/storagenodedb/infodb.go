@@ -163,6 +163,23 @@ func (db *InfoDB) Migration() *migrate.Migration {
 					`CREATE INDEX idx_order_archive_status ON order_archive(status)`,
 				},
 			},
+			{
+				Description: "Add tracking of expired piece collection",
+				Version:     1,
+				Action: migrate.SQL{
+					// date when deleting the expired piece failed, to retry it later
+					`ALTER TABLE pieceinfo ADD COLUMN deletion_failed_at TIMESTAMP`,
+					`CREATE INDEX idx_pieceinfo_expiration ON pieceinfo(piece_expiration)`,
+				},
+			},
+			{
+				Description: "Add piece creation date for garbage collection",
+				Version:     2,
+				Action: migrate.SQL{
+					// pieces without a creation date were uploaded before the migration
+					`ALTER TABLE pieceinfo ADD COLUMN piece_creation TIMESTAMP`,
+				},
+			},
 		},
 	}
 }
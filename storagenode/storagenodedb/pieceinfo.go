@@ -6,8 +6,10 @@ package storagenodedb
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
@@ -39,9 +41,9 @@ func (db *pieceinfo) Add(ctx context.Context, info *pieces.Info) error {
 
 	_, err = db.db.Exec(`
 		INSERT INTO
-			pieceinfo(satellite_id, piece_id, piece_size, piece_expiration, uplink_piece_hash, uplink_cert_id)
-		VALUES (?,?,?,?,?,?)
-	`, info.SatelliteID, info.PieceID, info.PieceSize, info.PieceExpiration, uplinkPieceHash, certid)
+			pieceinfo(satellite_id, piece_id, piece_size, piece_expiration, piece_creation, uplink_piece_hash, uplink_cert_id)
+		VALUES (?,?,?,?,?,?,?)
+	`, info.SatelliteID, info.PieceID, info.PieceSize, info.PieceExpiration, info.PieceCreation.UTC(), uplinkPieceHash, certid)
 
 	return ErrInfo.Wrap(err)
 }
@@ -52,21 +54,25 @@ func (db *pieceinfo) Get(ctx context.Context, satelliteID storj.NodeID, pieceID
 	info.SatelliteID = satelliteID
 	info.PieceID = pieceID
 
+	var pieceCreation *time.Time
 	var uplinkPieceHash []byte
 	var uplinkIdentity []byte
 
 	db.mu.Lock()
 	err := db.db.QueryRow(`
-		SELECT piece_size, piece_expiration, uplink_piece_hash, certificate.peer_identity
+		SELECT piece_size, piece_expiration, piece_creation, uplink_piece_hash, certificate.peer_identity
 		FROM pieceinfo
 		INNER JOIN certificate ON pieceinfo.uplink_cert_id = certificate.cert_id
 		WHERE satellite_id = ? AND piece_id = ?
-	`, satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceExpiration, &uplinkPieceHash, &uplinkIdentity)
+	`, satelliteID, pieceID).Scan(&info.PieceSize, &info.PieceExpiration, &pieceCreation, &uplinkPieceHash, &uplinkIdentity)
 	db.mu.Unlock()
 
 	if err != nil {
 		return nil, ErrInfo.Wrap(err)
 	}
+	if pieceCreation != nil {
+		info.PieceCreation = *pieceCreation
+	}
 
 	info.UplinkPieceHash = &pb.PieceHash{}
 	err = proto.Unmarshal(uplinkPieceHash, info.UplinkPieceHash)
@@ -91,6 +97,76 @@ func (db *pieceinfo) Delete(ctx context.Context, satelliteID storj.NodeID, piece
 	return ErrInfo.Wrap(err)
 }
 
+// DeleteFailed marks piece as a failed deletion.
+func (db *pieceinfo) DeleteFailed(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, failedAt time.Time) error {
+	defer db.locked()()
+
+	_, err := db.db.Exec(`
+		UPDATE pieceinfo
+		SET deletion_failed_at = ?
+		WHERE satellite_id = ? AND piece_id = ?
+	`, failedAt, satelliteID, pieceID)
+
+	return ErrInfo.Wrap(err)
+}
+
+// GetExpired gets pieces that are expired and were not yet attempted to be deleted at expiredAt.
+func (db *pieceinfo) GetExpired(ctx context.Context, expiredAt time.Time, limit int64) (infos []pieces.ExpiredInfo, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.Query(`
+		SELECT satellite_id, piece_id, piece_size
+		FROM pieceinfo
+		WHERE piece_expiration < ? AND (deletion_failed_at IS NULL OR deletion_failed_at <> ?)
+		ORDER BY satellite_id
+		LIMIT ?
+	`, expiredAt, expiredAt, limit)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrInfo.Wrap(rows.Close())) }()
+
+	for rows.Next() {
+		info := pieces.ExpiredInfo{}
+		err = rows.Scan(&info.SatelliteID, &info.PieceID, &info.PieceSize)
+		if err != nil {
+			return infos, ErrInfo.Wrap(err)
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, ErrInfo.Wrap(rows.Err())
+}
+
+// GetPieceIDs gets the IDs of the pieces of the satellite created before createdBefore,
+// pieces without a creation date are treated as created before any date.
+func (db *pieceinfo) GetPieceIDs(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time, limit int, cursor storj.PieceID) (pieceIDs []storj.PieceID, err error) {
+	defer db.locked()()
+
+	rows, err := db.db.Query(`
+		SELECT piece_id
+		FROM pieceinfo
+		WHERE satellite_id = ? AND piece_id > ? AND (piece_creation IS NULL OR piece_creation < ?)
+		ORDER BY piece_id
+		LIMIT ?
+	`, satelliteID, cursor, createdBefore.UTC(), limit)
+	if err != nil {
+		return nil, ErrInfo.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrInfo.Wrap(rows.Close())) }()
+
+	for rows.Next() {
+		var pieceID storj.PieceID
+		err = rows.Scan(&pieceID)
+		if err != nil {
+			return pieceIDs, ErrInfo.Wrap(err)
+		}
+		pieceIDs = append(pieceIDs, pieceID)
+	}
+
+	return pieceIDs, ErrInfo.Wrap(rows.Err())
+}
+
 // SpaceUsed calculates disk space used by all pieces
 func (db *pieceinfo) SpaceUsed(ctx context.Context) (int64, error) {
 	defer db.locked()()
@@ -6,15 +6,18 @@ package uplink
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/vivint/infectious"
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/memory"
+	libuplink "storj.io/storj/lib/uplink"
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/peertls/tlsopts"
 	"storj.io/storj/pkg/storage/buckets"
@@ -35,6 +38,9 @@ type RSConfig struct {
 	RepairThreshold  int         `help:"the minimum safe pieces before a repair is triggered. m." default:"35" devDefault:"6"`
 	SuccessThreshold int         `help:"the desired total pieces for a segment. o." default:"80" devDefault:"8"`
 	MaxThreshold     int         `help:"the largest amount of pieces to encode to. n." default:"95" devDefault:"10"`
+
+	DownloadExtra       int           `help:"the number of pieces downloaded in addition to the minimum, the others are downloaded only instead of the failing and slow ones (-1 downloads all pieces)" default:"2"`
+	DownloadSlowTimeout time.Duration `help:"how long to wait for a slow piece download before downloading another piece instead (0 waits until it fails)" default:"5s"`
 }
 
 // EncryptionConfig is a configuration struct that keeps details about
@@ -51,6 +57,7 @@ type EncryptionConfig struct {
 type ClientConfig struct {
 	APIKey        string      `default:"" help:"the api key to use for the satellite" noprefix:"true"`
 	SatelliteAddr string      `default:"localhost:7778" devDefault:"localhost:10000" help:"the address to use for the satellite" noprefix:"true"`
+	Access        string      `default:"" help:"the serialized access to use instead of the api key, satellite address and encryption key" noprefix:"true"`
 	MaxInlineSize memory.Size `help:"max inline segment size in bytes" default:"4KiB"`
 	SegmentSize   memory.Size `help:"the size of a segment in bytes" default:"64MiB"`
 }
@@ -70,8 +77,9 @@ var (
 	Error = errs.Class("Uplink configuration error")
 )
 
-// GetMetainfo returns an implementation of storj.Metainfo
-func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity) (db storj.Metainfo, ss streams.Store, err error) {
+// GetMetainfo returns an implementation of storj.Metainfo, whose Close
+// closes its connection to the satellite
+func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity) (db *kvmetainfo.DB, ss streams.Store, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	tlsOpts, err := tlsopts.NewOptions(identity, c.TLS)
@@ -83,16 +91,27 @@ func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity
 
 	tc := transport.NewClient(tlsOpts)
 
-	if c.Client.SatelliteAddr == "" {
+	satelliteAddr, apiKey, keys, err := c.access()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if satelliteAddr == "" {
 		return nil, nil, errors.New("satellite address not specified")
 	}
 
-	metainfo, err := metainfo.NewClient(ctx, tc, c.Client.SatelliteAddr, c.Client.APIKey)
+	metainfo, err := metainfo.NewClient(ctx, tc, satelliteAddr, apiKey)
 	if err != nil {
 		return nil, nil, Error.New("failed to connect to metainfo service: %v", err)
 	}
 
 	ec := ecclient.NewClient(tc, c.RS.MaxBufferMem.Int())
+	if c.RS.DownloadExtra >= 0 {
+		ec = ec.WithLongTail(eestream.LongTail{
+			Extra:       c.RS.DownloadExtra,
+			SlowTimeout: c.RS.DownloadSlowTimeout,
+		})
+	}
 	fc, err := infectious.NewFEC(c.RS.MinThreshold, c.RS.MaxThreshold)
 	if err != nil {
 		return nil, nil, Error.New("failed to create erasure coding client: %v", err)
@@ -113,17 +132,85 @@ func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity
 		return nil, nil, err
 	}
 
-	key := new(storj.Key)
-	copy(key[:], c.Enc.Key)
-
-	streams, err := streams.NewStreamStore(segments, c.Client.SegmentSize.Int64(), key, c.Enc.BlockSize.Int(), storj.Cipher(c.Enc.DataType))
+	streams, err := streams.NewStreamStore(segments, c.Client.SegmentSize.Int64(), keys, c.Enc.BlockSize.Int(), storj.Cipher(c.Enc.DataType))
 	if err != nil {
 		return nil, nil, Error.New("failed to create stream store: %v", err)
 	}
 
 	buckets := buckets.NewStore(streams)
 
-	return kvmetainfo.New(metainfo, buckets, streams, segments, key), streams, nil
+	return kvmetainfo.New(metainfo, buckets, streams, segments, keys), streams, nil
+}
+
+// access returns the satellite address, the api key and the encryption keys
+// to use, taken from the serialized access when one is configured
+func (c Config) access() (satelliteAddr, apiKey string, keys *encryption.Store, err error) {
+	if c.Client.Access == "" {
+		key := new(storj.Key)
+		copy(key[:], c.Enc.Key)
+		return c.Client.SatelliteAddr, c.Client.APIKey, encryption.NewStore(key), nil
+	}
+
+	access, err := c.Access()
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	keys, err = access.EncryptionAccess.Store()
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return access.SatelliteAddr, access.APIKey.Serialize(), keys, nil
+}
+
+// Access returns the access the uplink is configured with, either the
+// serialized access or the api key, satellite address and encryption key
+func (c Config) Access() (libuplink.Access, error) {
+	if c.Client.Access != "" {
+		access, err := libuplink.ParseAccess(c.Client.Access)
+		if err != nil {
+			return libuplink.Access{}, Error.New("invalid access: %v", err)
+		}
+		return access, nil
+	}
+
+	apiKey, err := libuplink.ParseAPIKey(c.Client.APIKey)
+	if err != nil {
+		return libuplink.Access{}, err
+	}
+
+	access := libuplink.Access{
+		SatelliteAddr: c.Client.SatelliteAddr,
+		APIKey:        apiKey,
+	}
+	copy(access.EncryptionAccess.Key[:], c.Enc.Key)
+	return access, nil
+}
+
+// Restrict returns a copy of the config with the api key restricted by caveat
+func (c Config) Restrict(caveat macaroon.Caveat) (Config, error) {
+	access, err := c.Access()
+	if err != nil {
+		return Config{}, err
+	}
+
+	apiKey, err := access.APIKey.Restrict(caveat)
+	if err != nil {
+		return Config{}, Error.Wrap(err)
+	}
+
+	if c.Client.Access == "" {
+		c.Client.APIKey = apiKey.Serialize()
+		return c, nil
+	}
+
+	access.APIKey = apiKey
+	c.Client.Access, err = access.Serialize()
+	if err != nil {
+		return Config{}, Error.Wrap(err)
+	}
+	return c, nil
 }
 
 // GetRedundancyScheme returns the configured redundancy scheme for new uploads
@@ -31,6 +31,7 @@ var (
 
 // Metainfo creates a grpcClient
 type Metainfo struct {
+	conn   *grpc.ClientConn
 	client pb.MetainfoClient
 }
 
@@ -57,6 +58,16 @@ type Client interface {
 	ReadSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64) (*pb.Pointer, []*pb.AddressedOrderLimit, error)
 	DeleteSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64) ([]*pb.AddressedOrderLimit, error)
 	ListSegments(ctx context.Context, bucket string, prefix, startAfter, endBefore storj.Path, recursive bool, limit int32, metaFlags uint32) (items []ListItem, more bool, err error)
+	MoveSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, newBucket string, newPath storj.Path, newSegmentIndex int64, newMetadata []byte) (*pb.Pointer, error)
+	CopySegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, newBucket string, newPath storj.Path, newSegmentIndex int64, newMetadata []byte) (*pb.Pointer, error)
+
+	SetBucketLifecycle(ctx context.Context, bucket string, lifecycle *pb.BucketLifecycle) error
+	GetBucketLifecycle(ctx context.Context, bucket string) (*pb.BucketLifecycle, error)
+
+	SetBucketPlacement(ctx context.Context, bucket string, placement *pb.BucketPlacement) error
+	GetBucketPlacement(ctx context.Context, bucket string) (*pb.BucketPlacement, error)
+
+	RevokeAPIKey(ctx context.Context, apiKey string) error
 }
 
 // NewClient initializes a new metainfo client
@@ -71,7 +82,15 @@ func NewClient(ctx context.Context, tc transport.Client, address string, APIKey
 		return nil, Error.Wrap(err)
 	}
 
-	return &Metainfo{client: pb.NewMetainfoClient(conn)}, nil
+	return &Metainfo{conn: conn, client: pb.NewMetainfoClient(conn)}, nil
+}
+
+// Close closes the connection of a client created with NewClient
+func (metainfo *Metainfo) Close() error {
+	if metainfo.conn == nil {
+		return nil
+	}
+	return Error.Wrap(metainfo.conn.Close())
 }
 
 // CreateSegment requests the order limits for creating a new segment
@@ -223,3 +242,129 @@ func (metainfo *Metainfo) ListSegments(ctx context.Context, bucket string, prefi
 
 	return items, response.GetMore(), nil
 }
+
+// MoveSegment requests to move the pointer of a segment to a new path and replace its metadata
+func (metainfo *Metainfo) MoveSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, newBucket string, newPath storj.Path, newSegmentIndex int64, newMetadata []byte) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := metainfo.client.MoveSegment(ctx, &pb.SegmentMoveRequest{
+		Bucket:      []byte(bucket),
+		Path:        []byte(path),
+		Segment:     segmentIndex,
+		NewBucket:   []byte(newBucket),
+		NewPath:     []byte(newPath),
+		NewSegment:  newSegmentIndex,
+		NewMetadata: newMetadata,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return response.GetPointer(), nil
+}
+
+// CopySegment requests to copy the pointer of a segment to a new path with new metadata
+func (metainfo *Metainfo) CopySegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, newBucket string, newPath storj.Path, newSegmentIndex int64, newMetadata []byte) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := metainfo.client.CopySegment(ctx, &pb.SegmentCopyRequest{
+		Bucket:      []byte(bucket),
+		Path:        []byte(path),
+		Segment:     segmentIndex,
+		NewBucket:   []byte(newBucket),
+		NewPath:     []byte(newPath),
+		NewSegment:  newSegmentIndex,
+		NewMetadata: newMetadata,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return response.GetPointer(), nil
+}
+
+// SetBucketLifecycle requests to replace the lifecycle rules of a bucket
+func (metainfo *Metainfo) SetBucketLifecycle(ctx context.Context, bucket string, lifecycle *pb.BucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = metainfo.client.SetBucketLifecycle(ctx, &pb.SetBucketLifecycleRequest{
+		Bucket:    []byte(bucket),
+		Lifecycle: lifecycle,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetBucketLifecycle requests the lifecycle rules of a bucket
+func (metainfo *Metainfo) GetBucketLifecycle(ctx context.Context, bucket string) (lifecycle *pb.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := metainfo.client.GetBucketLifecycle(ctx, &pb.GetBucketLifecycleRequest{
+		Bucket: []byte(bucket),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return response.GetLifecycle(), nil
+}
+
+// SetBucketPlacement requests to replace the placement constraints of a bucket
+func (metainfo *Metainfo) SetBucketPlacement(ctx context.Context, bucket string, placement *pb.BucketPlacement) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = metainfo.client.SetBucketPlacement(ctx, &pb.SetBucketPlacementRequest{
+		Bucket:    []byte(bucket),
+		Placement: placement,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetBucketPlacement requests the placement constraints of a bucket
+func (metainfo *Metainfo) GetBucketPlacement(ctx context.Context, bucket string) (placement *pb.BucketPlacement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	response, err := metainfo.client.GetBucketPlacement(ctx, &pb.GetBucketPlacementRequest{
+		Bucket: []byte(bucket),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return response.GetPlacement(), nil
+}
+
+// RevokeAPIKey requests to revoke an api key restricted from the api key of the client
+func (metainfo *Metainfo) RevokeAPIKey(ctx context.Context, apiKey string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = metainfo.client.RevokeAPIKey(ctx, &pb.RevokeAPIKeyRequest{
+		ApiKey: apiKey,
+	})
+	return Error.Wrap(err)
+}
@@ -65,6 +65,12 @@ func (client *Client) Delete(ctx context.Context, limit *pb.OrderLimit2) error {
 	return Error.Wrap(err)
 }
 
+// Retain sends a bloom filter of the pieces the storage node should keep.
+func (client *Client) Retain(ctx context.Context, req *pb.RetainRequest) error {
+	_, err := client.client.Retain(ctx, req)
+	return Error.Wrap(err)
+}
+
 // Close closes the underlying connection.
 func (client *Client) Close() error {
 	return client.conn.Close()
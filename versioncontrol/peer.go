@@ -5,22 +5,36 @@ package versioncontrol
 
 import (
 	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/pkcrypto"
 )
 
+// Error is the default error class for the version control server
+var Error = errs.Class("versioncontrol error")
+
 // Config is all the configuration parameters for a Version Control Server
 type Config struct {
-	Address  string `user:"true" help:"public address to listen on" default:":8080"`
-	Versions ServiceVersions
+	Address    string        `user:"true" help:"public address to listen on" default:":8080"`
+	SigningKey string        `user:"true" help:"path to the PEM encoded private key signing the responses, the responses aren't signed if empty" default:""`
+	Expiration time.Duration `user:"true" help:"how long the responses are valid, they are created again after half of it" default:"24h0m0s"`
+	Versions   ServiceVersions
+	Binary     ProcessesConfig
 }
 
 // ServiceVersions provides a list of allowed Versions per Service
@@ -32,6 +46,35 @@ type ServiceVersions struct {
 	Gateway     string `user:"true" help:"Allowed Gateway Versions" default:"v0.0.1"`
 }
 
+// ProcessesConfig provides the version information per Service
+type ProcessesConfig struct {
+	Bootstrap   ProcessConfig
+	Satellite   ProcessConfig
+	Storagenode ProcessConfig
+	Uplink      ProcessConfig
+	Gateway     ProcessConfig
+}
+
+// ProcessConfig is the version information of a Service
+type ProcessConfig struct {
+	Minimum   VersionConfig
+	Suggested VersionConfig
+	Rollout   RolloutConfig
+}
+
+// VersionConfig is a version of a Service with its download URL
+type VersionConfig struct {
+	Version string `user:"true" help:"version, empty if not set" default:""`
+	URL     string `user:"true" help:"download URL template, where {version}, {os} and {arch} are replaced" default:""`
+	SHA256  string `user:"true" help:"comma separated SHA-256 hashes of the downloads per platform, like linux_amd64:<hex>,windows_amd64:<hex>" default:""`
+}
+
+// RolloutConfig is the staged rollout of the suggested version
+type RolloutConfig struct {
+	Seed   string `user:"true" help:"random seed selecting the nodes, it should be changed for every rollout" default:""`
+	Cursor int    `user:"true" help:"percentage of the nodes updating to the suggested version" default:"0"`
+}
+
 // Peer is the representation of a VersionControl Server.
 type Peer struct {
 	// core dependencies
@@ -44,8 +87,17 @@ type Peer struct {
 	}
 	Versions version.AllowedVersions
 
+	// signingKey signs the responses, they aren't signed if it's nil
+	signingKey crypto.PrivateKey
+	expiration time.Duration
+
+	mu sync.Mutex
 	// response contains the byte version of current allowed versions
 	response []byte
+	// signature is the base64 encoded signature of the response
+	signature string
+	// created is when the response was created
+	created time.Time
 }
 
 func ignoreCancel(err error) error {
@@ -69,42 +121,130 @@ func (peer *Peer) HandleGet(w http.ResponseWriter, r *http.Request) {
 	}
 	zap.S().Debugf("Request from: %s for %s", r.RemoteAddr, xfor)
 
+	response, signature, err := peer.currentResponse(time.Now())
+	if err != nil {
+		zap.S().Errorf("error creating response: %v", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	_, err := w.Write(peer.response)
+	if signature != "" {
+		w.Header().Set(version.SignatureHeader, signature)
+	}
+	_, err = w.Write(response)
 	if err != nil {
 		zap.S().Errorf("error writing response to client: %v", err)
 	}
 }
 
+// currentResponse returns the response and its signature, they are created
+// again once half of their expiration has passed
+func (peer *Peer) currentResponse(now time.Time) (response []byte, signature string, err error) {
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	if peer.response != nil && now.Sub(peer.created) < peer.expiration/2 {
+		return peer.response, peer.signature, nil
+	}
+
+	versions := peer.Versions
+	versions.Timestamp = now.UTC()
+	versions.Expires = now.Add(peer.expiration).UTC()
+
+	response, err = json.Marshal(versions)
+	if err != nil {
+		return nil, "", Error.New("error marshalling version info: %v", err)
+	}
+
+	if peer.signingKey != nil {
+		signatureData, err := pkcrypto.HashAndSign(peer.signingKey, response)
+		if err != nil {
+			return nil, "", Error.Wrap(err)
+		}
+		signature = base64.StdEncoding.EncodeToString(signatureData)
+	}
+
+	peer.response, peer.signature, peer.created = response, signature, now
+	return response, signature, nil
+}
+
 // New creates a new VersionControl Server.
 func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
+	if config.Expiration <= 0 {
+		return nil, Error.New("invalid expiration %v: must be positive", config.Expiration)
+	}
+
 	peer = &Peer{
-		Log: log,
+		Log:        log,
+		expiration: config.Expiration,
 	}
 
 	// Convert each Service's Version String to List of SemVer
 	bootstrapVersions := strings.Split(config.Versions.Bootstrap, ",")
 	peer.Versions.Bootstrap, err = version.StrToSemVerList(bootstrapVersions)
+	if err != nil {
+		return nil, Error.New("invalid bootstrap versions: %v", err)
+	}
 
 	satelliteVersions := strings.Split(config.Versions.Satellite, ",")
 	peer.Versions.Satellite, err = version.StrToSemVerList(satelliteVersions)
+	if err != nil {
+		return nil, Error.New("invalid satellite versions: %v", err)
+	}
 
 	storagenodeVersions := strings.Split(config.Versions.Storagenode, ",")
 	peer.Versions.Storagenode, err = version.StrToSemVerList(storagenodeVersions)
+	if err != nil {
+		return nil, Error.New("invalid storagenode versions: %v", err)
+	}
 
 	uplinkVersions := strings.Split(config.Versions.Uplink, ",")
 	peer.Versions.Uplink, err = version.StrToSemVerList(uplinkVersions)
+	if err != nil {
+		return nil, Error.New("invalid uplink versions: %v", err)
+	}
 
 	gatewayVersions := strings.Split(config.Versions.Gateway, ",")
 	peer.Versions.Gateway, err = version.StrToSemVerList(gatewayVersions)
+	if err != nil {
+		return nil, Error.New("invalid gateway versions: %v", err)
+	}
+
+	peer.Versions.Processes.Bootstrap, err = configToProcess(config.Binary.Bootstrap)
+	if err != nil {
+		return nil, Error.New("invalid bootstrap binary: %v", err)
+	}
+	peer.Versions.Processes.Satellite, err = configToProcess(config.Binary.Satellite)
+	if err != nil {
+		return nil, Error.New("invalid satellite binary: %v", err)
+	}
+	peer.Versions.Processes.Storagenode, err = configToProcess(config.Binary.Storagenode)
+	if err != nil {
+		return nil, Error.New("invalid storagenode binary: %v", err)
+	}
+	peer.Versions.Processes.Uplink, err = configToProcess(config.Binary.Uplink)
+	if err != nil {
+		return nil, Error.New("invalid uplink binary: %v", err)
+	}
+	peer.Versions.Processes.Gateway, err = configToProcess(config.Binary.Gateway)
+	if err != nil {
+		return nil, Error.New("invalid gateway binary: %v", err)
+	}
 
-	peer.response, err = json.Marshal(peer.Versions)
+	if config.SigningKey != "" {
+		peer.signingKey, err = loadSigningKey(log, config.SigningKey)
+		if err != nil {
+			return nil, err
+		}
+	}
 
+	response, _, err := peer.currentResponse(time.Now())
 	if err != nil {
-		peer.Log.Sugar().Fatalf("Error marshalling version info: %v", err)
+		return nil, err
 	}
 
-	peer.Log.Sugar().Debugf("setting version info to: %v", string(peer.response))
+	peer.Log.Sugar().Debugf("setting version info to: %v", string(response))
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", peer.HandleGet)
@@ -119,6 +259,88 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 	return peer, nil
 }
 
+// configToProcess converts the version information of a Service from the config
+func configToProcess(config ProcessConfig) (process version.Process, err error) {
+	process.Minimum, err = configToVersion(config.Minimum)
+	if err != nil {
+		return version.Process{}, err
+	}
+	process.Suggested, err = configToVersion(config.Suggested)
+	if err != nil {
+		return version.Process{}, err
+	}
+	if !process.Suggested.Version.IsZero() && process.Suggested.Version.Compare(process.Minimum.Version) < 0 {
+		return version.Process{}, errs.New("suggested version %s is older than the minimum %s",
+			process.Suggested.Version.String(), process.Minimum.Version.String())
+	}
+	if config.Rollout.Cursor < 0 || config.Rollout.Cursor > 100 {
+		return version.Process{}, errs.New("rollout cursor %d isn't a percentage", config.Rollout.Cursor)
+	}
+	process.Rollout = version.Rollout{
+		Seed:   config.Rollout.Seed,
+		Cursor: config.Rollout.Cursor,
+	}
+	return process, nil
+}
+
+// configToVersion converts a version from the config, the version is zero if
+// not set
+func configToVersion(config VersionConfig) (version.Version, error) {
+	hashes, err := parseHashes(config.SHA256)
+	if err != nil {
+		return version.Version{}, err
+	}
+	if config.Version == "" {
+		return version.Version{URL: config.URL, SHA256: hashes}, nil
+	}
+	sv, err := version.NewSemVer(config.Version)
+	if err != nil {
+		return version.Version{}, err
+	}
+	return version.Version{Version: *sv, URL: config.URL, SHA256: hashes}, nil
+}
+
+// parseHashes parses the comma separated platform:hash pairs of the hex
+// encoded SHA-256 hashes
+func parseHashes(config string) (map[string]string, error) {
+	if config == "" {
+		return nil, nil
+	}
+	hashes := map[string]string{}
+	for _, pair := range strings.Split(config, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errs.New("invalid SHA-256 hash %q: must be platform:hash", pair)
+		}
+		hash, err := hex.DecodeString(parts[1])
+		if err != nil || len(hash) != sha256.Size {
+			return nil, errs.New("invalid SHA-256 hash of %s: %q", parts[0], parts[1])
+		}
+		hashes[parts[0]] = strings.ToLower(parts[1])
+	}
+	return hashes, nil
+}
+
+// loadSigningKey loads the PEM encoded private key in keyPath, which signs the responses
+func loadSigningKey(log *zap.Logger, keyPath string) (crypto.PrivateKey, error) {
+	keyPEM, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, Error.New("unable to read signing key: %v", err)
+	}
+	key, err := pkcrypto.PrivateKeyFromPEM(keyPEM)
+	if err != nil {
+		return nil, Error.New("invalid signing key: %v", err)
+	}
+
+	publicKey, err := pkcrypto.PublicKeyToPKIX(pkcrypto.PublicKeyFromPrivate(key))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	log.Sugar().Infof("signing responses, the public key is %s", base64.StdEncoding.EncodeToString(publicKey))
+
+	return key, nil
+}
+
 // Run runs versioncontrol server until it's either closed or it errors.
 func (peer *Peer) Run(ctx context.Context) (err error) {
 
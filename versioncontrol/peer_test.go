@@ -0,0 +1,165 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package versioncontrol_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/versioncontrol"
+)
+
+func TestSignedVersions(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	key, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	keyPEM, err := pkcrypto.PrivateKeyToPEM(key)
+	require.NoError(t, err)
+	keyPath := ctx.File("signing.key")
+	require.NoError(t, ioutil.WriteFile(keyPath, keyPEM, 0600))
+
+	publicKey, err := pkcrypto.PublicKeyToPKIX(pkcrypto.PublicKeyFromPrivate(key))
+	require.NoError(t, err)
+
+	otherKey, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	otherPublicKey, err := pkcrypto.PublicKeyToPKIX(pkcrypto.PublicKeyFromPrivate(otherKey))
+	require.NoError(t, err)
+
+	config := &versioncontrol.Config{
+		Address:    "127.0.0.1:0",
+		SigningKey: keyPath,
+		Expiration: time.Hour,
+		Versions: versioncontrol.ServiceVersions{
+			Bootstrap:   "v0.0.1",
+			Satellite:   "v0.0.1",
+			Storagenode: "v0.0.1",
+			Uplink:      "v0.0.1",
+			Gateway:     "v0.0.1",
+		},
+	}
+	config.Binary.Storagenode = versioncontrol.ProcessConfig{
+		Minimum: versioncontrol.VersionConfig{Version: "v0.2.0"},
+		Suggested: versioncontrol.VersionConfig{
+			Version: "v0.3.0",
+			URL:     "https://example.com/{version}/storagenode_{os}_{arch}.zip",
+			SHA256:  "linux_amd64:" + strings.Repeat("ab", sha256.Size),
+		},
+		Rollout: versioncontrol.RolloutConfig{Seed: "seed", Cursor: 100},
+	}
+
+	peer, err := versioncontrol.New(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+	ctx.Go(func() error { return peer.Run(ctx) })
+	defer ctx.Check(peer.Close)
+
+	newService := func(v string, publicKey []byte) *version.Service {
+		sv, err := version.NewSemVer(v)
+		require.NoError(t, err)
+		service, err := version.NewService(version.Config{
+			ServerAddress:  fmt.Sprintf("http://%s/", peer.Addr()),
+			RequestTimeout: 15 * time.Second,
+			CheckInterval:  time.Minute,
+			PublicKey:      base64.StdEncoding.EncodeToString(publicKey),
+		}, version.Info{Version: *sv, Release: true}, "Storagenode")
+		require.NoError(t, err)
+		return service
+	}
+
+	var nodeID storj.NodeID
+
+	{ // the responses expire
+		ver, err := version.QueryAllowedVersions(ctx, version.Config{
+			ServerAddress:  fmt.Sprintf("http://%s/", peer.Addr()),
+			RequestTimeout: 15 * time.Second,
+			PublicKey:      base64.StdEncoding.EncodeToString(publicKey),
+		})
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), ver.Timestamp, time.Minute)
+		assert.WithinDuration(t, ver.Timestamp.Add(time.Hour), ver.Expires, time.Second)
+	}
+
+	{ // the public key is required
+		_, err := version.QueryAllowedVersions(ctx, version.Config{
+			ServerAddress:  fmt.Sprintf("http://%s/", peer.Addr()),
+			RequestTimeout: 15 * time.Second,
+		})
+		assert.Error(t, err)
+	}
+
+	{ // older than the minimum
+		service := newService("v0.1.0", publicKey)
+		assert.Error(t, service.CheckVersion(ctx))
+		assert.False(t, service.IsAllowed())
+		assert.True(t, service.ShouldUpdate(nodeID))
+	}
+
+	{ // allowed, the suggested version is rolled out
+		service := newService("v0.2.1", publicKey)
+		assert.NoError(t, service.CheckVersion(ctx))
+		assert.True(t, service.IsAllowed())
+		assert.True(t, service.ShouldUpdate(nodeID))
+
+		process, ok := service.Process()
+		require.True(t, ok)
+		assert.Equal(t, "https://example.com/v0.3.0/storagenode_linux_amd64.zip",
+			process.Suggested.DownloadURL("linux", "amd64"))
+		assert.Equal(t, strings.Repeat("ab", sha256.Size), process.Suggested.DownloadSHA256("linux", "amd64"))
+		assert.Empty(t, process.Suggested.DownloadSHA256("windows", "amd64"))
+	}
+
+	{ // up to date
+		service := newService("v0.3.0", publicKey)
+		assert.NoError(t, service.CheckVersion(ctx))
+		assert.False(t, service.ShouldUpdate(nodeID))
+	}
+
+	{ // the responses signed by another key don't allow running
+		service := newService("v0.3.0", otherPublicKey)
+		assert.Error(t, service.CheckVersion(ctx))
+		assert.False(t, service.IsAllowed())
+		_, ok := service.Process()
+		assert.False(t, ok)
+	}
+}
+
+func TestInvalidHashes(t *testing.T) {
+	for _, hashes := range []string{
+		"linux_amd64",
+		"linux_amd64:xyz",
+		"linux_amd64:abcd",
+		":" + strings.Repeat("ab", sha256.Size),
+	} {
+		config := &versioncontrol.Config{
+			Address:    "127.0.0.1:0",
+			Expiration: time.Hour,
+			Versions: versioncontrol.ServiceVersions{
+				Bootstrap:   "v0.0.1",
+				Satellite:   "v0.0.1",
+				Storagenode: "v0.0.1",
+				Uplink:      "v0.0.1",
+				Gateway:     "v0.0.1",
+			},
+		}
+		config.Binary.Storagenode.Suggested = versioncontrol.VersionConfig{Version: "v0.3.0", SHA256: hashes}
+
+		_, err := versioncontrol.New(zaptest.NewLogger(t), config)
+		assert.Error(t, err, hashes)
+	}
+}